@@ -19,6 +19,9 @@ local volumes = import 'volumes.libsonnet';
         template+: {
           spec+: {
             volumes: volumes.backendVolumes,
+            // Exceeds shutdown_delay plus shutdown_timeout, so that requests
+            // in progress drain before the container is killed.
+            terminationGracePeriodSeconds: 45,
             soloContainer:: base.Container('core-service') {
               image: metadata.backend.image,
               imagePullPolicy: 'Always',
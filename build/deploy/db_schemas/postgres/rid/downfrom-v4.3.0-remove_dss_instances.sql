@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS dss_instances;
+UPDATE schema_versions set schema_version = 'v4.2.0' WHERE onerow_enforcer = TRUE;
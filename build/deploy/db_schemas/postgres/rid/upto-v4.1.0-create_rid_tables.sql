@@ -0,0 +1,46 @@
+-- PostgreSQL equivalent of the CockroachDB rid schema up to v4.1.0.
+CREATE TABLE IF NOT EXISTS subscriptions (
+    id UUID PRIMARY KEY,
+    owner TEXT NOT NULL,
+    url TEXT NOT NULL,
+    notification_index INT4 DEFAULT 0,
+    starts_at TIMESTAMPTZ,
+    ends_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ NOT NULL,
+    cells BIGINT[] NOT NULL,
+    writer TEXT,
+    CHECK (starts_at IS NULL OR ends_at IS NULL OR starts_at < ends_at),
+    CONSTRAINT subs_cells_not_null CHECK (array_length(cells, 1) IS NOT NULL)
+);
+CREATE INDEX IF NOT EXISTS subscriptions_owner_idx ON subscriptions (owner);
+CREATE INDEX IF NOT EXISTS subscriptions_starts_at_idx ON subscriptions (starts_at);
+CREATE INDEX IF NOT EXISTS subscriptions_ends_at_idx ON subscriptions (ends_at);
+CREATE INDEX IF NOT EXISTS subscriptions_cell_idx ON subscriptions USING GIN (cells);
+CREATE INDEX IF NOT EXISTS subs_by_time_with_owner ON subscriptions (ends_at) INCLUDE (owner);
+
+CREATE TABLE IF NOT EXISTS identification_service_areas (
+    id UUID PRIMARY KEY,
+    owner TEXT NOT NULL,
+    url TEXT NOT NULL,
+    starts_at TIMESTAMPTZ,
+    ends_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ NOT NULL,
+    cells BIGINT[] NOT NULL,
+    writer TEXT,
+    deleted_at TIMESTAMPTZ,
+    CHECK (starts_at IS NULL OR ends_at IS NULL OR starts_at < ends_at),
+    CONSTRAINT isa_cells_not_null CHECK (array_length(cells, 1) IS NOT NULL)
+);
+CREATE INDEX IF NOT EXISTS identification_service_areas_owner_idx ON identification_service_areas (owner);
+CREATE INDEX IF NOT EXISTS identification_service_areas_starts_at_idx ON identification_service_areas (starts_at);
+CREATE INDEX IF NOT EXISTS identification_service_areas_ends_at_idx ON identification_service_areas (ends_at);
+CREATE INDEX IF NOT EXISTS identification_service_areas_updated_at_idx ON identification_service_areas (updated_at);
+CREATE INDEX IF NOT EXISTS identification_service_areas_cell_idx ON identification_service_areas USING GIN (cells);
+CREATE INDEX IF NOT EXISTS isas_by_deleted_at ON identification_service_areas (deleted_at);
+
+CREATE TABLE IF NOT EXISTS schema_versions (
+    onerow_enforcer bool PRIMARY KEY DEFAULT TRUE CHECK(onerow_enforcer),
+    schema_version TEXT NOT NULL
+);
+
+INSERT INTO schema_versions (schema_version) VALUES ('v4.1.0');
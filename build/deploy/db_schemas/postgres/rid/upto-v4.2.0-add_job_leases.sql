@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS job_leases (
+    name TEXT PRIMARY KEY,
+    holder TEXT NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+UPDATE schema_versions set schema_version = 'v4.2.0' WHERE onerow_enforcer = TRUE;
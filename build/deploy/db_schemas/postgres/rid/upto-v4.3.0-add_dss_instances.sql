@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS dss_instances (
+    id TEXT PRIMARY KEY,
+    process TEXT NOT NULL,
+    hostname TEXT NOT NULL,
+    locality TEXT NOT NULL,
+    version TEXT NOT NULL,
+    commit_hash TEXT NOT NULL,
+    started_at TIMESTAMPTZ NOT NULL,
+    reported_at TIMESTAMPTZ NOT NULL,
+    last_seen_at TIMESTAMPTZ NOT NULL
+);
+UPDATE schema_versions set schema_version = 'v4.3.0' WHERE onerow_enforcer = TRUE;
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS rate_limit_buckets (
+    id TEXT PRIMARY KEY,
+    tokens FLOAT8 NOT NULL,
+    taken BOOL NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+UPDATE schema_versions set schema_version = 'v4.4.0' WHERE onerow_enforcer = TRUE;
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS maintenance_windows (
+    id TEXT PRIMARY KEY,
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ,
+    reads_available BOOL NOT NULL,
+    message TEXT NOT NULL,
+    scheduled_by TEXT NOT NULL,
+    scheduled_at TIMESTAMPTZ NOT NULL
+);
+UPDATE schema_versions set schema_version = 'v4.5.0' WHERE onerow_enforcer = TRUE;
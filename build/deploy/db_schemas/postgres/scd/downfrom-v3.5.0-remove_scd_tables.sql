@@ -0,0 +1,10 @@
+DROP TABLE IF EXISTS scd_entity_changes;
+DROP TABLE IF EXISTS scd_dss_reports;
+DROP TABLE IF EXISTS scd_notification_deliveries;
+DROP TABLE IF EXISTS scd_operation_metadata;
+DROP TABLE IF EXISTS scd_uss_availability;
+DROP TABLE IF EXISTS scd_constraints;
+DROP TABLE IF EXISTS scd_operations;
+DROP TABLE IF EXISTS scd_subscriptions;
+DROP TYPE IF EXISTS operational_intent_state;
+DROP TABLE IF EXISTS schema_versions;
@@ -0,0 +1,124 @@
+-- PostgreSQL equivalent of the CockroachDB scd schema up to v3.5.0.
+CREATE TYPE operational_intent_state AS ENUM ('Unknown', 'Accepted', 'Activated', 'Nonconforming', 'Contingent');
+
+CREATE TABLE IF NOT EXISTS scd_subscriptions (
+  id UUID PRIMARY KEY,
+  owner TEXT NOT NULL,
+  version INT4 NOT NULL DEFAULT 0,
+  url TEXT NOT NULL,
+  notification_index INT4 DEFAULT 0,
+  notify_for_operations BOOL DEFAULT false,
+  notify_for_constraints BOOL DEFAULT false,
+  implicit BOOL DEFAULT false,
+  starts_at TIMESTAMPTZ,
+  ends_at TIMESTAMPTZ,
+  updated_at TIMESTAMPTZ NOT NULL,
+  cells BIGINT[],
+  CHECK (starts_at IS NULL OR ends_at IS NULL OR starts_at < ends_at),
+  CHECK (notify_for_operations OR notify_for_constraints)
+);
+CREATE INDEX IF NOT EXISTS scd_subscriptions_owner_idx ON scd_subscriptions (owner);
+CREATE INDEX IF NOT EXISTS scd_subscriptions_starts_at_idx ON scd_subscriptions (starts_at);
+CREATE INDEX IF NOT EXISTS scd_subscriptions_ends_at_idx ON scd_subscriptions (ends_at);
+CREATE INDEX IF NOT EXISTS scd_subscriptions_cell_idx ON scd_subscriptions USING GIN (cells);
+
+CREATE TABLE IF NOT EXISTS scd_operations (
+  id UUID PRIMARY KEY,
+  owner TEXT NOT NULL,
+  version INT4 NOT NULL DEFAULT 0,
+  url TEXT NOT NULL,
+  altitude_lower REAL,
+  altitude_upper REAL,
+  starts_at TIMESTAMPTZ,
+  ends_at TIMESTAMPTZ,
+  subscription_id UUID REFERENCES scd_subscriptions(id) ON DELETE CASCADE,
+  updated_at TIMESTAMPTZ NOT NULL,
+  state operational_intent_state NOT NULL DEFAULT 'Unknown',
+  cells BIGINT[],
+  CHECK (starts_at IS NULL OR ends_at IS NULL OR starts_at < ends_at)
+);
+CREATE INDEX IF NOT EXISTS scd_operations_owner_idx ON scd_operations (owner);
+CREATE INDEX IF NOT EXISTS scd_operations_altitude_lower_idx ON scd_operations (altitude_lower);
+CREATE INDEX IF NOT EXISTS scd_operations_altitude_upper_idx ON scd_operations (altitude_upper);
+CREATE INDEX IF NOT EXISTS scd_operations_starts_at_idx ON scd_operations (starts_at);
+CREATE INDEX IF NOT EXISTS scd_operations_ends_at_idx ON scd_operations (ends_at);
+CREATE INDEX IF NOT EXISTS scd_operations_updated_at_idx ON scd_operations (updated_at);
+CREATE INDEX IF NOT EXISTS scd_operations_subscription_id_idx ON scd_operations (subscription_id);
+CREATE INDEX IF NOT EXISTS scd_operations_cell_idx ON scd_operations USING GIN (cells);
+
+CREATE TABLE IF NOT EXISTS scd_constraints (
+  id UUID PRIMARY KEY,
+  owner TEXT NOT NULL,
+  version INT4 NOT NULL DEFAULT 0,
+  url TEXT NOT NULL,
+  altitude_lower REAL,
+  altitude_upper REAL,
+  starts_at TIMESTAMPTZ,
+  ends_at TIMESTAMPTZ,
+  updated_at TIMESTAMPTZ NOT NULL,
+  cells BIGINT[] NOT NULL CHECK (array_length(cells, 1) IS NOT NULL),
+  CHECK (starts_at IS NULL OR ends_at IS NULL OR starts_at < ends_at)
+);
+CREATE INDEX IF NOT EXISTS scd_constraints_cells_idx ON scd_constraints USING GIN (cells);
+CREATE INDEX IF NOT EXISTS scd_constraints_owner_idx ON scd_constraints (owner);
+CREATE INDEX IF NOT EXISTS scd_constraints_starts_at_idx ON scd_constraints (starts_at);
+CREATE INDEX IF NOT EXISTS scd_constraints_ends_at_idx ON scd_constraints (ends_at);
+
+CREATE TABLE IF NOT EXISTS scd_uss_availability (
+  id TEXT PRIMARY KEY,
+  availability TEXT NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS scd_operation_metadata (
+  id UUID PRIMARY KEY REFERENCES scd_operations (id) ON DELETE CASCADE,
+  priority INT4 NOT NULL DEFAULT 0,
+  off_nominal_since TIMESTAMPTZ,
+  CHECK (priority >= 0)
+);
+
+CREATE TABLE IF NOT EXISTS scd_notification_deliveries (
+  id UUID PRIMARY KEY,
+  subscription_id UUID NOT NULL,
+  owner TEXT NOT NULL,
+  entity_id UUID NOT NULL,
+  notification_index INT4 NOT NULL,
+  url TEXT NOT NULL,
+  status TEXT NOT NULL,
+  attempts INT4 NOT NULL DEFAULT 0,
+  last_error TEXT,
+  created_at TIMESTAMPTZ NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX IF NOT EXISTS notification_deliveries_by_subscription ON scd_notification_deliveries (subscription_id, created_at);
+CREATE INDEX IF NOT EXISTS notification_deliveries_by_created_at ON scd_notification_deliveries (created_at);
+
+CREATE TABLE IF NOT EXISTS scd_dss_reports (
+  id UUID PRIMARY KEY,
+  reporter TEXT NOT NULL,
+  exchange JSONB NOT NULL,
+  dss_records JSONB,
+  created_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX IF NOT EXISTS dss_reports_by_reporter ON scd_dss_reports (reporter, created_at);
+CREATE INDEX IF NOT EXISTS dss_reports_by_created_at ON scd_dss_reports (created_at);
+
+CREATE TABLE IF NOT EXISTS scd_entity_changes (
+  id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  entity_type TEXT NOT NULL,
+  entity_id UUID NOT NULL,
+  change TEXT NOT NULL,
+  owner TEXT NOT NULL,
+  version INT4 NOT NULL,
+  cells BIGINT[] NOT NULL,
+  occurred_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX IF NOT EXISTS entity_changes_cell_idx ON scd_entity_changes USING GIN (cells);
+CREATE INDEX IF NOT EXISTS entity_changes_by_occurred_at ON scd_entity_changes (occurred_at);
+
+CREATE TABLE IF NOT EXISTS schema_versions (
+  onerow_enforcer bool PRIMARY KEY DEFAULT TRUE CHECK(onerow_enforcer),
+  schema_version TEXT NOT NULL
+);
+
+INSERT INTO schema_versions (schema_version) VALUES ('v3.5.0');
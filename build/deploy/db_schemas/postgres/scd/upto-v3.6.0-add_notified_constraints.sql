@@ -0,0 +1,3 @@
+ALTER TABLE scd_notification_deliveries ADD COLUMN IF NOT EXISTS constraint_json TEXT;
+UPDATE scd_notification_deliveries SET status = 'Failed', last_error = 'Recorded before the notified Constraint was recorded with notifications' WHERE status = 'Pending';
+UPDATE schema_versions set schema_version = 'v3.6.0' WHERE onerow_enforcer = TRUE;
@@ -6,6 +6,16 @@
     "upto-v3.1.0-add_writer_column.sql": importstr "rid/upto-v3.1.0-add_writer_column.sql",
     "upto-v3.1.1-add_index_by_time_subscriptions.sql": importstr "rid/upto-v3.1.1-add_index_by_time_subscriptions.sql",
     "upto-v4.0.0-rename_defaultdb_to_rid.sql": importstr "rid/upto-v4.0.0-rename_defaultdb_to_rid.sql",
+    "upto-v4.1.0-add_isa_tombstones.sql": importstr "rid/upto-v4.1.0-add_isa_tombstones.sql",
+    "upto-v4.2.0-add_job_leases.sql": importstr "rid/upto-v4.2.0-add_job_leases.sql",
+    "upto-v4.3.0-add_dss_instances.sql": importstr "rid/upto-v4.3.0-add_dss_instances.sql",
+    "upto-v4.4.0-add_rate_limit_buckets.sql": importstr "rid/upto-v4.4.0-add_rate_limit_buckets.sql",
+    "upto-v4.5.0-add_maintenance_windows.sql": importstr "rid/upto-v4.5.0-add_maintenance_windows.sql",
+    "downfrom-v4.5.0-remove_maintenance_windows.sql": importstr "rid/downfrom-v4.5.0-remove_maintenance_windows.sql",
+    "downfrom-v4.4.0-remove_rate_limit_buckets.sql": importstr "rid/downfrom-v4.4.0-remove_rate_limit_buckets.sql",
+    "downfrom-v4.3.0-remove_dss_instances.sql": importstr "rid/downfrom-v4.3.0-remove_dss_instances.sql",
+    "downfrom-v4.2.0-remove_job_leases.sql": importstr "rid/downfrom-v4.2.0-remove_job_leases.sql",
+    "downfrom-v4.1.0-remove_isa_tombstones.sql": importstr "rid/downfrom-v4.1.0-remove_isa_tombstones.sql",
     "downfrom-v4.0.0-move_rid_to_defaultdb.sql": importstr "rid/downfrom-v4.0.0-move_rid_to_defaultdb.sql",
     "downfrom-v3.1.1-remove_index_by_time_subscriptions.sql": importstr "rid/downfrom-v3.1.1-remove_index_by_time_subscriptions.sql",
     "downfrom-v3.1.0-remove_writer_column.sql": importstr "rid/downfrom-v3.1.0-remove_writer_column.sql",
@@ -0,0 +1,4 @@
+DELETE FROM identification_service_areas WHERE deleted_at IS NOT NULL;
+DROP INDEX IF EXISTS identification_service_areas@isas_by_deleted_at;
+ALTER TABLE identification_service_areas DROP COLUMN IF EXISTS deleted_at;
+UPDATE schema_versions set schema_version = 'v4.0.0' WHERE onerow_enforcer = TRUE;
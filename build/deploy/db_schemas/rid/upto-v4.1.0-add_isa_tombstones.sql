@@ -0,0 +1,3 @@
+ALTER TABLE identification_service_areas ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+CREATE INDEX IF NOT EXISTS isas_by_deleted_at ON identification_service_areas (deleted_at);
+UPDATE schema_versions set schema_version = 'v4.1.0' WHERE onerow_enforcer = TRUE;
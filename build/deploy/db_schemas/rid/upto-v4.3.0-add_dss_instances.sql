@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS dss_instances (
+    id STRING PRIMARY KEY,
+    process STRING NOT NULL,
+    hostname STRING NOT NULL,
+    locality STRING NOT NULL,
+    version STRING NOT NULL,
+    commit_hash STRING NOT NULL,
+    started_at TIMESTAMPTZ NOT NULL,
+    reported_at TIMESTAMPTZ NOT NULL,
+    last_seen_at TIMESTAMPTZ NOT NULL
+);
+UPDATE schema_versions set schema_version = 'v4.3.0' WHERE onerow_enforcer = TRUE;
@@ -4,6 +4,16 @@
     "upto-v2.0.0-support_api_1_0_0.sql": importstr "rid/upto-v2.0.0-support_api_1_0_0.sql",
     "upto-v3.0.0-add_inverted_indices.sql": importstr "rid/upto-v3.0.0-add_inverted_indices.sql",
     "upto-v3.1.0-create_uss_availability.sql": importstr "rid/upto-v3.1.0-create_uss_availability.sql",
+    "upto-v3.2.0-add_operational_intent_metadata.sql": importstr "scd/upto-v3.2.0-add_operational_intent_metadata.sql",
+    "upto-v3.3.0-add_notification_deliveries.sql": importstr "scd/upto-v3.3.0-add_notification_deliveries.sql",
+    "upto-v3.4.0-add_dss_reports.sql": importstr "scd/upto-v3.4.0-add_dss_reports.sql",
+    "upto-v3.5.0-add_entity_changes.sql": importstr "scd/upto-v3.5.0-add_entity_changes.sql",
+    "upto-v3.6.0-add_notified_constraints.sql": importstr "scd/upto-v3.6.0-add_notified_constraints.sql",
+    "downfrom-v3.6.0-remove_notified_constraints.sql": importstr "scd/downfrom-v3.6.0-remove_notified_constraints.sql",
+    "downfrom-v3.5.0-remove_entity_changes.sql": importstr "scd/downfrom-v3.5.0-remove_entity_changes.sql",
+    "downfrom-v3.4.0-remove_dss_reports.sql": importstr "scd/downfrom-v3.4.0-remove_dss_reports.sql",
+    "downfrom-v3.3.0-remove_notification_deliveries.sql": importstr "scd/downfrom-v3.3.0-remove_notification_deliveries.sql",
+    "downfrom-v3.2.0-remove_operational_intent_metadata.sql": importstr "scd/downfrom-v3.2.0-remove_operational_intent_metadata.sql",
     "downfrom-v3.1.0-remove_uss_availability.sql": importstr "rid/downfrom-v3.1.0-remove_uss_availability.sql",
     "downfrom-v3.0.0-remove_inverted_indices.sql": importstr "rid/downfrom-v3.0.0-remove_inverted_indices.sql",
     "downfrom-v2.0.0-remove_api_1_0_0_support.sql": importstr "rid/downfrom-v2.0.0-remove_api_1_0_0_support.sql",
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS scd_operation_metadata;
+UPDATE schema_versions set schema_version = 'v3.1.0' WHERE onerow_enforcer = TRUE;
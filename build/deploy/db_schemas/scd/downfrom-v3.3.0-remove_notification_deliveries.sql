@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS scd_notification_deliveries;
+UPDATE schema_versions set schema_version = 'v3.2.0' WHERE onerow_enforcer = TRUE;
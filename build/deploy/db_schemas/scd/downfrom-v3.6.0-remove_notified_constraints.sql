@@ -0,0 +1,2 @@
+ALTER TABLE scd_notification_deliveries DROP COLUMN IF EXISTS constraint_json;
+UPDATE schema_versions set schema_version = 'v3.5.0' WHERE onerow_enforcer = TRUE;
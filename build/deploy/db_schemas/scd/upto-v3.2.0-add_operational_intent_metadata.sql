@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS scd_operation_metadata (
+  id UUID PRIMARY KEY REFERENCES scd_operations (id) ON DELETE CASCADE,
+  priority INT4 NOT NULL DEFAULT 0,
+  off_nominal_since TIMESTAMPTZ,
+  CHECK (priority >= 0)
+);
+
+/* Update database version */
+UPDATE schema_versions set schema_version = 'v3.2.0' WHERE onerow_enforcer = TRUE;
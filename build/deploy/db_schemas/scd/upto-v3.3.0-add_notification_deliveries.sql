@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS scd_notification_deliveries (
+  id UUID PRIMARY KEY,
+  subscription_id UUID NOT NULL,
+  owner STRING NOT NULL,
+  entity_id UUID NOT NULL,
+  notification_index INT4 NOT NULL,
+  url STRING NOT NULL,
+  status STRING NOT NULL,
+  attempts INT4 NOT NULL DEFAULT 0,
+  last_error STRING,
+  created_at TIMESTAMPTZ NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL,
+  INDEX notification_deliveries_by_subscription (subscription_id, created_at),
+  INDEX notification_deliveries_by_created_at (created_at)
+);
+
+/* Update database version */
+UPDATE schema_versions set schema_version = 'v3.3.0' WHERE onerow_enforcer = TRUE;
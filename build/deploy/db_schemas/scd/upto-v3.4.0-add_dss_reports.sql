@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS scd_dss_reports (
+  id UUID PRIMARY KEY,
+  reporter STRING NOT NULL,
+  exchange JSONB NOT NULL,
+  dss_records JSONB,
+  created_at TIMESTAMPTZ NOT NULL,
+  INDEX dss_reports_by_reporter (reporter, created_at),
+  INDEX dss_reports_by_created_at (created_at)
+);
+
+/* Update database version */
+UPDATE schema_versions set schema_version = 'v3.4.0' WHERE onerow_enforcer = TRUE;
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS scd_entity_changes (
+  id INT64 PRIMARY KEY DEFAULT unique_rowid(),
+  entity_type STRING NOT NULL,
+  entity_id UUID NOT NULL,
+  change STRING NOT NULL,
+  owner STRING NOT NULL,
+  version INT4 NOT NULL,
+  cells INT64[] NOT NULL,
+  occurred_at TIMESTAMPTZ NOT NULL,
+  INVERTED INDEX entity_changes_cell_idx (cells),
+  INDEX entity_changes_by_occurred_at (occurred_at)
+);
+
+/* Update database version */
+UPDATE schema_versions set schema_version = 'v3.5.0' WHERE onerow_enforcer = TRUE;
@@ -0,0 +1,8 @@
+ALTER TABLE scd_notification_deliveries ADD COLUMN IF NOT EXISTS constraint_json STRING;
+
+/* Pending deliveries recorded without the notified Constraint can no longer
+   be delivered. */
+UPDATE scd_notification_deliveries SET status = 'Failed', last_error = 'Recorded before the notified Constraint was recorded with notifications' WHERE status = 'Pending';
+
+/* Update database version */
+UPDATE schema_versions set schema_version = 'v3.6.0' WHERE onerow_enforcer = TRUE;
@@ -62,6 +62,9 @@ local ingress(metadata) = base.Ingress(metadata, 'https-ingress') {
       spec+: {
         template+: {
           spec+: {
+            // Exceeds shutdown_delay plus shutdown_timeout, so that requests
+            // in progress drain before the container is killed.
+            terminationGracePeriodSeconds: 45,
             soloContainer:: base.Container('http-gateway') {
               image: metadata.gateway.image,
               ports: [
@@ -80,10 +83,17 @@ local ingress(metadata) = base.Ingress(metadata, 'https-ingress') {
               },
               readinessProbe: {
                 httpGet: {
-                  path: '/healthy',
+                  path: '/readyz',
                   port: metadata.gateway.port,
                 },
               },
+              livenessProbe: {
+                httpGet: {
+                  path: '/healthz',
+                  port: metadata.gateway.port,
+                },
+                initialDelaySeconds: 10,
+              },
             },
           },
         },
@@ -2,19 +2,23 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
 	"flag"
 	"fmt"
-	"log"
+	"io/ioutil"
 	"net"
-	"net/url"
+	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
 	"cloud.google.com/go/profiler"
+	"github.com/coreos/go-semver/semver"
 	"github.com/interuss/dss/pkg/api/v1/auxpb"
 	"github.com/interuss/dss/pkg/api/v1/ridpbv1"
 	"github.com/interuss/dss/pkg/api/v1/scdpb"
@@ -23,22 +27,51 @@ import (
 	aux "github.com/interuss/dss/pkg/aux_"
 	"github.com/interuss/dss/pkg/build"
 	"github.com/interuss/dss/pkg/cockroach"
+	"github.com/interuss/dss/pkg/cockroach/celllevel"
 	"github.com/interuss/dss/pkg/cockroach/flags" // Force command line flag registration
+	"github.com/interuss/dss/pkg/cockroach/migration"
+	"github.com/interuss/dss/pkg/config"
+	"github.com/interuss/dss/pkg/deadline"
+	"github.com/interuss/dss/pkg/debug"
+	"github.com/interuss/dss/pkg/encryption"
 	uss_errors "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/dss/pkg/geo"
+	"github.com/interuss/dss/pkg/jobs"
 	"github.com/interuss/dss/pkg/logging"
+	"github.com/interuss/dss/pkg/maintenance"
+	"github.com/interuss/dss/pkg/membership"
+	"github.com/interuss/dss/pkg/metrics"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	"github.com/interuss/dss/pkg/ratelimit"
 	application "github.com/interuss/dss/pkg/rid/application"
 	rid_v1 "github.com/interuss/dss/pkg/rid/server/v1"
 	rid_v2 "github.com/interuss/dss/pkg/rid/server/v2"
+	ridstore "github.com/interuss/dss/pkg/rid/store"
 	ridc "github.com/interuss/dss/pkg/rid/store/cockroach"
+	ridmemory "github.com/interuss/dss/pkg/rid/store/memory"
 	"github.com/interuss/dss/pkg/scd"
+	scdmodels "github.com/interuss/dss/pkg/scd/models"
+	scdstore "github.com/interuss/dss/pkg/scd/store"
 	scdc "github.com/interuss/dss/pkg/scd/store/cockroach"
+	scdmemory "github.com/interuss/dss/pkg/scd/store/memory"
+	"github.com/interuss/dss/pkg/tracing"
+	tracingflags "github.com/interuss/dss/pkg/tracing/flags"
 	"github.com/interuss/dss/pkg/validations"
+	"github.com/interuss/dss/pkg/version"
 	"github.com/interuss/stacktrace"
+	"github.com/jonboulle/clockwork"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/robfig/cron/v3"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -59,13 +92,147 @@ var (
 	locality             = flag.String("locality", "", "self-identification string used as CRDB table writer column")
 	garbageCollectorSpec = flag.String("garbage_collector_spec", "@every 30m", "Garbage collector schedule. The value must follow robfig/cron format. See https://godoc.org/github.com/robfig/cron#hdr-Usage for more detail.")
 
+	maxSubscriptionDuration      = flag.Duration("max_subscription_duration", dssmodels.DefaultSubscriptionLifetime.MaxDuration, "Maximum allowed interval between the start and end times of a subscription")
+	truncateSubscriptionDuration = flag.Bool("truncate_subscription_duration", false, "Truncate subscriptions exceeding max_subscription_duration to the maximum duration instead of rejecting them, reporting the truncation in the expiry_truncated field of the response")
+	isaRecoveryWindow            = flag.Duration("isa_recovery_window", 0, "Duration during which deleted ISAs may be restored by their owner; 0 deletes ISAs immediately. Requires remote ID schema 4.1.0 or later.")
+
 	jwtAudiences = flag.String("accepted_jwt_audiences", "", "comma-separated acceptable JWT `aud` claims")
+
+	accessLog            = flag.Bool("access_log", true, "Log a JSON access log entry for each request, in place of the gRPC request logs")
+	accessLogFields      = flag.String("access_log_fields", "", "Comma-separated fields of the access log entries, among "+strings.Join(logging.AccessFields, ", ")+"; all of them when empty")
+	accessLogRedact      = flag.String("access_log_redact", "", "Comma-separated access log fields whose values are replaced by a placeholder, e.g. subject,peer to keep client identities out of the logs")
+	accessLogSampleRatio = flag.Float64("access_log_sample_ratio", 1, "Fraction of the successful requests logged in the access log; failed requests are always logged")
+
+	s2CellLevel = flag.Int("s2_cell_level", geo.DefaultMinimumCellLevel, fmt.Sprintf("Level of the S2 cells covering the areas of entities and searches, between %d and %d; finer levels match entities more precisely in dense areas at the cost of larger coverings of large areas. Must be the same across the pool, and the cells already stored must be releveled with db-manager --relevel_cells before changing it", geo.MinimumConfigurableCellLevel, geo.MaximumConfigurableCellLevel))
+
+	maxAreaKm2         = flag.Float64("max_area_km2", geo.DefaultLimits.MaxAreaKm2, "Maximum area, in km², of the areas searched and of the entities created")
+	maxPolygonVertices = flag.Int("max_polygon_vertices", geo.DefaultLimits.MaxPolygonVertices, "Maximum number of vertices of the polygons of the areas searched and of the entities created")
+	maxAreaCells       = flag.Int("max_area_cells", geo.DefaultLimits.MaxCells, "Maximum number of S2 cells, at --s2_cell_level, covering the areas searched and the entities created; 0 disables the limit")
+	coveringCacheCells = flag.Int("covering_cache_cells", geo.DefaultCoveringCacheCells, "Maximum number of S2 cells of the coverings of the areas most recently covered cached, e.g. those polled by display providers, so that they are not computed again; 0 disables the cache")
+
+	egm96GeoidFile   = flag.String("egm96_geoid_file", "", "GeographicLib PGM file of the EGM96 geoid, e.g. egm96-5.pgm, with which altitudes relative to EGM96 and pressure altitudes (STD) are converted to the WGS84 ellipsoid; such altitudes are rejected when empty")
+	egm2008GeoidFile = flag.String("egm2008_geoid_file", "", "GeographicLib PGM file of the EGM2008 geoid, e.g. egm2008-1.pgm, with which altitudes relative to EGM2008 are converted to the WGS84 ellipsoid; such altitudes are rejected when empty")
+
+	ridSearchFollowerReadStaleness = flag.Duration("rid_search_follower_read_staleness", 0, "When positive, remote ID searches read data as of this long ago so that CockroachDB may serve them from the nearest replicas; must exceed the closed timestamp target duration of the cluster (a few seconds by default) for follower reads to occur. Mutations remain strongly consistent; 0 disables follower reads.")
+	ridSearchShardCells            = flag.Int("rid_search_shard_cells", 0, "When positive, number of cells beyond which the covering of a remote ID search is split into concurrent queries of at most this many cells each, whose results are merged, to reduce the latency of continent-scale searches; 0 disables sharding")
+	ridSearchShardConcurrency      = flag.Int("rid_search_shard_concurrency", ridc.DefaultSearchShardConcurrency, "Maximum number of concurrent queries of each remote ID search split by rid_search_shard_cells")
+
+	ridSearchRate  = flag.Float64("rid_search_rate_limit", 0, "Number of remote ID search requests per second allowed for each subject; 0 disables rate limiting")
+	ridSearchBurst = flag.Int("rid_search_burst", 20, "Number of remote ID search requests each subject may make at once when rate limited")
+
+	scdMutationRate  = flag.Float64("scd_mutation_rate_limit", 0, "Number of strategic conflict detection create, update and delete requests per minute allowed for each subject; 0 disables rate limiting")
+	scdMutationBurst = flag.Int("scd_mutation_burst", 20, "Number of strategic conflict detection create, update and delete requests each subject may make at once when rate limited")
+
+	searchTimeout   = flag.Duration("search_timeout", 0, "Deadline of remote ID and strategic conflict detection search requests, after which they are aborted with a 504 response; 0 applies the server timeout")
+	mutationTimeout = flag.Duration("mutation_timeout", 0, "Deadline of remote ID and strategic conflict detection create, update and delete requests, after which they are aborted with a 504 response; 0 applies the server timeout")
+	reportTimeout   = flag.Duration("report_timeout", 0, "Deadline of report, export and consistency check requests, after which they are aborted with a 504 response; 0 applies the server timeout")
+
+	enableConstraintNotifications  = flag.Bool("enable_constraint_notifications", false, "Have the DSS notify the USSs subscribed to Constraint changes, recording each delivery. Requires strategic conflict detection schema 3.6.0 or later.")
+	constraintNotificationAttempts = flag.Int("constraint_notification_attempts", 5, "Number of attempts made to deliver each Constraint notification")
+	constraintNotificationBackoff  = flag.Duration("constraint_notification_backoff", time.Second, "Delay before retrying a failed Constraint notification; doubles with each retry, up to half of the lease")
+	constraintNotificationPoll     = flag.Duration("constraint_notification_poll_interval", 10*time.Second, "Period at which pending Constraint notifications recorded by any DSS instance are looked up for delivery")
+	constraintNotificationLease    = flag.Duration("constraint_notification_lease", 5*time.Minute, "Duration after which a pending Constraint notification not attempted by the DSS instance delivering it, e.g. after a crash, is delivered by another instance; must exceed twice the timeout")
+	constraintNotificationFeed     = flag.Bool("constraint_notification_changefeed", false, "Dispatch the Constraint notifications recorded by any DSS instance as soon as they are committed, using a CockroachDB changefeed which requires the kv.rangefeed.enabled cluster setting; other datastores rely on polling")
+	notificationAccessTokenFile    = flag.String("notification_access_token_file", "", "Path to a file holding the access token presented to notified USSs, read before each delivery attempt")
+	notificationDeliveryRetention  = flag.Duration("notification_delivery_retention", 24*time.Hour, "Duration for which records of notification deliveries are kept")
+	entityChangeRetention          = flag.Duration("entity_change_retention", 24*time.Hour, "Duration for which records of operational intent and constraint reference changes are kept; changes are recorded from strategic conflict detection schema 3.5.0")
+
+	danglingOperationalIntentCleanupSpec = flag.String("dangling_operational_intent_cleanup_spec", "", "Schedule of the detection of dangling operational intent references, in robfig/cron format; detection is disabled when empty")
+	danglingOperationalIntentUnreachable = flag.Duration("dangling_operational_intent_unreachable_for", time.Hour, "Duration for which a USS base URL must be unreachable before the operational intent references using it are considered dangling")
+	danglingOperationalIntentPolicy      = flag.String("dangling_operational_intent_policy", string(scd.DanglingPolicyFlag), "Action taken on dangling operational intent references: `flag` only reports them, `expire` ends them")
+	danglingOperationalIntentDryRun      = flag.Bool("dangling_operational_intent_dry_run", false, "Report the actions which would be taken on dangling operational intent references without taking them")
+
+	expiredEntityPurgeSpec = flag.String("scd_expired_entity_purge_spec", "@every 1h", "Schedule of the deletion of the strategic conflict detection operational intent references, constraint references and subscriptions which ended longer than scd_expired_entity_retention ago, in robfig/cron format; deletion is disabled when empty")
+	expiredEntityRetention = flag.Duration("scd_expired_entity_retention", 24*time.Hour, "Duration after their end time for which strategic conflict detection entities are kept before being deleted")
+
+	implicitSubscriptionCleanupSpec = flag.String("implicit_subscription_cleanup_spec", "@every 10m", "Schedule of the removal of implicit subscriptions whose operational intent references have all ended, in robfig/cron format, on the instance elected per --job_leader_election; removal is disabled when empty")
+	implicitSubscriptionRetention   = flag.Duration("implicit_subscription_retention", time.Hour, "Duration after the end of all the operational intent references depending on an implicit subscription before the subscription and those references are removed")
+
+	dbPoolMetricsSpec = flag.String("db_pool_metrics_spec", "@every 15s", "Schedule of the refresh of the database connection pool utilization, wait time and health metrics, in robfig/cron format; metrics are not exported when empty")
+	entityMetricsSpec = flag.String("entity_metrics_spec", "@every 5m", "Schedule of the refresh of the numbers of entities stored in each database, in robfig/cron format; counts are not exported when empty")
+
+	scdStateMetricsSpec = flag.String("scd_state_metrics_spec", "@every 1m", "Schedule of the refresh of the operational intent reference counts by state and manager, in robfig/cron format; counts are not exported when empty")
+
+	scdMaxVolumeDuration = flag.Duration("scd_max_volume_duration", 0, "Maximum duration of each volume submitted for strategic conflict detection; 0 disables the limit")
+	scdMaxVolumeAreaKm2  = flag.Float64("scd_max_volume_area_km2", 0, "Maximum area, in km², of the footprint of each volume submitted for strategic conflict detection; 0 disables the limit")
+	scdMinAltitude       = flag.Float64("scd_min_altitude", dssmodels.MinAltitude, "Minimum altitude, in meters above the WGS84 ellipsoid, of volumes submitted for strategic conflict detection")
+	scdMaxAltitude       = flag.Float64("scd_max_altitude", dssmodels.MaxAltitude, "Maximum altitude, in meters above the WGS84 ellipsoid, of volumes submitted for strategic conflict detection")
+
+	scdStateTransitionRulesFile = flag.String("scd_state_transition_rules_file", "", "Path to a JSON file listing the allowed state transitions of operational intents; defaults to the transitions of ASTM F3548-21")
+
+	enableManagerReset = flag.Bool("enable_manager_reset", false, "Enables the administrative endpoint deleting all the remote ID and strategic conflict detection entities managed by a USS; only for test environments")
+
+	autoMigrateSchemas = flag.Bool("auto_migrate_schemas", false, "Apply the pending migrations of the schemas_dir schemas at startup, up to the latest schema versions supported by this DSS; schemas are never migrated down. Only enable on one DSS instance at a time.")
+	schemasDir         = flag.String("schemas_dir", "", "Directory holding the rid and scd directories of schema migration files used by auto_migrate_schemas, e.g. build/deploy/db_schemas, or build/deploy/db_schemas/postgres for PostgreSQL and YugabyteDB")
+
+	encryptedColumns            = flag.String("encrypted_columns", "", "Comma-separated strategic conflict detection columns, as table.column, whose values are encrypted when written, among "+strings.Join(scdc.EncryptableColumns, ", ")+"; values written before a column is selected remain readable")
+	columnEncryptionKeyFiles    = flag.String("column_encryption_key_files", "", "Comma-separated key encryption keys of encrypted_columns, each specified as id=path to a file holding a base64-encoded 32-byte key; the first key encrypts new data keys, the others only decrypt existing ones during a key rotation")
+	columnEncryptionKMSCommand  = flag.String("column_encryption_kms_command", "", "Command wrapping and unwrapping the data keys of encrypted_columns with a key management service instead of column_encryption_key_files, run as `<command> wrap|unwrap <key ID>` with the base64-encoded key on its standard input")
+	columnEncryptionKMSKeyID    = flag.String("column_encryption_kms_key_id", "", "ID of the key management service key with which column_encryption_kms_command wraps new data keys")
+	columnEncryptionDataKeyLife = flag.Duration("column_encryption_data_key_lifetime", encryption.DefaultDataKeyLifetime, "Duration for which a data key encrypts new values of encrypted_columns before being replaced")
+
+	schemaCompatibilitySpec = flag.String("schema_compatibility_check_spec", "@every 30s", "Schedule of the check that the schema versions of the databases are supported by this DSS, in robfig/cron format; while they are not, e.g. after another instance of a mixed-version pool migrated them, the DSS reports itself not ready. The schemas are only checked at startup when empty")
+
+	jobLeaderElection = flag.Bool("job_leader_election", true, "Elect, through leases held in the remote ID database, a single DSS instance of the pool to run each maintenance job (garbage collection, purges, cleanups and consistency checks); every instance runs all maintenance jobs when false or when the remote ID schema is older than 4.2.0")
+	jobLeaseDuration  = flag.Duration("job_lease_duration", jobs.DefaultLeaseDuration, "Duration after which another DSS instance takes over the maintenance jobs of an instance which stopped renewing their leases")
+
+	scdConsistencyCheckSpec = flag.String("scd_consistency_check_spec", "", "Schedule of the check of the internal consistency of the strategic conflict detection entities in scd_consistency_check_area, in robfig/cron format; the check is disabled when empty")
+	scdConsistencyCheckArea = flag.String("scd_consistency_check_area", "", "Area checked by scd_consistency_check_spec, as lat0,lng0,lat1,lng1,..., circle:lat,lng,radius or a GeoJSON Polygon or MultiPolygon")
+
+	inMemoryDatastore = flag.Bool("in_memory_datastore", false, "Hold remote ID and strategic conflict detection data in memory instead of a database; data is lost when the service stops, only for tests and mock deployments")
+
+	grpcAddress     = flag.String("grpc_addr", "", "address on which core-service also serves its gRPC API directly to internal clients, such as co-located USS backends, with the gRPC health service and reflection; not served when empty")
+	grpcTLSCertFile = flag.String("grpc_tls_cert_file", "", "path to the PEM certificate chain with which grpc_addr serves TLS; grpc_addr serves plaintext connections when empty")
+	grpcTLSKeyFile  = flag.String("grpc_tls_key_file", "", "path to the PEM private key of grpc_tls_cert_file")
+
+	metricsAddress = flag.String("metrics_addr", "", "address on which to serve Prometheus metrics at /metrics; metrics are not served when empty")
+	debugAddress   = flag.String("debug_addr", "", "localhost or loopback address on which to serve pprof profiles at /debug/pprof/, expvar variables at /debug/vars and runtime statistics at /debug/runtime; debug endpoints are not served when empty")
+
+	instanceID        = flag.String("instance_id", "", "ID of this DSS instance in its pool, which must be unique in the pool and should remain the same across restarts, e.g. the name of its pod; defaults to the hostname")
+	heartbeatInterval = flag.Duration("instance_heartbeat_interval", membership.DefaultHeartbeatInterval, "Interval between the heartbeats registering this DSS instance in the remote ID database, listed at /aux/v1/pool/instances; instances are reported stale after missing 3 heartbeats")
+
+	shutdownDelay   = flag.Duration("shutdown_delay", 5*time.Second, "Duration for which the DSS reports itself not ready upon a termination signal while still accepting requests, for load balancers to stop routing requests to it before it drains")
+	shutdownTimeout = flag.Duration("shutdown_timeout", 30*time.Second, "Maximum duration for which the requests in progress upon shutdown are allowed to complete before being aborted")
 )
 
 const (
 	codeRetryable = stacktrace.ErrorCode(1)
+
+	// readyFile exists while the DSS is ready to serve requests, for container
+	// health checks.
+	readyFile = "service.ready"
 )
 
+// schemaMonitor monitors the compatibility of the schemas of the databases
+// used by the DSS.
+var schemaMonitor = &cockroach.SchemaMonitor{}
+
+// datastores are the connection pools closed once the DSS stops.
+var datastores []*cockroach.DB
+
+// healthServer reports whether the DSS is ready through the gRPC health
+// checking protocol, like its ready file.
+var healthServer = health.NewServer()
+
+// healthServices are the services whose status healthServer reports, besides
+// the overall status of the server.
+var healthServices []string
+
+// scheduler runs the maintenance jobs of the DSS pool which this instance is
+// elected to run.
+var scheduler *jobs.Scheduler
+
+// instance describes this DSS instance in the registry of its pool.
+var instance = membership.Instance{Process: membership.NewProcess(), StartedAt: time.Now()}
+
+// members registers the DSS instances of the pool, when the remote ID schema
+// supports it.
+var members *membership.Registry
+
+// maintenanceMode tracks the maintenance window of the pool, when the remote
+// ID schema supports it.
+var maintenanceMode *maintenance.Mode
+
 func getDBStats(ctx context.Context, db *cockroach.DB, databaseName string) {
 	logger := logging.WithValuesFromContext(ctx, logging.Logger)
 	statsPtr := db.Pool.Stat()
@@ -86,30 +253,104 @@ func getDBStats(ctx context.Context, db *cockroach.DB, databaseName string) {
 	}
 }
 
-func createKeyResolver() (auth.KeyResolver, error) {
-	switch {
-	case *pkFile != "":
-		return &auth.FromFileKeyResolver{
-			KeyFiles: strings.Split(*pkFile, ","),
-		}, nil
-	case *jwksEndpoint != "" && *jwksKeyIDs != "":
-		u, err := url.Parse(*jwksEndpoint)
-		if err != nil {
-			return nil, stacktrace.Propagate(err, "Error parsing JWKS URL")
+// schedulePoolMetrics schedules the refresh of the connection pool and health
+// metrics of db, labeled with databaseName, following --db_pool_metrics_spec,
+// and of the counts of the entities stored in entityTables following
+// --entity_metrics_spec.
+func schedulePoolMetrics(ctx context.Context, c *cron.Cron, db *cockroach.DB, databaseName string, entityTables map[string]string, logger *zap.Logger) error {
+	if *dbPoolMetricsSpec != "" {
+		if _, err := c.AddFunc(*dbPoolMetricsSpec, func() {
+			db.RecordPoolMetrics(databaseName)
+			db.RecordHealth(ctx, databaseName)
+		}); err != nil {
+			return stacktrace.Propagate(err, "Failed to schedule refresh of connection pool metrics of %s", databaseName)
+		}
+	}
+	if *entityMetricsSpec != "" {
+		if _, err := c.AddFunc(*entityMetricsSpec, func() {
+			if err := db.RecordEntityCounts(ctx, databaseName, entityTables); err != nil {
+				logger.Warn("Failed to refresh entity count metrics", zap.String("Database", databaseName), zap.Error(err))
+			}
+		}); err != nil {
+			return stacktrace.Propagate(err, "Failed to schedule refresh of entity count metrics of %s", databaseName)
 		}
+	}
+	return nil
+}
 
-		return &auth.JWKSResolver{
-			Endpoint: u,
-			KeyIDs:   strings.Split(*jwksKeyIDs, ","),
-		}, nil
-	default:
-		return nil, nil
+func subscriptionLifetime() dssmodels.SubscriptionLifetime {
+	return dssmodels.SubscriptionLifetime{
+		MaxDuration: *maxSubscriptionDuration,
+		Truncate:    *truncateSubscriptionDuration,
 	}
 }
 
-func createRIDServer(ctx context.Context, locality string, logger *zap.Logger) (*rid_v1.Server, *rid_v2.Server, error) {
-	connectParameters := flags.ConnectParameters()
-	connectParameters.DBName = "rid"
+// notificationClient returns the client of the requests made to USSs, whose
+// trace context is propagated so that USSs may correlate them with their own
+// traces.
+func notificationClient() *http.Client {
+	return &http.Client{Timeout: *timeout, Transport: otelhttp.NewTransport(http.DefaultTransport)}
+}
+
+// serveMetrics serves the metrics of the process over HTTP at /metrics.
+func serveMetrics(logger *zap.Logger) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	logger.Info("serving metrics", zap.String("address", *metricsAddress))
+	if err := http.ListenAndServe(*metricsAddress, mux); err != nil {
+		logger.Error("failed to serve metrics", zap.Error(err))
+	}
+}
+
+// serveDebug serves the profiles and runtime statistics of the process over
+// HTTP under /debug/.
+func serveDebug(logger *zap.Logger) {
+	logger.Info("serving debug endpoints", zap.String("address", *debugAddress))
+	if err := http.ListenAndServe(*debugAddress, debug.Handler()); err != nil {
+		logger.Error("failed to serve debug endpoints", zap.Error(err))
+	}
+}
+
+func createKeyResolver() (auth.KeyResolver, error) {
+	return auth.NewKeyResolver(*pkFile, *jwksEndpoint, *jwksKeyIDs)
+}
+
+// migrateSchema migrates the schema of the database named dbName up to
+// supportedVersion when auto_migrate_schemas is set.  Schemas newer than
+// supportedVersion are left unchanged, for the store to refuse them.
+func migrateSchema(ctx context.Context, dbName string, supportedVersion semver.Version, logger *zap.Logger) error {
+	if !*autoMigrateSchemas {
+		return nil
+	}
+	if *schemasDir == "" {
+		return stacktrace.NewError("schemas_dir must be specified to auto_migrate_schemas")
+	}
+	migrator := &migration.Migrator{
+		SchemasDir:        filepath.Join(*schemasDir, dbName),
+		ConnectParameters: flags.ConnectParameters(),
+		Logf:              logger.Sugar().Infof,
+	}
+	currentVersion, err := migrator.Migrate(ctx, nil)
+	if err != nil {
+		// TODO: More robustly detect failure to migrate is due to a problem that may be temporary
+		if strings.Contains(err.Error(), "connect: connection refused") {
+			return stacktrace.PropagateWithCode(err, codeRetryable, "Failed to connect to database server to migrate %s schema", dbName)
+		}
+		return stacktrace.Propagate(err, "Failed to determine %s schema version", dbName)
+	}
+	if !currentVersion.LessThan(supportedVersion) {
+		return nil
+	}
+	logger.Info("Migrating schema", zap.String("database", dbName), zap.Stringer("from", currentVersion), zap.Stringer("to", supportedVersion))
+	if _, err := migrator.Migrate(ctx, &supportedVersion); err != nil {
+		return stacktrace.Propagate(err, "Failed to migrate %s schema from %s to %s", dbName, currentVersion, supportedVersion)
+	}
+	return nil
+}
+
+// connectRIDStore connects to the remote ID database described by
+// connectParameters, falling back to the defaultdb database of older versions.
+func connectRIDStore(ctx context.Context, connectParameters cockroach.ConnectParameters, logger *zap.Logger) (*cockroach.DB, *ridc.Store, error) {
 	ridCrdb, err := cockroach.Dial(ctx, connectParameters)
 	if err != nil {
 		// TODO: More robustly detect failure to create RID server is due to a problem that may be temporary
@@ -124,7 +365,7 @@ func createRIDServer(ctx context.Context, locality string, logger *zap.Logger) (
 		// try DBName of defaultdb for older versions.
 		ridCrdb.Pool.Close()
 		connectParameters.DBName = "defaultdb"
-		ridCrdb, err := cockroach.Dial(ctx, connectParameters)
+		ridCrdb, err = cockroach.Dial(ctx, connectParameters)
 		if err != nil {
 			return nil, nil, stacktrace.Propagate(err, "Failed to connect to remote ID database for older version <defaultdb>; verify your database configuration is current with https://github.com/interuss/dss/tree/master/build#upgrading-database-schemas")
 		}
@@ -138,74 +379,395 @@ func createRIDServer(ctx context.Context, locality string, logger *zap.Logger) (
 			return nil, nil, stacktrace.Propagate(err, "Failed to create remote ID store")
 		}
 	}
+	return ridCrdb, ridStore, nil
+}
+
+func createRIDServer(ctx context.Context, locality string, logger *zap.Logger) (*rid_v1.Server, *rid_v2.Server, error) {
+	connectParameters := flags.ConnectParameters()
+	connectParameters.DBName = "rid"
+
+	var (
+		ridCrdb  *cockroach.DB
+		ridStore ridstore.Store
+	)
+	if *inMemoryDatastore {
+		ridStore = ridmemory.NewStore(logger)
+		maintenanceMode = &maintenance.Mode{Store: &maintenance.MemoryStore{}, Clock: clockwork.NewRealClock()}
+	} else {
+		if err := migrateSchema(ctx, connectParameters.DBName, ridc.LatestSchemaVersion, logger); err != nil {
+			return nil, nil, err // No need to Propagate this error as this is not a useful stacktrace line
+		}
+		if *ridSearchShardCells < 0 || *ridSearchShardConcurrency <= 0 {
+			return nil, nil, stacktrace.NewError("rid_search_shard_cells must not be negative and rid_search_shard_concurrency must be positive")
+		}
+		if *ridSearchFollowerReadStaleness > 0 && !connectParameters.Dialect.IsCockroachDB() {
+			return nil, nil, stacktrace.NewError("rid_search_follower_read_staleness requires the %s datastore dialect", cockroach.DialectCockroachDB)
+		}
+		crdb, store, err := connectRIDStore(ctx, connectParameters, logger)
+		if err != nil {
+			return nil, nil, err // No need to Propagate this error as this is not a useful stacktrace line
+		}
+		store.SearchStaleness = *ridSearchFollowerReadStaleness
+		store.SearchShardCells = *ridSearchShardCells
+		store.SearchShardConcurrency = *ridSearchShardConcurrency
+		ridCrdb, ridStore = crdb, store
+		datastores = append(datastores, ridCrdb)
+		if err := celllevel.Check(ctx, ridCrdb, "rid"); err != nil {
+			return nil, nil, stacktrace.Propagate(err, "Incompatible --s2_cell_level")
+		}
+		if store.SupportsInstanceRegistry() {
+			members = &membership.Registry{DB: ridCrdb, Self: instance, HeartbeatInterval: *heartbeatInterval, Logger: logger}
+		} else {
+			logger.Warn("Remote ID schema predates the instance registry; pool membership is not reported")
+		}
+		if store.SupportsMaintenanceWindows() {
+			maintenanceMode = &maintenance.Mode{Store: &maintenance.DatastoreStore{DB: ridCrdb}, Clock: clockwork.NewRealClock()}
+		} else {
+			logger.Warn("Remote ID schema predates maintenance windows; maintenance mode is not available")
+		}
+		if *jobLeaderElection {
+			if store.SupportsJobLeases() {
+				scheduler.Locker = &jobs.LeaseLocker{DB: ridCrdb}
+			} else {
+				logger.Warn("Remote ID schema predates job leases; every DSS instance runs all maintenance jobs")
+			}
+		}
+		schemaMonitor.Add(ridCrdb, connectParameters.DBName, ridc.MinimumSchemaVersion, ridc.LatestSchemaVersion)
+	}
 
 	repo, err := ridStore.Interact(ctx)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "Unable to interact with store")
 	}
-	gc := ridc.NewGarbageCollector(repo, locality)
+	gc := ridc.NewGarbageCollector(repo, locality, *isaRecoveryWindow)
 
 	// schedule period tasks for RID Server
 	ridCron := cron.New()
 	// schedule printing of DB connection stats every minute for the underlying storage for RID Server
-	if _, err := ridCron.AddFunc("@every 1m", func() { getDBStats(ctx, ridCrdb, connectParameters.DBName) }); err != nil {
-		return nil, nil, stacktrace.Propagate(err, "Failed to schedule periodic db stat check to %s", connectParameters.DBName)
+	if ridCrdb != nil {
+		if _, err := ridCron.AddFunc("@every 1m", func() { getDBStats(ctx, ridCrdb, ridCrdb.Pool.Config().ConnConfig.Database) }); err != nil {
+			return nil, nil, stacktrace.Propagate(err, "Failed to schedule periodic db stat check to %s", connectParameters.DBName)
+		}
+		if err := schedulePoolMetrics(ctx, ridCron, ridCrdb, "rid", ridc.EntityTables, logger); err != nil {
+			return nil, nil, err // No need to Propagate this error as this is not a useful stacktrace line
+		}
 	}
 
-	cronLogger := cron.VerbosePrintfLogger(log.New(os.Stdout, "RIDGarbageCollectorJob: ", log.LstdFlags))
-	if _, err = ridCron.AddJob(*garbageCollectorSpec, cron.NewChain(cron.SkipIfStillRunning(cronLogger)).Then(RIDGarbageCollectorJob{"delete rid expired records", *gc, ctx})); err != nil {
+	// Expired records are deleted by a single instance of each locality,
+	// whose records they are.
+	if err := scheduler.Add(jobs.Job{Name: "rid_gc:" + locality, Spec: *garbageCollectorSpec, Run: func(ctx context.Context) error {
+		if err := gc.DeleteRIDExpiredRecords(ctx); err != nil {
+			return stacktrace.Propagate(err, "Failed to delete expired records")
+		}
+		logger.Info("Successful delete expired records")
+		return nil
+	}}); err != nil {
 		return nil, nil, stacktrace.Propagate(err, "Failed to schedule periodic delete rid expired records to %s", connectParameters.DBName)
 	}
 	ridCron.Start()
 
-	app := application.NewFromTransactor(ridStore, logger)
+	app := application.NewFromTransactor(ridStore, logger, subscriptionLifetime(), *isaRecoveryWindow)
 	return &rid_v1.Server{
-			App:        app,
-			Timeout:    *timeout,
-			Locality:   locality,
-			EnableHTTP: *enableHTTP,
-			Cron:       ridCron,
-		}, &rid_v2.Server{
-			App:        app,
-			Timeout:    *timeout,
-			Locality:   locality,
-			EnableHTTP: *enableHTTP,
-			Cron:       ridCron,
-		}, nil
+		App:        app,
+		Timeout:    *timeout,
+		Locality:   locality,
+		EnableHTTP: *enableHTTP,
+		Cron:       ridCron,
+	}, &rid_v2.Server{
+		App:        app,
+		Timeout:    *timeout,
+		Locality:   locality,
+		EnableHTTP: *enableHTTP,
+		Cron:       ridCron,
+	}, nil
 }
 
-func createSCDServer(ctx context.Context, logger *zap.Logger) (*scd.Server, error) {
-	connectParameters := flags.ConnectParameters()
-	connectParameters.DBName = scdc.DatabaseName
-	scdCrdb, err := cockroach.Dial(ctx, connectParameters)
+func volumeValidator() dssmodels.VolumeValidator {
+	minAltitude, maxAltitude := float32(*scdMinAltitude), float32(*scdMaxAltitude)
+	return dssmodels.VolumeValidator{
+		MaxDuration: *scdMaxVolumeDuration,
+		MinAltitude: &minAltitude,
+		MaxAltitude: &maxAltitude,
+		MaxAreaKm2:  *scdMaxVolumeAreaKm2,
+	}
+}
+
+// newColumnEncryptor returns the Encryptor of the encrypted_columns, or nil
+// when no column is encrypted.
+func newColumnEncryptor() (*encryption.Encryptor, error) {
+	var columns []string
+	for _, column := range strings.Split(*encryptedColumns, ",") {
+		if column = strings.TrimSpace(column); column == "" {
+			continue
+		}
+		encryptable := false
+		for _, c := range scdc.EncryptableColumns {
+			encryptable = encryptable || c == column
+		}
+		if !encryptable {
+			return nil, stacktrace.NewError("Column %s in encrypted_columns is not encryptable; encryptable columns are %s", column, strings.Join(scdc.EncryptableColumns, ", "))
+		}
+		columns = append(columns, column)
+	}
+	if len(columns) == 0 {
+		return nil, nil
+	}
+
+	var wrapper encryption.KeyWrapper
+	switch {
+	case *columnEncryptionKeyFiles != "" && *columnEncryptionKMSCommand != "":
+		return nil, stacktrace.NewError("Only one of column_encryption_key_files and column_encryption_kms_command may be specified")
+	case *columnEncryptionKeyFiles != "":
+		keys, err := encryption.LoadKeyFiles(strings.Split(*columnEncryptionKeyFiles, ","))
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Failed to load column_encryption_key_files")
+		}
+		wrapper = keys
+	case *columnEncryptionKMSCommand != "":
+		if *columnEncryptionKMSKeyID == "" {
+			return nil, stacktrace.NewError("column_encryption_kms_key_id must be specified with column_encryption_kms_command")
+		}
+		wrapper = &encryption.CommandKeyWrapper{Command: *columnEncryptionKMSCommand, CurrentKeyID: *columnEncryptionKMSKeyID}
+	default:
+		return nil, stacktrace.NewError("encrypted_columns requires column_encryption_key_files or column_encryption_kms_command")
+	}
+
+	encryptor, err := encryption.NewEncryptor(wrapper, columns)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "Failed to connect to strategic conflict detection database; verify your database configuration is current with https://github.com/interuss/dss/tree/master/build#upgrading-database-schemas")
+		return nil, stacktrace.Propagate(err, "Failed to create column encryptor")
 	}
+	encryptor.DataKeyLifetime = *columnEncryptionDataKeyLife
+	return encryptor, nil
+}
 
-	scdStore, err := scdc.NewStore(ctx, scdCrdb, logger)
+func createSCDServer(ctx context.Context, logger *zap.Logger) (*scd.Server, error) {
+	var (
+		scdCrdb  *cockroach.DB
+		scdStore interface {
+			scdstore.Store
+			SupportsNotificationDeliveries() bool
+		}
+	)
+	encryptor, err := newColumnEncryptor()
 	if err != nil {
-		// TODO: More robustly detect failure to create SCD server is due to a problem that may be temporary
-		if strings.Contains(err.Error(), "connect: connection refused") || strings.Contains(err.Error(), "database \"scd\" does not exist") {
-			scdCrdb.Pool.Close()
-			return nil, stacktrace.PropagateWithCode(err, codeRetryable, "Failed to connect to CRDB server for strategic conflict detection store")
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	if *inMemoryDatastore {
+		if encryptor != nil {
+			return nil, stacktrace.NewError("encrypted_columns is not supported by the in-memory datastore")
+		}
+		scdStore = scdmemory.NewStore(logger)
+	} else {
+		connectParameters := flags.ConnectParameters()
+		connectParameters.DBName = scdc.DatabaseName
+		if err := migrateSchema(ctx, scdc.DatabaseName, scdc.LatestSchemaVersion, logger); err != nil {
+			return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+		}
+		scdCrdb, err = cockroach.Dial(ctx, connectParameters)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Failed to connect to strategic conflict detection database; verify your database configuration is current with https://github.com/interuss/dss/tree/master/build#upgrading-database-schemas")
+		}
+
+		store, err := scdc.NewStore(ctx, scdCrdb, logger)
+		if err != nil {
+			// TODO: More robustly detect failure to create SCD server is due to a problem that may be temporary
+			if strings.Contains(err.Error(), "connect: connection refused") || strings.Contains(err.Error(), "database \"scd\" does not exist") {
+				scdCrdb.Pool.Close()
+				return nil, stacktrace.PropagateWithCode(err, codeRetryable, "Failed to connect to CRDB server for strategic conflict detection store")
+			}
+			return nil, stacktrace.Propagate(err, "Failed to create strategic conflict detection store")
+		}
+		store.Encryptor = encryptor
+		scdStore = store
+		datastores = append(datastores, scdCrdb)
+		if err := celllevel.Check(ctx, scdCrdb, scdc.DatabaseName); err != nil {
+			return nil, stacktrace.Propagate(err, "Incompatible --s2_cell_level")
 		}
-		return nil, stacktrace.Propagate(err, "Failed to create strategic conflict detection store")
+		schemaMonitor.Add(scdCrdb, scdc.DatabaseName, scdc.MinimumSchemaVersion, scdc.LatestSchemaVersion)
 	}
 
 	// schedule period tasks for SCD Server
 	scdCron := cron.New()
 	// schedule printing of DB connection stats every minute for the underlying storage for RID Server
-	if _, err := scdCron.AddFunc("@every 1m", func() { getDBStats(ctx, scdCrdb, scdc.DatabaseName) }); err != nil {
-		return nil, stacktrace.Propagate(err, "Failed to schedule periodic db stat check to %s", scdc.DatabaseName)
+	if scdCrdb != nil {
+		if _, err := scdCron.AddFunc("@every 1m", func() { getDBStats(ctx, scdCrdb, scdc.DatabaseName) }); err != nil {
+			return nil, stacktrace.Propagate(err, "Failed to schedule periodic db stat check to %s", scdc.DatabaseName)
+		}
+		if err := schedulePoolMetrics(ctx, scdCron, scdCrdb, scdc.DatabaseName, scdc.EntityTables, logger); err != nil {
+			return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+		}
+	}
+
+	server := &scd.Server{
+		Store:                scdStore,
+		Timeout:              *timeout,
+		EnableHTTP:           *enableHTTP,
+		SubscriptionLifetime: subscriptionLifetime(),
+		VolumeValidator:      volumeValidator(),
+	}
+
+	if *scdStateTransitionRulesFile != "" {
+		data, err := ioutil.ReadFile(*scdStateTransitionRulesFile)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Unable to read state transition rules from %s", *scdStateTransitionRulesFile)
+		}
+		server.StateTransitionRules, err = scdmodels.StateTransitionRulesFromJSON(data)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Invalid state transition rules in %s", *scdStateTransitionRulesFile)
+		}
+	}
+
+	if *enableConstraintNotifications {
+		if !scdStore.SupportsNotificationDeliveries() {
+			return nil, stacktrace.NewError("Constraint notifications require strategic conflict detection schema 3.6.0 or later")
+		}
+		server.ConstraintNotifier = &scd.ConstraintNotifier{
+			Store:          scdStore,
+			Client:         notificationClient(),
+			Logger:         logger,
+			MaxAttempts:    *constraintNotificationAttempts,
+			InitialBackoff: *constraintNotificationBackoff,
+			PollInterval:   *constraintNotificationPoll,
+			Lease:          *constraintNotificationLease,
+		}
+		if *constraintNotificationPoll <= 0 {
+			return nil, stacktrace.NewError("Constraint notification poll interval must be positive")
+		}
+		if *constraintNotificationBackoff <= 0 {
+			return nil, stacktrace.NewError("Constraint notification backoff must be positive")
+		}
+		if *constraintNotificationLease <= 2**timeout {
+			return nil, stacktrace.NewError("Constraint notification lease must exceed %s, twice the request timeout", 2**timeout)
+		}
+		if *constraintNotificationFeed {
+			feed, ok := scdStore.(scd.NotificationFeed)
+			if !ok || scdCrdb == nil || !scdCrdb.Dialect.IsCockroachDB() {
+				return nil, stacktrace.NewError("Constraint notification changefeeds are only supported by CockroachDB")
+			}
+			server.ConstraintNotifier.Feed = feed
+		}
+		if *notificationAccessTokenFile != "" {
+			server.ConstraintNotifier.AccessToken = func() (string, error) {
+				token, err := ioutil.ReadFile(*notificationAccessTokenFile)
+				if err != nil {
+					return "", stacktrace.Propagate(err, "Failed to read notification access token file")
+				}
+				return strings.TrimSpace(string(token)), nil
+			}
+		}
+		go server.ConstraintNotifier.Run(ctx)
+	}
+
+	// schedule purging of expired notification delivery records
+	if err := scheduler.Add(jobs.Job{Name: "scd_notification_delivery_purge", Spec: "@every 1h", Run: func(ctx context.Context) error {
+		purged, err := server.PurgeNotificationDeliveries(ctx, time.Now().Add(-*notificationDeliveryRetention))
+		if err != nil {
+			return stacktrace.Propagate(err, "Failed to purge notification deliveries")
+		}
+		logger.Info("Purged notification deliveries", zap.Int64("count", purged))
+		return nil
+	}}); err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to schedule purging of notification deliveries")
+	}
+
+	// schedule purging of expired entity change records
+	if err := scheduler.Add(jobs.Job{Name: "scd_entity_change_purge", Spec: "@every 1h", Run: func(ctx context.Context) error {
+		purged, err := server.PurgeEntityChanges(ctx, time.Now().Add(-*entityChangeRetention))
+		if err != nil {
+			return stacktrace.Propagate(err, "Failed to purge entity changes")
+		}
+		logger.Info("Purged entity changes", zap.Int64("count", purged))
+		return nil
+	}}); err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to schedule purging of entity changes")
+	}
+
+	if *implicitSubscriptionCleanupSpec != "" {
+		if err := scheduler.Add(jobs.Job{Name: "scd_implicit_subscription_cleanup", Spec: *implicitSubscriptionCleanupSpec, Run: func(ctx context.Context) error {
+			removed, err := server.CleanUpImplicitSubscriptions(ctx, *implicitSubscriptionRetention)
+			if err != nil {
+				return stacktrace.Propagate(err, "Failed to clean up implicit subscriptions")
+			}
+			logger.Info("Cleaned up implicit subscriptions", zap.Int("count", removed))
+			return nil
+		}}); err != nil {
+			return nil, stacktrace.Propagate(err, "Failed to schedule cleanup of implicit subscriptions")
+		}
+	}
+
+	if *expiredEntityPurgeSpec != "" {
+		if err := scheduler.Add(jobs.Job{Name: "scd_expired_entity_purge", Spec: *expiredEntityPurgeSpec, Run: func(ctx context.Context) error {
+			purged, err := server.PurgeExpiredEntities(ctx, time.Now().Add(-*expiredEntityRetention))
+			if err != nil {
+				return stacktrace.Propagate(err, "Failed to purge expired strategic conflict detection entities")
+			}
+			logger.Info("Purged expired strategic conflict detection entities", zap.Int("count", purged))
+			return nil
+		}}); err != nil {
+			return nil, stacktrace.Propagate(err, "Failed to schedule purging of expired strategic conflict detection entities")
+		}
+	}
+
+	if *scdStateMetricsSpec != "" {
+		if _, err := scdCron.AddFunc(*scdStateMetricsSpec, func() {
+			if err := server.RefreshStateMetrics(ctx); err != nil {
+				logger.Warn("Failed to refresh operational intent state metrics", zap.Error(err))
+			}
+		}); err != nil {
+			return nil, stacktrace.Propagate(err, "Failed to schedule refresh of operational intent state metrics")
+		}
+	}
+
+	if *danglingOperationalIntentCleanupSpec != "" {
+		policy, err := scd.DanglingPolicyFromString(*danglingOperationalIntentPolicy)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Invalid --dangling_operational_intent_policy")
+		}
+		cleaner := &scd.DanglingOperationalIntentCleaner{
+			Store:          scdStore,
+			Client:         notificationClient(),
+			Logger:         logger,
+			UnreachableFor: *danglingOperationalIntentUnreachable,
+			Policy:         policy,
+			DryRun:         *danglingOperationalIntentDryRun,
+		}
+		if err := scheduler.Add(jobs.Job{Name: "scd_dangling_operational_intent_cleanup", Spec: *danglingOperationalIntentCleanupSpec, Run: func(ctx context.Context) error {
+			if err := cleaner.Run(ctx); err != nil {
+				return stacktrace.Propagate(err, "Failed to clean up dangling operational intent references")
+			}
+			return nil
+		}}); err != nil {
+			return nil, stacktrace.Propagate(err, "Failed to schedule cleanup of dangling operational intent references")
+		}
+	}
+
+	if *scdConsistencyCheckSpec != "" {
+		area, err := geo.AreaToCellIDs(*scdConsistencyCheckArea)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Invalid --scd_consistency_check_area")
+		}
+		if err := scheduler.Add(jobs.Job{Name: "scd_consistency_check", Spec: *scdConsistencyCheckSpec, Run: func(ctx context.Context) error {
+			_, issues, err := server.CheckConsistency(ctx, area)
+			if err != nil {
+				return stacktrace.Propagate(err, "Failed to check consistency of strategic conflict detection entities")
+			}
+			for _, issue := range issues {
+				logger.Warn("Inconsistent strategic conflict detection entity",
+					zap.String("kind", issue.Kind), zap.String("entity_type", issue.EntityType),
+					zap.String("entity_id", issue.EntityID.String()), zap.String("detail", issue.Detail))
+			}
+			if len(issues) > 0 {
+				return stacktrace.NewError("Found %d inconsistencies of strategic conflict detection entities", len(issues))
+			}
+			return nil
+		}}); err != nil {
+			return nil, stacktrace.Propagate(err, "Failed to schedule consistency check of strategic conflict detection entities")
+		}
 	}
 
 	scdCron.Start()
 
-	return &scd.Server{
-		Store:      scdStore,
-		Timeout:    *timeout,
-		EnableHTTP: *enableHTTP,
-	}, nil
+	return server, nil
 }
 
 // RunGRPCServer starts the example gRPC service.
@@ -219,13 +781,63 @@ func RunGRPCServer(ctx context.Context, ctxCanceler func(), address string, loca
 		logger.Warn("missing required --accepted_jwt_audiences")
 	}
 
+	// Databases are connected and monitored anew on each attempt to start the
+	// servers.
+	schemaMonitor = &cockroach.SchemaMonitor{}
+	defer closeDatastores()
+
 	var (
 		ridServerV1 *rid_v1.Server
 		ridServerV2 *rid_v2.Server
 		scdServer   *scd.Server
-		auxServer   = &aux.Server{}
+		auxServer   = &aux.Server{Timeout: *timeout, EnableManagerReset: *enableManagerReset, Schemas: schemaMonitor}
 	)
 
+	hostname, err := os.Hostname()
+	if err != nil {
+		return stacktrace.Propagate(err, "Failed to get hostname identifying this DSS instance")
+	}
+	if *heartbeatInterval <= 0 {
+		return stacktrace.NewError("instance_heartbeat_interval must be positive")
+	}
+	if err := geo.SetCellLevel(*s2CellLevel); err != nil {
+		return stacktrace.Propagate(err, "Invalid --s2_cell_level")
+	}
+	if err := geo.SetLimits(geo.Limits{MaxAreaKm2: *maxAreaKm2, MaxPolygonVertices: *maxPolygonVertices, MaxCells: *maxAreaCells}); err != nil {
+		return stacktrace.Propagate(err, "Invalid --max_area_km2, --max_polygon_vertices or --max_area_cells")
+	}
+	if err := geo.SetCoveringCacheCells(*coveringCacheCells); err != nil {
+		return stacktrace.Propagate(err, "Invalid --covering_cache_cells")
+	}
+	for datum, path := range map[geo.VerticalDatum]string{geo.DatumEGM96: *egm96GeoidFile, geo.DatumEGM2008: *egm2008GeoidFile} {
+		if path == "" {
+			continue
+		}
+		geoid, err := geo.LoadGeoid(path)
+		if err != nil {
+			return stacktrace.Propagate(err, "Failed to load %s geoid", datum)
+		}
+		if err := geo.SetGeoid(datum, geoid); err != nil {
+			return stacktrace.Propagate(err, "Failed to set %s geoid", datum)
+		}
+	}
+	instance.ID = *instanceID
+	if instance.ID == "" {
+		instance.ID = hostname
+	}
+	instance.Hostname = hostname
+	instance.Locality = locality
+	instance.Version = version.Current().String()
+	instance.Commit = build.Describe().Commit
+	members = nil
+	maintenanceMode = nil
+	// Jobs are scheduled anew on each attempt to start the servers.
+	scheduler = &jobs.Scheduler{
+		Holder:        fmt.Sprintf("%s/%d", instance.ID, os.Getpid()),
+		LeaseDuration: *jobLeaseDuration,
+		Logger:        logger,
+	}
+
 	// Initialize remote ID
 	serverV1, serverV2, err := createRIDServer(ctx, locality, logger)
 	if err != nil {
@@ -233,6 +845,10 @@ func RunGRPCServer(ctx context.Context, ctxCanceler func(), address string, loca
 	}
 	ridServerV1 = serverV1
 	ridServerV2 = serverV2
+	auxServer.RIDApp = ridServerV1.App
+	auxServer.InstanceID = instance.ID
+	auxServer.Members = members
+	auxServer.Maintenance = maintenanceMode
 
 	scopesValidators := auth.MergeOperationsAndScopesValidators(
 		ridServerV1.AuthScopes(), ridServerV2.AuthScopes(),
@@ -251,6 +867,7 @@ func RunGRPCServer(ctx context.Context, ctxCanceler func(), address string, loca
 			return stacktrace.Propagate(err, "Failed to create strategic conflict detection server")
 		}
 		scdServer = server
+		auxServer.SCD = scdServer
 
 		scopesValidators = auth.MergeOperationsAndScopesValidators(
 			scopesValidators, scdServer.AuthScopes(),
@@ -272,61 +889,244 @@ func RunGRPCServer(ctx context.Context, ctxCanceler func(), address string, loca
 			KeyRefreshTimeout: *keyRefreshTimeout,
 			ScopesValidators:  scopesValidators,
 			AcceptedAudiences: strings.Split(*jwtAudiences, ","),
+			Unauthenticated:   append(auxServer.UnauthenticatedOperations(), "/grpc.health.v1.Health/Check"),
 		},
 	)
 	if err != nil {
 		return stacktrace.Propagate(err, "Error creating RSA authorizer")
 	}
 
+	// Readiness requires the datastores to be reachable and the access tokens
+	// to be verifiable, so that load balancers stop routing requests to
+	// instances which could only fail them.
+	auxServer.HealthChecks = map[string]aux.HealthCheck{"keys": authorizer.CheckKeys}
+	for _, db := range datastores {
+		auxServer.HealthChecks["datastore:"+db.Pool.Config().ConnConfig.Database] = db.Pool.Ping
+	}
+
+	// Share each subject's search budget across remote ID versions.
+	searchLimiter := ratelimit.NewLimiter(ratelimit.Limit{Rate: *ridSearchRate, Burst: *ridSearchBurst}, clockwork.NewRealClock())
+	limiters := map[auth.Operation]*ratelimit.Limiter{}
+	for _, op := range append(ridServerV1.SearchOperations(), ridServerV2.SearchOperations()...) {
+		limiters[op] = searchLimiter
+	}
+	var mutationLimiter *ratelimit.Limiter
+	if scdServer != nil {
+		// Share each subject's mutation budget across entity types.
+		mutationLimiter = ratelimit.NewLimiter(ratelimit.Limit{Rate: *scdMutationRate / 60, Burst: *scdMutationBurst}, clockwork.NewRealClock())
+		for _, op := range scdServer.MutationOperations() {
+			limiters[op] = mutationLimiter
+		}
+	}
+
+	// Apply the changes of the runtime settings upon SIGHUP or through the
+	// administrative endpoint, without restarting.
+	reload := func(ctx context.Context) (config.ReloadResult, error) {
+		result, err := config.Reload(reloadableFlags, func(changes []config.Change) error {
+			return applyReloadedFlags(ctx, changes, authorizer, searchLimiter, mutationLimiter)
+		})
+		if err != nil {
+			logger.Error("failed to reload configuration", zap.Error(err))
+			return result, err // No need to Propagate this error as this is not a useful stacktrace line
+		}
+		for _, c := range result.Changes {
+			logger.Info("configuration changed", zap.String("flag", c.Name), zap.String("old", c.Old), zap.String("new", c.New))
+		}
+		if len(result.RestartRequired) > 0 {
+			logger.Warn("configuration changes require a restart", zap.Strings("flags", result.RestartRequired))
+		}
+		logger.Info("reloaded configuration", zap.Int("changes", len(result.Changes)))
+		return result, nil
+	}
+	auxServer.Reload = reload
+
+	// Bound the datastore resources each class of request may hold.
+	deadlines := map[auth.Operation]deadline.Deadline{}
+	classes := []struct {
+		name       string
+		timeout    time.Duration
+		operations []auth.Operation
+	}{
+		{"search", *searchTimeout, append(ridServerV1.SearchOperations(), ridServerV2.SearchOperations()...)},
+		{"mutation", *mutationTimeout, append(ridServerV1.MutationOperations(), ridServerV2.MutationOperations()...)},
+		{"report", *reportTimeout, auxServer.ReportOperations()},
+	}
+	if scdServer != nil {
+		classes[0].operations = append(classes[0].operations, scdServer.SearchOperations()...)
+		classes[1].operations = append(classes[1].operations, scdServer.MutationOperations()...)
+		classes[2].operations = append(classes[2].operations, scdServer.ReportOperations()...)
+	}
+	for _, class := range classes {
+		if class.timeout < 0 || class.timeout > *timeout {
+			return stacktrace.NewError("The %s timeout must be between 0 and the server timeout of %s", class.name, *timeout)
+		}
+		for _, op := range class.operations {
+			deadlines[op] = deadline.Deadline{Class: class.name, Timeout: class.timeout}
+		}
+	}
+
+	// Reject mutations, and reads unless allowed, during maintenance windows.
+	mutations := append(ridServerV1.MutationOperations(), ridServerV2.MutationOperations()...)
+	if scdServer != nil {
+		mutations = append(mutations, scdServer.MutationOperations()...)
+	}
+	readScopes := []map[auth.Operation]auth.KeyClaimedScopesValidator{ridServerV1.AuthScopes(), ridServerV2.AuthScopes()}
+	if scdServer != nil {
+		readScopes = append(readScopes, scdServer.AuthScopes())
+	}
+	var reads []auth.Operation
+	for _, scopes := range readScopes {
+		for op := range scopes {
+			reads = append(reads, op)
+		}
+	}
+
 	// Set up server functionality
 	interceptors := []grpc.UnaryServerInterceptor{
-		uss_errors.Interceptor(logger),
-		logging.Interceptor(logger),
+		otelgrpc.UnaryServerInterceptor(),
+		metrics.Interceptor(),
+	}
+	if *accessLog {
+		fields, err := logging.ParseAccessFields(*accessLogFields)
+		if err != nil {
+			return stacktrace.Propagate(err, "Invalid --access_log_fields")
+		}
+		redact, err := logging.ParseAccessFields(*accessLogRedact)
+		if err != nil {
+			return stacktrace.Propagate(err, "Invalid --access_log_redact")
+		}
+		interceptor, err := logging.AccessLogInterceptor(logger, logging.AccessLogConfig{
+			Fields:      fields,
+			Redact:      redact,
+			SampleRatio: *accessLogSampleRatio,
+		})
+		if err != nil {
+			return stacktrace.Propagate(err, "Invalid access log configuration")
+		}
+		interceptors = append(interceptors, interceptor, uss_errors.Interceptor(logger))
+	} else {
+		interceptors = append(interceptors, uss_errors.Interceptor(logger), logging.Interceptor(logger))
+	}
+	interceptors = append(interceptors,
 		authorizer.AuthInterceptor,
-		validations.ValidationInterceptor,
+	)
+	if maintenanceMode != nil {
+		interceptors = append(interceptors, maintenance.Interceptor(maintenanceMode, mutations, reads))
 	}
+	interceptors = append(interceptors,
+		ratelimit.Interceptor(limiters),
+		deadline.Interceptor(deadlines),
+		validations.ValidationInterceptor,
+		cockroach.OperationInterceptor(),
+	)
 	if *dumpRequests {
 		interceptors = append(interceptors, logging.DumpRequestResponseInterceptor(logger))
 	}
 
-	s := grpc.NewServer(grpc_middleware.WithUnaryServerChain(interceptors...))
-	if err != nil {
-		return stacktrace.Propagate(err, "Error creating new gRPC server")
+	serverOptions := []grpc.ServerOption{grpc_middleware.WithUnaryServerChain(interceptors...)}
+	register := func(s *grpc.Server) {
+		ridpbv1.RegisterDiscoveryAndSynchronizationServiceServer(s, ridServerV1)
+		ridpbv2.RegisterStandardRemoteIDAPIInterfacesServiceServer(s, ridServerV2)
+		auxpb.RegisterDSSAuxServiceServer(s, auxServer)
+		if *enableSCD {
+			scdpb.RegisterUTMAPIUSSDSSAndUSSUSSServiceServer(s, scdServer)
+		}
+		healthpb.RegisterHealthServer(s, healthServer)
 	}
+	s := grpc.NewServer(serverOptions...)
 	if *reflectAPI {
 		reflection.Register(s)
 	}
 
 	logger.Info("build", zap.Any("description", build.Describe()))
 
-	ridpbv1.RegisterDiscoveryAndSynchronizationServiceServer(s, ridServerV1)
-	ridpbv2.RegisterStandardRemoteIDAPIInterfacesServiceServer(s, ridServerV2)
-	auxpb.RegisterDSSAuxServiceServer(s, auxServer)
+	register(s)
+	for service := range s.GetServiceInfo() {
+		healthServices = append(healthServices, service)
+	}
 	if *enableSCD {
 		logger.Info("config", zap.Any("scd", "enabled"))
-		scdpb.RegisterUTMAPIUSSDSSAndUSSUSSServiceServer(s, scdServer)
 	} else {
 		logger.Info("config", zap.Any("scd", "disabled"))
 	}
+	// Not ready until the servers start.
+	setServing(false)
 
-	signals := make(chan os.Signal)
+	// Internal clients may bypass the http-gateway.
+	servers := []*grpc.Server{s}
+	var (
+		direct         *grpc.Server
+		directListener net.Listener
+	)
+	if *grpcAddress != "" {
+		options := append([]grpc.ServerOption{}, serverOptions...)
+		if *grpcTLSCertFile != "" || *grpcTLSKeyFile != "" {
+			cert, err := tls.LoadX509KeyPair(*grpcTLSCertFile, *grpcTLSKeyFile)
+			if err != nil {
+				return stacktrace.Propagate(err, "Error loading grpc_tls_cert_file and grpc_tls_key_file")
+			}
+			options = append(options, grpc.Creds(credentials.NewServerTLSFromCert(&cert)))
+		} else {
+			logger.Warn("serving gRPC clients at grpc_addr without TLS")
+		}
+		direct = grpc.NewServer(options...)
+		register(direct)
+		reflection.Register(direct)
+		servers = append(servers, direct)
+
+		directListener, err = net.Listen("tcp", *grpcAddress)
+		if err != nil {
+			return stacktrace.Propagate(err, "Error attempting to listen at %s", *grpcAddress)
+		}
+	}
+
+	if *metricsAddress != "" {
+		go serveMetrics(logger)
+	}
+	if *debugAddress != "" {
+		if err := debug.CheckAddress(*debugAddress); err != nil {
+			return stacktrace.Propagate(err, "Invalid --debug_addr")
+		}
+		go serveDebug(logger)
+	}
+
+	signals := make(chan os.Signal, 1)
 	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
 	defer signal.Stop(signals)
 
+	reloads := make(chan os.Signal, 1)
+	signal.Notify(reloads, syscall.SIGHUP)
+	defer signal.Stop(reloads)
+	reloadCtx, stopReloads := context.WithCancel(ctx)
+	defer stopReloads()
 	go func() {
-		defer s.GracefulStop()
-
 		for {
 			select {
-			case <-ctx.Done():
-				logger.Info("stopping server due to context having been canceled")
+			case <-reloads:
+				logger.Info("received OS signal", zap.Stringer("signal", syscall.SIGHUP))
+				_, _ = reload(reloadCtx)
+			case <-reloadCtx.Done():
 				return
-			case s := <-signals:
-				logger.Info("received OS signal", zap.Stringer("signal", s))
-				ctxCanceler()
 			}
 		}
 	}()
+
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+
+		select {
+		case <-ctx.Done():
+			logger.Info("stopping server due to context having been canceled")
+		case sig := <-signals:
+			logger.Info("received OS signal", zap.Stringer("signal", sig))
+			drain(logger, auxServer)
+			stopGracefully(logger, servers...)
+			ctxCanceler()
+			return
+		}
+		stopGracefully(logger, servers...)
+	}()
 	l, err := net.Listen("tcp", address)
 	if err != nil {
 		return stacktrace.Propagate(err, "Error attempting to listen at %s", address)
@@ -335,33 +1135,236 @@ func RunGRPCServer(ctx context.Context, ctxCanceler func(), address string, loca
 	// l will close it on a graceful stop.
 
 	// Indicate ready for container health checks
-	readyFile, err := os.Create("service.ready")
+	if err := schemaMonitor.Refresh(ctx); err != nil {
+		return stacktrace.Propagate(err, "Incompatible database schemas")
+	}
+	if err := touchReadyFile(); err != nil {
+		return err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	if err := scheduler.Start(ctx); err != nil {
+		return stacktrace.Propagate(err, "Failed to start maintenance job scheduler")
+	}
+	defer scheduler.Stop()
+	if members != nil {
+		heartbeatCtx, stopHeartbeats := context.WithCancel(ctx)
+		defer stopHeartbeats()
+		go sendHeartbeats(heartbeatCtx, members, logger)
+	}
+	if maintenanceMode != nil {
+		refreshCtx, stopRefreshes := context.WithCancel(ctx)
+		defer stopRefreshes()
+		go refreshMaintenanceWindow(refreshCtx, maintenanceMode, logger)
+	}
+	if *schemaCompatibilitySpec != "" {
+		schemaCron := cron.New()
+		if _, err := schemaCron.AddFunc(*schemaCompatibilitySpec, func() { checkSchemaCompatibility(ctx, logger, auxServer) }); err != nil {
+			return stacktrace.Propagate(err, "Failed to schedule schema compatibility check")
+		}
+		schemaCron.Start()
+		defer schemaCron.Stop()
+	}
+
+	if direct != nil {
+		logger.Info("serving gRPC clients", zap.String("address", *grpcAddress))
+		go func() {
+			if err := direct.Serve(directListener); err != nil {
+				logger.Error("failed to serve gRPC clients", zap.Error(err))
+			}
+		}()
+	}
+	if err := s.Serve(l); err != nil {
+		return stacktrace.Propagate(err, "Failed to serve gRPC requests")
+	}
+	// Serve returns as soon as the server starts stopping; the requests in
+	// progress complete before the datastores are closed.
+	<-stopped
+	return nil
+}
+
+// sendHeartbeats registers this DSS instance in members every heartbeat
+// interval until ctx is done.
+func sendHeartbeats(ctx context.Context, members *membership.Registry, logger *zap.Logger) {
+	ticker := time.NewTicker(*heartbeatInterval)
+	defer ticker.Stop()
+	for {
+		if err := members.Heartbeat(ctx); err != nil && ctx.Err() == nil {
+			logger.Warn("Failed to send instance heartbeat", zap.Error(err))
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// refreshMaintenanceWindow reads the maintenance window of the pool into m
+// every maintenance.RefreshInterval until ctx is done, so that this DSS
+// instance observes the windows scheduled through the other instances.
+func refreshMaintenanceWindow(ctx context.Context, m *maintenance.Mode, logger *zap.Logger) {
+	ticker := time.NewTicker(maintenance.RefreshInterval)
+	defer ticker.Stop()
+	for {
+		if err := m.Refresh(ctx); err != nil && ctx.Err() == nil {
+			logger.Warn("Failed to refresh maintenance window", zap.Error(err))
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// reloadableFlags are the flags whose changes are applied without restart by
+// applyReloadedFlags.
+var reloadableFlags = []string{
+	"log_level",
+	"rid_search_rate_limit",
+	"rid_search_burst",
+	"scd_mutation_rate_limit",
+	"scd_mutation_burst",
+	"accepted_jwt_audiences",
+	"public_key_files",
+	"jwks_endpoint",
+	"jwks_key_ids",
+}
+
+// applyReloadedFlags applies the new values of the reloadableFlags changed
+// upon a configuration reload.  The changes which may fail are checked before
+// any is applied.
+func applyReloadedFlags(ctx context.Context, changes []config.Change, authorizer *auth.Authorizer, searchLimiter *ratelimit.Limiter, mutationLimiter *ratelimit.Limiter) error {
+	changed := map[string]bool{}
+	for _, c := range changes {
+		changed[c.Name] = true
+	}
+	var level zapcore.Level
+	if err := level.UnmarshalText([]byte(*logLevel)); err != nil {
+		return stacktrace.Propagate(err, "Invalid log level")
+	}
+	if changed["public_key_files"] || changed["jwks_endpoint"] || changed["jwks_key_ids"] {
+		keyResolver, err := createKeyResolver()
+		switch {
+		case err != nil:
+			return stacktrace.Propagate(err, "Error creating key resolver")
+		case keyResolver == nil:
+			return stacktrace.NewError("Missing public_key_files or jwks_endpoint and jwks_key_ids")
+		}
+		if err := authorizer.SetKeyResolver(ctx, keyResolver); err != nil {
+			return stacktrace.Propagate(err, "Error resolving keys")
+		}
+	}
+
+	if err := logging.SetLevel(*logLevel); err != nil {
+		return stacktrace.Propagate(err, "Error setting log level")
+	}
+	authorizer.SetAcceptedAudiences(strings.Split(*jwtAudiences, ","))
+	searchLimiter.SetLimit(ratelimit.Limit{Rate: *ridSearchRate, Burst: *ridSearchBurst})
+	if mutationLimiter != nil {
+		mutationLimiter.SetLimit(ratelimit.Limit{Rate: *scdMutationRate / 60, Burst: *scdMutationBurst})
+	}
+	return nil
+}
+
+// drain reports the DSS not ready, while still serving requests, for
+// --shutdown_delay, so that load balancers stop routing requests to it before
+// it stops accepting them.
+func drain(logger *zap.Logger, auxServer *aux.Server) {
+	auxServer.Drain()
+	removeReadyFile(logger)
+	logger.Info("draining before shutdown", zap.Duration("delay", *shutdownDelay))
+	time.Sleep(*shutdownDelay)
+}
+
+// stopGracefully stops servers once the requests in progress complete,
+// aborting the ones still in progress after --shutdown_timeout.
+func stopGracefully(logger *zap.Logger, servers ...*grpc.Server) {
+	var wg sync.WaitGroup
+	for _, s := range servers {
+		wg.Add(1)
+		go func(s *grpc.Server) {
+			defer wg.Done()
+			s.GracefulStop()
+		}(s)
+	}
+	stopped := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(*shutdownTimeout):
+		logger.Warn("aborting requests still in progress after shutdown timeout", zap.Duration("timeout", *shutdownTimeout))
+		for _, s := range servers {
+			s.Stop()
+		}
+		<-stopped
+	}
+}
+
+// closeDatastores closes the connection pools of the datastores.
+func closeDatastores() {
+	for _, db := range datastores {
+		db.Pool.Close()
+	}
+	datastores = nil
+}
+
+// touchReadyFile indicates that the DSS is ready for container health checks
+// and gRPC health checks.
+func touchReadyFile() error {
+	f, err := os.Create(readyFile)
 	if err != nil {
 		return stacktrace.Propagate(err, "Error touching file to indicate service ready")
 	}
-	readyFile.Close()
+	setServing(true)
+	return f.Close()
+}
 
-	return s.Serve(l)
+// removeReadyFile indicates that the DSS is not ready for container health
+// checks and gRPC health checks.
+func removeReadyFile(logger *zap.Logger) {
+	setServing(false)
+	if err := os.Remove(readyFile); err != nil && !os.IsNotExist(err) {
+		logger.Warn("Failed to remove ready file", zap.Error(err))
+	}
 }
 
-type RIDGarbageCollectorJob struct {
-	name string
-	gc   ridc.GarbageCollector
-	ctx  context.Context
+// setServing reports through healthServer whether the DSS is ready.
+func setServing(serving bool) {
+	status := healthpb.HealthCheckResponse_NOT_SERVING
+	if serving {
+		status = healthpb.HealthCheckResponse_SERVING
+	}
+	healthServer.SetServingStatus("", status)
+	for _, service := range healthServices {
+		healthServer.SetServingStatus(service, status)
+	}
 }
 
-func (gcj RIDGarbageCollectorJob) Run() {
-	logger := logging.WithValuesFromContext(gcj.ctx, logging.Logger)
-	err := gcj.gc.DeleteRIDExpiredRecords(gcj.ctx)
-	if err != nil {
-		logger.Warn("Fail to delete expired records", zap.Error(err))
-	} else {
-		logger.Info("Successful delete expired records")
+// checkSchemaCompatibility reports the DSS not ready while the schema versions
+// of its databases are not supported, so that mixed-version pools fail loudly
+// rather than corrupting entities.
+func checkSchemaCompatibility(ctx context.Context, logger *zap.Logger, auxServer *aux.Server) {
+	if auxServer.Draining() {
+		return
+	}
+	if err := schemaMonitor.Refresh(ctx); err != nil {
+		logger.Error("Database schemas are not supported by this DSS; reporting not ready", zap.Error(err))
+		removeReadyFile(logger)
+		return
+	}
+	if _, err := os.Stat(readyFile); os.IsNotExist(err) {
+		logger.Info("Database schemas are supported by this DSS again; reporting ready")
+		if err := touchReadyFile(); err != nil {
+			logger.Warn("Failed to indicate service ready", zap.Error(err))
+		}
 	}
 }
 
 func main() {
-	flag.Parse()
+	config.Parse()
 
 	if err := logging.Configure(*logLevel, *logFormat); err != nil {
 		panic(fmt.Sprintf("Failed to configure logging: %s", err.Error()))
@@ -381,6 +1384,20 @@ func main() {
 		}
 	}
 
+	shutdownTracing, err := tracing.Init(ctx, tracingflags.Config("core-service"))
+	if err != nil {
+		logger.Panic("Failed to initialize tracing", zap.Error(err))
+	}
+	defer func() {
+		// Unexported traces are dropped rather than delaying the exit when the
+		// collector is unreachable.
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			logger.Warn("Failed to flush traces", zap.Error(err))
+		}
+	}()
+
 	backoffs := []time.Duration{
 		5 * time.Second, 15 * time.Second, 1 * time.Minute, 1 * time.Minute,
 		1 * time.Minute, 5 * time.Minute}
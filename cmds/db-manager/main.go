@@ -4,57 +4,116 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"io/ioutil"
 	"log"
+	"os"
 	"path/filepath"
-	"regexp"
 	"strings"
+	"time"
 
 	"github.com/coreos/go-semver/semver"
 	"github.com/interuss/dss/pkg/cockroach"
+	"github.com/interuss/dss/pkg/cockroach/celllevel"
 	"github.com/interuss/dss/pkg/cockroach/flags"
+	"github.com/interuss/dss/pkg/cockroach/indexes"
+	"github.com/interuss/dss/pkg/cockroach/migration"
+	"github.com/interuss/dss/pkg/cockroach/snapshot"
+	"github.com/interuss/dss/pkg/cockroach/topology"
+	"github.com/interuss/dss/pkg/config"
+	"github.com/interuss/dss/pkg/geo"
+	ridc "github.com/interuss/dss/pkg/rid/store/cockroach"
 	"github.com/interuss/stacktrace"
 )
 
-type MigrationStep struct {
-	version      semver.Version
-	upToFile     string
-	downFromFile string
-}
-
 var (
-	// Pattern to match files describing migration steps
-	migrationStepRegexp = "(upto|downfrom)-v(\\d+\\.\\d+\\.\\d+)-(.*)\\.sql"
-)
-
-var (
-	path      = flag.String("schemas_dir", "", "path to db migration files directory. the migrations found there will be applied to the database whose name matches the folder name.")
-	dbVersion = flag.String("db_version", "", "the db version to migrate to (ex: 1.0.0) or use \"latest\" to automatically upgrade to the latest version or leave blank to print the current version")
+	path                  = flag.String("schemas_dir", "", "path to db migration files directory. the migrations found there will be applied to the database whose name matches the folder name.")
+	dbVersion             = flag.String("db_version", "", "the db version to migrate to (ex: 1.0.0) or use \"latest\" to automatically upgrade to the latest version or leave blank to print the current version")
+	exportSnapshot        = flag.String("export_snapshot", "", "path of a file to which the content of the snapshot_databases is exported as a portable snapshot, instead of migrating a schema")
+	restoreSnapshot       = flag.String("restore_snapshot", "", "path of a snapshot file whose content is restored into the snapshot_databases, instead of migrating a schema; the databases must be empty, with the schema versions of the snapshot")
+	debugSnapshot         = flag.String("debug_snapshot", "", "path of a new directory to which a transactionally consistent copy of all the tables of the snapshot_databases is written as newline-delimited JSON files with a manifest, to reproduce reported inconsistencies offline, instead of migrating a schema")
+	debugSnapshotAge      = flag.Duration("debug_snapshot_age", 0, "age of the data copied by debug_snapshot, i.e. how long before now the CockroachDB databases are read AS OF SYSTEM TIME; other datastores are read at the present time")
+	topologyMode          = flag.String("topology", "", "`plan` prints the statements configuring the multi-region topology of the topology_databases, `apply` executes them and verifies the result, `verify` only reports the discrepancies between the current and the configured topologies; CockroachDB only")
+	primaryRegion         = flag.String("primary_region", "", "primary region of the multi-region topology")
+	regions               = flag.String("regions", "", "comma-separated other regions of the multi-region topology")
+	surviveRegionFailure  = flag.Bool("survive_region_failure", false, "whether the multi-region topology survives the failure of a whole region rather than of an availability zone; requires at least 3 regions")
+	topologyDatabases     = flag.String("topology_databases", "rid,scd", "comma-separated names of the databases whose multi-region topology is configured")
+	checkIndexes          = flag.Bool("check_indexes", false, "report the query shapes of the DSS served by no index, and the indexes never read which serve none, in the index_databases, along with recommended statements, instead of migrating a schema")
+	indexDatabases        = flag.String("index_databases", "rid,scd", "comma-separated names of the databases whose indexes are checked")
+	relevelCells          = flag.Int("relevel_cells", 0, "S2 cell level to which the cells of the entities stored in the relevel_databases are migrated, instead of migrating a schema, before restarting the DSS instances with this --s2_cell_level; the DSS instances must not write meanwhile, e.g. during a maintenance window")
+	relevelDatabases      = flag.String("relevel_databases", "rid,scd", "comma-separated names of the databases whose cells are migrated by relevel_cells")
+	snapshotDatabases     = flag.String("snapshot_databases", "rid,scd", "comma-separated names of the databases exported to or restored from a snapshot")
+	dryRun                = flag.Bool("dry_run", false, "print the current version and the SQL statements the migration would execute, without changing the database")
+	allowPoolTableRemoval = flag.Bool("allow_pool_table_removal", false, "allow migrating the remote ID schema down past the versions creating the tables shared by all the DSS instances of the pool (job leases, instance registry, rate limits, maintenance windows), which disables the corresponding features of every instance")
 )
 
 func main() {
+	config.Parse()
+	connectParameters := flags.ConnectParameters()
+	connectParameters.ApplicationName = "db-manager"
+
+	switch {
+	case *exportSnapshot != "" && *restoreSnapshot != "":
+		log.Panic("Must not specify both export_snapshot and restore_snapshot")
+	case *exportSnapshot != "":
+		if err := exportDatabases(context.Background(), connectParameters, *exportSnapshot); err != nil {
+			log.Panicf("Failed to export snapshot to %s: %v", *exportSnapshot, err)
+		}
+		return
+	case *restoreSnapshot != "":
+		if err := restoreDatabases(context.Background(), connectParameters, *restoreSnapshot); err != nil {
+			log.Panicf("Failed to restore snapshot from %s: %v", *restoreSnapshot, err)
+		}
+		return
+	case *debugSnapshot != "":
+		if err := captureDebugSnapshot(context.Background(), connectParameters, *debugSnapshot); err != nil {
+			log.Panicf("Failed to capture debug snapshot to %s: %v", *debugSnapshot, err)
+		}
+		return
+	case *checkIndexes:
+		if err := checkDatabaseIndexes(context.Background(), connectParameters); err != nil {
+			log.Panicf("Failed to check indexes: %v", err)
+		}
+		return
+	case *relevelCells != 0:
+		if err := relevelDatabaseCells(context.Background(), connectParameters, *relevelCells); err != nil {
+			log.Panicf("Failed to relevel cells to level %d: %v", *relevelCells, err)
+		}
+		return
+	case *topologyMode != "":
+		if err := configureTopology(context.Background(), connectParameters, *topologyMode); err != nil {
+			log.Panicf("Failed to %s multi-region topology: %v", *topologyMode, err)
+		}
+		return
+	}
+
 	// Read and validate schemas_dir input
-	flag.Parse()
 	if *path == "" {
 		log.Panic("Must specify schemas_dir path")
 	}
-	dbName := filepath.Base(*path)
 
-	// Enumerate schema versions
-	steps, err := enumerateMigrationSteps(path)
-	if err != nil {
-		log.Panicf("Failed to read schema version migration definitions: %v", err)
+	migrator := &migration.Migrator{
+		SchemasDir:            *path,
+		ConnectParameters:     connectParameters,
+		Logf:                  log.Printf,
+		AllowPoolTableRemoval: *allowPoolTableRemoval,
 	}
-	if len(steps) == 0 {
-		log.Panicf("No migration definitions found in schemas_dir=%s", *path)
+	if filepath.Base(*path) == "rid" {
+		migrator.PoolTables = ridc.PoolTables
 	}
 
 	// Determine target version
-	var targetVersion *semver.Version
+	var (
+		targetVersion *semver.Version
+		err           error
+	)
 	if strings.ToLower(*dbVersion) == "latest" {
-		targetVersion = &steps[len(steps)-1].version
+		targetVersion, err = migrator.LatestVersion()
+		if err != nil {
+			log.Panicf("Failed to determine latest db_version: %v", err)
+		}
 	} else if strings.TrimSpace(*dbVersion) == "" {
 		// User just wants to print the current version
 		targetVersion = nil
@@ -65,173 +124,286 @@ func main() {
 		}
 	}
 
-	// Connect to database server
-	connectParameters := flags.ConnectParameters()
-	connectParameters.ApplicationName = "db-manager"
-	connectParameters.DBName = "postgres" // Use an initial database that is known to always be present
-	crdb, err := cockroach.Dial(context.Background(), connectParameters)
-	if err != nil {
-		log.Panicf("Failed to connect to database with %+v: %v", connectParameters, err)
+	if *dryRun {
+		plan, err := migrator.Plan(context.Background(), targetVersion)
+		if err != nil {
+			log.Panicf("Failed to plan database schema migration in %s: %v", *path, err)
+		}
+		printPlan(plan)
+		return
 	}
-	defer func() {
-		crdb.Pool.Close()
-	}()
 
-	// Make sure specified database exists
-	exists, err := doesDatabaseExist(crdb, dbName)
-	if err != nil {
-		log.Panicf("Failed to check whether database %s exists: %v", dbName, err)
+	if _, err := migrator.Migrate(context.Background(), targetVersion); err != nil {
+		log.Panicf("Failed to migrate database schema in %s: %v", *path, err)
 	}
-	if !exists && dbName == "rid" {
-		// In the special case of rid, the database was previously named defaultdb
-		log.Printf("Database %s does not exist; checking for older \"defaultdb\" database", dbName)
-		dbName = "defaultdb"
-		exists, err = doesDatabaseExist(crdb, dbName)
-		if err != nil {
-			log.Panicf("Failed to check whether old defaultdb database exists: %v", err)
+}
+
+// printPlan prints the changes plan would make to its database.
+func printPlan(plan *migration.Plan) {
+	fmt.Printf("-- Database: %s\n", plan.Database)
+	fmt.Printf("-- Current schema version: %v\n", &plan.CurrentVersion)
+	if plan.CreateDatabase != "" {
+		fmt.Printf("\n-- Database %s does not exist\n%s;\n", plan.Database, plan.CreateDatabase)
+	}
+	if len(plan.Steps) == 0 {
+		fmt.Println("\n-- No migration steps to execute")
+		return
+	}
+	for _, step := range plan.Steps {
+		fmt.Printf("\n-- %s: migrate %v to %v\n", step.File, &step.From, &step.To)
+		if len(step.RemovedPoolTables) > 0 {
+			fmt.Printf("-- Removes tables shared by all the DSS instances of the pool: %s\n", strings.Join(step.RemovedPoolTables, ", "))
 		}
+		fmt.Println(strings.TrimRight(step.SQL, "\n"))
 	}
-	if !exists {
-		log.Printf("Database %s does not exist; creating now", dbName)
-		createDB := fmt.Sprintf("CREATE DATABASE IF NOT EXISTS %s", dbName)
-		if _, err := crdb.Pool.Exec(context.Background(), createDB); err != nil {
-			log.Panicf("Failed to create new database %s: %v", dbName, err)
+}
+
+// exportDatabases writes a snapshot of the content of the snapshot_databases
+// to the file at path.
+func exportDatabases(ctx context.Context, connectParameters cockroach.ConnectParameters, path string) error {
+	result := &snapshot.Snapshot{Format: snapshot.Format, CreatedAt: time.Now().UTC()}
+	for _, dbName := range strings.Split(*snapshotDatabases, ",") {
+		dbName = strings.TrimSpace(dbName)
+		connectParameters.DBName = dbName
+		crdb, err := cockroach.Dial(ctx, connectParameters)
+		if err != nil {
+			return stacktrace.Propagate(err, "Failed to connect to database %s", dbName)
 		}
-	} else {
-		log.Printf("Database %s already exists; reading current state", dbName)
+		db, err := snapshot.Export(ctx, crdb, dbName)
+		crdb.Pool.Close()
+		if err != nil {
+			return stacktrace.Propagate(err, "Failed to export database %s", dbName)
+		}
+		rows := 0
+		for _, table := range db.Tables {
+			rows += len(table.Rows)
+		}
+		log.Printf("Exported %d rows from %d tables of %s at schema version %s", rows, len(db.Tables), dbName, db.SchemaVersion)
+		result.Databases = append(result.Databases, db)
 	}
 
-	// Read current schema version of database
-	currentVersion, err := crdb.GetVersion(context.Background(), dbName)
+	content, err := json.MarshalIndent(result, "", "  ")
 	if err != nil {
-		log.Panicf("Failed to get current database version for %s: %v", dbName, err)
+		return stacktrace.Propagate(err, "Failed to encode snapshot")
 	}
-	log.Printf("Initial %s database schema version is %v, target is %v", dbName, currentVersion, targetVersion)
-	if targetVersion == nil {
-		return
+	if err := ioutil.WriteFile(path, content, 0600); err != nil {
+		return stacktrace.Propagate(err, "Failed to write snapshot")
 	}
+	return nil
+}
 
-	// Compute index of current version
-	var currentStepIndex int = -1
-	for i, version := range steps {
-		if version.version == *currentVersion {
-			currentStepIndex = i
-		}
+// restoreDatabases restores the content of the snapshot_databases from the
+// snapshot in the file at path.
+func restoreDatabases(ctx context.Context, connectParameters cockroach.ConnectParameters, path string) error {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return stacktrace.Propagate(err, "Failed to read snapshot")
+	}
+	var source snapshot.Snapshot
+	if err := json.Unmarshal(content, &source); err != nil {
+		return stacktrace.Propagate(err, "Failed to decode snapshot")
+	}
+	if source.Format != snapshot.Format {
+		return stacktrace.NewError("Unsupported snapshot format `%s`; expected %s", source.Format, snapshot.Format)
 	}
 
-	// Perform migration steps until current version matches target version
-	for !currentVersion.Equal(*targetVersion) {
-		// Compute which migration step to run next and how it will change the schema version
-		var newCurrentStepIndex int
-		var sqlFile string
-		var newVersion *semver.Version
-		if currentVersion.LessThan(*targetVersion) {
-			// Migrate up to next version
-			sqlFile = steps[currentStepIndex+1].upToFile
-			newVersion = &steps[currentStepIndex+1].version
-			newCurrentStepIndex = currentStepIndex + 1
-		} else {
-			// Migrate down from current version
-			sqlFile = steps[currentStepIndex].downFromFile
-			newCurrentStepIndex = currentStepIndex - 1
-			newVersion = &steps[newCurrentStepIndex].version
+	for _, dbName := range strings.Split(*snapshotDatabases, ",") {
+		dbName = strings.TrimSpace(dbName)
+		db := source.Database(dbName)
+		if db == nil {
+			return stacktrace.NewError("Snapshot does not hold database %s", dbName)
 		}
-		log.Printf("Running %s to migrate %v to %v", sqlFile, currentVersion, newVersion)
-
-		// Read migration SQL into string
-		fullFilePath := filepath.Join(*path, sqlFile)
-		rawMigrationSQL, err := ioutil.ReadFile(fullFilePath)
+		connectParameters.DBName = dbName
+		crdb, err := cockroach.Dial(ctx, connectParameters)
 		if err != nil {
-			log.Panicf("Failed to load SQL content from %s: %v", fullFilePath, err)
+			return stacktrace.Propagate(err, "Failed to connect to database %s", dbName)
 		}
-		migrationSQL := fmt.Sprintf("USE %s;\n", dbName) + string(rawMigrationSQL)
-
-		// Execute migration step
-		if _, err := crdb.Pool.Exec(context.Background(), migrationSQL); err != nil {
-			log.Panicf("Failed to execute %s migration step %s: %v", dbName, fullFilePath, err)
+		err = snapshot.Restore(ctx, crdb, dbName, db)
+		crdb.Pool.Close()
+		if err != nil {
+			return stacktrace.Propagate(err, "Failed to restore database %s", dbName)
 		}
+		log.Printf("Restored %d tables of %s from snapshot created at %s", len(db.Tables), dbName, source.CreatedAt.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// captureDebugSnapshot writes a consistent copy of the tables of the
+// snapshot_databases to the new directory dir.  On CockroachDB, all the
+// databases are read as of the same system time.
+func captureDebugSnapshot(ctx context.Context, connectParameters cockroach.ConnectParameters, dir string) error {
+	if _, err := os.Stat(dir); err == nil {
+		return stacktrace.NewError("%s already exists", dir)
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return stacktrace.Propagate(err, "Failed to create %s", dir)
+	}
 
-		// Update current state
-		if dbName == "defaultdb" && newVersion.String() == "4.0.0" && newCurrentStepIndex > currentStepIndex {
-			// RID database changes from `defaultdb` to `rid` when moving up to 4.0.0
-			dbName = "rid"
+	manifest := &snapshot.Manifest{Format: snapshot.DebugFormat, CreatedAt: time.Now().UTC()}
+	for i, dbName := range strings.Split(*snapshotDatabases, ",") {
+		dbName = strings.TrimSpace(dbName)
+		connectParameters.DBName = dbName
+		crdb, err := cockroach.Dial(ctx, connectParameters)
+		if err != nil {
+			return stacktrace.Propagate(err, "Failed to connect to database %s", dbName)
 		}
-		if dbName == "rid" && currentVersion.String() == "4.0.0" && newCurrentStepIndex < currentStepIndex {
-			// RID database changes from `rid` to `defaultdb` when moving down from 4.0.0
-			dbName = "defaultdb"
+		if i == 0 && crdb.Dialect.IsCockroachDB() {
+			manifest.AsOfSystemTime, err = snapshot.SystemTime(ctx, crdb, *debugSnapshotAge)
+			if err != nil {
+				crdb.Pool.Close()
+				return stacktrace.Propagate(err, "Failed to determine system time of debug snapshot")
+			}
 		}
-		actualVersion, err := crdb.GetVersion(context.Background(), dbName)
+		db, err := snapshot.Capture(ctx, crdb, dbName, manifest.AsOfSystemTime, dir)
+		crdb.Pool.Close()
 		if err != nil {
-			log.Panicf("Failed to get current database version for %s: %v", dbName, err)
+			return stacktrace.Propagate(err, "Failed to capture database %s", dbName)
 		}
-		if !actualVersion.Equal(*newVersion) {
-			log.Panicf("Migration %s should have migrated %s schema version %v to %v, but instead resulted in %v", fullFilePath, dbName, currentVersion, newVersion, currentVersion)
+		rows := 0
+		for _, table := range db.Tables {
+			rows += table.Rows
 		}
-		currentVersion = actualVersion
-		currentStepIndex = newCurrentStepIndex
+		log.Printf("Captured %d rows from %d tables of %s at schema version %s", rows, len(db.Tables), dbName, db.SchemaVersion)
+		manifest.Databases = append(manifest.Databases, db)
 	}
 
-	log.Printf("Final %s version: %v", dbName, currentVersion)
+	content, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return stacktrace.Propagate(err, "Failed to encode debug snapshot manifest")
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, snapshot.ManifestFile), content, 0600); err != nil {
+		return stacktrace.Propagate(err, "Failed to write debug snapshot manifest")
+	}
+	return nil
 }
 
-func enumerateMigrationSteps(path *string) ([]MigrationStep, error) {
-	steps := make(map[semver.Version]MigrationStep)
-
-	// Identify files defining version migration steps
-	files, err := ioutil.ReadDir(*path)
-	if err != nil {
-		return make([]MigrationStep, 0), stacktrace.Propagate(err, "Failed to read schema files directory")
-	}
-	r := regexp.MustCompile(migrationStepRegexp)
-	for _, file := range files {
-		if !file.IsDir() {
-			match := r.FindStringSubmatch(file.Name())
-			if len(match) > 0 {
-				v := *semver.New(match[2])
-				step := steps[v]
-				step.version = v
-				if match[1] == "upto" {
-					step.upToFile = file.Name()
-				} else if match[1] == "downfrom" {
-					step.downFromFile = file.Name()
-				} else {
-					return make([]MigrationStep, 0), fmt.Errorf("Unexpected migration step prefix: %s", match[1])
-				}
-				steps[v] = step
-			}
+// configureTopology plans, applies or verifies the multi-region topology of
+// the topology_databases, according to mode.
+func configureTopology(ctx context.Context, connectParameters cockroach.ConnectParameters, mode string) error {
+	config := topology.Config{
+		PrimaryRegion:        *primaryRegion,
+		SurviveRegionFailure: *surviveRegionFailure,
+	}
+	for _, region := range strings.Split(*regions, ",") {
+		if region = strings.TrimSpace(region); region != "" {
+			config.Regions = append(config.Regions, region)
 		}
 	}
-
-	// Sort versions in ascending order
-	versions := make([]*semver.Version, len(steps))
-	i := 0
-	for k := range steps {
-		v := steps[k].version
-		versions[i] = &v
-		i++
+	if err := config.Validate(); err != nil {
+		return stacktrace.Propagate(err, "Invalid multi-region topology")
 	}
-	semver.Sort(versions)
-
-	// Render sorted step list
-	result := make([]MigrationStep, len(versions)+1)
-	result[0].version = *semver.New("0.0.0")
-	for i := 0; i < len(versions); i++ {
-		result[i+1] = steps[*versions[i]]
+	if mode != "plan" && mode != "apply" && mode != "verify" {
+		return stacktrace.NewError("Unknown topology mode `%s`; must be plan, apply or verify", mode)
 	}
 
-	return result, nil
+	consistent := true
+	for _, dbName := range strings.Split(*topologyDatabases, ",") {
+		dbName = strings.TrimSpace(dbName)
+		connectParameters.DBName = dbName
+		crdb, err := cockroach.Dial(ctx, connectParameters)
+		if err != nil {
+			return stacktrace.Propagate(err, "Failed to connect to database %s", dbName)
+		}
+		discrepancies, err := configureDatabaseTopology(ctx, crdb, dbName, config, mode)
+		crdb.Pool.Close()
+		if err != nil {
+			return err // No need to Propagate this error as this is not a useful stacktrace line
+		}
+		if mode == "plan" {
+			continue
+		}
+		if len(discrepancies) == 0 {
+			log.Printf("Multi-region topology of %s is as configured", dbName)
+		}
+		for _, discrepancy := range discrepancies {
+			consistent = false
+			log.Printf("Multi-region topology of %s: %s", dbName, discrepancy)
+		}
+	}
+	if !consistent {
+		return stacktrace.NewError("Multi-region topologies differ from the configured topology")
+	}
+	return nil
 }
 
-func doesDatabaseExist(crdb *cockroach.DB, database string) (bool, error) {
-	const checkDbQuery = `
-		SELECT EXISTS (
-			SELECT * FROM pg_database WHERE datname = $1
-		)`
+func configureDatabaseTopology(ctx context.Context, crdb *cockroach.DB, dbName string, config topology.Config, mode string) ([]string, error) {
+	switch mode {
+	case "plan":
+		tables, err := topology.Tables(ctx, crdb, dbName)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Failed to list tables of %s", dbName)
+		}
+		fmt.Printf("-- Database: %s\n", dbName)
+		for _, statement := range config.Statements(dbName, tables) {
+			fmt.Printf("%s;\n", statement)
+		}
+		return nil, nil
+	case "apply":
+		discrepancies, err := config.Apply(ctx, crdb, dbName, log.Printf)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Failed to apply multi-region topology to %s", dbName)
+		}
+		return discrepancies, nil
+	default:
+		state, err := topology.Read(ctx, crdb, dbName)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Failed to read multi-region topology of %s", dbName)
+		}
+		return config.Verify(state), nil
+	}
+}
 
-	var exists bool
-	if err := crdb.Pool.QueryRow(context.Background(), checkDbQuery, database).Scan(&exists); err != nil {
-		return false, err
+// checkDatabaseIndexes reports the index findings of the index_databases,
+// and returns an error if there are any.
+func relevelDatabaseCells(ctx context.Context, connectParameters cockroach.ConnectParameters, level int) error {
+	if level < geo.MinimumConfigurableCellLevel || level > geo.MaximumConfigurableCellLevel {
+		return stacktrace.NewError("Cell level %d is not between %d and %d", level, geo.MinimumConfigurableCellLevel, geo.MaximumConfigurableCellLevel)
 	}
+	for _, dbName := range strings.Split(*relevelDatabases, ",") {
+		dbName = strings.TrimSpace(dbName)
+		connectParameters.DBName = dbName
+		crdb, err := cockroach.Dial(ctx, connectParameters)
+		if err != nil {
+			return stacktrace.Propagate(err, "Failed to connect to database %s", dbName)
+		}
+		for _, table := range celllevel.Tables(dbName) {
+			updated, err := celllevel.Relevel(ctx, crdb, table, level)
+			if err != nil {
+				crdb.Pool.Close()
+				return stacktrace.Propagate(err, "Failed to relevel cells of %s", dbName)
+			}
+			log.Printf("Releveled the cells of %d rows of %s.%s to level %d", updated, dbName, table.Name, level)
+		}
+		crdb.Pool.Close()
+	}
+	return nil
+}
 
-	return exists, nil
+func checkDatabaseIndexes(ctx context.Context, connectParameters cockroach.ConnectParameters) error {
+	healthy := true
+	for _, dbName := range strings.Split(*indexDatabases, ",") {
+		dbName = strings.TrimSpace(dbName)
+		connectParameters.DBName = dbName
+		crdb, err := cockroach.Dial(ctx, connectParameters)
+		if err != nil {
+			return stacktrace.Propagate(err, "Failed to connect to database %s", dbName)
+		}
+		schema, err := indexes.Read(ctx, crdb)
+		crdb.Pool.Close()
+		if err != nil {
+			return stacktrace.Propagate(err, "Failed to read indexes of %s", dbName)
+		}
+		findings := indexes.Check(schema, indexes.ExpectedShapes(dbName), crdb.Dialect)
+		if len(findings) == 0 {
+			log.Printf("Indexes of %s serve all DSS query shapes", dbName)
+		}
+		for _, finding := range findings {
+			healthy = false
+			log.Printf("Indexes of %s: table %s: %s; recommended: %s;", dbName, finding.Table, finding.Message, finding.Recommendation)
+		}
+	}
+	if !healthy {
+		return stacktrace.NewError("Indexes differ from the query shapes of the DSS")
+	}
+	return nil
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/golang-jwt/jwt"
 	"github.com/interuss/dss/cmds/dummy-oauth/api"
 	"github.com/interuss/dss/cmds/dummy-oauth/api/dummyoauth"
+	"github.com/interuss/dss/pkg/config"
 )
 
 var (
@@ -91,7 +92,7 @@ func (*PermissiveAuthorizer) Authorize(w http.ResponseWriter, r *http.Request, s
 }
 
 func main() {
-	flag.Parse()
+	config.Parse()
 
 	// Read private key
 	bytes, err := ioutil.ReadFile(*keyFile)
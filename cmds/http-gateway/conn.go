@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"math"
+	"net"
+	"net/http"
+
+	"github.com/interuss/stacktrace"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+// newServer returns the server of handler on address, serving HTTPS with
+// serverTLS unless nil, with the connection settings of the
+// --http2_max_concurrent_streams, --idle_timeout and --h2c flags.
+func newServer(address string, handler http.Handler, serverTLS *tls.Config) (*http.Server, error) {
+	if *http2MaxConcurrentStreams == 0 || *http2MaxConcurrentStreams > math.MaxUint32 {
+		return nil, stacktrace.NewError("http2_max_concurrent_streams must be between 1 and %d", uint32(math.MaxUint32))
+	}
+	if *idleTimeout < 0 {
+		return nil, stacktrace.NewError("idle_timeout must not be negative")
+	}
+	h2 := &http2.Server{
+		MaxConcurrentStreams: uint32(*http2MaxConcurrentStreams),
+		IdleTimeout:          *idleTimeout,
+	}
+	if serverTLS == nil && *enableH2C {
+		handler = h2c.NewHandler(handler, h2)
+	}
+	server := &http.Server{
+		Addr:        address,
+		Handler:     handler,
+		TLSConfig:   serverTLS,
+		IdleTimeout: *idleTimeout,
+	}
+	if serverTLS != nil {
+		if err := http2.ConfigureServer(server, h2); err != nil {
+			return nil, stacktrace.Propagate(err, "Failed to configure HTTP/2")
+		}
+	}
+	return server, nil
+}
+
+// listen listens for client connections on address, by default on the
+// standard port of HTTPS when useTLS and of HTTP otherwise, with the TCP
+// keep-alive interval of --tcp_keepalive_interval.
+func listen(ctx context.Context, address string, useTLS bool) (net.Listener, error) {
+	switch {
+	case address == "" && useTLS:
+		address = ":https"
+	case address == "":
+		address = ":http"
+	}
+	config := net.ListenConfig{KeepAlive: *tcpKeepAlive}
+	l, err := config.Listen(ctx, "tcp", address)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to listen for connections")
+	}
+	return l, nil
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/interuss/dss/pkg/api/v1/auxpb"
+	"go.uber.org/zap"
+)
+
+const (
+	livenessPath  = "/healthz"
+	readinessPath = "/readyz"
+)
+
+// probeCheck is the result of a readiness check, as served by readinessPath.
+type probeCheck struct {
+	Name       string `json:"name"`
+	Healthy    bool   `json:"healthy"`
+	Error      string `json:"error,omitempty"`
+	DurationMs int64  `json:"duration_ms"`
+}
+
+// writeProbe writes body as the JSON response of a probe, with the
+// ServiceUnavailable status unless ok.
+func writeProbe(w http.ResponseWriter, ok bool, body interface{}, logger *zap.Logger) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	if !ok {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		logger.Error("Error writing probe response", zap.Error(err))
+	}
+}
+
+// live serves the liveness probe, which only fails when the gateway cannot
+// serve requests at all; restarting the gateway would not fix its
+// dependencies, which the readiness probe checks.
+func live(w http.ResponseWriter, logger *zap.Logger) {
+	writeProbe(w, true, struct {
+		Status string `json:"status"`
+	}{"ok"}, logger)
+}
+
+// ready serves the readiness probe, which fails while the gateway or
+// core-service is draining, core-service is unreachable, or any of the
+// schema compatibility and dependency checks of core-service fails, listing
+// the result of each check.
+func ready(w http.ResponseWriter, r *http.Request, client auxpb.DSSAuxServiceClient, draining bool, logger *zap.Logger) {
+	checks := []probeCheck{{Name: "gateway", Healthy: !draining}}
+	if draining {
+		checks[0].Error = "draining"
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	start := time.Now()
+	status, err := client.GetStatus(ctx, &auxpb.GetStatusRequest{})
+	core := probeCheck{Name: "core-service", Healthy: true, DurationMs: time.Since(start).Milliseconds()}
+	switch {
+	case err != nil:
+		logger.Warn("Failed to get core-service status", zap.Error(err))
+		core.Healthy, core.Error = false, err.Error()
+	case status.Draining:
+		core.Healthy, core.Error = false, "draining"
+	}
+	checks = append(checks, core)
+	if err == nil {
+		for _, schema := range status.Schemas {
+			check := probeCheck{Name: "schema:" + schema.Database, Healthy: schema.Compatible, Error: schema.Error}
+			if !schema.Compatible && check.Error == "" {
+				check.Error = "schema version " + schema.SchemaVersion + " not between " + schema.MinimumSchemaVersion + " and " + schema.MaximumSchemaVersion
+			}
+			checks = append(checks, check)
+		}
+		for _, c := range status.Checks {
+			checks = append(checks, probeCheck{Name: c.Name, Healthy: c.Healthy, Error: c.Error, DurationMs: c.DurationMs})
+		}
+	}
+
+	ok := true
+	for _, check := range checks {
+		ok = ok && check.Healthy
+	}
+	writeProbe(w, ok, struct {
+		Ready  bool         `json:"ready"`
+		Checks []probeCheck `json:"checks"`
+	}{ok, checks}, logger)
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/dss/pkg/ipfilter"
+	"github.com/interuss/stacktrace"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+)
+
+// apiFamilies are the API families which listeners may serve, as named in
+// the apiVersions of the gateway.
+var apiFamilies = []string{"rid", "scd", "aux"}
+
+// listenerConfig describes a listener of the gateway serving some of the API
+// families, with its own TLS configuration and network restrictions.
+type listenerConfig struct {
+	// Name identifies the listener in the logs.
+	Name string `yaml:"name"`
+	// Address is the local address on which the listener accepts
+	// connections.
+	Address string `yaml:"address"`
+	// APIs are the API families served by the listener, among apiFamilies;
+	// other listeners do not serve them.
+	APIs []string `yaml:"apis"`
+	// TLSCertFile and TLSKeyFile are the PEM files of the certificate chain
+	// and private key with which the listener serves HTTPS instead of HTTP.
+	TLSCertFile string `yaml:"tls_cert_file"`
+	TLSKeyFile  string `yaml:"tls_key_file"`
+	// IPAllowlist, IPDenylist and TrustedProxies restrict the clients of the
+	// listener like the flags of the same names restrict the clients of
+	// --addr, which do not apply to this listener.
+	IPAllowlist    []string `yaml:"ip_allowlist"`
+	IPDenylist     []string `yaml:"ip_denylist"`
+	TrustedProxies []string `yaml:"trusted_proxies"`
+	// RateLimit is whether the rate limit policy of --rate_limit_policy_file
+	// applies to the requests of the listener; true when unspecified.
+	RateLimit *bool `yaml:"rate_limit"`
+}
+
+// ipFilter returns the ipfilter.Filter restricting the clients of c.
+func (c listenerConfig) ipFilter() (*ipfilter.Filter, error) {
+	allow, err := ipfilter.ParseCIDRs(strings.Join(c.IPAllowlist, ","))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Invalid ip_allowlist")
+	}
+	deny, err := ipfilter.ParseCIDRs(strings.Join(c.IPDenylist, ","))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Invalid ip_denylist")
+	}
+	proxies, err := ipfilter.ParseCIDRs(strings.Join(c.TrustedProxies, ","))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Invalid trusted_proxies")
+	}
+	return &ipfilter.Filter{Allow: allow, Deny: deny, TrustedProxies: proxies}, nil
+}
+
+// tlsConfig returns the TLS configuration of c, or nil when it serves HTTP.
+// Its certificate is reloaded every --tls_reload_interval until ctx is done.
+func (c listenerConfig) tlsConfig(ctx context.Context, logger *zap.Logger) (*tls.Config, error) {
+	if c.TLSCertFile == "" && c.TLSKeyFile == "" {
+		return nil, nil
+	}
+	if c.TLSCertFile == "" || c.TLSKeyFile == "" {
+		return nil, stacktrace.NewError("tls_cert_file and tls_key_file must be specified together")
+	}
+	if *tlsReloadInterval <= 0 {
+		return nil, stacktrace.NewError("tls_reload_interval must be positive")
+	}
+	reloader, err := newCertReloader(c.TLSCertFile, c.TLSKeyFile, logger)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	go reloader.watch(ctx, *tlsReloadInterval)
+	return &tls.Config{
+		GetCertificate: reloader.GetCertificate,
+		MinVersion:     tls.VersionTLS12,
+	}, nil
+}
+
+// parseListeners parses the YAML list of listenerConfigs of data, e.g.
+//
+//	# Serves the auxiliary API on the internal network only.
+//	- name: admin
+//	  address: 10.0.0.5:8443
+//	  apis: [aux]
+//	  tls_cert_file: /etc/dss/internal/tls.crt
+//	  tls_key_file: /etc/dss/internal/tls.key
+//	  ip_allowlist: [10.0.0.0/8]
+//	  rate_limit: false
+//
+// checking that each API family is served by at most one listener.
+func parseListeners(data []byte) ([]listenerConfig, error) {
+	var listeners []listenerConfig
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&listeners); err != nil {
+		return nil, stacktrace.Propagate(err, "Error parsing listeners")
+	}
+	known := map[string]bool{}
+	for _, api := range apiFamilies {
+		known[api] = true
+	}
+	served := map[string]string{}
+	for i, l := range listeners {
+		if l.Name == "" {
+			return nil, stacktrace.NewError("Listener %d has no name", i)
+		}
+		if l.Address == "" {
+			return nil, stacktrace.NewError("Listener %s has no address", l.Name)
+		}
+		if len(l.APIs) == 0 {
+			return nil, stacktrace.NewError("Listener %s serves no API", l.Name)
+		}
+		for _, api := range l.APIs {
+			if !known[api] {
+				return nil, stacktrace.NewError("Unknown API `%s` of listener %s; must be among %s", api, l.Name, strings.Join(apiFamilies, ", "))
+			}
+			if other, ok := served[api]; ok {
+				return nil, stacktrace.NewError("API `%s` is served by both listeners %s and %s", api, other, l.Name)
+			}
+			served[api] = l.Name
+		}
+	}
+	return listeners, nil
+}
+
+// loadListeners parses the listenerConfigs of the YAML file at path.
+func loadListeners(path string) ([]listenerConfig, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error reading listeners %s", path)
+	}
+	listeners, err := parseListeners(data)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Invalid listeners %s", path)
+	}
+	return listeners, nil
+}
+
+// restrictAPIs serves the requests to the API families of apis with next, and
+// responds to the requests to the other API families of vs as not found, as
+// they are served by other listeners.  Requests outside of any API family,
+// such as probes, are passed on to next.
+func (vs *apiVersions) restrictAPIs(apis map[string]bool, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, v := range vs.versions {
+			if strings.HasPrefix(r.URL.Path, v.Prefix) {
+				if !apis[v.API] {
+					_ = dsserr.WriteHTTPError(w, http.StatusNotFound, dsserr.NotFound, dsserr.MakeErrID(), "",
+						"The "+v.API+" API is not served on this address")
+					return
+				}
+				break
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -2,15 +2,19 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/textproto"
 	"os"
 	"os/signal"
 	"reflect"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -19,16 +23,31 @@ import (
 	"github.com/interuss/dss/pkg/api/v1/ridpbv1"
 	"github.com/interuss/dss/pkg/api/v1/scdpb"
 	"github.com/interuss/dss/pkg/api/v2/ridpbv2"
+	"github.com/interuss/dss/pkg/bodylimit"
 	"github.com/interuss/dss/pkg/build"
+	"github.com/interuss/dss/pkg/compression"
+	"github.com/interuss/dss/pkg/config"
+	"github.com/interuss/dss/pkg/debug"
 	"github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/dss/pkg/ipfilter"
 	"github.com/interuss/dss/pkg/logging"
+	"github.com/interuss/dss/pkg/metrics"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	"github.com/interuss/dss/pkg/ratelimit"
+	"github.com/interuss/dss/pkg/tracing"
+	tracingflags "github.com/interuss/dss/pkg/tracing/flags"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/interuss/stacktrace"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.uber.org/zap"
+	"golang.org/x/crypto/acme/autocert"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -38,8 +57,60 @@ var (
 	coreService     = flag.String("core-service", "", "Endpoint for core service. Only to be set if run in proxy mode")
 	profServiceName = flag.String("gcp_prof_service_name", "", "Service name for the Go profiler")
 	enableSCD       = flag.Bool("enable_scd", false, "Enables the Strategic Conflict Detection API")
+	metricsAddress  = flag.String("metrics_addr", "", "address on which to serve Prometheus metrics at /metrics; metrics are not served when empty")
+	debugAddress    = flag.String("debug_addr", "", "localhost or loopback address on which to serve pprof profiles at /debug/pprof/, expvar variables at /debug/vars and runtime statistics at /debug/runtime; debug endpoints are not served when empty")
+
+	shutdownDelay   = flag.Duration("shutdown_delay", 5*time.Second, "Duration for which the gateway reports itself unhealthy upon a termination signal while still accepting requests, for load balancers to stop routing requests to it before it drains")
+	shutdownTimeout = flag.Duration("shutdown_timeout", 30*time.Second, "Maximum duration for which the requests in progress upon shutdown are allowed to complete before their connections are closed")
+
+	deprecatedAPIVersions = flag.String("deprecated_api_versions", "", "Comma-separated API versions signaled as deprecated to clients, as api/version optionally followed by =YYYY-MM-DD to announce a sunset date, e.g. rid/v1=2025-06-30; served versions are listed at "+apiVersionsPath)
+
+	tlsCertFile       = flag.String("tls_cert_file", "", "PEM file of the certificate chain with which the gateway serves HTTPS instead of HTTP, along with tls_key_file; the files are reloaded when they change, e.g. upon renewal")
+	tlsKeyFile        = flag.String("tls_key_file", "", "PEM file of the private key of tls_cert_file")
+	tlsReloadInterval = flag.Duration("tls_reload_interval", 10*time.Second, "Interval at which tls_cert_file and tls_key_file are checked for changes")
+
+	compressResponses  = flag.Bool("compress_responses", true, "Gzip-compresses the responses of at least compression_min_size bytes for the clients accepting it")
+	compressionMinSize = flag.Int("compression_min_size", 1024, "Minimum size in bytes of the responses compressed with compress_responses")
+
+	rateLimitPolicyFile = flag.String("rate_limit_policy_file", "", "YAML file of the rate limit policy of API requests, with the global budget of all requests, the default budget of each token subject and the budgets of specific subjects; requests are not rate limited when empty")
+	rateLimitShared     = flag.Bool("rate_limit_shared", false, "Shares the token buckets of rate_limit_policy_file among the gateways of the pool through the remote ID database, per the cockroach_* flags, instead of each gateway enforcing the budgets by itself")
+	pkFile              = flag.String("public_key_files", "", "Path to public Keys verifying the access tokens whose subjects are checked against the subject budgets of rate_limit_policy_file, separated by commas, like core-service; requests are only checked against the global budget when no keys are specified")
+	jwksEndpoint        = flag.String("jwks_endpoint", "", "URL pointing to an endpoint serving JWKS, instead of public_key_files")
+	jwksKeyIDs          = flag.String("jwks_key_ids", "", "IDs of a set of key in a JWKS, separated by commas")
+	keyRefreshTimeout   = flag.Duration("key_refresh_timeout", 1*time.Minute, "Timeout for refreshing keys for JWT verification")
+
+	maxEntityBodySize = flag.Int64("max_entity_body_size", 1<<20, "Maximum size in bytes of the bodies of the requests creating or updating entities, such as identification service areas, subscriptions, operational intents and constraints")
+	maxReportBodySize = flag.Int64("max_report_body_size", 4<<20, "Maximum size in bytes of the bodies of the reports submitted to the DSS")
+	maxBodySize       = flag.Int64("max_body_size", 1<<20, "Maximum size in bytes of the bodies of other requests, such as searches")
+
+	ipAllowlist    = flag.String("ip_allowlist", "", "Comma-separated CIDR network ranges (or IP addresses) of the clients allowed to make API requests, e.g. 192.0.2.0/24,2001:db8::/32; clients are not restricted to network ranges when empty")
+	ipDenylist     = flag.String("ip_denylist", "", "Comma-separated CIDR network ranges (or IP addresses) of the clients denied API requests, even when within ip_allowlist")
+	trustedProxies = flag.String("trusted_proxies", "", "Comma-separated CIDR network ranges (or IP addresses) of the proxies, such as load balancers, whose X-Forwarded-For header identifies the clients checked against ip_allowlist and ip_denylist")
+
+	http2MaxConcurrentStreams = flag.Uint("http2_max_concurrent_streams", 250, "Maximum number of concurrent HTTP/2 streams of each client connection; further requests wait for streams to complete")
+	idleTimeout               = flag.Duration("idle_timeout", 0, "Duration for which idle client connections are kept open for subsequent requests before being closed; connections are kept open until clients close them when 0. Should exceed the idle timeout of load balancers, so that they do not reuse connections being closed")
+	tcpKeepAlive              = flag.Duration("tcp_keepalive_interval", 15*time.Second, "Interval between the TCP keep-alive probes of idle client connections, detecting dead peers and keeping network middleboxes from dropping the connections; keep-alive probes are disabled when negative")
+	enableH2C                 = flag.Bool("h2c", false, "Serves HTTP/2 over plaintext connections (h2c) along with HTTP/1.1 when not serving HTTPS, e.g. for load balancers multiplexing requests to the gateway over HTTP/2; HTTP/2 is always negotiated over HTTPS")
+
+	listenersFile = flag.String("listeners_file", "", "YAML file of the additional listeners of the gateway, each serving some of the rid, scd and aux API families on its own address, with its own TLS certificate, network restrictions and rate limiting; the API families served by these listeners are no longer served on addr")
+
+	acmeDomains      = flag.String("acme_domains", "", "Comma-separated domains for which the gateway serves HTTPS with certificates obtained and renewed automatically from an ACME certificate authority, instead of tls_cert_file. The TLS-ALPN-01 challenge requires the gateway to be reachable on port 443 of these domains")
+	acmeCacheDir     = flag.String("acme_cache_dir", "acme-cache", "Directory persisting the ACME account key and certificates across restarts, so that certificates are not requested anew")
+	acmeEmail        = flag.String("acme_email", "", "Contact email address of the ACME account, notified by the certificate authority about certificate problems")
+	acmeDirectoryURL = flag.String("acme_directory_url", autocert.DefaultACMEDirectory, "Directory URL of the ACME certificate authority")
+	acmeHTTPAddress  = flag.String("acme_http_addr", "", "Local address on which to also answer HTTP-01 challenges, e.g. :80, redirecting other HTTP requests to HTTPS; only TLS-ALPN-01 challenges are answered when empty")
 )
 
+// scdAPIVersions lists the strategic conflict detection API versions served
+// when strategic conflict detection is enabled, oldest first.
+var scdAPIVersions = []struct {
+	version  string
+	prefix   string
+	register func(context.Context, *runtime.ServeMux, string, []grpc.DialOption) error
+}{
+	{"v1", "/dss/v1/", scdpb.RegisterUTMAPIUSSDSSAndUSSUSSServiceHandlerFromEndpoint},
+}
+
 const (
 	codeRetryable = stacktrace.ErrorCode(1)
 )
@@ -61,20 +132,29 @@ func RunHTTPProxy(ctx context.Context, ctxCanceler func(), address, endpoint str
 	// Register gRPC server endpoint
 	// Note: Make sure the gRPC server is running properly and accessible
 	grpcMux := runtime.NewServeMux(
-		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
-			OrigName:     true,
-			EmitDefaults: true, // Include empty JSON arrays.
-			Indent:       "  ",
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.HTTPBodyMarshaler{
+			// Serves google.api.HttpBody responses, such as exports, verbatim.
+			Marshaler: &runtime.JSONPb{
+				OrigName:     true,
+				EmitDefaults: true, // Include empty JSON arrays.
+				Indent:       "  ",
+			},
 		}),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+		runtime.WithMetadata(dryRunAnnotator),
 	)
 
 	opts := []grpc.DialOption{
 		grpc.WithInsecure(),
 		grpc.WithBlock(),
+		grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
 		//lint:ignore SA1019 This is required as an argument to a generated function.
 		grpc.WithTimeout(10 * time.Second),
 	}
 
+	versions := &apiVersions{}
+
 	logger.Info("Registering RID v1 service")
 	if err := ridpbv1.RegisterDiscoveryAndSynchronizationServiceHandlerFromEndpoint(ctx, grpcMux, endpoint, opts); err != nil {
 		// TODO: More robustly detect failure to create RID server is due to a problem that may be temporary
@@ -83,6 +163,7 @@ func RunHTTPProxy(ctx context.Context, ctxCanceler func(), address, endpoint str
 		}
 		return stacktrace.Propagate(err, "Error registering RID v1 service handler")
 	}
+	versions.mount("rid", "v1", "/v1/dss/")
 
 	logger.Info("Registering RID v2 service")
 	if err := ridpbv2.RegisterStandardRemoteIDAPIInterfacesServiceHandlerFromEndpoint(ctx, grpcMux, endpoint, opts); err != nil {
@@ -91,6 +172,7 @@ func RunHTTPProxy(ctx context.Context, ctxCanceler func(), address, endpoint str
 		}
 		return stacktrace.Propagate(err, "Error registering RID v2 service handler")
 	}
+	versions.mount("rid", "v2", "/rid/v2/")
 
 	logger.Info("Registering aux service")
 	if err := auxpb.RegisterDSSAuxServiceHandlerFromEndpoint(ctx, grpcMux, endpoint, opts); err != nil {
@@ -100,63 +182,221 @@ func RunHTTPProxy(ctx context.Context, ctxCanceler func(), address, endpoint str
 		}
 		return stacktrace.Propagate(err, "Error registering aux service handler")
 	}
+	versions.mount("aux", "v1", "/aux/v1/")
 
 	logger.Info("Registering SCD service")
 	if *enableSCD {
-		if err := scdpb.RegisterUTMAPIUSSDSSAndUSSUSSServiceHandlerFromEndpoint(ctx, grpcMux, endpoint, opts); err != nil {
-			// TODO: More robustly detect failure to create SCD server is due to a problem that may be temporary
-			if strings.Contains(err.Error(), "context deadline exceeded") {
-				return stacktrace.PropagateWithCode(err, codeRetryable, "Failed to connect to core-service for strategic conflict detection")
+		for _, v := range scdAPIVersions {
+			if err := v.register(ctx, grpcMux, endpoint, opts); err != nil {
+				// TODO: More robustly detect failure to create SCD server is due to a problem that may be temporary
+				if strings.Contains(err.Error(), "context deadline exceeded") {
+					return stacktrace.PropagateWithCode(err, codeRetryable, "Failed to connect to core-service for strategic conflict detection %s", v.version)
+				}
+				return stacktrace.Propagate(err, "Error registering SCD %s service handler", v.version)
 			}
-			return stacktrace.Propagate(err, "Error registering SCD service handler")
+			versions.mount("scd", v.version, v.prefix)
 		}
 		logger.Info("config", zap.Any("scd", "enabled"))
 	} else {
 		logger.Info("config", zap.Any("scd", "disabled"))
 	}
 
-	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.URL.Path == "/healthy" {
-			if _, err := w.Write([]byte("ok")); err != nil {
-				logger.Error("Error writing to /healthy")
+	if err := versions.deprecate(*deprecatedAPIVersions); err != nil {
+		return stacktrace.Propagate(err, "Invalid deprecated API versions")
+	}
+
+	// Readiness depends on the core-service supporting the schemas of its
+	// databases, so that mixed-version pools fail loudly.
+	statusConn, err := grpc.DialContext(ctx, endpoint, grpc.WithInsecure())
+	if err != nil {
+		return stacktrace.Propagate(err, "Failed to connect to core-service for status")
+	}
+	defer statusConn.Close()
+	statusClient := auxpb.NewDSSAuxServiceClient(statusConn)
+
+	var listeners []listenerConfig
+	if *listenersFile != "" {
+		listeners, err = loadListeners(*listenersFile)
+		if err != nil {
+			return stacktrace.Propagate(err, "Invalid --listeners_file")
+		}
+	}
+	mainAPIs := map[string]bool{}
+	for _, api := range apiFamilies {
+		mainAPIs[api] = true
+	}
+	for _, l := range listeners {
+		for _, api := range l.APIs {
+			mainAPIs[api] = false
+		}
+	}
+
+	// Restrict API requests, but not probes, to the network ranges of the
+	// participants of the pool, to their budgets and to reasonable sizes.
+	limited, err := bodylimit.Middleware(logger, bodyClass, map[string]int64{
+		bodyClassEntity: *maxEntityBodySize,
+		bodyClassReport: *maxReportBodySize,
+		bodyClassOther:  *maxBodySize,
+	}, grpcMux)
+	if err != nil {
+		return stacktrace.Propagate(err, "Invalid maximum body sizes")
+	}
+	rateLimiter, err := newRateLimiter(ctx, logger)
+	if err != nil {
+		return stacktrace.Propagate(err, "Invalid rate limit configuration")
+	}
+	if rateLimiter != nil {
+		logger.Info("config", zap.String("rate_limit_policy_file", *rateLimitPolicyFile), zap.Bool("rate_limit_shared", *rateLimitShared))
+	}
+	ipFilter, err := newIPFilter()
+	if err != nil {
+		return stacktrace.Propagate(err, "Invalid IP filter configuration")
+	}
+	if ipFilter.Enabled() {
+		logger.Info("config", zap.Int("ip_allowlist", len(ipFilter.Allow)), zap.Int("ip_denylist", len(ipFilter.Deny)), zap.Int("trusted_proxies", len(ipFilter.TrustedProxies)))
+	}
+
+	var draining int32
+	// newHandler returns the handler of a listener serving the API families
+	// of apis to the clients allowed by filter, rate limited unless
+	// !rateLimit, along with the probes.
+	newHandler := func(apis map[string]bool, filter *ipfilter.Filter, rateLimit bool) (http.Handler, error) {
+		api := limited
+		if rateLimiter != nil && rateLimit {
+			api = rateLimiter.Middleware(api)
+		}
+		api = versions.restrictAPIs(apis, api)
+		if filter.Enabled() {
+			api = filter.Middleware(logger, api)
+		}
+		var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/healthy":
+				healthy(w, r, statusClient, atomic.LoadInt32(&draining) != 0, logger)
+			case livenessPath:
+				live(w, logger)
+			case readinessPath:
+				ready(w, r, statusClient, atomic.LoadInt32(&draining) != 0, logger)
+			default:
+				api.ServeHTTP(w, r)
+			}
+		})
+		handler = versions.handler(handler)
+		if *compressResponses {
+			var err error
+			handler, err = compression.Middleware(*compressionMinSize, versions.route, handler)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "Invalid --compression_min_size")
 			}
-		} else {
-			grpcMux.ServeHTTP(w, r)
 		}
-	})
+		handler = metrics.HTTPMiddleware(versions.route, handler)
+		handler = otelhttp.NewHandler(handler, "http-gateway", otelhttp.WithSpanNameFormatter(func(operation string, r *http.Request) string {
+			return r.Method + " " + versions.route(r)
+		}))
 
-	if *traceRequests {
-		handler = logging.HTTPMiddleware(logger, handler)
+		if *traceRequests {
+			handler = logging.HTTPMiddleware(logger, handler)
+		}
+		return handler, nil
+	}
+	handler, err := newHandler(mainAPIs, ipFilter, true)
+	if err != nil {
+		return err // No need to Propagate this error as this is not a useful stacktrace line
 	}
 
-	signals := make(chan os.Signal)
+	signals := make(chan os.Signal, 1)
 	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
 	defer signal.Stop(signals)
 
-	server := &http.Server{
-		Addr:    address,
-		Handler: handler,
+	serverTLS, err := tlsConfig(ctx, logger)
+	if err != nil {
+		return stacktrace.Propagate(err, "Invalid TLS configuration")
+	}
+	server, err := newServer(address, handler, serverTLS)
+	if err != nil {
+		return stacktrace.Propagate(err, "Invalid connection configuration")
+	}
+	listener, err := listen(ctx, address, serverTLS != nil)
+	if err != nil {
+		return stacktrace.Propagate(err, "Failed to listen on %s", address)
+	}
+
+	// Listen on the addresses of the additional listeners before serving any,
+	// so that the gateway fails to start rather than serve a subset of its
+	// APIs.
+	type extraServer struct {
+		config   listenerConfig
+		server   *http.Server
+		listener net.Listener
+		useTLS   bool
+	}
+	var extras []extraServer
+	servers := []*http.Server{server}
+	for _, l := range listeners {
+		filter, err := l.ipFilter()
+		if err != nil {
+			return stacktrace.Propagate(err, "Invalid IP filter of listener %s", l.Name)
+		}
+		apis := map[string]bool{}
+		for _, api := range l.APIs {
+			apis[api] = true
+		}
+		h, err := newHandler(apis, filter, l.RateLimit == nil || *l.RateLimit)
+		if err != nil {
+			return err // No need to Propagate this error as this is not a useful stacktrace line
+		}
+		lTLS, err := l.tlsConfig(ctx, logger)
+		if err != nil {
+			return stacktrace.Propagate(err, "Invalid TLS configuration of listener %s", l.Name)
+		}
+		lServer, err := newServer(l.Address, h, lTLS)
+		if err != nil {
+			return stacktrace.Propagate(err, "Invalid connection configuration of listener %s", l.Name)
+		}
+		lListener, err := listen(ctx, l.Address, lTLS != nil)
+		if err != nil {
+			return stacktrace.Propagate(err, "Failed to listen on %s for listener %s", l.Address, l.Name)
+		}
+		extras = append(extras, extraServer{config: l, server: lServer, listener: lListener, useTLS: lTLS != nil})
+		servers = append(servers, lServer)
 	}
 
+	stopped := make(chan struct{})
 	go func() {
-		defer func() {
-			if err := server.Shutdown(context.Background()); err != nil {
-				logger.Warn("failed to shut down http server", zap.Error(err))
-			}
-		}()
-
-		for {
-			select {
-			case <-ctx.Done():
-				logger.Info("stopping server due to context having been canceled")
-				return
-			case s := <-signals:
-				logger.Info("received OS signal", zap.Stringer("signal", s))
-				ctxCanceler()
+		defer close(stopped)
+
+		select {
+		case <-ctx.Done():
+			logger.Info("stopping server due to context having been canceled")
+			shutdown(servers, logger)
+		case s := <-signals:
+			logger.Info("received OS signal", zap.Stringer("signal", s))
+			// Report unhealthy while still serving requests, so that load
+			// balancers stop routing requests to this gateway before it stops
+			// accepting them.
+			atomic.StoreInt32(&draining, 1)
+			if err := os.Remove("service.ready"); err != nil && !os.IsNotExist(err) {
+				logger.Warn("failed to remove ready file", zap.Error(err))
 			}
+			logger.Info("draining before shutdown", zap.Duration("delay", *shutdownDelay))
+			time.Sleep(*shutdownDelay)
+			// The connections to core-service close with ctx, so the requests
+			// in progress complete first.
+			shutdown(servers, logger)
+			ctxCanceler()
 		}
 	}()
 
+	if *metricsAddress != "" {
+		go serveMetrics(logger)
+	}
+	if *debugAddress != "" {
+		if err := debug.CheckAddress(*debugAddress); err != nil {
+			return stacktrace.Propagate(err, "Invalid --debug_addr")
+		}
+		go serveDebug(logger)
+	}
+
 	// Indicate ready for container health checks
 	readyFile, err := os.Create("service.ready")
 	if err != nil {
@@ -164,9 +404,147 @@ func RunHTTPProxy(ctx context.Context, ctxCanceler func(), address, endpoint str
 	}
 	readyFile.Close()
 
+	for _, e := range extras {
+		go func(e extraServer) {
+			logger.Info("Starting listener", zap.String("name", e.config.Name), zap.String("listener_address", e.config.Address), zap.Strings("apis", e.config.APIs), zap.Bool("tls", e.useTLS))
+			var err error
+			if e.useTLS {
+				err = e.server.ServeTLS(e.listener, "", "")
+			} else {
+				err = e.server.Serve(e.listener)
+			}
+			if err != nil && err != http.ErrServerClosed {
+				logger.Error("failed to serve listener", zap.String("name", e.config.Name), zap.Error(err))
+				ctxCanceler()
+			}
+		}(e)
+	}
+
 	// Start HTTP server (and proxy calls to gRPC server endpoint)
-	logger.Info("Starting HTTP server")
-	return server.ListenAndServe()
+	if serverTLS != nil {
+		logger.Info("Starting HTTPS server")
+		err = server.ServeTLS(listener, "", "")
+	} else {
+		logger.Info("Starting HTTP server")
+		err = server.Serve(listener)
+	}
+	if err == http.ErrServerClosed {
+		// Serve returns as soon as the server starts shutting down.
+		<-stopped
+	}
+	return err
+}
+
+// The classes of endpoints whose request bodies are limited.
+const (
+	bodyClassEntity = "entity"
+	bodyClassReport = "report"
+	bodyClassOther  = "other"
+)
+
+// bodyClass returns the class of the endpoint of r for its body size limit.
+func bodyClass(r *http.Request) string {
+	switch {
+	case r.Method == http.MethodPut:
+		return bodyClassEntity
+	case r.Method == http.MethodPost && r.URL.Path == "/dss/v1/reports":
+		return bodyClassReport
+	}
+	return bodyClassOther
+}
+
+// newIPFilter returns the ipfilter.Filter of the --ip_allowlist,
+// --ip_denylist and --trusted_proxies flags.
+func newIPFilter() (*ipfilter.Filter, error) {
+	allow, err := ipfilter.ParseCIDRs(*ipAllowlist)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Invalid --ip_allowlist")
+	}
+	deny, err := ipfilter.ParseCIDRs(*ipDenylist)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Invalid --ip_denylist")
+	}
+	proxies, err := ipfilter.ParseCIDRs(*trustedProxies)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Invalid --trusted_proxies")
+	}
+	return &ipfilter.Filter{Allow: allow, Deny: deny, TrustedProxies: proxies}, nil
+}
+
+// serveMetrics serves the metrics of the process over HTTP at /metrics.
+func serveMetrics(logger *zap.Logger) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	logger.Info("serving metrics", zap.String("address", *metricsAddress))
+	if err := http.ListenAndServe(*metricsAddress, mux); err != nil {
+		logger.Error("failed to serve metrics", zap.Error(err))
+	}
+}
+
+// serveDebug serves the profiles and runtime statistics of the process over
+// HTTP under /debug/.
+func serveDebug(logger *zap.Logger) {
+	logger.Info("serving debug endpoints", zap.String("address", *debugAddress))
+	if err := http.ListenAndServe(*debugAddress, debug.Handler()); err != nil {
+		logger.Error("failed to serve debug endpoints", zap.Error(err))
+	}
+}
+
+// shutdown stops servers once the requests in progress complete, closing the
+// connections still active after --shutdown_timeout.
+func shutdown(servers []*http.Server, logger *zap.Logger) {
+	ctx, cancel := context.WithTimeout(context.Background(), *shutdownTimeout)
+	defer cancel()
+	var wg sync.WaitGroup
+	for _, server := range servers {
+		wg.Add(1)
+		go func(server *http.Server) {
+			defer wg.Done()
+			if err := server.Shutdown(ctx); err != nil {
+				logger.Warn("failed to shut down http server within shutdown timeout; closing remaining connections", zap.String("address", server.Addr), zap.Error(err))
+				if err := server.Close(); err != nil {
+					logger.Warn("failed to close http server", zap.String("address", server.Addr), zap.Error(err))
+				}
+			}
+		}(server)
+	}
+	wg.Wait()
+}
+
+// outgoingHeaderMatcher forwards the standard HTTP headers the core service
+// emits as gRPC metadata under their HTTP name, and other metadata as
+// Grpc-Metadata-* headers.
+func outgoingHeaderMatcher(key string) (string, bool) {
+	switch key {
+	case dssmodels.ETagHeader:
+		return "ETag", true
+	case ratelimit.RetryAfterHeader:
+		return "Retry-After", true
+	case dssmodels.DryRunHeader:
+		return "Dss-Dry-Run", true
+	case logging.RequestIDHeader:
+		return "X-Request-Id", true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// incomingHeaderMatcher forwards the request ID of requests to core-service,
+// so that clients and load balancers may correlate their logs with the DSS
+// access log.
+func incomingHeaderMatcher(key string) (string, bool) {
+	if textproto.CanonicalMIMEHeaderKey(key) == "X-Request-Id" {
+		return logging.RequestIDHeader, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// dryRunAnnotator forwards the dry_run query parameter of requests as
+// metadata, as it is not part of the API request messages.
+func dryRunAnnotator(_ context.Context, r *http.Request) metadata.MD {
+	if value := r.URL.Query().Get(dssmodels.DryRunParameter); value != "" {
+		return metadata.Pairs(dssmodels.DryRunHeader, value)
+	}
+	return nil
 }
 
 func myCodeToHTTPStatus(code codes.Code) int {
@@ -210,12 +588,47 @@ func myCodeToHTTPStatus(code codes.Code) int {
 		return http.StatusRequestEntityTooLarge
 	case codes.Code(uint16(errors.MissingOVNs)):
 		return http.StatusConflict
+	case codes.Code(uint16(errors.NotModified)):
+		return http.StatusNotModified
+	case codes.Code(uint16(errors.RequestTooLarge)):
+		return http.StatusRequestEntityTooLarge
 	}
 
 	grpclog.Warningf("Unknown gRPC error code: %v", code)
 	return http.StatusInternalServerError
 }
 
+// healthy reports whether the core-service behind the gateway is ready to
+// serve requests.
+func healthy(w http.ResponseWriter, r *http.Request, client auxpb.DSSAuxServiceClient, draining bool, logger *zap.Logger) {
+	if draining {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		if _, err := w.Write([]byte("draining")); err != nil {
+			logger.Error("Error writing to /healthy")
+		}
+		return
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	body := "ok"
+	status, err := client.GetStatus(ctx, &auxpb.GetStatusRequest{})
+	switch {
+	case err != nil:
+		logger.Warn("Failed to get core-service status", zap.Error(err))
+		w.WriteHeader(http.StatusServiceUnavailable)
+		body = "core-service unavailable"
+	case status.Draining:
+		w.WriteHeader(http.StatusServiceUnavailable)
+		body = "core-service draining"
+	case !status.Ready:
+		w.WriteHeader(http.StatusServiceUnavailable)
+		body = "core-service not ready; see " + readinessPath
+	}
+	if _, err := w.Write([]byte(body)); err != nil {
+		logger.Error("Error writing to /healthy")
+	}
+}
+
 // this method was copied directly from github.com/grpc-ecosystem/grpc-gateway/runtime/errors
 // we initially only needed to add 1 extra Code to handle but since they didn't
 // export HTTPStatusFromCode we had to copy the whole thing.  Since then, we have added
@@ -225,8 +638,8 @@ func myCodeToHTTPStatus(code codes.Code) int {
 func myHTTPError(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, _ *http.Request, err error) {
 	errID := errors.MakeErrID()
 	fallback := fmt.Sprintf(
-		`{"error": "Internal server error (fallback) %s", "message": "Internal server error (fallback) %s", "error_id": "%s", "code": %d}`,
-		errID, errID, errID, codes.Internal)
+		`{"error": "Internal server error (fallback) %s", "message": "Internal server error (fallback) %s", "error_id": "%s", "code": %d, "error_code": "%s"}`,
+		errID, errID, errID, codes.Internal, errors.CodeInternal)
 
 	s, ok := status.FromError(err)
 	if !ok {
@@ -235,6 +648,15 @@ func myHTTPError(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.M
 
 	w.Header().Del("Trailer")
 
+	if s.Code() == codes.Code(uint16(errors.NotModified)) {
+		// A 304 response carries the entity headers but no body.
+		if md, ok := runtime.ServerMetadataFromContext(ctx); ok {
+			handleForwardResponseServerMetadata(w, mux, md)
+		}
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
 	contentType := marshaler.ContentType()
 	// Check marshaler on run time in order to keep backwards compatibility
 	// An interface param needs to be added to the ContentType() function on
@@ -244,6 +666,7 @@ func myHTTPError(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.M
 		contentType = httpBodyMarshaler.ContentTypeFromMessage(pb)
 	}
 	w.Header().Set("Content-Type", contentType)
+	errorCode := errors.ErrorCode(stacktrace.ErrorCode(s.Code()))
 
 	// Marshal error content into buf
 	var buf []byte
@@ -257,6 +680,11 @@ func myHTTPError(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.M
 			body, ok := s.Details()[0].(*scdpb.AirspaceConflictResponse)
 			if ok {
 				buf, marshalingErr = marshaler.Marshal(body)
+				if marshalingErr == nil {
+					// AirspaceConflictResponse follows the ASTM API, which
+					// lacks the machine-readable code.
+					buf, marshalingErr = withErrorCode(buf, errorCode)
+				}
 				grpclog.Errorf("Error %s was an AirspaceConflictResponse from the Core Service", errID)
 			} else {
 				marshalingErr = stacktrace.NewError("Unable to cast s.Details()[0] from %s to *scdpb.AirspaceConflictResponse", reflect.TypeOf(s.Details()[0]))
@@ -267,6 +695,10 @@ func myHTTPError(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.M
 		// Handle explicit error responses
 		result, ok := s.Details()[0].(*auxpb.StandardErrorResponse)
 		if ok {
+			if result.ErrorCode == "" {
+				// Older core-services do not report machine-readable codes.
+				result.ErrorCode = errorCode
+			}
 			buf, marshalingErr = marshaler.Marshal(result)
 			grpclog.Errorf("Error %s was a StandardErrorResponse from the Core Service", errID)
 			handled = true
@@ -275,10 +707,11 @@ func myHTTPError(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.M
 	if !handled {
 		// Default error-handling schema
 		body := &auxpb.StandardErrorResponse{
-			Error:   s.Message(),
-			Message: s.Message(),
-			Code:    int32(s.Code()),
-			ErrorId: errID,
+			Error:     s.Message(),
+			Message:   s.Message(),
+			Code:      int32(s.Code()),
+			ErrorId:   errID,
+			ErrorCode: errorCode,
 		}
 		grpclog.Errorf("Error %s during a request did not include Details in Status; constructed code %d, message `%s`", errID, body.Code, body.Message)
 
@@ -314,9 +747,23 @@ func myHTTPError(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.M
 	handleForwardResponseTrailer(w, md)
 }
 
+// withErrorCode adds errorCode to the error_code field of the JSON object buf,
+// unless already present.
+func withErrorCode(buf []byte, errorCode string) ([]byte, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(buf, &fields); err != nil {
+		return nil, stacktrace.Propagate(err, "Error parsing error response")
+	}
+	if _, ok := fields["error_code"]; ok {
+		return buf, nil
+	}
+	fields["error_code"], _ = json.Marshal(errorCode)
+	return json.MarshalIndent(fields, "", "  ")
+}
+
 func handleForwardResponseServerMetadata(w http.ResponseWriter, mux *runtime.ServeMux, md runtime.ServerMetadata) {
 	for k, vs := range md.HeaderMD {
-		if h, ok := runtime.DefaultHeaderMatcher(k); ok {
+		if h, ok := outgoingHeaderMatcher(k); ok {
 			for _, v := range vs {
 				w.Header().Add(h, v)
 			}
@@ -341,7 +788,7 @@ func handleForwardResponseTrailer(w http.ResponseWriter, md runtime.ServerMetada
 }
 
 func main() {
-	flag.Parse()
+	config.Parse()
 	var (
 		ctx, cancel = context.WithCancel(context.Background())
 		logger      = logging.WithValuesFromContext(ctx, logging.Logger)
@@ -357,6 +804,20 @@ func main() {
 		}
 	}
 
+	shutdownTracing, err := tracing.Init(ctx, tracingflags.Config("http-gateway"))
+	if err != nil {
+		logger.Panic("Failed to initialize tracing", zap.Error(err))
+	}
+	defer func() {
+		// Unexported traces are dropped rather than delaying the exit when the
+		// collector is unreachable.
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			logger.Warn("Failed to flush traces", zap.Error(err))
+		}
+	}()
+
 	backoffs := []time.Duration{
 		5 * time.Second, 15 * time.Second, 1 * time.Minute, 1 * time.Minute,
 		1 * time.Minute, 5 * time.Minute}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/interuss/dss/pkg/auth"
+	"github.com/interuss/dss/pkg/cockroach"
+	"github.com/interuss/dss/pkg/cockroach/flags"
+	"github.com/interuss/dss/pkg/ratelimit"
+	ridc "github.com/interuss/dss/pkg/rid/store/cockroach"
+	"github.com/interuss/stacktrace"
+	"github.com/jonboulle/clockwork"
+	"go.uber.org/zap"
+)
+
+const (
+	// rateLimitPruneInterval is the interval between deletions of the unused
+	// shared token buckets.
+	rateLimitPruneInterval = time.Hour
+
+	// rateLimitRetention is the duration after which unused shared token
+	// buckets are deleted.
+	rateLimitRetention = 24 * time.Hour
+)
+
+// newRateLimiter returns the ratelimit.HTTPLimiter of --rate_limit_policy_file,
+// or nil when not specified.  Its subject budgets apply to the access tokens
+// verified with the keys of --public_key_files or --jwks_endpoint.  With
+// --rate_limit_shared, its token buckets are shared through the remote ID
+// database until ctx is done.
+func newRateLimiter(ctx context.Context, logger *zap.Logger) (*ratelimit.HTTPLimiter, error) {
+	if *rateLimitPolicyFile == "" {
+		if *rateLimitShared {
+			return nil, stacktrace.NewError("--rate_limit_shared requires --rate_limit_policy_file")
+		}
+		return nil, nil
+	}
+	policy, err := ratelimit.LoadPolicy(*rateLimitPolicyFile)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Invalid --rate_limit_policy_file")
+	}
+	local := ratelimit.NewLocalBuckets(clockwork.NewRealClock())
+	limiter := &ratelimit.HTTPLimiter{Policy: policy, Buckets: local, Logger: logger}
+
+	// Subjects are only trusted once their access tokens are verified like in
+	// core-service, so that clients cannot consume the budgets of other
+	// subjects, nor evade their own.
+	keyResolver, err := auth.NewKeyResolver(*pkFile, *jwksEndpoint, *jwksKeyIDs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Invalid keys verifying access tokens")
+	}
+	if keyResolver == nil {
+		logger.Warn("checking requests against the global budget only, without public_key_files or jwks_endpoint and jwks_key_ids")
+	} else {
+		limiter.Authorizer, err = auth.NewRSAAuthorizer(ctx, auth.Configuration{
+			KeyResolver:       keyResolver,
+			KeyRefreshTimeout: *keyRefreshTimeout,
+		})
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Error creating RSA authorizer for rate limits")
+		}
+	}
+
+	if !*rateLimitShared {
+		return limiter, nil
+	}
+
+	connectParameters := flags.ConnectParameters()
+	connectParameters.DBName = "rid"
+	db, err := cockroach.Dial(ctx, connectParameters)
+	if err != nil {
+		if strings.Contains(err.Error(), "connect: connection refused") {
+			return nil, stacktrace.PropagateWithCode(err, codeRetryable, "Failed to connect to CRDB server for shared rate limits")
+		}
+		return nil, stacktrace.Propagate(err, "Failed to connect to remote ID database for shared rate limits")
+	}
+	store, err := ridc.NewStore(ctx, db, connectParameters.DBName, logger)
+	if err != nil {
+		db.Pool.Close()
+		return nil, stacktrace.PropagateWithCode(err, codeRetryable, "Failed to read the remote ID database schema for shared rate limits")
+	}
+	if !store.SupportsRateLimitBuckets() {
+		db.Pool.Close()
+		return nil, stacktrace.NewError("--rate_limit_shared requires a remote ID database with schema 4.4.0 or later")
+	}
+
+	shared := &ratelimit.DatastoreBuckets{DB: db}
+	go func() {
+		defer db.Pool.Close()
+		ticker := time.NewTicker(rateLimitPruneInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := shared.Prune(ctx, rateLimitRetention); err != nil {
+					logger.Warn("Failed to prune shared rate limit buckets", zap.Error(err))
+				}
+			}
+		}
+	}()
+	// Each gateway enforces the budgets by itself while the datastore is
+	// unavailable.
+	limiter.Buckets, limiter.Fallback = shared, local
+	return limiter, nil
+}
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/interuss/stacktrace"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/acme"
+	"golang.org/x/crypto/acme/autocert"
+)
+
+// certReloader serves the certificate of a pair of certificate and key files,
+// reloading them when they change.
+type certReloader struct {
+	certFile, keyFile string
+	logger            *zap.Logger
+
+	mu       sync.RWMutex
+	cert     *tls.Certificate
+	modTimes [2]time.Time
+}
+
+func newCertReloader(certFile, keyFile string, logger *zap.Logger) (*certReloader, error) {
+	r := &certReloader{certFile: certFile, keyFile: keyFile, logger: logger}
+	if _, err := r.reload(); err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	return r, nil
+}
+
+// GetCertificate implements tls.Config.GetCertificate.
+func (r *certReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert, nil
+}
+
+// reload loads the files of r if they changed since they were last loaded,
+// and returns whether they did.
+func (r *certReloader) reload() (bool, error) {
+	var modTimes [2]time.Time
+	for i, file := range []string{r.certFile, r.keyFile} {
+		info, err := os.Stat(file)
+		if err != nil {
+			return false, stacktrace.Propagate(err, "Failed to stat %s", file)
+		}
+		modTimes[i] = info.ModTime()
+	}
+	r.mu.RLock()
+	unchanged := r.cert != nil && modTimes == r.modTimes
+	r.mu.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "Failed to load TLS certificate %s and key %s", r.certFile, r.keyFile)
+	}
+	r.mu.Lock()
+	r.cert, r.modTimes = &cert, modTimes
+	r.mu.Unlock()
+	return true, nil
+}
+
+// watch reloads the files of r every interval until ctx is done.  The current
+// certificate keeps being served when the files cannot be loaded, e.g. while
+// only one of them has been replaced.
+func (r *certReloader) watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		reloaded, err := r.reload()
+		if err != nil {
+			r.logger.Warn("Failed to reload TLS certificate; serving the previous one", zap.Error(err))
+		} else if reloaded {
+			r.logger.Info("Reloaded TLS certificate", zap.String("cert_file", r.certFile))
+		}
+	}
+}
+
+// tlsConfig returns the TLS configuration of the gateway, or nil when it serves
+// HTTP.  Certificates are reloaded or renewed until ctx is done.
+func tlsConfig(ctx context.Context, logger *zap.Logger) (*tls.Config, error) {
+	var domains []string
+	for _, domain := range strings.Split(*acmeDomains, ",") {
+		if domain = strings.TrimSpace(domain); domain != "" {
+			domains = append(domains, domain)
+		}
+	}
+
+	switch {
+	case len(domains) > 0 && (*tlsCertFile != "" || *tlsKeyFile != ""):
+		return nil, stacktrace.NewError("acme_domains and tls_cert_file are mutually exclusive")
+
+	case len(domains) > 0:
+		m := &autocert.Manager{
+			Prompt:     autocert.AcceptTOS,
+			Cache:      autocert.DirCache(*acmeCacheDir),
+			HostPolicy: autocert.HostWhitelist(domains...),
+			Email:      *acmeEmail,
+			Client:     &acme.Client{DirectoryURL: *acmeDirectoryURL},
+		}
+		if *acmeHTTPAddress != "" {
+			go serveACMEChallenges(ctx, m, logger)
+		}
+		config := m.TLSConfig()
+		config.MinVersion = tls.VersionTLS12
+		return config, nil
+
+	case *tlsCertFile != "" || *tlsKeyFile != "":
+		if *tlsCertFile == "" || *tlsKeyFile == "" {
+			return nil, stacktrace.NewError("tls_cert_file and tls_key_file must be specified together")
+		}
+		if *tlsReloadInterval <= 0 {
+			return nil, stacktrace.NewError("tls_reload_interval must be positive")
+		}
+		reloader, err := newCertReloader(*tlsCertFile, *tlsKeyFile, logger)
+		if err != nil {
+			return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+		}
+		go reloader.watch(ctx, *tlsReloadInterval)
+		return &tls.Config{
+			GetCertificate: reloader.GetCertificate,
+			MinVersion:     tls.VersionTLS12,
+		}, nil
+	}
+	return nil, nil
+}
+
+// serveACMEChallenges answers the HTTP-01 challenges of m on --acme_http_addr
+// until ctx is done.
+func serveACMEChallenges(ctx context.Context, m *autocert.Manager, logger *zap.Logger) {
+	server := &http.Server{Addr: *acmeHTTPAddress, Handler: m.HTTPHandler(nil)}
+	go func() {
+		<-ctx.Done()
+		if err := server.Close(); err != nil {
+			logger.Warn("failed to close ACME challenge server", zap.Error(err))
+		}
+	}()
+	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		logger.Error("failed to serve ACME challenges", zap.Error(err))
+	}
+}
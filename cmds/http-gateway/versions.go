@@ -0,0 +1,134 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/interuss/stacktrace"
+)
+
+// apiVersionsPath is the path at which the gateway lists the API versions it
+// serves.
+const apiVersionsPath = "/api_versions"
+
+// apiVersion describes an API version served by the gateway under Prefix.
+type apiVersion struct {
+	API        string     `json:"api"`
+	Version    string     `json:"version"`
+	Prefix     string     `json:"prefix"`
+	Deprecated bool       `json:"deprecated"`
+	Sunset     *time.Time `json:"sunset,omitempty"`
+	Successor  string     `json:"successor,omitempty"`
+}
+
+// apiVersions is the set of API versions served by the gateway, in the order
+// they were mounted; later versions of an API supersede earlier ones.
+type apiVersions struct {
+	versions []*apiVersion
+}
+
+// mount records that version of api is served under prefix.
+func (vs *apiVersions) mount(api, version, prefix string) {
+	vs.versions = append(vs.versions, &apiVersion{API: api, Version: version, Prefix: prefix})
+}
+
+// deprecate marks the versions listed in spec as deprecated.  spec is a
+// comma-separated list of api/version, each optionally followed by
+// =YYYY-MM-DD to announce the date after which the version will no longer be
+// served.
+func (vs *apiVersions) deprecate(spec string) error {
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		name, sunset, hasSunset := entry, "", false
+		if i := strings.Index(entry, "="); i >= 0 {
+			name, sunset, hasSunset = entry[:i], entry[i+1:], true
+		}
+		v := vs.find(name)
+		if v == nil {
+			return stacktrace.NewError("Unknown API version `%s` to deprecate", name)
+		}
+		v.Deprecated = true
+		if hasSunset {
+			t, err := time.Parse("2006-01-02", sunset)
+			if err != nil {
+				return stacktrace.Propagate(err, "Invalid sunset date `%s` for API version `%s`", sunset, name)
+			}
+			v.Sunset = &t
+		}
+	}
+
+	// Point each deprecated version to the latest version of its API which is
+	// not deprecated
+	for _, v := range vs.versions {
+		if !v.Deprecated {
+			continue
+		}
+		for _, other := range vs.versions {
+			if other.API == v.API && !other.Deprecated {
+				v.Successor = other.Prefix
+			}
+		}
+	}
+	return nil
+}
+
+// find returns the version named api/version, or nil if it is not served.
+func (vs *apiVersions) find(name string) *apiVersion {
+	for _, v := range vs.versions {
+		if v.API+"/"+v.Version == name {
+			return v
+		}
+	}
+	return nil
+}
+
+// handler serves the list of API versions at apiVersionsPath, and adds
+// Deprecation, Sunset (RFC 8594) and successor-version Link headers to the
+// responses of deprecated versions before passing requests on to next.
+func (vs *apiVersions) handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == apiVersionsPath {
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(struct {
+				Versions []*apiVersion `json:"versions"`
+			}{vs.versions})
+			return
+		}
+		for _, v := range vs.versions {
+			if !v.Deprecated || !strings.HasPrefix(r.URL.Path, v.Prefix) {
+				continue
+			}
+			w.Header().Set("Deprecation", "true")
+			if v.Sunset != nil {
+				w.Header().Set("Sunset", v.Sunset.UTC().Format(http.TimeFormat))
+			}
+			if v.Successor != "" {
+				w.Header().Set("Link", "<"+v.Successor+`>; rel="successor-version"`)
+			}
+			break
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// route returns the API version serving r, as api/version, to label its
+// metrics with a bounded number of values.
+func (vs *apiVersions) route(r *http.Request) string {
+	switch r.URL.Path {
+	case "/healthy", livenessPath, readinessPath:
+		return strings.TrimPrefix(r.URL.Path, "/")
+	case apiVersionsPath:
+		return "versions"
+	}
+	for _, v := range vs.versions {
+		if strings.HasPrefix(r.URL.Path, v.Prefix) {
+			return v.API + "/" + v.Version
+		}
+	}
+	return "other"
+}
@@ -11,6 +11,10 @@ webapp = flask.Flask(__name__)
 enabled_services = set()
 
 webapp.config.from_object(config.Config)
+
+from monitoring.mock_uss import identities
+
+webapp.wsgi_app = identities.IdentityMiddleware(webapp.wsgi_app)
 print(
     "################################################################################\n"
     + "################################ Configuration  ################################\n"
@@ -21,6 +25,22 @@ print(
 )
 
 from monitoring.mock_uss import routes as basic_routes
+from monitoring.mock_uss.interactions import recording as interactions_recording
+from monitoring.mock_uss.interactions import routes as interactions_routes
+from monitoring.mock_uss.overrides import routes as overrides_routes
+from monitoring.mock_uss.fault_injection import routes as fault_injection_routes
+from monitoring.mock_uss.notification_callbacks import (
+    routes as notification_callbacks_routes,
+)
+from monitoring.mock_uss.admin import routes as admin_routes
+from monitoring.mock_uss.clock import routes as clock_routes
+from monitoring.mock_uss.log_capture import capture as log_capture
+from monitoring.mock_uss.log_capture import routes as log_capture_routes
+from monitoring.mock_uss.flight_authorisation import (
+    routes as flight_authorisation_routes,
+)
+from monitoring.mock_uss.configuration import routes as configuration_routes
+from monitoring.mock_uss.request_ledger import routes as request_ledger_routes
 
 if SERVICE_GEOAWARENESS in webapp.config[config.KEY_SERVICES]:
     enabled_services.add(SERVICE_GEOAWARENESS)
@@ -0,0 +1,89 @@
+from typing import Tuple
+
+import flask
+
+from monitoring.mock_uss import (
+    identities,
+    webapp,
+    enabled_services,
+    SERVICE_RIDDP,
+    SERVICE_SCDSC,
+)
+
+ADMIN_PATH = "/mock_uss/admin"
+
+
+@webapp.route(ADMIN_PATH + "/operational_intents", methods=["GET"])
+def inspect_operational_intents() -> Tuple[str, int]:
+    """List the operational intents managed by this USS identity, by flight ID."""
+    if SERVICE_SCDSC not in enabled_services:
+        return "The {} service is not enabled".format(SERVICE_SCDSC), 404
+    from monitoring.mock_uss.scdsc.database import db
+
+    value = db.value
+    operational_intents = {}
+    for flight_id, flight in value.flights.items():
+        operational_intents[flight_id] = {
+            "reference": flight.op_intent_reference,
+            "injection": flight.op_intent_injection,
+            "behavior": value.behaviors.get(flight_id, None),
+            "conformance": value.conformance.get(flight_id, None),
+            "stale": flight_id in value.stale_flights,
+        }
+    return flask.jsonify(
+        {"identity": identities.current(), "operational_intents": operational_intents}
+    )
+
+
+@webapp.route(ADMIN_PATH + "/subscriptions", methods=["GET"])
+def inspect_subscriptions() -> Tuple[str, int]:
+    """List the DSS subscriptions held by this USS identity, by service."""
+    subscriptions = {}
+    if SERVICE_SCDSC in enabled_services:
+        from monitoring.mock_uss.scdsc.database import db as scdsc_db
+
+        # Operational intents are created with implicit subscriptions
+        scd_subscriptions = {}
+        for flight_id, flight in scdsc_db.value.flights.items():
+            subscription_id = flight.op_intent_reference.subscription_id
+            scd_subscriptions.setdefault(subscription_id, []).append(flight_id)
+        subscriptions[SERVICE_SCDSC] = {
+            subscription_id: {"flights": flight_ids}
+            for subscription_id, flight_ids in scd_subscriptions.items()
+        }
+    if SERVICE_RIDDP in enabled_services:
+        from monitoring.mock_uss.riddp.database import db as riddp_db
+
+        subscriptions[SERVICE_RIDDP] = riddp_db.value.subscriptions
+    return flask.jsonify(
+        {"identity": identities.current(), "subscriptions": subscriptions}
+    )
+
+
+@webapp.route(ADMIN_PATH + "/peer_data", methods=["GET"])
+def inspect_peer_data() -> Tuple[str, int]:
+    """List the data this USS identity cached from other USSs, by service."""
+    peer_data = {}
+    if SERVICE_SCDSC in enabled_services:
+        from monitoring.mock_uss.scdsc.database import db as scdsc_db
+
+        peer_data[SERVICE_SCDSC] = {
+            "operational_intents": scdsc_db.value.cached_operations
+        }
+    if SERVICE_RIDDP in enabled_services:
+        from monitoring.mock_uss.riddp.database import db as riddp_db
+
+        value = riddp_db.value
+        isas = {}
+        for subscription in value.subscriptions.values():
+            isas.update(subscription.isas)
+        peer_data[SERVICE_RIDDP] = {"flights": value.flights, "isas": isas}
+    return flask.jsonify({"identity": identities.current(), "peer_data": peer_data})
+
+
+@webapp.route(ADMIN_PATH + "/notifications", methods=["GET"])
+def inspect_notifications() -> Tuple[str, int]:
+    """List the notification receipts not yet delivered to their callbacks."""
+    from monitoring.mock_uss.notification_callbacks.database import db
+
+    return flask.jsonify({"pending": db.value.pending})
@@ -3,7 +3,9 @@ from monitoring.mock_uss import webapp
 from . import config
 
 
+# The key and audience are looked up for each request so that reloading the
+# configuration takes effect immediately
 requires_scope = auth_validation.requires_scope_decorator(
-    webapp.config.get(config.KEY_TOKEN_PUBLIC_KEY),
-    webapp.config.get(config.KEY_TOKEN_AUDIENCE),
+    lambda: webapp.config.get(config.KEY_TOKEN_PUBLIC_KEY),
+    lambda: webapp.config.get(config.KEY_TOKEN_AUDIENCE),
 )
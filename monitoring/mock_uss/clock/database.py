@@ -0,0 +1,30 @@
+from datetime import datetime, timedelta
+import json
+
+from implicitdict import ImplicitDict
+from monitoring.monitorlib.multiprocessing import SynchronizedValue
+from monitoring.mock_uss.config import Config, state_path
+
+
+class Database(ImplicitDict):
+    """Offset of the virtual clock of mock_uss from the actual time"""
+
+    offset_seconds: float = 0
+    """Seconds added to the actual time; negative to simulate a clock running
+    behind"""
+
+
+db = SynchronizedValue(
+    Database(offset_seconds=Config.CLOCK_OFFSET_SECONDS),
+    decoder=lambda b: ImplicitDict.parse(json.loads(b.decode("utf-8")), Database),
+    persistence_path=state_path("clock"),
+)
+
+
+def offset() -> timedelta:
+    return timedelta(seconds=db.value.offset_seconds)
+
+
+def now() -> datetime:
+    """Current (UTC) time according to the virtual clock of mock_uss."""
+    return datetime.utcnow() + offset()
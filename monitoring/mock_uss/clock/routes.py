@@ -0,0 +1,46 @@
+from typing import Tuple
+
+import flask
+
+from implicitdict import ImplicitDict
+from monitoring.mock_uss import config, webapp
+from monitoring.mock_uss.idempotency.replay import idempotent
+from .database import db, now, Database
+
+
+def _clock_state() -> dict:
+    return {"offset_seconds": db.value.offset_seconds, "now": now().isoformat() + "Z"}
+
+
+@webapp.route("/mock_uss/clock", methods=["PUT"])
+@idempotent
+def set_clock() -> Tuple[str, int]:
+    """Set the offset of the virtual clock applied to the timestamps mock_uss
+    emits."""
+    try:
+        json = flask.request.json
+        if json is None:
+            raise ValueError("Request did not contain a JSON payload")
+        clock = ImplicitDict.parse(json, Database)
+    except ValueError as e:
+        msg = "Set clock unable to parse JSON: {}".format(e)
+        return msg, 400
+
+    with db as tx:
+        tx.offset_seconds = clock.offset_seconds
+    return flask.jsonify(_clock_state())
+
+
+@webapp.route("/mock_uss/clock", methods=["GET"])
+def get_clock() -> Tuple[str, int]:
+    """Get the offset of the virtual clock and the time it currently reads."""
+    return flask.jsonify(_clock_state())
+
+
+@webapp.route("/mock_uss/clock", methods=["DELETE"])
+@idempotent
+def reset_clock() -> Tuple[str, int]:
+    """Restore the configured offset of the virtual clock."""
+    with db as tx:
+        tx.offset_seconds = webapp.config[config.KEY_CLOCK_OFFSET]
+    return flask.jsonify(_clock_state())
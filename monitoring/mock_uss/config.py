@@ -1,5 +1,7 @@
 from enum import Enum
+import json
 import os
+from typing import Any, Dict, Optional
 
 from monitoring.monitorlib import auth_validation
 from monitoring.monitorlib.locality import Locality
@@ -12,6 +14,20 @@ ENV_KEY_AUTH = "{}_AUTH_SPEC".format(ENV_KEY_PREFIX)
 ENV_KEY_SERVICES = "{}_SERVICES".format(ENV_KEY_PREFIX)
 ENV_KEY_DSS = "{}_DSS_URL".format(ENV_KEY_PREFIX)
 ENV_KEY_BEHAVIOR_LOCALITY = "{}_BEHAVIOR_LOCALITY".format(ENV_KEY_PREFIX)
+ENV_KEY_STATE_DIR = "{}_STATE_DIR".format(ENV_KEY_PREFIX)
+ENV_KEY_IDENTITIES = "{}_IDENTITIES".format(ENV_KEY_PREFIX)
+ENV_KEY_CLOCK_OFFSET = "{}_CLOCK_OFFSET_SECONDS".format(ENV_KEY_PREFIX)
+ENV_KEY_CONFIG_FILE = "{}_CONFIG_FILE".format(ENV_KEY_PREFIX)
+
+# Settings which may be reloaded while mock_uss runs
+RELOADABLE_ENV_KEYS = [
+    ENV_KEY_PUBLIC_KEY,
+    ENV_KEY_TOKEN_AUDIENCE,
+    ENV_KEY_BASE_URL,
+    ENV_KEY_AUTH,
+    ENV_KEY_DSS,
+    ENV_KEY_BEHAVIOR_LOCALITY,
+]
 
 # These keys map to entries in the Config class
 KEY_TOKEN_PUBLIC_KEY = "TOKEN_PUBLIC_KEY"
@@ -21,22 +37,70 @@ KEY_AUTH_SPEC = "AUTH_SPEC"
 KEY_SERVICES = "SERVICES"
 KEY_DSS_URL = "DSS_URL"
 KEY_BEHAVIOR_LOCALITY = "BEHAVIOR_LOCALITY"
+KEY_STATE_DIR = "STATE_DIR"
+KEY_IDENTITIES = "IDENTITIES"
+KEY_CLOCK_OFFSET = "CLOCK_OFFSET_SECONDS"
 
 KEY_CODE_VERSION = "MONITORING_VERSION"
 
 workspace_path = os.path.join(os.path.abspath(os.path.dirname(__file__)), "workspace")
 
 
+def load_settings() -> Dict[str, str]:
+    """Settings from the environment, overridden by the JSON object of settings
+    in the file at MOCK_USS_CONFIG_FILE, if any."""
+    settings = dict(os.environ)
+    path = settings.get(ENV_KEY_CONFIG_FILE, None)
+    if path:
+        with open(path, "r") as f:
+            overrides = json.load(f)
+        if not isinstance(overrides, dict):
+            raise ValueError("{} must contain a JSON object".format(path))
+        settings.update({k: str(v) for k, v in overrides.items()})
+    return settings
+
+
+def reloadable_config(settings: Dict[str, str]) -> Dict[str, Any]:
+    """Values of the reloadable entries of the Config class according to
+    settings."""
+    return {
+        KEY_TOKEN_PUBLIC_KEY: auth_validation.fix_key(
+            settings.get(ENV_KEY_PUBLIC_KEY, "")
+        ).encode("utf-8"),
+        KEY_TOKEN_AUDIENCE: settings.get(ENV_KEY_TOKEN_AUDIENCE, ""),
+        KEY_BASE_URL: settings.get(ENV_KEY_BASE_URL, None),
+        KEY_AUTH_SPEC: settings.get(ENV_KEY_AUTH, None),
+        KEY_DSS_URL: settings.get(ENV_KEY_DSS, None),
+        KEY_BEHAVIOR_LOCALITY: Locality(
+            settings.get(ENV_KEY_BEHAVIOR_LOCALITY, "CHE")
+        ),
+    }
+
+
+_settings = load_settings()
+_reloadable = reloadable_config(_settings)
+
+
 class Config(object):
-    TOKEN_PUBLIC_KEY = auth_validation.fix_key(
-        os.environ.get(ENV_KEY_PUBLIC_KEY, "")
-    ).encode("utf-8")
-    TOKEN_AUDIENCE = os.environ.get(ENV_KEY_TOKEN_AUDIENCE, "")
-    USS_BASE_URL = os.environ.get(ENV_KEY_BASE_URL, None)
-    AUTH_SPEC = os.environ.get(ENV_KEY_AUTH, None)
+    TOKEN_PUBLIC_KEY = _reloadable[KEY_TOKEN_PUBLIC_KEY]
+    TOKEN_AUDIENCE = _reloadable[KEY_TOKEN_AUDIENCE]
+    USS_BASE_URL = _reloadable[KEY_BASE_URL]
+    AUTH_SPEC = _reloadable[KEY_AUTH_SPEC]
     SERVICES = set(
-        svc.strip().lower() for svc in os.environ.get(ENV_KEY_SERVICES, "").split(",")
+        svc.strip().lower() for svc in _settings.get(ENV_KEY_SERVICES, "").split(",")
     )
-    DSS_URL = os.environ.get(ENV_KEY_DSS, None)
-    BEHAVIOR_LOCALITY = Locality(os.environ.get(ENV_KEY_BEHAVIOR_LOCALITY, "CHE"))
-    CODE_VERSION = os.environ.get(KEY_CODE_VERSION, "Unknown")
+    DSS_URL = _reloadable[KEY_DSS_URL]
+    BEHAVIOR_LOCALITY = _reloadable[KEY_BEHAVIOR_LOCALITY]
+    CODE_VERSION = _settings.get(KEY_CODE_VERSION, "Unknown")
+    STATE_DIR = _settings.get(ENV_KEY_STATE_DIR, None)
+    IDENTITIES = json.loads(_settings.get(ENV_KEY_IDENTITIES, "") or "{}")
+    CLOCK_OFFSET_SECONDS = float(_settings.get(ENV_KEY_CLOCK_OFFSET, "") or "0")
+
+
+def state_path(name: str) -> Optional[str]:
+    """Path of the file persisting the state named name, or None when state is
+    not persisted."""
+    if not Config.STATE_DIR:
+        return None
+    os.makedirs(Config.STATE_DIR, exist_ok=True)
+    return os.path.join(Config.STATE_DIR, "{}.json".format(name))
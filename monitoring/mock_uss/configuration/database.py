@@ -0,0 +1,35 @@
+import json
+from typing import Dict
+
+from implicitdict import ImplicitDict
+from monitoring.monitorlib.multiprocessing import SynchronizedValue
+from monitoring.mock_uss import config
+
+
+class Database(ImplicitDict):
+    """Reloadable settings shared by all mock_uss worker processes"""
+
+    version: int = 0
+    """Incremented each time the settings change, so that each worker knows
+    whether it has applied the latest settings"""
+
+    settings: Dict[str, str] = {}
+    """Reloadable settings, by environment variable name"""
+
+    file_settings: Dict[str, str] = {}
+    """Reloadable settings as last loaded from the environment and configuration
+    file"""
+
+
+def reloadable_settings(settings: Dict[str, str]) -> Dict[str, str]:
+    """The entries of settings which may be reloaded."""
+    return {k: settings[k] for k in config.RELOADABLE_ENV_KEYS if k in settings}
+
+
+_initial = reloadable_settings(config.load_settings())
+
+# Settings include secrets, so they are not persisted
+db = SynchronizedValue(
+    Database(settings=_initial, file_settings=_initial),
+    decoder=lambda b: ImplicitDict.parse(json.loads(b.decode("utf-8")), Database),
+)
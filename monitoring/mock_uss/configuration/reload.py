@@ -0,0 +1,120 @@
+"""Reloading of the configuration of a running mock_uss.
+
+New settings are published to the shared database, and each worker process
+applies them before handling its next request.  All the values derived from the
+new settings are computed before any is applied, so a request is handled with
+either the old or the new configuration, never a mix.
+"""
+
+import logging
+import os
+import signal
+import threading
+from typing import Dict
+
+from monitoring.mock_uss import config, resources, webapp
+from .database import db, reloadable_settings
+
+logger = logging.getLogger(__name__)
+
+_lock = threading.Lock()
+_applied_version = 0
+
+_reload_pending = None
+"""Reload requested asynchronously: "always", "if_changed" or None"""
+
+
+def publish(settings: Dict[str, str]) -> int:
+    """Publish reloadable settings (by environment variable name) to all
+    workers, replacing the settings they specify.
+
+    :return: Version of the configuration including settings
+    """
+    with db as tx:
+        merged = dict(tx.settings)
+        merged.update(reloadable_settings(settings))
+        # Raises ValueError before anything is published if settings are invalid
+        config.reloadable_config(merged)
+        if merged != tx.settings:
+            tx.settings = merged
+            tx.version += 1
+        version = tx.version
+    apply()
+    return version
+
+
+def reload_from_file() -> int:
+    """Reload the settings from the environment and configuration file,
+    discarding settings published otherwise.
+
+    :return: Version of the reloaded configuration
+    """
+    settings = reloadable_settings(config.load_settings())
+    config.reloadable_config(settings)
+    with db as tx:
+        if settings != tx.settings:
+            tx.settings = settings
+            tx.version += 1
+        tx.file_settings = settings
+        version = tx.version
+    apply()
+    return version
+
+
+def _reload_if_file_changed() -> None:
+    settings = reloadable_settings(config.load_settings())
+    if settings != db.value.file_settings:
+        logger.info("Configuration file changed; reloading configuration")
+        reload_from_file()
+
+
+def apply() -> None:
+    """Apply the latest published settings to this worker, if not done yet."""
+    global _applied_version, _reload_pending
+    if _reload_pending is not None:
+        pending, _reload_pending = _reload_pending, None
+        try:
+            if pending == "always":
+                reload_from_file()
+            else:
+                _reload_if_file_changed()
+        except (OSError, ValueError) as e:
+            logger.error("Unable to reload configuration: {}".format(e))
+
+    current = db.value
+    if current.version == _applied_version:
+        return
+    with _lock:
+        if current.version == _applied_version:
+            return
+        values = config.reloadable_config(current.settings)
+        webapp.config.update(values)
+        for k, v in values.items():
+            setattr(config.Config, k, v)
+        resources.utm_client.reset()
+        _applied_version = current.version
+    logger.info("Applied configuration version {}".format(current.version))
+
+
+def _on_sighup(signum, frame) -> None:
+    # Signal handlers may interrupt a transaction, so the reload is deferred to
+    # the next request
+    global _reload_pending
+    _reload_pending = "always"
+
+
+def _after_fork() -> None:
+    global _reload_pending
+    _reload_pending = "if_changed"
+
+
+webapp.before_request(apply)
+
+# When run by gunicorn, SIGHUP restarts the workers from the preloaded
+# application, so each new worker checks the configuration file instead
+os.register_at_fork(after_in_child=_after_fork)
+try:
+    signal.signal(signal.SIGHUP, _on_sighup)
+except ValueError:
+    # Signal handlers may only be registered by the main thread
+    logger.warning("Unable to reload configuration on SIGHUP")
@@ -0,0 +1,63 @@
+from typing import Tuple
+
+import flask
+
+from monitoring.mock_uss import config, webapp
+from monitoring.mock_uss.idempotency.replay import idempotent
+from . import reload
+from .database import db
+
+# Settings reported only as configured or not, as they contain secrets
+REDACTED_ENV_KEYS = {config.ENV_KEY_AUTH}
+
+
+def _configuration() -> dict:
+    value = db.value
+    return {
+        "version": value.version,
+        "settings": {
+            k: "<redacted>" if k in REDACTED_ENV_KEYS and v else v
+            for k, v in value.settings.items()
+        },
+    }
+
+
+@webapp.route("/mock_uss/configuration", methods=["GET"])
+def get_configuration() -> Tuple[str, int]:
+    """Get the current reloadable settings of mock_uss."""
+    return flask.jsonify(_configuration())
+
+
+@webapp.route("/mock_uss/configuration", methods=["PUT"])
+@idempotent
+def set_configuration() -> Tuple[str, int]:
+    """Replace some of the reloadable settings of mock_uss for all workers; the
+    JSON body maps environment variable names to their new values."""
+    try:
+        json = flask.request.json
+        if json is None:
+            raise ValueError("Request did not contain a JSON payload")
+        if not isinstance(json, dict):
+            raise ValueError("Request payload was not a JSON object")
+        unknown = set(json) - set(config.RELOADABLE_ENV_KEYS)
+        if unknown:
+            raise ValueError(
+                "Settings may not be reloaded: {}".format(", ".join(sorted(unknown)))
+            )
+        reload.publish({k: str(v) for k, v in json.items()})
+    except ValueError as e:
+        msg = "Set configuration unable to parse JSON: {}".format(e)
+        return msg, 400
+    return flask.jsonify(_configuration())
+
+
+@webapp.route("/mock_uss/configuration/reload", methods=["POST"])
+@idempotent
+def reload_configuration() -> Tuple[str, int]:
+    """Reload the reloadable settings from the environment and configuration
+    file, discarding settings set otherwise."""
+    try:
+        reload.reload_from_file()
+    except (OSError, ValueError) as e:
+        return "Unable to reload configuration: {}".format(e), 400
+    return flask.jsonify(_configuration())
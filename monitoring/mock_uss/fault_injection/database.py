@@ -0,0 +1,54 @@
+import json
+from typing import Dict
+
+from implicitdict import ImplicitDict
+from monitoring.monitorlib.multiprocessing import SynchronizedValue
+from monitoring.mock_uss.config import state_path
+
+ALL_ENDPOINTS = "*"
+"""Key of the faults injected on endpoints without faults of their own"""
+
+
+class EndpointFaults(ImplicitDict):
+    """Faults injected on an endpoint, each in a percentage of its requests"""
+
+    delay_percent: float = 0
+    """Percentage of requests delayed by a duration drawn uniformly between
+    delay_ms_min and delay_ms_max before being served"""
+
+    delay_ms_min: float = 0
+    delay_ms_max: float = 0
+
+    timeout_percent: float = 0
+    """Percentage of requests answered with a 504 only after timeout_seconds,
+    to exceed the timeout of the requester"""
+
+    timeout_seconds: float = 30
+
+    error_percent: float = 0
+    """Percentage of requests answered immediately with error_status_code"""
+
+    error_status_code: int = 503
+
+
+class FaultCounts(ImplicitDict):
+    requests: int = 0
+    delayed: int = 0
+    timed_out: int = 0
+    errored: int = 0
+
+
+class Database(ImplicitDict):
+    """Faults injected on mock_uss endpoints, by route (e.g.,
+    `/mock/scd/uss/v1/operational_intents/<entityid>`) or ALL_ENDPOINTS"""
+
+    endpoints: Dict[str, EndpointFaults] = {}
+    counts: Dict[str, FaultCounts] = {}
+    """Number of requests and injected faults, by route"""
+
+
+db = SynchronizedValue(
+    Database(),
+    decoder=lambda b: ImplicitDict.parse(json.loads(b.decode("utf-8")), Database),
+    persistence_path=state_path("fault_injection"),
+)
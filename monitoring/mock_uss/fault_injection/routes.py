@@ -0,0 +1,114 @@
+import random
+import time
+from typing import Dict, Tuple
+
+import flask
+
+from implicitdict import ImplicitDict
+from monitoring.mock_uss import webapp
+from monitoring.mock_uss.idempotency.replay import idempotent
+from .database import db, ALL_ENDPOINTS, EndpointFaults, FaultCounts
+
+# Path prefix of the endpoints of mock_uss itself, on which faults are never
+# injected
+MOCK_USS_PATH = "/mock_uss/"
+
+
+@webapp.route("/mock_uss/fault_injection", methods=["PUT"])
+@idempotent
+def set_fault_injection() -> Tuple[str, int]:
+    """Replace the faults injected on mock_uss endpoints, and reset the counts
+    of injected faults."""
+    try:
+        json = flask.request.json
+        if json is None:
+            raise ValueError("Request did not contain a JSON payload")
+        endpoints: Dict[str, EndpointFaults] = {
+            endpoint: ImplicitDict.parse(faults, EndpointFaults)
+            for endpoint, faults in json.get("endpoints", {}).items()
+        }
+        for endpoint, faults in endpoints.items():
+            percents = [
+                faults.delay_percent,
+                faults.timeout_percent,
+                faults.error_percent,
+            ]
+            if any(p < 0 for p in percents) or sum(percents) > 100:
+                raise ValueError(
+                    "Fault percentages of {} must be positive and add up to at most 100".format(
+                        endpoint
+                    )
+                )
+            if faults.delay_ms_min > faults.delay_ms_max:
+                raise ValueError(
+                    "Minimum delay of {} exceeds its maximum delay".format(endpoint)
+                )
+    except (ValueError, AttributeError) as e:
+        msg = "Set fault injection unable to parse JSON: {}".format(e)
+        return msg, 400
+
+    with db as tx:
+        tx.endpoints = endpoints
+        tx.counts = {}
+    return flask.jsonify({"endpoints": endpoints})
+
+
+@webapp.route("/mock_uss/fault_injection", methods=["GET"])
+def get_fault_injection() -> Tuple[str, int]:
+    """Get the faults injected on mock_uss endpoints and the counts of requests
+    and injected faults since they were set."""
+    return flask.jsonify(db.value)
+
+
+@webapp.route("/mock_uss/fault_injection", methods=["DELETE"])
+@idempotent
+def delete_fault_injection() -> Tuple[str, int]:
+    """Stop injecting faults, returning the final counts."""
+    with db as tx:
+        counts = tx.counts
+        tx.endpoints = {}
+        tx.counts = {}
+    return flask.jsonify({"counts": counts})
+
+
+@webapp.before_request
+def inject_faults():
+    if flask.request.path.startswith(MOCK_USS_PATH) or not db.value.endpoints:
+        return None
+    rule = flask.request.url_rule
+    endpoint = rule.rule if rule is not None else flask.request.path
+    endpoints = db.value.endpoints
+    faults = endpoints.get(endpoint, endpoints.get(ALL_ENDPOINTS, None))
+    if faults is None:
+        return None
+
+    # Draw at most one fault for the request
+    draw = random.uniform(0, 100)
+    fault = None
+    for name, percent in (
+        ("errored", faults.error_percent),
+        ("timed_out", faults.timeout_percent),
+        ("delayed", faults.delay_percent),
+    ):
+        if draw < percent:
+            fault = name
+            break
+        draw -= percent
+
+    with db as tx:
+        counts = tx.counts.setdefault(endpoint, FaultCounts())
+        counts.requests += 1
+        if fault is not None:
+            counts[fault] += 1
+
+    if fault == "errored":
+        return (
+            flask.jsonify({"message": "Injected fault"}),
+            faults.error_status_code,
+        )
+    elif fault == "timed_out":
+        time.sleep(faults.timeout_seconds)
+        return flask.jsonify({"message": "Injected timeout"}), 504
+    elif fault == "delayed":
+        time.sleep(random.uniform(faults.delay_ms_min, faults.delay_ms_max) / 1000)
+    return None
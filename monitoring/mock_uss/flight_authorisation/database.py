@@ -0,0 +1,57 @@
+import json
+from typing import List, Optional
+
+from implicitdict import ImplicitDict
+from monitoring.monitorlib.multiprocessing import SynchronizedValue
+from monitoring.monitorlib.scd_automated_testing.scd_injection_api import (
+    OperationCategory,
+    OperationMode,
+    UASClass,
+)
+from monitoring.mock_uss import identities
+from monitoring.mock_uss.config import state_path
+
+
+class FlightAuthorisationRules(ImplicitDict):
+    """Rules against which the flight authorisation data of flight plans is
+    evaluated; omitted rules do not restrict flight plans"""
+
+    validate_data: bool = True
+    """Whether the flight authorisation data must be valid (serial number,
+    operator ID, endurance, etc.)"""
+
+    allowed_uas_classes: Optional[List[UASClass]]
+    allowed_operation_modes: Optional[List[OperationMode]]
+    allowed_operation_categories: Optional[List[OperationCategory]]
+
+    require_type_certificate: bool = False
+    """Whether the UAS must have a type certificate"""
+
+    max_endurance_minutes: Optional[int]
+
+    denied_operator_ids: List[str] = []
+    denied_serial_numbers: List[str] = []
+
+    required_identification_technologies: List[str] = []
+    """Identification technologies the UAS must all have"""
+
+    max_flight_duration_minutes: Optional[float]
+    """Maximum duration of the operational intent of a flight plan"""
+
+    max_priority: Optional[int]
+    """Maximum priority of the operational intent of a flight plan"""
+
+
+class Database(ImplicitDict):
+    rules: FlightAuthorisationRules = FlightAuthorisationRules()
+
+
+db = identities.PerIdentityValue(
+    lambda identity: SynchronizedValue(
+        Database(),
+        decoder=lambda b: ImplicitDict.parse(json.loads(b.decode("utf-8")), Database),
+        persistence_path=state_path(
+            identities.state_name("flight_authorisation", identity)
+        ),
+    )
+)
@@ -0,0 +1,123 @@
+from typing import List, Optional
+
+from implicitdict import ImplicitDict
+from monitoring.monitorlib import scd
+from monitoring.monitorlib.scd_automated_testing.scd_injection_api import (
+    FlightAuthorisationData,
+    OperationalIntentTestInjection,
+)
+from monitoring.monitorlib.uspace import problems_with_flight_authorisation
+from .database import FlightAuthorisationRules
+
+
+class Denial(ImplicitDict):
+    """A reason a flight plan is not authorised"""
+
+    rule: str
+    """Name of the FlightAuthorisationRules field denying the flight plan"""
+
+    message: str
+
+
+class FlightAuthorisationDecision(ImplicitDict):
+    approved: bool
+    denials: List[Denial] = []
+
+    @property
+    def notes(self) -> str:
+        return ", ".join(d.message for d in self.denials)
+
+
+def evaluate(
+    rules: FlightAuthorisationRules,
+    flight_auth: FlightAuthorisationData,
+    op_intent: Optional[OperationalIntentTestInjection] = None,
+) -> FlightAuthorisationDecision:
+    """Evaluate a flight plan against rules.
+
+    :param flight_auth: Flight authorisation data of the flight plan
+    :param op_intent: Operational intent of the flight plan, if any
+    """
+    denials: List[Denial] = []
+
+    def deny(rule: str, message: str):
+        denials.append(Denial(rule=rule, message=message))
+
+    if rules.validate_data:
+        for problem in problems_with_flight_authorisation(flight_auth):
+            deny("validate_data", problem)
+
+    for rule, field in (
+        ("allowed_uas_classes", "uas_class"),
+        ("allowed_operation_modes", "operation_mode"),
+        ("allowed_operation_categories", "operation_category"),
+    ):
+        allowed = rules.get(rule, None)
+        if allowed is None:
+            continue
+        value = flight_auth.get(field, None)
+        if value not in allowed:
+            deny(
+                rule,
+                "{} {} is not allowed; allowed: {}".format(
+                    field, value, ", ".join(allowed)
+                ),
+            )
+
+    if rules.require_type_certificate and not flight_auth.get(
+        "uas_type_certificate", None
+    ):
+        deny("require_type_certificate", "UAS has no type certificate")
+
+    max_endurance = rules.get("max_endurance_minutes", None)
+    if max_endurance is not None and flight_auth.endurance_minutes > max_endurance:
+        deny(
+            "max_endurance_minutes",
+            "Endurance of {} minutes exceeds {} minutes".format(
+                flight_auth.endurance_minutes, max_endurance
+            ),
+        )
+
+    if str(flight_auth.operator_id) in rules.denied_operator_ids:
+        deny(
+            "denied_operator_ids",
+            "Operator {} is denied".format(flight_auth.operator_id),
+        )
+    if str(flight_auth.uas_serial_number) in rules.denied_serial_numbers:
+        deny(
+            "denied_serial_numbers",
+            "UAS {} is denied".format(flight_auth.uas_serial_number),
+        )
+
+    missing = [
+        t
+        for t in rules.required_identification_technologies
+        if t not in flight_auth.identification_technologies
+    ]
+    if missing:
+        deny(
+            "required_identification_technologies",
+            "Missing identification technologies {}".format(", ".join(missing)),
+        )
+
+    if op_intent is not None:
+        max_duration = rules.get("max_flight_duration_minutes", None)
+        if max_duration is not None:
+            duration = (
+                scd.end_of(op_intent.volumes) - scd.start_of(op_intent.volumes)
+            ).total_seconds() / 60
+            if duration > max_duration:
+                deny(
+                    "max_flight_duration_minutes",
+                    "Flight duration of {:.1f} minutes exceeds {} minutes".format(
+                        duration, max_duration
+                    ),
+                )
+        max_priority = rules.get("max_priority", None)
+        if max_priority is not None and op_intent.priority > max_priority:
+            deny(
+                "max_priority",
+                "Priority {} exceeds {}".format(op_intent.priority, max_priority),
+            )
+
+    return FlightAuthorisationDecision(approved=not denials, denials=denials)
@@ -0,0 +1,65 @@
+from typing import Tuple
+
+import flask
+
+from implicitdict import ImplicitDict
+from monitoring.monitorlib.scd_automated_testing.scd_injection_api import (
+    InjectFlightRequest,
+)
+from monitoring.mock_uss import webapp
+from monitoring.mock_uss.idempotency.replay import idempotent
+from .database import db, FlightAuthorisationRules
+from .evaluation import evaluate
+
+
+@webapp.route("/flight_authorisation/rules", methods=["PUT"])
+@idempotent
+def set_flight_authorisation_rules() -> Tuple[str, int]:
+    """Set the rules against which flight plans are authorised."""
+    try:
+        json = flask.request.json
+        if json is None:
+            raise ValueError("Request did not contain a JSON payload")
+        rules = ImplicitDict.parse(json, FlightAuthorisationRules)
+    except ValueError as e:
+        msg = "Set flight authorisation rules unable to parse JSON: {}".format(e)
+        return msg, 400
+
+    with db as tx:
+        tx.rules = rules
+    return flask.jsonify(rules)
+
+
+@webapp.route("/flight_authorisation/rules", methods=["GET"])
+def get_flight_authorisation_rules() -> Tuple[str, int]:
+    """Get the rules against which flight plans are authorised."""
+    return flask.jsonify(db.value.rules)
+
+
+@webapp.route("/flight_authorisation/rules", methods=["DELETE"])
+@idempotent
+def delete_flight_authorisation_rules() -> Tuple[str, int]:
+    """Restore the default rules, which only validate flight authorisation
+    data."""
+    with db as tx:
+        tx.rules = FlightAuthorisationRules()
+    return flask.jsonify(db.value.rules)
+
+
+@webapp.route("/flight_authorisation/evaluate", methods=["POST"])
+def evaluate_flight_authorisation() -> Tuple[str, int]:
+    """Evaluate a flight plan, in the form of an SCD injection request, against
+    the current rules without planning it."""
+    try:
+        json = flask.request.json
+        if json is None:
+            raise ValueError("Request did not contain a JSON payload")
+        req_body = ImplicitDict.parse(json, InjectFlightRequest)
+    except ValueError as e:
+        msg = "Evaluate flight authorisation unable to parse JSON: {}".format(e)
+        return msg, 400
+
+    decision = evaluate(
+        db.value.rules, req_body.flight_authorisation, req_body.operational_intent
+    )
+    return flask.jsonify(decision)
@@ -2,6 +2,8 @@ import json
 from typing import Dict, Optional
 from implicitdict import ImplicitDict
 from monitoring.monitorlib.multiprocessing import SynchronizedValue
+from monitoring.mock_uss import identities
+from monitoring.mock_uss.config import state_path
 from uas_standards.eurocae_ed269 import ED269Schema
 from uas_standards.interuss.automated_testing.geo_awareness.v1.api import (
     CreateGeozoneSourceRequest,
@@ -78,7 +80,10 @@ class Database(ImplicitDict):
             return tx.sources.pop(id, None)
 
 
-db = SynchronizedValue(
-    Database(),
-    decoder=lambda b: ImplicitDict.parse(json.loads(b.decode("utf-8")), Database),
+db = identities.PerIdentityValue(
+    lambda identity: SynchronizedValue(
+        Database(),
+        decoder=lambda b: ImplicitDict.parse(json.loads(b.decode("utf-8")), Database),
+        persistence_path=state_path(identities.state_name("geoawareness", identity)),
+    )
 )
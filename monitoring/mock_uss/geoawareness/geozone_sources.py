@@ -45,7 +45,7 @@ def create_geozone_source(id, source_definition: CreateGeozoneSourceRequest):
                 source = Database.update_source_state(
                     db, id, GeozoneSourceResponseResult.Ready
                 )
-        except ValueError as e:
+        except (ValueError, requests.exceptions.RequestException) as e:
             source = Database.update_source_state(
                 db,
                 id,
@@ -67,6 +67,27 @@ def create_geozone_source(id, source_definition: CreateGeozoneSourceRequest):
     )
 
 
+def load_geozone_dataset(id, raw_data: dict):
+    """This handler creates and activates a geozone source from an ED-269
+    dataset provided directly rather than downloaded"""
+
+    try:
+        geozones = ED269Schema.from_dict(raw_data)
+    except (ValueError, KeyError, TypeError) as e:
+        return f"Unable to parse ED-269 dataset for source {id}: {str(e)}", 400
+
+    try:
+        Database.insert_source(
+            db, id, CreateGeozoneSourceRequest(), GeozoneSourceResponseResult.Activating
+        )
+    except ExistingRecordException:
+        return f"source {id} already exists in database", 409
+
+    Database.update_source_geozone_ed269(db, id, geozones)
+    source = Database.update_source_state(db, id, GeozoneSourceResponseResult.Ready)
+    return GeozoneSourceResponse(result=source.state)
+
+
 def delete_geozone_source(geozone_source_id):
     """This handler deactivates and deletes a geozone source"""
 
@@ -13,11 +13,13 @@ from monitoring.mock_uss.geoawareness.check import check_geozones
 from monitoring.mock_uss.geoawareness.geozone_sources import (
     get_geozone_source,
     create_geozone_source,
+    load_geozone_dataset,
     delete_geozone_source,
 )
 from monitoring.monitorlib.geoawareness_automated_testing.api import (
     SCOPE_GEOAWARENESS_TEST,
 )
+from monitoring.mock_uss.idempotency.replay import idempotent
 
 
 @webapp.route(
@@ -34,6 +36,7 @@ def get_geozone_sources(geozone_source_id: str) -> Tuple[str, int]:
     methods=["PUT"],
 )
 @requires_scope([SCOPE_GEOAWARENESS_TEST])
+@idempotent
 def put_geozone_sources(geozone_source_id: str) -> Tuple[str, int]:
     try:
         json = flask.request.json
@@ -51,11 +54,30 @@ def put_geozone_sources(geozone_source_id: str) -> Tuple[str, int]:
     return create_geozone_source(geozone_source_id, body)
 
 
+@webapp.route(
+    "/geoawareness/geozone_sources/<geozone_source_id>/ed269",
+    methods=["PUT"],
+)
+@requires_scope([SCOPE_GEOAWARENESS_TEST])
+@idempotent
+def put_geozone_dataset(geozone_source_id: str) -> Tuple[str, int]:
+    """Creates a geozone source from the ED-269 dataset in the request body."""
+    json = flask.request.get_json(silent=True)
+    if not isinstance(json, dict):
+        msg = "Load geozone dataset {} unable to parse JSON: {}".format(
+            geozone_source_id, "Request did not contain a JSON object"
+        )
+        return msg, 400
+
+    return load_geozone_dataset(geozone_source_id, json)
+
+
 @webapp.route(
     "/geoawareness/geozone_sources/<geozone_source_id>",
     methods=["DELETE"],
 )
 @requires_scope([SCOPE_GEOAWARENESS_TEST])
+@idempotent
 def delete_geozone_sources(geozone_source_id: str) -> Tuple[str, int]:
     return delete_geozone_source(geozone_source_id)
 
@@ -1,3 +1,4 @@
+import os
 import uuid
 
 import pytest
@@ -7,6 +8,10 @@ from monitoring.mock_uss import webapp
 from monitoring.monitorlib.auth import NoAuth
 
 TEST_DATASET_URL = "https://raw.githubusercontent.com/interuss/dss/517595ad4074bdb621feb4ab81c2d2f4fc11eff1/monitoring/uss_qualifier/scenarios/uspace/geo_awareness/design/CHE/geo-awareness-che-1.json"
+TEST_DATASET_PATH = os.path.join(
+    os.path.dirname(__file__),
+    "../../uss_qualifier/scenarios/uspace/geo_awareness/design/CHE/geo-awareness-che-1.json",
+)
 
 
 @pytest.fixture()
@@ -131,6 +136,49 @@ def test_geosource_bad_url(client, client_options):
     )
 
 
+def test_geosource_inline_dataset(client, client_options):
+    id = uuid.uuid4()
+    with open(TEST_DATASET_PATH, "r") as f:
+        dataset = json.load(f)
+
+    # Creation
+    response = client.put(
+        f"/geoawareness/geozone_sources/{id}/ed269", json=dataset, **client_options
+    )
+    assert response.status_code == 200
+    assert response.json["result"] == "Ready"
+
+    # Already exists
+    response = client.put(
+        f"/geoawareness/geozone_sources/{id}/ed269", json=dataset, **client_options
+    )
+    assert response.status_code == 409
+
+    # Check against the loaded geozones
+    response = client.post(
+        f"/geoawareness/check",
+        json={"checks": [{"filterSets": [{"before": "2020-01-01T00:00:00Z"}]}]},
+        **client_options,
+    )
+    assert response.status_code == 200
+    assert response.json["applicableGeozone"] == ["Present"]
+
+    response = client.delete(f"/geoawareness/geozone_sources/{id}", **client_options)
+    assert response.status_code == 200
+
+
+def test_geosource_invalid_dataset(client, client_options):
+    id = uuid.uuid4()
+
+    response = client.put(
+        f"/geoawareness/geozone_sources/{id}/ed269", json=[], **client_options
+    )
+    assert response.status_code == 400
+
+    response = client.get(f"/geoawareness/geozone_sources/{id}", **client_options)
+    assert response.status_code == 404
+
+
 def test_geozone_simple_check(client, client_options):
     id = uuid.uuid4()
 
@@ -0,0 +1,46 @@
+import json
+from typing import Dict, Optional
+
+from implicitdict import ImplicitDict, StringBasedDateTime
+from monitoring.monitorlib.multiprocessing import SynchronizedValue
+from monitoring.mock_uss import identities
+from monitoring.mock_uss.config import state_path
+
+MAX_RECORDS = 1000
+"""Maximum number of responses kept for replay; the oldest are discarded
+first"""
+
+RETENTION_SECONDS = 24 * 60 * 60
+"""Time during which a response may be replayed"""
+
+
+class IdempotencyRecord(ImplicitDict):
+    """Outcome of a request carrying an idempotency key"""
+
+    received_at: StringBasedDateTime
+    request_hash: str
+    """Hash of the body of the request, so that reusing the key for another
+    request is detected"""
+
+    completed: bool = False
+    """False while the original request is being handled"""
+
+    status_code: Optional[int]
+    mimetype: Optional[str]
+    body: Optional[str]
+
+
+class Database(ImplicitDict):
+    """Responses replayed for repeated requests, by method, path and idempotency
+    key"""
+
+    records: Dict[str, IdempotencyRecord] = {}
+
+
+db = identities.PerIdentityValue(
+    lambda identity: SynchronizedValue(
+        Database(),
+        decoder=lambda b: ImplicitDict.parse(json.loads(b.decode("utf-8")), Database),
+        persistence_path=state_path(identities.state_name("idempotency", identity)),
+    )
+)
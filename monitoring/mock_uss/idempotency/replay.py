@@ -0,0 +1,85 @@
+from datetime import datetime, timedelta
+import functools
+import hashlib
+
+import flask
+
+from implicitdict import StringBasedDateTime
+from .database import db, IdempotencyRecord, MAX_RECORDS, RETENTION_SECONDS
+
+IDEMPOTENCY_KEY_HEADER = "Idempotency-Key"
+REPLAYED_HEADER = "Idempotent-Replayed"
+
+
+def _prune(records: dict, now: datetime) -> None:
+    cutoff = now - timedelta(seconds=RETENTION_SECONDS)
+    expired = [k for k, r in records.items() if r.received_at.datetime < cutoff]
+    for k in expired:
+        del records[k]
+    if len(records) > MAX_RECORDS:
+        oldest = sorted(records, key=lambda k: records[k].received_at.datetime)
+        for k in oldest[: len(records) - MAX_RECORDS]:
+            del records[k]
+
+
+def idempotent(handler):
+    """Decorator making the repetition of a request carrying an Idempotency-Key
+    header replay the response to the original request instead of acting again.
+
+    A key reused with a different request body is rejected with 422, and a
+    repetition received while the original request is still being handled is
+    rejected with 409.  Responses with a 5xx status are not recorded, so that
+    such requests may be retried.
+    """
+
+    @functools.wraps(handler)
+    def wrapper(*args, **kwargs):
+        key = flask.request.headers.get(IDEMPOTENCY_KEY_HEADER, None)
+        if not key:
+            return handler(*args, **kwargs)
+
+        record_id = "{} {} {}".format(flask.request.method, flask.request.path, key)
+        request_hash = hashlib.sha256(flask.request.get_data()).hexdigest()
+        now = datetime.utcnow()
+        with db as tx:
+            record = tx.records.get(record_id, None)
+            if record is None:
+                _prune(tx.records, now)
+                tx.records[record_id] = IdempotencyRecord(
+                    received_at=StringBasedDateTime(now), request_hash=request_hash
+                )
+        if record is not None:
+            if record.request_hash != request_hash:
+                msg = "{} {} was already used for a different request".format(
+                    IDEMPOTENCY_KEY_HEADER, key
+                )
+                return msg, 422
+            if not record.completed:
+                msg = "Request with {} {} is still being handled".format(
+                    IDEMPOTENCY_KEY_HEADER, key
+                )
+                return msg, 409
+            response = flask.Response(
+                record.body, status=record.status_code, mimetype=record.mimetype
+            )
+            response.headers[REPLAYED_HEADER] = "true"
+            return response
+
+        try:
+            response = flask.make_response(handler(*args, **kwargs))
+        except Exception:
+            with db as tx:
+                tx.records.pop(record_id, None)
+            raise
+        with db as tx:
+            if response.status_code >= 500:
+                tx.records.pop(record_id, None)
+            elif record_id in tx.records:
+                record = tx.records[record_id]
+                record.completed = True
+                record.status_code = response.status_code
+                record.mimetype = response.mimetype
+                record.body = response.get_data(as_text=True)
+        return response
+
+    return wrapper
@@ -0,0 +1,134 @@
+"""Logical USS identities simulated by a single mock_uss process.
+
+Besides its default identity, mock_uss may simulate other USSs configured in
+MOCK_USS_IDENTITIES.  Each identity authenticates to the DSS with its own auth
+spec (and therefore its own access token subject), serves its endpoints under
+its own base URL, and keeps its own service state.
+"""
+
+import contextlib
+import threading
+from typing import Any, Callable, Dict, List
+
+import flask
+
+from monitoring.monitorlib.multiprocessing import SynchronizedValue
+from monitoring.mock_uss import config
+
+DEFAULT_IDENTITY = ""
+
+IDENTITY_PREFIX = "/identities/"
+"""Path prefix under which each additional identity serves its endpoints"""
+
+ENVIRON_KEY = "mock_uss.identity"
+
+_acting = threading.local()
+
+
+def names() -> List[str]:
+    """All the identities simulated by this process, starting with the default
+    identity."""
+    return [DEFAULT_IDENTITY] + sorted(config.Config.IDENTITIES)
+
+
+def current() -> str:
+    """Identity the current thread acts as, otherwise the identity serving the
+    current request, or the default identity outside of requests."""
+    identity = getattr(_acting, "identity", None)
+    if identity is not None:
+        return identity
+    if not flask.has_request_context():
+        return DEFAULT_IDENTITY
+    return flask.request.environ.get(ENVIRON_KEY, DEFAULT_IDENTITY)
+
+
+@contextlib.contextmanager
+def acting_as(identity: str):
+    """Act on behalf of identity in the current thread, e.g. in a background
+    task started by a request."""
+    previous = getattr(_acting, "identity", None)
+    _acting.identity = identity
+    try:
+        yield
+    finally:
+        _acting.identity = previous
+
+
+def auth_spec(identity: str) -> str:
+    if identity == DEFAULT_IDENTITY:
+        return config.Config.AUTH_SPEC
+    return config.Config.IDENTITIES[identity].get("auth_spec", config.Config.AUTH_SPEC)
+
+
+def base_url(identity: str = None) -> str:
+    """Base URL of the endpoints of identity (the current identity by default)."""
+    if identity is None:
+        identity = current()
+    if identity == DEFAULT_IDENTITY:
+        return config.Config.USS_BASE_URL
+    default_url = "{}{}{}".format(
+        config.Config.USS_BASE_URL, IDENTITY_PREFIX, identity
+    )
+    return config.Config.IDENTITIES[identity].get("base_url", default_url)
+
+
+def state_name(name: str, identity: str) -> str:
+    """Name of the persisted state `name` of identity."""
+    return name if identity == DEFAULT_IDENTITY else "{}.{}".format(name, identity)
+
+
+class IdentityMiddleware(object):
+    """WSGI middleware routing requests under the path prefix of an identity to
+    the regular endpoints, on behalf of that identity."""
+
+    def __init__(self, app):
+        self._app = app
+
+    def __call__(self, environ, start_response):
+        path = environ.get("PATH_INFO", "")
+        if path.startswith(IDENTITY_PREFIX):
+            identity, _, rest = path[len(IDENTITY_PREFIX) :].partition("/")
+            if identity in config.Config.IDENTITIES:
+                environ[ENVIRON_KEY] = identity
+                environ["SCRIPT_NAME"] = (
+                    environ.get("SCRIPT_NAME", "") + IDENTITY_PREFIX + identity
+                )
+                environ["PATH_INFO"] = "/" + rest
+        return self._app(environ, start_response)
+
+
+class PerIdentityValue(object):
+    """SynchronizedValue isolating the value of each identity; `.value` and
+    transactions apply to the value of the current identity."""
+
+    _values: Dict[str, SynchronizedValue]
+
+    def __init__(self, make_value: Callable[[str], SynchronizedValue]):
+        """:param make_value: Function creating the SynchronizedValue of an identity"""
+        self._values = {identity: make_value(identity) for identity in names()}
+
+    @property
+    def value(self):
+        return self._values[current()].value
+
+    def __enter__(self):
+        return self._values[current()].__enter__()
+
+    def __exit__(self, exc_type, exc_val, exc_tb):
+        return self._values[current()].__exit__(exc_type, exc_val, exc_tb)
+
+
+class PerIdentityClient(object):
+    """Forwards attribute access to the client of the current identity."""
+
+    def __init__(self, make_client: Callable[[str], Any]):
+        self._make_client = make_client
+        self._clients = {identity: make_client(identity) for identity in names()}
+
+    def reset(self) -> None:
+        """Replace the clients of all identities with new ones, e.g. after the
+        configuration they were created from changed."""
+        self._clients = {identity: self._make_client(identity) for identity in names()}
+
+    def __getattr__(self, name):
+        return getattr(self._clients[current()], name)
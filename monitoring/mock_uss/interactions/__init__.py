@@ -0,0 +1,3 @@
+CORRELATION_ID_HEADER = "X-Correlation-ID"
+"""Header carrying the ID correlating an interaction with others, such as the
+outgoing requests performed to serve an incoming request"""
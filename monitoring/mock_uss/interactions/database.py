@@ -0,0 +1,66 @@
+import json
+from typing import Dict, List, Optional
+
+from implicitdict import ImplicitDict, StringBasedDateTime
+from monitoring.monitorlib.multiprocessing import SynchronizedValue
+from monitoring.mock_uss.config import state_path
+
+MAX_INTERACTIONS_PER_SESSION = 5000
+
+
+class Interaction(ImplicitDict):
+    """An HTTP interaction of mock_uss with a peer"""
+
+    direction: str
+    """Either `incoming` (request received by mock_uss) or `outgoing` (request
+    sent by mock_uss)"""
+
+    initiated_at: StringBasedDateTime
+    method: str
+    endpoint: str
+    """Route of an incoming request (e.g.,
+    `/mock/scd/uss/v1/operational_intents/<entityid>`), or path of an outgoing
+    request"""
+
+    url: str
+    peer: str
+    """Subject of the access token of an incoming request (or its remote
+    address when it has none), or host of an outgoing request"""
+
+    status_code: int
+    latency_ms: float
+    correlation_id: Optional[str]
+
+
+class Session(ImplicitDict):
+    """Interactions recorded since a scenario started the session"""
+
+    started_at: StringBasedDateTime
+    interactions: List[Interaction] = []
+    dropped: int = 0
+    """Number of interactions not recorded because the session was full"""
+
+
+class Database(ImplicitDict):
+    """Recording sessions, by session ID"""
+
+    sessions: Dict[str, Session] = {}
+
+
+db = SynchronizedValue(
+    Database(),
+    decoder=lambda b: ImplicitDict.parse(json.loads(b.decode("utf-8")), Database),
+    persistence_path=state_path("interactions"),
+)
+
+
+def record(interaction: Interaction) -> None:
+    """Record interaction in every active session."""
+    if not db.value.sessions:
+        return
+    with db as tx:
+        for session in tx.sessions.values():
+            if len(session.interactions) >= MAX_INTERACTIONS_PER_SESSION:
+                session.dropped += 1
+            else:
+                session.interactions.append(interaction)
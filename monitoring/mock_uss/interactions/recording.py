@@ -0,0 +1,95 @@
+from datetime import datetime, timedelta
+import time
+from typing import Optional
+import urllib.parse
+
+import flask
+import jwt
+import requests
+
+from implicitdict import StringBasedDateTime
+from monitoring.mock_uss import webapp
+from . import CORRELATION_ID_HEADER
+from .database import Interaction, record
+
+# Path prefix of the endpoints managing recording sessions, whose own
+# interactions are not recorded
+INTERACTIONS_PATH = "/mock_uss/interactions"
+
+
+def incoming_peer() -> str:
+    """Identify the peer making the current request."""
+    authorization = flask.request.headers.get("Authorization", "")
+    if authorization.lower().startswith("bearer "):
+        try:
+            payload = jwt.decode(
+                authorization[len("bearer ") :], options={"verify_signature": False}
+            )
+            if "sub" in payload:
+                return payload["sub"]
+        except jwt.InvalidTokenError:
+            pass
+    return flask.request.remote_addr or "unknown"
+
+
+def _correlation_id() -> Optional[str]:
+    if not flask.has_request_context():
+        return None
+    return flask.request.headers.get(CORRELATION_ID_HEADER, None)
+
+
+@webapp.before_request
+def start_incoming():
+    flask.g.interaction_started_at = datetime.utcnow()
+    flask.g.interaction_started = time.monotonic()
+
+
+@webapp.after_request
+def record_incoming(response: flask.Response) -> flask.Response:
+    if flask.request.path.startswith(INTERACTIONS_PATH) or not hasattr(
+        flask.g, "interaction_started"
+    ):
+        return response
+    rule = flask.request.url_rule
+    fields = {}
+    correlation_id = _correlation_id()
+    if correlation_id is not None:
+        fields["correlation_id"] = correlation_id
+    record(
+        Interaction(
+            direction="incoming",
+            initiated_at=StringBasedDateTime(flask.g.interaction_started_at),
+            method=flask.request.method,
+            endpoint=rule.rule if rule is not None else flask.request.path,
+            url=flask.request.url,
+            peer=incoming_peer(),
+            status_code=response.status_code,
+            latency_ms=(time.monotonic() - flask.g.interaction_started) * 1000,
+            **fields,
+        )
+    )
+    return response
+
+
+def record_outgoing(response: requests.Response, *args, **kwargs):
+    """Response hook recording the requests sent by a requests.Session."""
+    url = urllib.parse.urlparse(response.request.url)
+    fields = {}
+    correlation_id = response.request.headers.get(
+        CORRELATION_ID_HEADER, _correlation_id()
+    )
+    if correlation_id is not None:
+        fields["correlation_id"] = correlation_id
+    record(
+        Interaction(
+            direction="outgoing",
+            initiated_at=StringBasedDateTime(datetime.utcnow() - response.elapsed),
+            method=response.request.method,
+            endpoint=url.path,
+            url=response.request.url,
+            peer=url.netloc,
+            status_code=response.status_code,
+            latency_ms=response.elapsed / timedelta(milliseconds=1),
+            **fields,
+        )
+    )
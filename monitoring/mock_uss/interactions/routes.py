@@ -0,0 +1,69 @@
+from datetime import datetime
+from typing import Tuple
+
+import flask
+
+from implicitdict import StringBasedDateTime
+from monitoring.mock_uss import webapp
+from .database import db, Session
+from .recording import INTERACTIONS_PATH
+
+# Query parameters filtering the interactions of a session, and the Interaction
+# field each one applies to
+FILTERS = {
+    "direction": "direction",
+    "method": "method",
+    "endpoint": "endpoint",
+    "peer": "peer",
+    "status_code": "status_code",
+    "correlation_id": "correlation_id",
+}
+
+
+@webapp.route(INTERACTIONS_PATH + "/sessions/<session_id>", methods=["PUT"])
+def start_interactions_session(session_id: str) -> Tuple[str, int]:
+    """Start recording interactions in a session, discarding any interactions
+    previously recorded in a session with the same ID."""
+    session = Session(started_at=StringBasedDateTime(datetime.utcnow()))
+    with db as tx:
+        tx.sessions[session_id] = session
+    return flask.jsonify(session)
+
+
+@webapp.route(INTERACTIONS_PATH + "/sessions/<session_id>", methods=["GET"])
+def query_interactions_session(session_id: str) -> Tuple[str, int]:
+    """List the interactions recorded in a session that match all the filters
+    specified as query parameters."""
+    session = db.value.sessions.get(session_id, None)
+    if session is None:
+        return "Session {} is not recording interactions".format(session_id), 404
+
+    interactions = session.interactions
+    for param, field in FILTERS.items():
+        value = flask.request.args.get(param, None)
+        if value is None:
+            continue
+        interactions = [
+            interaction
+            for interaction in interactions
+            if str(interaction.get(field, "")) == value
+        ]
+    return flask.jsonify(
+        {
+            "started_at": session.started_at,
+            "dropped": session.dropped,
+            "count": len(interactions),
+            "interactions": interactions,
+        }
+    )
+
+
+@webapp.route(INTERACTIONS_PATH + "/sessions/<session_id>", methods=["DELETE"])
+def end_interactions_session(session_id: str) -> Tuple[str, int]:
+    """Stop recording interactions in a session, returning everything it
+    recorded."""
+    with db as tx:
+        session = tx.sessions.pop(session_id, None)
+    if session is None:
+        return "Session {} is not recording interactions".format(session_id), 404
+    return flask.jsonify(session)
@@ -0,0 +1,57 @@
+from datetime import datetime
+import logging
+import threading
+
+import flask
+
+from implicitdict import StringBasedDateTime
+from monitoring.mock_uss import identities
+from monitoring.mock_uss.interactions import CORRELATION_ID_HEADER
+from .database import LogEntry, record
+
+
+class LogCaptureHandler(logging.Handler):
+    """Captures the log records of mock_uss in the active capture sessions."""
+
+    def __init__(self):
+        super().__init__()
+        # Records emitted while capturing a record are not captured themselves
+        self._capturing = threading.local()
+
+    def emit(self, log_record: logging.LogRecord) -> None:
+        if getattr(self._capturing, "active", False):
+            return
+        self._capturing.active = True
+        try:
+            kwargs = {}
+            if flask.has_request_context():
+                rule = flask.request.url_rule
+                kwargs["endpoint"] = (
+                    rule.rule if rule is not None else flask.request.path
+                )
+                correlation_id = flask.request.headers.get(CORRELATION_ID_HEADER)
+                if correlation_id is not None:
+                    kwargs["correlation_id"] = correlation_id
+            if log_record.exc_info:
+                kwargs["exception"] = logging.Formatter().formatException(
+                    log_record.exc_info
+                )
+            record(
+                LogEntry(
+                    logged_at=StringBasedDateTime(
+                        datetime.utcfromtimestamp(log_record.created)
+                    ),
+                    level=log_record.levelname,
+                    logger=log_record.name,
+                    message=log_record.getMessage(),
+                    identity=identities.current(),
+                    **kwargs,
+                )
+            )
+        except Exception:
+            self.handleError(log_record)
+        finally:
+            self._capturing.active = False
+
+
+logging.getLogger().addHandler(LogCaptureHandler())
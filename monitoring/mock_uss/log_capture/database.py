@@ -0,0 +1,65 @@
+import json
+from typing import Dict, List, Optional
+
+from implicitdict import ImplicitDict, StringBasedDateTime
+from monitoring.monitorlib.multiprocessing import SynchronizedValue
+from monitoring.mock_uss.config import state_path
+
+MAX_ENTRIES_PER_SESSION = 10000
+
+
+class LogEntry(ImplicitDict):
+    """A log record emitted by mock_uss"""
+
+    logged_at: StringBasedDateTime
+    level: str
+    logger: str
+    message: str
+
+    identity: str
+    """USS identity serving the request during which the record was emitted;
+    the default identity (empty) outside of requests"""
+
+    endpoint: Optional[str]
+    """Route of the request during which the record was emitted, if any"""
+
+    correlation_id: Optional[str]
+    exception: Optional[str]
+    """Formatted exception logged with the record, if any"""
+
+
+class Session(ImplicitDict):
+    """Log records captured since a test driver started the session"""
+
+    started_at: StringBasedDateTime
+    stopped_at: Optional[StringBasedDateTime]
+    entries: List[LogEntry] = []
+    dropped: int = 0
+    """Number of records not captured because the session was full"""
+
+
+class Database(ImplicitDict):
+    """Capture sessions, by session ID"""
+
+    sessions: Dict[str, Session] = {}
+
+
+db = SynchronizedValue(
+    Database(),
+    decoder=lambda b: ImplicitDict.parse(json.loads(b.decode("utf-8")), Database),
+    persistence_path=state_path("log_capture"),
+)
+
+
+def record(entry: LogEntry) -> None:
+    """Record entry in every session still capturing."""
+    if not any("stopped_at" not in s for s in db.value.sessions.values()):
+        return
+    with db as tx:
+        for session in tx.sessions.values():
+            if "stopped_at" in session:
+                continue
+            if len(session.entries) >= MAX_ENTRIES_PER_SESSION:
+                session.dropped += 1
+            else:
+                session.entries.append(entry)
@@ -0,0 +1,73 @@
+from datetime import datetime
+import json
+from typing import Tuple
+
+import flask
+
+from implicitdict import StringBasedDateTime
+from monitoring.mock_uss import webapp
+from .database import db, Session
+
+LOG_CAPTURE_PATH = "/mock_uss/log_capture"
+
+
+@webapp.route(LOG_CAPTURE_PATH + "/sessions/<session_id>", methods=["PUT"])
+def start_log_capture(session_id: str) -> Tuple[str, int]:
+    """Start capturing the logs of mock_uss in a session, discarding any logs
+    previously captured in a session with the same ID."""
+    session = Session(started_at=StringBasedDateTime(datetime.utcnow()))
+    with db as tx:
+        tx.sessions[session_id] = session
+    return flask.jsonify(session)
+
+
+@webapp.route(LOG_CAPTURE_PATH + "/sessions/<session_id>/stop", methods=["POST"])
+def stop_log_capture(session_id: str) -> Tuple[str, int]:
+    """Stop capturing logs in a session, keeping the logs it captured until the
+    session is deleted."""
+    with db as tx:
+        session = tx.sessions.get(session_id, None)
+        if session is not None and "stopped_at" not in session:
+            session.stopped_at = StringBasedDateTime(datetime.utcnow())
+    if session is None:
+        return "Session {} is not capturing logs".format(session_id), 404
+    return flask.jsonify(
+        {
+            "started_at": session.started_at,
+            "stopped_at": session.stopped_at,
+            "dropped": session.dropped,
+            "count": len(session.entries),
+        }
+    )
+
+
+@webapp.route(LOG_CAPTURE_PATH + "/sessions/<session_id>", methods=["GET"])
+def download_log_capture(session_id: str) -> Tuple[str, int]:
+    """Download the logs captured in a session, as a JSON session or, with
+    `format=jsonl`, as a file with one JSON log entry per line."""
+    session = db.value.sessions.get(session_id, None)
+    if session is None:
+        return "Session {} is not capturing logs".format(session_id), 404
+    if flask.request.args.get("format", "json") == "jsonl":
+        content = "".join(json.dumps(entry) + "\n" for entry in session.entries)
+        return flask.Response(
+            content,
+            mimetype="application/x-ndjson",
+            headers={
+                "Content-Disposition": 'attachment; filename="mock_uss_{}.jsonl"'.format(
+                    session_id
+                )
+            },
+        )
+    return flask.jsonify(session)
+
+
+@webapp.route(LOG_CAPTURE_PATH + "/sessions/<session_id>", methods=["DELETE"])
+def delete_log_capture(session_id: str) -> Tuple[str, int]:
+    """Stop capturing logs in a session and discard it, returning everything it
+    captured."""
+    with db as tx:
+        session = tx.sessions.pop(session_id, None)
+    if session is None:
+        return "Session {} is not capturing logs".format(session_id), 404
+    return flask.jsonify(session)
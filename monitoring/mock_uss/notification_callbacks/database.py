@@ -0,0 +1,42 @@
+import json
+from typing import Dict, List, Optional
+
+from implicitdict import ImplicitDict, StringBasedDateTime
+from monitoring.monitorlib.multiprocessing import SynchronizedValue
+from monitoring.mock_uss.config import state_path
+
+
+class NotificationCallback(ImplicitDict):
+    """A callback registered by a test driver to be informed of the
+    notifications received by mock_uss"""
+
+    url: str
+    """URL to which a NotificationReceipt is POSTed for each notification"""
+
+    services: Optional[List[str]]
+    """Services (e.g., `scdsc`, `riddp`) whose notifications are reported; all
+    when omitted"""
+
+
+class PendingDelivery(ImplicitDict):
+    """A NotificationReceipt not yet delivered to its callback"""
+
+    callback_id: str
+    url: str
+    service: str
+    queued_at: StringBasedDateTime
+
+
+class Database(ImplicitDict):
+    """Registered callbacks, by callback ID, and deliveries in progress, by
+    delivery ID"""
+
+    callbacks: Dict[str, NotificationCallback] = {}
+    pending: Dict[str, PendingDelivery] = {}
+
+
+db = SynchronizedValue(
+    Database(),
+    decoder=lambda b: ImplicitDict.parse(json.loads(b.decode("utf-8")), Database),
+    persistence_path=state_path("notification_callbacks"),
+)
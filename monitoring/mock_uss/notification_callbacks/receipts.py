@@ -0,0 +1,93 @@
+from datetime import datetime
+import logging
+import threading
+from typing import List, Optional
+import uuid
+
+import flask
+import requests
+
+from implicitdict import ImplicitDict, StringBasedDateTime
+from monitoring.mock_uss.interactions.recording import incoming_peer
+from .database import db, PendingDelivery
+
+CALLBACK_TIMEOUT_SECONDS = 5
+
+logger = logging.getLogger(__name__)
+
+
+class NotificationValidation(ImplicitDict):
+    valid: bool
+    problems: List[str] = []
+
+
+class NotificationReceipt(ImplicitDict):
+    """Report of a notification received by mock_uss, sent to callbacks"""
+
+    callback_id: str
+    service: str
+    endpoint: str
+    peer: str
+    received_at: StringBasedDateTime
+    payload: Optional[dict]
+    validation: NotificationValidation
+
+
+def _deliver(delivery_id: str, url: str, receipt: NotificationReceipt) -> None:
+    try:
+        resp = requests.post(url, json=receipt, timeout=CALLBACK_TIMEOUT_SECONDS)
+        if resp.status_code >= 300:
+            logger.warning(
+                "Notification callback %s to %s failed with %s",
+                receipt.callback_id,
+                url,
+                resp.status_code,
+            )
+    except requests.RequestException as e:
+        logger.warning(
+            "Notification callback %s to %s failed: %s", receipt.callback_id, url, e
+        )
+    finally:
+        with db as tx:
+            tx.pending.pop(delivery_id, None)
+
+
+def report_notification(service: str, problems: List[str]) -> None:
+    """Report the notification being handled in the current request to the
+    callbacks registered for service, without delaying the response.
+
+    :param service: Service receiving the notification (e.g., `scdsc`)
+    :param problems: Problems found while validating the notification; empty when the notification is valid
+    """
+    callbacks = db.value.callbacks
+    if not callbacks:
+        return
+    payload = flask.request.get_json(silent=True)
+    rule = flask.request.url_rule
+    kwargs = {}
+    if isinstance(payload, dict):
+        kwargs["payload"] = payload
+    for callback_id, callback in callbacks.items():
+        if "services" in callback and callback.services is not None:
+            if service not in callback.services:
+                continue
+        receipt = NotificationReceipt(
+            callback_id=callback_id,
+            service=service,
+            endpoint=rule.rule if rule is not None else flask.request.path,
+            peer=incoming_peer(),
+            received_at=StringBasedDateTime(datetime.utcnow()),
+            validation=NotificationValidation(valid=not problems, problems=problems),
+            **kwargs,
+        )
+        delivery_id = str(uuid.uuid4())
+        with db as tx:
+            tx.pending[delivery_id] = PendingDelivery(
+                callback_id=callback_id,
+                url=callback.url,
+                service=service,
+                queued_at=receipt.received_at,
+            )
+        threading.Thread(
+            target=_deliver, args=(delivery_id, callback.url, receipt), daemon=True
+        ).start()
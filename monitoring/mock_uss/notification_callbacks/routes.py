@@ -0,0 +1,45 @@
+from typing import Tuple
+
+import flask
+
+from implicitdict import ImplicitDict
+from monitoring.mock_uss import webapp
+from monitoring.mock_uss.idempotency.replay import idempotent
+from .database import db, NotificationCallback
+
+
+@webapp.route("/mock_uss/notification_callbacks/<callback_id>", methods=["PUT"])
+@idempotent
+def register_notification_callback(callback_id: str) -> Tuple[str, int]:
+    """Register a callback invoked whenever mock_uss receives a notification."""
+    try:
+        json = flask.request.json
+        if json is None:
+            raise ValueError("Request did not contain a JSON payload")
+        callback = ImplicitDict.parse(json, NotificationCallback)
+    except ValueError as e:
+        msg = "Register notification callback {} unable to parse JSON: {}".format(
+            callback_id, e
+        )
+        return msg, 400
+
+    with db as tx:
+        tx.callbacks[callback_id] = callback
+    return flask.jsonify(callback)
+
+
+@webapp.route("/mock_uss/notification_callbacks", methods=["GET"])
+def list_notification_callbacks() -> Tuple[str, int]:
+    """List the registered notification callbacks."""
+    return flask.jsonify(db.value)
+
+
+@webapp.route("/mock_uss/notification_callbacks/<callback_id>", methods=["DELETE"])
+@idempotent
+def unregister_notification_callback(callback_id: str) -> Tuple[str, int]:
+    """Stop invoking a notification callback."""
+    with db as tx:
+        callback = tx.callbacks.pop(callback_id, None)
+    if callback is None:
+        return "Notification callback {} does not exist".format(callback_id), 404
+    return flask.jsonify(callback)
@@ -0,0 +1,64 @@
+import json
+from typing import Dict, List, Optional
+
+from implicitdict import ImplicitDict, StringBasedDateTime
+from monitoring.monitorlib.multiprocessing import SynchronizedValue
+from monitoring.mock_uss.config import state_path
+
+DEFAULT_DURATION_SECONDS = 300
+MAX_DURATION_SECONDS = 3600
+
+
+class ResponseOverride(ImplicitDict):
+    """Overrides the response to the requests it matches"""
+
+    path: str
+    """Regular expression that the entire path of a request must match"""
+
+    method: Optional[str]
+    """HTTP method that a request must use; any method matches when omitted"""
+
+    peer: Optional[str]
+    """Subject of the access token of a request; any peer matches when
+    omitted"""
+
+    status_code: Optional[int]
+    """Status code of the overriding response; when omitted, the request is
+    served normally after delay_seconds"""
+
+    body: Optional[dict]
+    """JSON body of the overriding response"""
+
+    delay_seconds: float = 0
+    """Delay before responding"""
+
+    max_matches: Optional[int]
+    """Number of requests after which the override no longer applies; no limit
+    when omitted"""
+
+
+class Ruleset(ImplicitDict):
+    """Ordered overrides uploaded by a scenario; the first active override
+    matching a request applies"""
+
+    overrides: List[ResponseOverride]
+    duration_seconds: float = DEFAULT_DURATION_SECONDS
+    """Duration of the time window, starting when the ruleset is uploaded,
+    during which the ruleset applies (at most MAX_DURATION_SECONDS)"""
+
+    expires_at: Optional[StringBasedDateTime]
+    matches: List[int] = []
+    """Number of requests matched by each override"""
+
+
+class Database(ImplicitDict):
+    """Rulesets, by ruleset ID"""
+
+    rulesets: Dict[str, Ruleset] = {}
+
+
+db = SynchronizedValue(
+    Database(),
+    decoder=lambda b: ImplicitDict.parse(json.loads(b.decode("utf-8")), Database),
+    persistence_path=state_path("overrides"),
+)
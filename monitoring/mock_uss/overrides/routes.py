@@ -0,0 +1,112 @@
+import re
+import time
+from typing import Optional, Tuple
+
+import arrow
+import flask
+
+from implicitdict import ImplicitDict, StringBasedDateTime
+from monitoring.mock_uss import webapp
+from monitoring.mock_uss.interactions.recording import incoming_peer
+from monitoring.mock_uss.idempotency.replay import idempotent
+from .database import db, ResponseOverride, Ruleset, MAX_DURATION_SECONDS
+
+# Path prefix of the endpoints of mock_uss itself, which are never overridden
+MOCK_USS_PATH = "/mock_uss/"
+
+
+@webapp.route("/mock_uss/overrides/<ruleset_id>", methods=["PUT"])
+@idempotent
+def upload_ruleset(ruleset_id: str) -> Tuple[str, int]:
+    """Upload a ruleset overriding responses for a bounded time window,
+    replacing any ruleset with the same ID."""
+    try:
+        json = flask.request.json
+        if json is None:
+            raise ValueError("Request did not contain a JSON payload")
+        ruleset = ImplicitDict.parse(json, Ruleset)
+        for override in ruleset.overrides:
+            re.compile(override.path)
+    except (ValueError, re.error) as e:
+        msg = "Upload ruleset {} unable to parse JSON: {}".format(ruleset_id, e)
+        return msg, 400
+    if not 0 < ruleset.duration_seconds <= MAX_DURATION_SECONDS:
+        msg = "Ruleset duration must be positive and at most {} seconds".format(
+            MAX_DURATION_SECONDS
+        )
+        return msg, 400
+
+    ruleset.expires_at = StringBasedDateTime(
+        arrow.utcnow().shift(seconds=ruleset.duration_seconds).datetime
+    )
+    ruleset.matches = [0] * len(ruleset.overrides)
+    with db as tx:
+        tx.rulesets[ruleset_id] = ruleset
+    return flask.jsonify(ruleset)
+
+
+@webapp.route("/mock_uss/overrides/<ruleset_id>", methods=["GET"])
+def get_ruleset(ruleset_id: str) -> Tuple[str, int]:
+    """Get a ruleset and the number of requests each of its overrides matched."""
+    ruleset = db.value.rulesets.get(ruleset_id, None)
+    if ruleset is None:
+        return "Ruleset {} does not exist".format(ruleset_id), 404
+    return flask.jsonify(ruleset)
+
+
+@webapp.route("/mock_uss/overrides/<ruleset_id>", methods=["DELETE"])
+@idempotent
+def delete_ruleset(ruleset_id: str) -> Tuple[str, int]:
+    """Remove a ruleset before the end of its time window."""
+    with db as tx:
+        ruleset = tx.rulesets.pop(ruleset_id, None)
+    if ruleset is None:
+        return "Ruleset {} does not exist".format(ruleset_id), 404
+    return flask.jsonify(ruleset)
+
+
+def _matches(override: ResponseOverride, matches: int, peer: str) -> bool:
+    if "max_matches" in override and override.max_matches is not None:
+        if matches >= override.max_matches:
+            return False
+    if "method" in override and override.method is not None:
+        if override.method.upper() != flask.request.method:
+            return False
+    if "peer" in override and override.peer is not None:
+        if override.peer != peer:
+            return False
+    return re.fullmatch(override.path, flask.request.path) is not None
+
+
+def _find_override() -> Optional[ResponseOverride]:
+    """Find the override applying to the current request, if any, and count the
+    match."""
+    if not db.value.rulesets:
+        return None
+    peer = incoming_peer()
+    now = arrow.utcnow().datetime
+    with db as tx:
+        for ruleset_id in list(tx.rulesets):
+            if arrow.get(tx.rulesets[ruleset_id].expires_at).datetime < now:
+                del tx.rulesets[ruleset_id]
+        for ruleset in tx.rulesets.values():
+            for i, override in enumerate(ruleset.overrides):
+                if _matches(override, ruleset.matches[i], peer):
+                    ruleset.matches[i] += 1
+                    return override
+    return None
+
+
+@webapp.before_request
+def override_response():
+    if flask.request.path.startswith(MOCK_USS_PATH):
+        return None
+    override = _find_override()
+    if override is None:
+        return None
+    if override.delay_seconds > 0:
+        time.sleep(override.delay_seconds)
+    if "status_code" not in override or override.status_code is None:
+        return None
+    body = override.body if "body" in override and override.body is not None else {}
+    return flask.jsonify(body), override.status_code
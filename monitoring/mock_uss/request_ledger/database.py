@@ -0,0 +1,77 @@
+import json
+from typing import Dict, List, Optional
+
+from implicitdict import ImplicitDict, StringBasedDateTime
+from monitoring.monitorlib.multiprocessing import SynchronizedValue
+from monitoring.mock_uss.config import state_path
+
+MAX_CALLS_PER_SESSION = 5000
+
+TARGET_DSS = "dss"
+TARGET_USS = "uss"
+
+
+class Call(ImplicitDict):
+    """A call made by mock_uss to the DSS or a peer USS"""
+
+    initiated_at: StringBasedDateTime
+    identity: str
+    """USS identity on behalf of which the call was made"""
+
+    target: str
+    """Either `dss` (call to the DSS) or `uss` (call to a peer USS)"""
+
+    method: str
+    url: str
+    peer: str
+    """Host the call was made to"""
+
+    attempts: int
+    """Number of HTTP requests sent for the call, including redirects"""
+
+    status_code: Optional[int]
+    """Status of the final response; omitted when no response was received"""
+
+    failure: Optional[str]
+    """Error preventing a response from being received, if any"""
+
+    duration_ms: float
+    correlation_id: Optional[str]
+
+    @property
+    def succeeded(self) -> bool:
+        return self.get("status_code", None) is not None and self.status_code < 400
+
+
+class Session(ImplicitDict):
+    """Calls made since a scenario started the session"""
+
+    started_at: StringBasedDateTime
+    calls: List[Call] = []
+    dropped: int = 0
+    """Number of calls not recorded because the session was full"""
+
+
+class Database(ImplicitDict):
+    """Ledger sessions, by session ID"""
+
+    sessions: Dict[str, Session] = {}
+
+
+db = SynchronizedValue(
+    Database(),
+    decoder=lambda b: ImplicitDict.parse(json.loads(b.decode("utf-8")), Database),
+    persistence_path=state_path("request_ledger"),
+)
+
+
+def record(call: Call) -> None:
+    """Record call in every active session."""
+    if not db.value.sessions:
+        return
+    with db as tx:
+        for session in tx.sessions.values():
+            if len(session.calls) >= MAX_CALLS_PER_SESSION:
+                session.dropped += 1
+            else:
+                session.calls.append(call)
@@ -0,0 +1,90 @@
+from datetime import datetime
+import threading
+import time
+from typing import Optional
+import urllib.parse
+
+import flask
+import requests
+
+from implicitdict import StringBasedDateTime
+from monitoring.monitorlib import infrastructure
+from monitoring.mock_uss import identities
+from monitoring.mock_uss.interactions import CORRELATION_ID_HEADER
+from .database import Call, db, record, TARGET_DSS, TARGET_USS
+
+
+class LedgerClientSession(infrastructure.UTMClientSession):
+    """UTMClientSession recording each call it makes in the active ledger
+    sessions."""
+
+    def __init__(self, prefix_url: str, auth_adapter=None):
+        super().__init__(prefix_url, auth_adapter)
+        self._attempts = threading.local()
+
+    # Overrides method on requests.Session; called for each request sent,
+    # including redirects
+    def send(self, request, **kwargs):
+        self._attempts.count = getattr(self._attempts, "count", 0) + 1
+        return super().send(request, **kwargs)
+
+    def request(self, method, url, **kwargs):
+        if not db.value.sessions:
+            return super().request(method, url, **kwargs)
+
+        full_url = self._prefix_url + url if url.startswith("/") else url
+        initiated_at = datetime.utcnow()
+        t0 = time.monotonic()
+        self._attempts.count = 0
+        resp: Optional[requests.Response] = None
+        failure: Optional[str] = None
+        try:
+            resp = super().request(method, url, **kwargs)
+            return resp
+        except requests.RequestException as e:
+            failure = "{}: {}".format(type(e).__name__, str(e))
+            raise
+        finally:
+            self._record(
+                method,
+                resp.url if resp is not None else full_url,
+                resp,
+                failure,
+                initiated_at,
+                (time.monotonic() - t0) * 1000,
+            )
+
+    def _record(
+        self,
+        method: str,
+        url: str,
+        resp: Optional[requests.Response],
+        failure: Optional[str],
+        initiated_at: datetime,
+        duration_ms: float,
+    ) -> None:
+        fields = {}
+        if resp is not None:
+            fields["status_code"] = resp.status_code
+            correlation_id = resp.request.headers.get(CORRELATION_ID_HEADER, None)
+        else:
+            correlation_id = None
+        if correlation_id is None and flask.has_request_context():
+            correlation_id = flask.request.headers.get(CORRELATION_ID_HEADER, None)
+        if correlation_id is not None:
+            fields["correlation_id"] = correlation_id
+        if failure is not None:
+            fields["failure"] = failure
+        record(
+            Call(
+                initiated_at=StringBasedDateTime(initiated_at),
+                identity=identities.current(),
+                target=TARGET_DSS if url.startswith(self._prefix_url) else TARGET_USS,
+                method=method.upper(),
+                url=url,
+                peer=urllib.parse.urlparse(url).netloc,
+                attempts=self._attempts.count,
+                duration_ms=duration_ms,
+                **fields,
+            )
+        )
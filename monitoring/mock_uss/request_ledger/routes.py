@@ -0,0 +1,112 @@
+from datetime import datetime
+from typing import Dict, List, Tuple
+
+import flask
+
+from implicitdict import StringBasedDateTime
+from monitoring.mock_uss import webapp
+from .database import Call, db, Session
+
+LEDGER_PATH = "/mock_uss/request_ledger"
+
+# Query parameters filtering the calls of a session, and the Call field each
+# one applies to
+FILTERS = {
+    "identity": "identity",
+    "target": "target",
+    "method": "method",
+    "peer": "peer",
+    "status_code": "status_code",
+    "correlation_id": "correlation_id",
+}
+
+
+def _filtered_calls(session: Session) -> List[Call]:
+    calls = session.calls
+    for param, field in FILTERS.items():
+        value = flask.request.args.get(param, None)
+        if value is None:
+            continue
+        calls = [call for call in calls if str(call.get(field, "")) == value]
+    return calls
+
+
+def _summarize(calls: List[Call]) -> dict:
+    statuses: Dict[str, int] = {}
+    for call in calls:
+        status = str(call.status_code) if "status_code" in call else "failed"
+        statuses[status] = statuses.get(status, 0) + 1
+    durations = [call.duration_ms for call in calls]
+    return {
+        "calls": len(calls),
+        "succeeded": sum(1 for call in calls if call.succeeded),
+        "failed": sum(1 for call in calls if not call.succeeded),
+        "attempts": sum(call.attempts for call in calls),
+        "statuses": statuses,
+        "total_duration_ms": sum(durations),
+        "max_duration_ms": max(durations) if durations else 0,
+    }
+
+
+@webapp.route(LEDGER_PATH + "/sessions/<session_id>", methods=["PUT"])
+def start_ledger_session(session_id: str) -> Tuple[str, int]:
+    """Start recording the calls mock_uss makes in a session, discarding any
+    calls previously recorded in a session with the same ID."""
+    session = Session(started_at=StringBasedDateTime(datetime.utcnow()))
+    with db as tx:
+        tx.sessions[session_id] = session
+    return flask.jsonify(session)
+
+
+@webapp.route(LEDGER_PATH + "/sessions/<session_id>", methods=["GET"])
+def query_ledger_session(session_id: str) -> Tuple[str, int]:
+    """List the calls recorded in a session that match all the filters
+    specified as query parameters."""
+    session = db.value.sessions.get(session_id, None)
+    if session is None:
+        return "Session {} is not recording calls".format(session_id), 404
+
+    calls = _filtered_calls(session)
+    return flask.jsonify(
+        {
+            "started_at": session.started_at,
+            "dropped": session.dropped,
+            "count": len(calls),
+            "calls": calls,
+        }
+    )
+
+
+@webapp.route(LEDGER_PATH + "/sessions/<session_id>/summary", methods=["GET"])
+def summarize_ledger_session(session_id: str) -> Tuple[str, int]:
+    """Summarize the calls recorded in a session that match all the filters
+    specified as query parameters, overall and by target and peer."""
+    session = db.value.sessions.get(session_id, None)
+    if session is None:
+        return "Session {} is not recording calls".format(session_id), 404
+
+    calls = _filtered_calls(session)
+    by_target: Dict[str, List[Call]] = {}
+    by_peer: Dict[str, List[Call]] = {}
+    for call in calls:
+        by_target.setdefault(call.target, []).append(call)
+        by_peer.setdefault(call.peer, []).append(call)
+    return flask.jsonify(
+        {
+            "started_at": session.started_at,
+            "dropped": session.dropped,
+            "overall": _summarize(calls),
+            "by_target": {k: _summarize(v) for k, v in by_target.items()},
+            "by_peer": {k: _summarize(v) for k, v in by_peer.items()},
+        }
+    )
+
+
+@webapp.route(LEDGER_PATH + "/sessions/<session_id>", methods=["DELETE"])
+def end_ledger_session(session_id: str) -> Tuple[str, int]:
+    """Stop recording calls in a session, returning everything it recorded."""
+    with db as tx:
+        session = tx.sessions.pop(session_id, None)
+    if session is None:
+        return "Session {} is not recording calls".format(session_id), 404
+    return flask.jsonify(session)
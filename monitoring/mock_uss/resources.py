@@ -1,9 +1,17 @@
 from monitoring.monitorlib import auth, infrastructure
-from monitoring.mock_uss import webapp
+from monitoring.mock_uss import identities, webapp
 from . import config
+from .clock.database import now
+from .interactions.recording import record_outgoing
+from .request_ledger.recording import LedgerClientSession
 
 
-utm_client = infrastructure.UTMClientSession(
-    webapp.config[config.KEY_DSS_URL],
-    auth.make_auth_adapter(webapp.config[config.KEY_AUTH_SPEC]),
-)
+def _make_utm_client(identity: str) -> infrastructure.UTMClientSession:
+    adapter = auth.make_auth_adapter(identities.auth_spec(identity))
+    adapter.clock = now
+    client = LedgerClientSession(webapp.config[config.KEY_DSS_URL], adapter)
+    client.hooks["response"].append(record_outgoing)
+    return client
+
+
+utm_client = identities.PerIdentityClient(_make_utm_client)
@@ -5,3 +5,6 @@ if config.Config.DSS_URL is None:
 
 if config.Config.AUTH_SPEC is None:
     raise ValueError(f"AUTH_SPEC is required for the {SERVICE_RIDDP} service")
+
+if config.Config.USS_BASE_URL is None:
+    raise ValueError(f"USS_BASE_URL is required for the {SERVICE_RIDDP} service")
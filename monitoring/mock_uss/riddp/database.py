@@ -2,22 +2,56 @@ import json
 from typing import Dict
 
 from .behavior import DisplayProviderBehavior
-from implicitdict import ImplicitDict
+from implicitdict import ImplicitDict, StringBasedDateTime
 from monitoring.monitorlib.multiprocessing import SynchronizedValue
+from monitoring.mock_uss import identities
+from monitoring.mock_uss.config import state_path
 
 
 class FlightInfo(ImplicitDict):
     flights_url: str
 
 
+class ISAInfo(ImplicitDict):
+    """Display Provider's knowledge of an ISA relevant to one of its subscriptions"""
+
+    owner: str
+    flights_url: str
+
+
+class ObservationSubscription(ImplicitDict):
+    """Subscription held in the DSS in order to keep track of ISAs in a view"""
+
+    bounds: str
+    """View (lat,lng,lat,lng) for which this subscription was established"""
+
+    version: str
+    """Current version of the subscription in the DSS"""
+
+    end_time: StringBasedDateTime
+    """Time at which the subscription expires in the DSS"""
+
+    isas: Dict[str, ISAInfo] = {}
+    """ISAs known to intersect this subscription, by ISA ID"""
+
+    @property
+    def flight_urls(self) -> Dict[str, str]:
+        """Returns map of flight URL to USS"""
+        return {isa.flights_url: isa.owner for isa in self.isas.values()}
+
+
 class Database(ImplicitDict):
     """Simple pseudo-database structure tracking the state of the mock system"""
 
     flights: Dict[str, FlightInfo] = {}
+    subscriptions: Dict[str, ObservationSubscription] = {}
     behavior: DisplayProviderBehavior = DisplayProviderBehavior()
 
 
-db = SynchronizedValue(
-    Database(),
-    decoder=lambda b: ImplicitDict.parse(json.loads(b.decode("utf-8")), Database),
+db = identities.PerIdentityValue(
+    lambda identity: SynchronizedValue(
+        Database(),
+        decoder=lambda b: ImplicitDict.parse(json.loads(b.decode("utf-8")), Database),
+        persistence_path=state_path(identities.state_name("riddp", identity)),
+    )
 )
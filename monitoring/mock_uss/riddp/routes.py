@@ -6,5 +6,6 @@ def riddp_status():
     return "Mock RID Display Provider ok"
 
 
+from . import routes_riddp
 from . import routes_observation
 from . import routes_behavior
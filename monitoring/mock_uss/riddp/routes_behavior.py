@@ -4,11 +4,13 @@ import flask
 
 from implicitdict import ImplicitDict
 from monitoring.mock_uss import webapp
+from monitoring.mock_uss.idempotency.replay import idempotent
 from .behavior import DisplayProviderBehavior
 from .database import db
 
 
 @webapp.route("/riddp/behavior", methods=["PUT"])
+@idempotent
 def set_dp_behavior() -> Tuple[str, int]:
     """Set the behavior of the mock Display Provider."""
     try:
@@ -1,4 +1,6 @@
+import datetime
 from typing import Dict, List, Optional, Tuple
+import uuid
 
 import arrow
 import flask
@@ -6,15 +8,73 @@ import s2sphere
 
 from monitoring.monitorlib import geo, rid
 from monitoring.monitorlib.fetch import rid as fetch
+from monitoring.monitorlib.mutate import rid as mutate
 from monitoring.monitorlib.rid_automated_testing import observation_api
-from implicitdict import ImplicitDict
-from monitoring.mock_uss import resources, webapp
+from implicitdict import ImplicitDict, StringBasedDateTime
+from monitoring.mock_uss import identities, resources, webapp
 from monitoring.mock_uss.auth import requires_scope
 from . import clustering, database
 from .behavior import DisplayProviderBehavior
 from .database import db
 
 
+# Lifetime of the subscriptions established to observe a view.  Subsequent
+# observations of the same view within this period rely on ISA notifications
+# rather than querying the DSS.
+SUBSCRIPTION_DURATION = datetime.timedelta(minutes=5)
+
+# Margin before expiry at which a subscription is no longer relied upon.
+SUBSCRIPTION_EXPIRY_MARGIN = datetime.timedelta(seconds=10)
+
+
+def _get_subscription(view_spec: str, view: s2sphere.LatLngRect, t: datetime.datetime):
+    """Returns a current subscription covering the view, creating it if needed.
+
+    Returns a tuple of (subscription, error response).
+    """
+    tx = db.value
+    for subscription in tx.subscriptions.values():
+        if (
+            subscription.bounds == view_spec
+            and subscription.end_time.datetime > t + SUBSCRIPTION_EXPIRY_MARGIN
+        ):
+            return subscription, None
+
+    subscription_id = str(uuid.uuid4())
+    callback_url = "{}/mock/riddp/v1/uss/identification_service_areas".format(
+        identities.base_url()
+    )
+    t1 = t + SUBSCRIPTION_DURATION
+    mutated_sub = mutate.put_subscription(
+        resources.utm_client, view, t, t1, callback_url, subscription_id
+    )
+    if not mutated_sub.success:
+        response = rid.ErrorResponse(message="Unable to create subscription in DSS")
+        response["errors"] = mutated_sub.errors
+        return None, response
+
+    subscription = database.ObservationSubscription(
+        bounds=view_spec,
+        version=mutated_sub.subscription.version,
+        end_time=StringBasedDateTime(t1),
+        isas={},
+    )
+    for isa in mutated_sub.json_result.get("service_areas", []):
+        if "id" in isa and "flights_url" in isa:
+            subscription.isas[isa["id"]] = database.ISAInfo(
+                owner=isa.get("owner", ""), flights_url=isa["flights_url"]
+            )
+
+    with db as tx:
+        # Discard expired subscriptions; the DSS has already forgotten them
+        for expired_id in [
+            k for k, v in tx.subscriptions.items() if v.end_time.datetime <= t
+        ]:
+            del tx.subscriptions[expired_id]
+        tx.subscriptions[subscription_id] = subscription
+    return subscription, None
+
+
 def _make_flight_observation(
     flight: rid.RIDFlight, view: s2sphere.LatLngRect
 ) -> observation_api.Flight:
@@ -94,13 +154,13 @@ def display_data() -> Tuple[str, int]:
             413,
         )
 
-    # Get ISAs in the DSS
+    # Get ISAs in the view via a DSS subscription
     t = arrow.utcnow().datetime
-    isas_response: fetch.FetchedISAs = fetch.isas(resources.utm_client, view, t, t)
-    if not isas_response.success:
-        response = rid.ErrorResponse(message="Unable to fetch ISAs from DSS")
-        response["errors"] = [isas_response]
-        return flask.jsonify(response), 412
+    subscription, error_response = _get_subscription(
+        flask.request.args["view"], view, t
+    )
+    if subscription is None:
+        return flask.jsonify(error_response), 412
 
     # Fetch flights from each unique flights URL
     validated_flights: List[rid.RIDFlight] = []
@@ -108,7 +168,7 @@ def display_data() -> Tuple[str, int]:
     flight_info: Dict[str, database.FlightInfo] = {k: v for k, v in tx.flights.items()}
     behavior: DisplayProviderBehavior = tx.behavior
 
-    for flights_url, uss in isas_response.flight_urls.items():
+    for flights_url, uss in subscription.flight_urls.items():
         if uss in behavior.do_not_display_flights_from:
             continue
         flights_response = fetch.flights(resources.utm_client, flights_url, view, True)
@@ -0,0 +1,53 @@
+from typing import Tuple
+
+import flask
+
+from monitoring.monitorlib import rid
+from monitoring.mock_uss import webapp, SERVICE_RIDDP
+from monitoring.mock_uss.auth import requires_scope
+from monitoring.mock_uss.notification_callbacks.receipts import report_notification
+from . import database
+from .database import db
+
+
+@webapp.route("/mock/riddp/v1/uss/identification_service_areas/<id>", methods=["POST"])
+@requires_scope([rid.SCOPE_WRITE])
+def notify_isa(id: str) -> Tuple[str, int]:
+    """Implements ISA change notification receiver for the Display Provider."""
+
+    json = flask.request.json
+    if json is None:
+        report_notification(
+            SERVICE_RIDDP, ["Notification did not contain a JSON payload"]
+        )
+        return (
+            flask.jsonify(
+                rid.ErrorResponse(message="Notification did not contain a JSON payload")
+            ),
+            400,
+        )
+    subscription_ids = [
+        s.get("subscription_id", "") for s in json.get("subscriptions", [])
+    ]
+    isa = json.get("service_area", None)
+    problems = []
+    if not subscription_ids:
+        problems.append("Notification did not identify any subscription")
+    if isa is not None and "flights_url" not in isa:
+        problems.append("Notified ISA did not specify flights_url")
+    report_notification(SERVICE_RIDDP, problems)
+
+    with db as tx:
+        for subscription_id in subscription_ids:
+            subscription = tx.subscriptions.get(subscription_id, None)
+            if subscription is None:
+                continue
+            if isa is None:
+                # ISA was deleted
+                subscription.isas.pop(id, None)
+            elif "flights_url" in isa:
+                subscription.isas[id] = database.ISAInfo(
+                    owner=isa.get("owner", ""), flights_url=isa["flights_url"]
+                )
+
+    return "", 204
@@ -2,6 +2,8 @@ import json
 from typing import Dict, List, Optional
 
 from monitoring.monitorlib.multiprocessing import SynchronizedValue
+from monitoring.mock_uss import identities
+from monitoring.mock_uss.config import state_path
 from monitoring.monitorlib.rid_automated_testing import injection_api
 from implicitdict import ImplicitDict
 from .behavior import ServiceProviderBehavior
@@ -31,7 +33,10 @@ class Database(ImplicitDict):
     behavior: ServiceProviderBehavior = ServiceProviderBehavior()
 
 
-db = SynchronizedValue(
-    Database(),
-    decoder=lambda b: ImplicitDict.parse(json.loads(b.decode("utf-8")), Database),
+db = identities.PerIdentityValue(
+    lambda identity: SynchronizedValue(
+        Database(),
+        decoder=lambda b: ImplicitDict.parse(json.loads(b.decode("utf-8")), Database),
+        persistence_path=state_path(identities.state_name("ridsp", identity)),
+    )
 )
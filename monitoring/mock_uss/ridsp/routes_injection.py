@@ -1,28 +1,50 @@
 import datetime
-from typing import Tuple
+import logging
+from typing import Dict, Tuple
 import uuid
 
 import flask
 
-from monitoring.monitorlib import rid
+from monitoring.monitorlib import fetch, rid
 from monitoring.monitorlib.mutate import rid as mutate
 from monitoring.monitorlib.rid_automated_testing import injection_api
 from implicitdict import ImplicitDict
 from monitoring.mock_uss import webapp
 from monitoring.mock_uss.auth import requires_scope
-from monitoring.mock_uss import config, resources
+from monitoring.mock_uss import identities, resources
 from uas_standards.interuss.automated_testing.rid.v1.injection import ChangeTestResponse
-from . import database
+from monitoring.mock_uss.idempotency.replay import idempotent
+from . import database, synthetic
 from .database import db
 
 
+logger = logging.getLogger(__name__)
+logger.setLevel(logging.INFO)
+
 # Time after the last position report during which the created ISA will still
 # exist.  This value must be at least 60 seconds per NET0610.
 RECENT_POSITIONS_BUFFER = datetime.timedelta(seconds=60.2)
 
 
+def _log_notification_failures(
+    isa_id: str, notifications: Dict[str, fetch.Query]
+) -> None:
+    """Logs any subscriber notification that was not acknowledged properly."""
+    for (url, notification) in notifications.items():
+        code = notification.response.status_code
+        if code == 200:
+            logger.warning(
+                f"Subscriber at {url} acknowledged ISA {isa_id} notification with 200 rather than 204"
+            )
+        elif code != 204:
+            logger.error(
+                f"Notification to subscriber at {url} for ISA {isa_id} failed with status {code}"
+            )
+
+
 @webapp.route("/ridsp/injection/tests/<test_id>", methods=["PUT"])
 @requires_scope([injection_api.SCOPE_RID_QUALIFIER_INJECT])
+@idempotent
 def create_test(test_id: str) -> Tuple[str, int]:
     """Implements test creation in RID automated testing injection API."""
 
@@ -40,12 +62,50 @@ def create_test(test_id: str) -> Tuple[str, int]:
         msg = "Create test {} unable to parse JSON: {}".format(test_id, e)
         return msg, 400
 
+    return _create_test(test_id, record, req_body)
+
+
+@webapp.route("/ridsp/injection/tests/<test_id>/synthetic", methods=["PUT"])
+@requires_scope([injection_api.SCOPE_RID_QUALIFIER_INJECT])
+@idempotent
+def create_synthetic_test(test_id: str) -> Tuple[str, int]:
+    """Creates a test whose flights report telemetry generated along
+    parameterized trajectories."""
+
+    try:
+        json = flask.request.json
+        if json is None:
+            raise ValueError("Request did not contain a JSON payload")
+        req_body: synthetic.CreateSyntheticTestParameters = ImplicitDict.parse(
+            json, synthetic.CreateSyntheticTestParameters
+        )
+        if not req_body.flights:
+            raise ValueError("Request did not contain any flights")
+        now = datetime.datetime.now(datetime.timezone.utc)
+        flights = [synthetic.make_test_flight(f, now) for f in req_body.flights]
+        record = database.TestRecord(version=str(uuid.uuid4()), flights=flights)
+    except ValueError as e:
+        msg = "Create synthetic test {} unable to parse JSON: {}".format(test_id, e)
+        return msg, 400
+
+    return _create_test(
+        test_id,
+        record,
+        injection_api.CreateTestParameters(requested_flights=record.flights),
+    )
+
+
+def _create_test(
+    test_id: str,
+    record: database.TestRecord,
+    req_body: injection_api.CreateTestParameters,
+) -> Tuple[str, int]:
     # Create ISA in DSS
     (t0, t1) = req_body.get_span()
     t1 += RECENT_POSITIONS_BUFFER
     rect = req_body.get_rect()
     flights_url = "{}/mock/ridsp/v1/uss/flights".format(
-        webapp.config.get(config.KEY_BASE_URL)
+        identities.base_url()
     )
     mutated_isa = mutate.put_isa(
         resources.utm_client, rect, t0, t1, flights_url, record.version
@@ -55,10 +115,7 @@ def create_test(test_id: str) -> Tuple[str, int]:
         response["errors"] = mutated_isa.dss_response.errors
         return flask.jsonify(response), 412
     record.isa_version = mutated_isa.dss_response.isa.version
-    for (url, notification) in mutated_isa.notifications.items():
-        code = notification.response.status_code
-        if code != 204 and code != 200:
-            pass  # TODO: Log notification failures (maybe also log incorrect 200s)
+    _log_notification_failures(record.version, mutated_isa.notifications)
 
     with db as tx:
         tx.tests[test_id] = record
@@ -69,6 +126,7 @@ def create_test(test_id: str) -> Tuple[str, int]:
 
 @webapp.route("/ridsp/injection/tests/<test_id>", methods=["DELETE"])
 @requires_scope([injection_api.SCOPE_RID_QUALIFIER_INJECT])
+@idempotent
 def delete_test(test_id: str) -> Tuple[str, int]:
     """Implements test deletion in RID automated testing injection API."""
 
@@ -85,10 +143,7 @@ def delete_test(test_id: str) -> Tuple[str, int]:
         response = rid.ErrorResponse(message="Unable to delete ISA from DSS")
         response["errors"] = deleted_isa.dss_response.errors
         return flask.jsonify(response), 412
-    for (url, notification) in deleted_isa.notifications.items():
-        code = notification.response.status_code
-        if code != 204 and code != 200:
-            pass  # TODO: Log notification failures (maybe also log incorrect 200s)
+    _log_notification_failures(record.version, deleted_isa.notifications)
 
     with db as tx:
         del tx.tests[test_id]
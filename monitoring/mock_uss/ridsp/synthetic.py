@@ -0,0 +1,199 @@
+import datetime
+import math
+import random
+from typing import List, Optional, Tuple
+import uuid
+
+import s2sphere
+
+from implicitdict import ImplicitDict, StringBasedDateTime
+from monitoring.monitorlib import geo, rid
+from monitoring.monitorlib.rid_automated_testing import injection_api
+
+
+class Waypoint(ImplicitDict):
+    """A point through which a synthetic trajectory passes"""
+
+    lat: float
+    lng: float
+
+    alt: float
+    """Altitude (meters above the WGS84 ellipsoid)"""
+
+    speed: Optional[float]
+    """Ground speed (meters per second) on the leg from this waypoint to the
+    next; the speed of the trajectory when omitted"""
+
+
+class Dropout(ImplicitDict):
+    """Interval during which no telemetry is produced"""
+
+    start_s: float
+    """Seconds after the start of the trajectory"""
+
+    duration_s: float
+
+
+class Trajectory(ImplicitDict):
+    """Parameters of a synthetic trajectory along which telemetry is generated"""
+
+    waypoints: List[Waypoint]
+
+    speed: float = 10
+    """Ground speed (meters per second) on legs without a speed of their own"""
+
+    start_time: Optional[StringBasedDateTime]
+    """Time at which the aircraft is at the first waypoint; the time the test is
+    created plus start_delay_s when omitted"""
+
+    start_delay_s: float = 0
+
+    telemetry_interval_s: float = 1
+    """Seconds between consecutive telemetry reports"""
+
+    dropouts: List[Dropout] = []
+
+    position_jitter_m: float = 0
+    """Standard deviation (meters) of the horizontal noise added to each reported
+    position"""
+
+    random_seed: int = 0
+    """Seed of the noise added to reported positions, so that tracks are
+    reproducible"""
+
+    height_agl: Optional[float]
+    """Height (meters above the takeoff location) reported with each position"""
+
+
+class SyntheticFlight(ImplicitDict):
+    trajectory: Trajectory
+
+    details: rid.RIDFlightDetails
+    """Details of the flight, served by the details endpoint from the start of
+    the trajectory"""
+
+    aircraft_type: str = "NotDeclared"
+
+
+class CreateSyntheticTestParameters(ImplicitDict):
+    flights: List[SyntheticFlight]
+
+
+class _Leg(object):
+    def __init__(self, start: Waypoint, end: Waypoint, speed: float):
+        self.start = s2sphere.LatLng.from_degrees(start.lat, start.lng)
+        self.dx, self.dy = geo.flatten(
+            self.start, s2sphere.LatLng.from_degrees(end.lat, end.lng)
+        )
+        self.alt0 = start.alt
+        self.dalt = end.alt - start.alt
+        self.speed = speed
+        self.duration_s = math.hypot(self.dx, self.dy) / speed
+        # Degrees clockwise from true north
+        self.track = math.degrees(math.atan2(self.dx, self.dy)) % 360
+
+    def position_at(self, t: float) -> Tuple[float, float, float]:
+        f = t / self.duration_s if self.duration_s > 0 else 1
+        p = geo.unflatten(self.start, (self.dx * f, self.dy * f))
+        return p.lat().degrees, p.lng().degrees, self.alt0 + self.dalt * f
+
+    @property
+    def vertical_speed(self) -> float:
+        return self.dalt / self.duration_s if self.duration_s > 0 else 0
+
+
+def _legs(trajectory: Trajectory) -> List[_Leg]:
+    if len(trajectory.waypoints) < 2:
+        raise ValueError("A trajectory must have at least 2 waypoints")
+    legs = []
+    for start, end in zip(trajectory.waypoints[:-1], trajectory.waypoints[1:]):
+        speed = start.get("speed", None)
+        if speed is None:
+            speed = trajectory.speed
+        if speed <= 0:
+            raise ValueError("Trajectory speeds must be positive")
+        legs.append(_Leg(start, end, speed))
+    return legs
+
+
+def generate_telemetry(trajectory: Trajectory, t0: datetime.datetime) -> List[dict]:
+    """Generate the telemetry of an aircraft following trajectory from time t0.
+
+    :return: Telemetry as JSON representations of RIDAircraftState
+    """
+    if trajectory.telemetry_interval_s <= 0:
+        raise ValueError("Telemetry interval must be positive")
+    legs = _legs(trajectory)
+    rng = random.Random(trajectory.random_seed)
+    telemetry = []
+    t = 0
+    i_leg = 0
+    leg_start = 0
+    duration = sum(leg.duration_s for leg in legs)
+    while t <= duration:
+        while i_leg < len(legs) - 1 and t > leg_start + legs[i_leg].duration_s:
+            leg_start += legs[i_leg].duration_s
+            i_leg += 1
+        leg = legs[i_leg]
+        lat, lng, alt = leg.position_at(min(t - leg_start, leg.duration_s))
+
+        # Noise is drawn even during dropouts so that dropouts do not change the
+        # other reported positions
+        jitter = (
+            rng.gauss(0, trajectory.position_jitter_m),
+            rng.gauss(0, trajectory.position_jitter_m),
+        )
+        in_dropout = any(
+            d.start_s <= t < d.start_s + d.duration_s for d in trajectory.dropouts
+        )
+        if not in_dropout:
+            if trajectory.position_jitter_m > 0:
+                p = geo.unflatten(s2sphere.LatLng.from_degrees(lat, lng), jitter)
+                lat, lng = p.lat().degrees, p.lng().degrees
+            state = rid.RIDAircraftState(
+                timestamp=StringBasedDateTime(t0 + datetime.timedelta(seconds=t)),
+                timestamp_accuracy=0,
+                operational_status="Airborne",
+                position=rid.RIDAircraftPosition(
+                    lat=lat,
+                    lng=lng,
+                    alt=alt,
+                    accuracy_h="HAUnknown",
+                    accuracy_v="VAUnknown",
+                    extrapolated=False,
+                ),
+                track=leg.track,
+                speed=leg.speed,
+                speed_accuracy="SAUnknown",
+                vertical_speed=leg.vertical_speed,
+            )
+            if trajectory.get("height_agl", None) is not None:
+                state.height = rid.RIDHeight(
+                    distance=trajectory.height_agl, reference="TakeoffLocation"
+                )
+            telemetry.append(state)
+        t += trajectory.telemetry_interval_s
+    return telemetry
+
+
+def make_test_flight(
+    flight: SyntheticFlight, now: datetime.datetime
+) -> injection_api.TestFlight:
+    """Make the test flight whose telemetry follows the trajectory of flight."""
+    trajectory = flight.trajectory
+    if trajectory.get("start_time", None) is not None:
+        t0 = trajectory.start_time.datetime
+    else:
+        t0 = now + datetime.timedelta(seconds=trajectory.start_delay_s)
+    test_flight = {
+        "injection_id": str(uuid.uuid4()),
+        "telemetry": generate_telemetry(trajectory, t0),
+        "details_responses": [
+            {
+                "effective_after": StringBasedDateTime(t0),
+                "details": flight.details,
+                "aircraft_type": flight.aircraft_type,
+            }
+        ],
+    }
+    return ImplicitDict.parse(test_flight, injection_api.TestFlight)
@@ -0,0 +1,76 @@
+import datetime
+
+from s2sphere import LatLng
+
+from monitoring.monitorlib.geo import flatten, unflatten
+from monitoring.mock_uss.ridsp.synthetic import (
+    Dropout,
+    Trajectory,
+    Waypoint,
+    generate_telemetry,
+)
+
+T0 = datetime.datetime(2022, 1, 1, tzinfo=datetime.timezone.utc)
+START = LatLng.from_degrees(46.97, 7.47)
+
+
+def _trajectory(**kwargs) -> Trajectory:
+    end = unflatten(START, (0, 100))
+    return Trajectory(
+        waypoints=[
+            Waypoint(lat=START.lat().degrees, lng=START.lng().degrees, alt=600),
+            Waypoint(lat=end.lat().degrees, lng=end.lng().degrees, alt=620),
+        ],
+        **kwargs,
+    )
+
+
+def test_straight_leg():
+    telemetry = generate_telemetry(_trajectory(speed=10), T0)
+    assert len(telemetry) == 11
+    assert telemetry[0].timestamp.datetime == T0
+    assert telemetry[-1].timestamp.datetime == T0 + datetime.timedelta(seconds=10)
+    dx, dy = flatten(
+        START,
+        LatLng.from_degrees(
+            telemetry[-1].position.lat, telemetry[-1].position.lng
+        ),
+    )
+    assert abs(dx) < 0.01 and abs(dy - 100) < 0.01
+    assert abs(telemetry[5].position.alt - 610) < 0.01
+    for state in telemetry:
+        assert state.speed == 10
+        assert abs(state.vertical_speed - 2) < 0.01
+        assert state.track < 0.01 or state.track > 359.99
+
+
+def test_dropouts():
+    telemetry = generate_telemetry(
+        _trajectory(speed=10, dropouts=[Dropout(start_s=2, duration_s=3)]), T0
+    )
+    times = [(s.timestamp.datetime - T0).total_seconds() for s in telemetry]
+    assert times == [0, 1, 5, 6, 7, 8, 9, 10]
+
+
+def test_jitter_is_reproducible():
+    trajectory = _trajectory(speed=10, position_jitter_m=5, random_seed=42)
+    telemetry1 = generate_telemetry(trajectory, T0)
+    telemetry2 = generate_telemetry(trajectory, T0)
+    assert [s.position for s in telemetry1] == [s.position for s in telemetry2]
+
+    exact = generate_telemetry(_trajectory(speed=10), T0)
+    assert any(
+        j.position.lat != e.position.lat for j, e in zip(telemetry1, exact)
+    )
+
+    # Dropouts do not change the other reported positions
+    with_dropout = generate_telemetry(
+        _trajectory(
+            speed=10,
+            position_jitter_m=5,
+            random_seed=42,
+            dropouts=[Dropout(start_s=2, duration_s=3)],
+        ),
+        T0,
+    )
+    assert with_dropout[-1].position == telemetry1[-1].position
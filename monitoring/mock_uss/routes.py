@@ -1,9 +1,33 @@
-import traceback
+import hashlib
+import json
 import flask
 from werkzeug.exceptions import HTTPException
 
-from monitoring.monitorlib import auth_validation, versioning
-from monitoring.mock_uss import webapp, enabled_services
+from monitoring.monitorlib import auth_validation, scd, versioning
+from monitoring.mock_uss import (
+    config,
+    identities,
+    webapp,
+    enabled_services,
+    SERVICE_GEOAWARENESS,
+    SERVICE_RIDDP,
+    SERVICE_RIDSP,
+    SERVICE_SCDSC,
+)
+
+# Configuration entries identifying a deployment in its configuration hash;
+# the auth spec is excluded as it may contain secrets
+HASHED_CONFIG_KEYS = [
+    config.KEY_TOKEN_PUBLIC_KEY,
+    config.KEY_TOKEN_AUDIENCE,
+    config.KEY_BASE_URL,
+    config.KEY_SERVICES,
+    config.KEY_DSS_URL,
+    config.KEY_BEHAVIOR_LOCALITY,
+    config.KEY_STATE_DIR,
+    config.KEY_IDENTITIES,
+    config.KEY_CLOCK_OFFSET,
+]
 
 
 @webapp.route("/status")
@@ -13,6 +37,66 @@ def status():
     )
 
 
+def _configuration_hash() -> str:
+    def jsonable(v):
+        if isinstance(v, bytes):
+            return v.decode("utf-8")
+        if isinstance(v, set):
+            return sorted(v)
+        if isinstance(v, dict):
+            # Identities are hashed without their auth specs, which may contain
+            # secrets
+            return sorted(v)
+        if v is None or isinstance(v, (str, int, float, bool)):
+            return v
+        return str(v)
+
+    content = json.dumps(
+        {key: jsonable(webapp.config.get(key)) for key in HASHED_CONFIG_KEYS},
+        sort_keys=True,
+    )
+    return hashlib.sha256(content.encode("utf-8")).hexdigest()
+
+
+@webapp.route("/mock_uss/capabilities")
+def capabilities():
+    """Report the features of this mock_uss deployment, so that test drivers can
+    discover what it supports before running scenarios."""
+    return flask.jsonify(
+        {
+            "services": sorted(enabled_services),
+            "features": {
+                "scd": SERVICE_SCDSC in enabled_services,
+                "flight_planning": SERVICE_SCDSC in enabled_services,
+                "rid_injection": SERVICE_RIDSP in enabled_services,
+                "rid_observation": SERVICE_RIDDP in enabled_services,
+                "geoawareness": SERVICE_GEOAWARENESS in enabled_services,
+                "message_signing": False,
+                "interaction_recording": True,
+                "response_overrides": True,
+                "fault_injection": True,
+                "state_persistence": bool(webapp.config[config.KEY_STATE_DIR]),
+                "multiple_identities": True,
+                "clock_skew": True,
+                "log_capture": True,
+                "flight_authorisation": True,
+                "configuration_reloading": True,
+                "request_ledger": True,
+                "idempotency_keys": True,
+            },
+            "identities": {
+                identity: identities.base_url(identity)
+                for identity in identities.names()
+            },
+            "versions": {
+                "code": versioning.get_code_version(),
+                "astm_scd_api": scd.API_1_0_0,
+            },
+            "configuration_hash": _configuration_hash(),
+        }
+    )
+
+
 @webapp.errorhandler(Exception)
 def handle_exception(e):
     if isinstance(e, HTTPException):
@@ -37,9 +121,9 @@ def handle_exception(e):
             500,
         )
     elif isinstance(e, ValueError):
-        traceback.print_exc()
+        webapp.logger.exception("Invalid request to %s", flask.request.path)
         return flask.jsonify({"message": str(e)}), 400
-    traceback.print_exc()
+    webapp.logger.exception("Unhandled error serving %s", flask.request.path)
     return (
         flask.jsonify({"message": "Unhandled {}: {}".format(type(e).__name__, str(e))}),
         500,
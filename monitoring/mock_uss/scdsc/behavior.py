@@ -0,0 +1,154 @@
+from enum import Enum
+from typing import List, Optional
+
+from implicitdict import ImplicitDict, StringBasedDateTime
+
+from monitoring.monitorlib import scd
+
+
+OFF_NOMINAL_STATES = {"Nonconforming", "Contingent"}
+
+CONFORMANCE_STATES = {"Activated"} | OFF_NOMINAL_STATES
+"""States to which simulated conformance monitoring may transition a flight"""
+
+
+class MisbehaviorProfileName(str, Enum):
+    SlowResponder = "slow_responder"
+    """Delays every response to the ASTM USS endpoints by
+    response_delay_seconds."""
+
+    WrongOvnReturner = "wrong_ovn_returner"
+    """Returns operational intent details whose reference has an OVN other
+    than the one in the DSS."""
+
+    NeverNotifies = "never_notifies"
+    """Never notifies subscribers of changes to its operational intents."""
+
+    StaleDetails = "stale_details"
+    """Keeps serving the operational intent details as they were when the
+    profile was selected."""
+
+
+class MisbehaviorProfile(ImplicitDict):
+    """Misbehaviors of the mock USS selected by a scenario"""
+
+    profiles: List[MisbehaviorProfileName] = []
+    response_delay_seconds: float = 5
+
+    def has(self, profile: MisbehaviorProfileName) -> bool:
+        return profile in self.profiles
+
+
+class OperationalIntentBehavior(ImplicitDict):
+    """Scenario-controlled content served for an injected flight's operational
+    intent, to exercise the handling of faulty USSs by peers."""
+
+    off_nominal_volumes: Optional[List[scd.Volume4D]]
+    """Off-nominal volumes reported in the operational intent details instead
+    of the injected ones."""
+
+    telemetry: Optional[scd.VehicleTelemetry]
+    """Telemetry reported for the operational intent; none is reported when
+    omitted."""
+
+    next_telemetry_opportunity: Optional[scd.Time]
+    """Time at which telemetry is reported to be available next."""
+
+    telemetry_status_code: Optional[int] = 200
+    """HTTP status code of telemetry responses; responses other than 200 are
+    returned as errors, to exercise the handling of failing USSs."""
+
+    details_delay_seconds: float = 0
+    """Delay before serving the operational intent details, to exercise the
+    timeouts of peers."""
+
+    details_status_code: int = 200
+    """HTTP status code of operational intent details responses; responses other
+    than 200 are returned as errors."""
+
+    details_max_volumes: Optional[int]
+    """When specified, the operational intent details served are truncated to
+    this number of volumes (and of off-nominal volumes)."""
+
+    details_version_offset: int = 0
+    """Added to the version of the operational intent reference served with the
+    details, so that it mismatches the version in the DSS."""
+
+
+class ConformanceTransition(ImplicitDict):
+    """A transition of the state of a flight determined by simulated conformance
+    monitoring"""
+
+    state: str
+    """Activated (conforming), Nonconforming or Contingent"""
+
+    after_seconds: float = 0
+    """Delay after the schedule is set at which the transition happens; ignored
+    when the transition is triggered directly"""
+
+    off_nominal_volumes: Optional[List[scd.Volume4D]]
+    """Volumes in which the flight is expected while off-nominal; its injected
+    volumes when omitted"""
+
+    executed_at: Optional[StringBasedDateTime]
+    notes: Optional[str]
+    """Failure to update the DSS, if any, once executed"""
+
+
+class ConformanceSchedule(ImplicitDict):
+    """Transitions of a flight to happen on a schedule"""
+
+    transitions: List[ConformanceTransition]
+    set_at: Optional[StringBasedDateTime]
+    version: Optional[str]
+    """Identifies this schedule, so that its transitions are not executed once
+    it is replaced"""
+
+
+def is_off_nominal(op_intent_ref: scd.OperationalIntentReference) -> bool:
+    return op_intent_ref.state in OFF_NOMINAL_STATES
+
+
+def adjust_details(
+    op_intent_ref: scd.OperationalIntentReference,
+    details: scd.OperationalIntentDetails,
+    behavior: Optional[OperationalIntentBehavior],
+) -> scd.OperationalIntentDetails:
+    """Adjust the details served for an operational intent based on the
+    behavior of its flight"""
+
+    if behavior is None:
+        return details
+    adjusted = ImplicitDict.parse(details, scd.OperationalIntentDetails)
+    if is_off_nominal(op_intent_ref) and behavior.has_field_with_value(
+        "off_nominal_volumes"
+    ):
+        adjusted.off_nominal_volumes = behavior.off_nominal_volumes
+    if behavior.has_field_with_value("details_max_volumes"):
+        n = max(0, behavior.details_max_volumes)
+        adjusted.volumes = adjusted.volumes[:n]
+        adjusted.off_nominal_volumes = adjusted.off_nominal_volumes[:n]
+    return adjusted
+
+
+def adjust_reference(
+    op_intent_ref: scd.OperationalIntentReference,
+    behavior: Optional[OperationalIntentBehavior],
+) -> scd.OperationalIntentReference:
+    """Adjust the reference served with the details of an operational intent
+    based on the behavior of its flight"""
+
+    if behavior is None or not behavior.details_version_offset:
+        return op_intent_ref
+    adjusted = ImplicitDict.parse(op_intent_ref, scd.OperationalIntentReference)
+    adjusted.version = op_intent_ref.version + behavior.details_version_offset
+    return adjusted
+
+
+def wrong_ovn(
+    op_intent_ref: scd.OperationalIntentReference,
+) -> scd.OperationalIntentReference:
+    """Return a copy of op_intent_ref with an OVN other than its own"""
+    adjusted = ImplicitDict.parse(op_intent_ref, scd.OperationalIntentReference)
+    adjusted.ovn = "wrong_" + op_intent_ref.ovn
+    return adjusted
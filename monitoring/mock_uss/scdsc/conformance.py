@@ -0,0 +1,148 @@
+from datetime import datetime
+import threading
+import time
+from typing import Optional
+
+import requests.exceptions
+
+from implicitdict import ImplicitDict, StringBasedDateTime
+from monitoring.monitorlib import scd
+from monitoring.monitorlib.clients import scd as scd_client
+from monitoring.mock_uss import identities, resources
+from monitoring.monitorlib.scd_automated_testing.scd_injection_api import (
+    OperationalIntentTestInjection,
+)
+from monitoring.mock_uss.scdsc import notifications
+from monitoring.mock_uss.scdsc.behavior import (
+    ConformanceTransition,
+    OFF_NOMINAL_STATES,
+)
+from monitoring.mock_uss.scdsc.database import db
+
+DSS_ERRORS = (
+    ValueError,
+    scd_client.OperationError,
+    requests.exceptions.ConnectionError,
+    ConnectionError,
+)
+
+
+def transition_flight(
+    flight_id: str, transition: ConformanceTransition
+) -> Optional[str]:
+    """Transition the operational intent of a flight to the state determined by
+    simulated conformance monitoring, updating the DSS and notifying subscribers.
+
+    :return: None on success, otherwise notes describing the failure
+    """
+    flight = db.value.flights.get(flight_id, None)
+    if flight is None:
+        return "Flight {} does not exist".format(flight_id)
+
+    injection = ImplicitDict.parse(
+        flight.op_intent_injection, OperationalIntentTestInjection
+    )
+    injection.state = transition.state
+    if transition.state in OFF_NOMINAL_STATES:
+        if transition.has_field_with_value("off_nominal_volumes"):
+            injection.off_nominal_volumes = transition.off_nominal_volumes
+        elif not injection.get("off_nominal_volumes", None):
+            injection.off_nominal_volumes = injection.volumes
+        # Off-nominal operational intents do not require a key
+        extents = injection.off_nominal_volumes
+        key = []
+    else:
+        injection.off_nominal_volumes = []
+        extents = injection.volumes
+        start_time = scd.start_of(extents)
+        end_time = scd.end_of(extents)
+        alt_lo, alt_hi = scd.meter_altitude_bounds_of(extents)
+        vol4 = scd.make_vol4(
+            start_time,
+            end_time,
+            alt_lo,
+            alt_hi,
+            polygon=scd.make_polygon(latlngrect=scd.rect_bounds_of(extents)),
+        )
+        try:
+            op_intent_refs = scd_client.query_operational_intent_references(
+                resources.utm_client, vol4
+            )
+        except DSS_ERRORS as e:
+            return "Error querying operational intents: {}".format(e)
+        key = [op_intent_ref.ovn for op_intent_ref in op_intent_refs]
+
+    req = scd.PutOperationalIntentReferenceParameters(
+        extents=extents,
+        key=key,
+        state=transition.state,
+        uss_base_url="{}/mock/scd".format(identities.base_url()),
+        subscription_id=flight.op_intent_reference.subscription_id,
+    )
+    try:
+        result = scd_client.update_operational_intent_reference(
+            resources.utm_client,
+            flight.op_intent_reference.id,
+            flight.op_intent_reference.ovn,
+            req,
+        )
+    except DSS_ERRORS as e:
+        return "Error updating operational intent: {}".format(e)
+    notifications.notify_subscribers(
+        result.operational_intent_reference.id,
+        scd.OperationalIntent(
+            reference=result.operational_intent_reference,
+            details=scd.OperationalIntentDetails(
+                volumes=injection.volumes,
+                off_nominal_volumes=injection.off_nominal_volumes,
+                priority=injection.priority,
+            ),
+        ),
+        result.subscribers,
+    )
+
+    with db as tx:
+        if flight_id in tx.flights:
+            record = tx.flights[flight_id]
+            record.op_intent_reference = result.operational_intent_reference
+            record.op_intent_injection = injection
+    return None
+
+
+def _run_schedule(
+    identity: str, flight_id: str, version: str, start: float
+) -> None:
+    with identities.acting_as(identity):
+        schedule = db.value.conformance.get(flight_id, None)
+        if schedule is None or schedule.version != version:
+            return
+        for i in sorted(
+            range(len(schedule.transitions)),
+            key=lambda i: schedule.transitions[i].after_seconds,
+        ):
+            transition = schedule.transitions[i]
+            time.sleep(max(0.0, start + transition.after_seconds - time.time()))
+
+            # Stop if the schedule was replaced or deleted meanwhile
+            current = db.value.conformance.get(flight_id, None)
+            if current is None or current.version != version:
+                return
+            notes = transition_flight(flight_id, transition)
+            with db as tx:
+                current = tx.conformance.get(flight_id, None)
+                if current is None or current.version != version:
+                    return
+                executed = current.transitions[i]
+                executed.executed_at = StringBasedDateTime(datetime.utcnow())
+                if notes is not None:
+                    executed.notes = notes
+
+
+def start_schedule(flight_id: str, version: str) -> None:
+    """Execute the scheduled transitions of a flight in the background, on
+    behalf of the current identity."""
+    threading.Thread(
+        target=_run_schedule,
+        args=(identities.current(), flight_id, version, time.time()),
+        daemon=True,
+    ).start()
@@ -3,26 +3,74 @@ from typing import Dict, Optional
 
 from monitoring.monitorlib import scd
 from monitoring.monitorlib.multiprocessing import SynchronizedValue
+from monitoring.mock_uss import identities
+from monitoring.mock_uss.config import state_path
 from monitoring.monitorlib.scd_automated_testing import scd_injection_api
-from implicitdict import ImplicitDict
+from monitoring.mock_uss.scdsc.behavior import (
+    ConformanceSchedule,
+    OperationalIntentBehavior,
+    MisbehaviorProfile,
+)
+from implicitdict import ImplicitDict, StringBasedDateTime
 
 
 class FlightRecord(ImplicitDict):
     """Representation of a flight in a USS"""
 
     op_intent_injection: scd_injection_api.OperationalIntentTestInjection
-    flight_authorisation: scd_injection_api.FlightAuthorisationData
+    flight_authorisation: Optional[scd_injection_api.FlightAuthorisationData]
     op_intent_reference: scd.OperationalIntentReference
 
 
+class FlightPlanningRequestRecord(ImplicitDict):
+    """Outcome of a flight planning request, replayed when the request is
+    repeated"""
+
+    flight_plan_id: str
+    response: dict
+    status_code: int
+
+
+class SupersededFlight(ImplicitDict):
+    """A flight of this USS superseded by a higher-priority flight"""
+
+    op_intent_reference: scd.OperationalIntentReference
+    """Operational intent of the flight when it was superseded"""
+
+    superseded_by: str
+    """ID of the higher-priority flight"""
+
+    superseded_at: StringBasedDateTime
+    closed: bool
+    """True when the flight was closed, deleting its operational intent"""
+
+    notes: Optional[str]
+    """Failure to close the flight, if any"""
+
+
 class Database(ImplicitDict):
     """Simple in-memory pseudo-database tracking the state of the mock system"""
 
     flights: Dict[str, FlightRecord] = {}
     cached_operations: Dict[str, scd.OperationalIntent] = {}
+    behaviors: Dict[str, OperationalIntentBehavior] = {}
+    """Behaviors of injected flights toward peers, by flight ID"""
+    flight_planning_requests: Dict[str, FlightPlanningRequestRecord] = {}
+    """Outcomes of flight planning requests, by request ID"""
+    misbehavior: MisbehaviorProfile = MisbehaviorProfile()
+    stale_flights: Dict[str, FlightRecord] = {}
+    """Flights as they were when the stale_details profile was selected, by
+    flight ID"""
+    conformance: Dict[str, ConformanceSchedule] = {}
+    """Scheduled conformance transitions of flights, by flight ID"""
+    superseded: Dict[str, SupersededFlight] = {}
+    """Flights superseded by higher-priority flights, by flight ID"""
 
 
-db = SynchronizedValue(
-    Database(),
-    decoder=lambda b: ImplicitDict.parse(json.loads(b.decode("utf-8")), Database),
+db = identities.PerIdentityValue(
+    lambda identity: SynchronizedValue(
+        Database(),
+        decoder=lambda b: ImplicitDict.parse(json.loads(b.decode("utf-8")), Database),
+        persistence_path=state_path(identities.state_name("scdsc", identity)),
+    )
 )
@@ -0,0 +1,21 @@
+from typing import List, Optional
+
+from monitoring.monitorlib import scd
+from monitoring.monitorlib.clients import scd as scd_client
+from monitoring.mock_uss import resources
+from monitoring.mock_uss.scdsc.behavior import MisbehaviorProfileName
+from monitoring.mock_uss.scdsc.database import db
+
+
+def notify_subscribers(
+    id: str,
+    operational_intent: Optional[scd.OperationalIntent],
+    subscribers: List[scd.SubscriberToNotify],
+):
+    """Notify subscribers of a change to an operational intent, unless the
+    never_notifies misbehavior profile is selected."""
+    if db.value.misbehavior.has(MisbehaviorProfileName.NeverNotifies):
+        return
+    scd_client.notify_subscribers(
+        resources.utm_client, id, operational_intent, subscribers
+    )
@@ -0,0 +1,79 @@
+from datetime import datetime
+from typing import Dict, List, Optional
+
+from implicitdict import StringBasedDateTime
+from monitoring.monitorlib import scd
+from monitoring.monitorlib.clients import scd as scd_client
+from monitoring.mock_uss import resources
+from monitoring.mock_uss.scdsc import notifications
+from monitoring.mock_uss.scdsc.conformance import DSS_ERRORS
+from monitoring.mock_uss.scdsc.database import db, SupersededFlight
+
+SUPERSEDE_CLOSE = "close"
+"""Close the superseded flights of this USS, deleting their operational
+intents"""
+
+SUPERSEDE_KEEP = "keep"
+"""Keep the superseded flights of this USS planned, only recording that they
+were superseded"""
+
+SUPERSEDE_MODES = {SUPERSEDE_CLOSE, SUPERSEDE_KEEP}
+
+
+def _close(
+    flight_id: str, op_intent_ref: scd.OperationalIntentReference
+) -> Optional[str]:
+    try:
+        result = scd_client.delete_operational_intent_reference(
+            resources.utm_client, op_intent_ref.id, op_intent_ref.ovn
+        )
+    except DSS_ERRORS as e:
+        return "Error deleting operational intent: {}".format(e)
+    notifications.notify_subscribers(
+        result.operational_intent_reference.id, None, result.subscribers
+    )
+    with db as tx:
+        tx.flights.pop(flight_id, None)
+        tx.behaviors.pop(flight_id, None)
+        tx.conformance.pop(flight_id, None)
+    return None
+
+
+def supersede(
+    priority_flight_id: str, lower_priority: List[scd.OperationalIntent], mode: str
+) -> Dict[str, SupersededFlight]:
+    """Supersede the flights of this USS whose operational intents are among the
+    lower_priority operational intents intersecting a higher-priority flight.
+
+    Peers managing the other lower_priority operational intents are notified by
+    their subscriptions when the higher-priority operational intent is created.
+
+    :return: Superseded flights, by flight ID
+    """
+    lower_priority_ids = {op_intent.reference.id for op_intent in lower_priority}
+    own_flights = {
+        flight_id: flight.op_intent_reference
+        for flight_id, flight in db.value.flights.items()
+        if flight_id != priority_flight_id
+        and flight.op_intent_reference.id in lower_priority_ids
+    }
+
+    superseded = {}
+    for flight_id, op_intent_ref in own_flights.items():
+        record = SupersededFlight(
+            op_intent_reference=op_intent_ref,
+            superseded_by=priority_flight_id,
+            superseded_at=StringBasedDateTime(datetime.utcnow()),
+            closed=False,
+        )
+        if mode == SUPERSEDE_CLOSE:
+            notes = _close(flight_id, op_intent_ref)
+            if notes is None:
+                record.closed = True
+            else:
+                record.notes = notes
+        superseded[flight_id] = record
+
+    with db as tx:
+        tx.superseded.update(superseded)
+    return superseded
@@ -16,3 +16,7 @@ def scdsc_status():
 
 from . import routes_scdsc
 from . import routes_injection
+from . import routes_behavior
+from . import routes_flight_planning
+from . import routes_conformance
+from . import routes_priority
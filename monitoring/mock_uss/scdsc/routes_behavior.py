@@ -0,0 +1,110 @@
+import time
+from typing import Tuple
+
+import flask
+
+from implicitdict import ImplicitDict
+from monitoring.mock_uss import webapp
+from monitoring.mock_uss.idempotency.replay import idempotent
+from .behavior import (
+    OperationalIntentBehavior,
+    MisbehaviorProfile,
+    MisbehaviorProfileName,
+)
+from .database import db
+
+
+@webapp.route("/scdsc/behavior/flights/<flight_id>", methods=["PUT"])
+@idempotent
+def set_flight_behavior(flight_id: str) -> Tuple[str, int]:
+    """Set the behavior of an injected flight toward peers."""
+    try:
+        json = flask.request.json
+        if json is None:
+            raise ValueError("Request did not contain a JSON payload")
+        behavior = ImplicitDict.parse(json, OperationalIntentBehavior)
+    except ValueError as e:
+        msg = "Change behavior for flight {} unable to parse JSON: {}".format(
+            flight_id, e
+        )
+        return msg, 400
+
+    with db as tx:
+        tx.behaviors[flight_id] = behavior
+
+    return flask.jsonify(behavior)
+
+
+@webapp.route("/scdsc/behavior/flights/<flight_id>", methods=["GET"])
+def get_flight_behavior(flight_id: str) -> Tuple[str, int]:
+    """Get the behavior of an injected flight toward peers."""
+    behavior = db.value.behaviors.get(flight_id, None)
+    if behavior is None:
+        return "No behavior set for flight {}".format(flight_id), 404
+    return flask.jsonify(behavior)
+
+
+@webapp.route("/scdsc/behavior/flights/<flight_id>", methods=["DELETE"])
+@idempotent
+def delete_flight_behavior(flight_id: str) -> Tuple[str, int]:
+    """Restore the default behavior of an injected flight."""
+    with db as tx:
+        behavior = tx.behaviors.pop(flight_id, None)
+    if behavior is None:
+        return "No behavior set for flight {}".format(flight_id), 404
+    return flask.jsonify(behavior)
+
+
+@webapp.route("/scdsc/behavior/misbehavior", methods=["PUT"])
+@idempotent
+def set_misbehavior() -> Tuple[str, int]:
+    """Select the misbehavior profiles of the mock USS."""
+    try:
+        json = flask.request.json
+        if json is None:
+            raise ValueError("Request did not contain a JSON payload")
+        misbehavior = ImplicitDict.parse(json, MisbehaviorProfile)
+        misbehavior.profiles = [MisbehaviorProfileName(p) for p in misbehavior.profiles]
+    except ValueError as e:
+        msg = "Change misbehavior unable to parse JSON: {}".format(e)
+        return msg, 400
+
+    with db as tx:
+        if not misbehavior.has(MisbehaviorProfileName.StaleDetails):
+            tx.stale_flights = {}
+        elif not tx.misbehavior.has(MisbehaviorProfileName.StaleDetails):
+            tx.stale_flights = dict(tx.flights)
+        tx.misbehavior = misbehavior
+
+    return flask.jsonify(misbehavior)
+
+
+@webapp.route("/scdsc/behavior/misbehavior", methods=["GET"])
+def get_misbehavior() -> Tuple[str, int]:
+    """Get the misbehavior profiles of the mock USS, and the available ones."""
+    return flask.jsonify(
+        {
+            "misbehavior": db.value.misbehavior,
+            "available_profiles": [p.value for p in MisbehaviorProfileName],
+        }
+    )
+
+
+@webapp.route("/scdsc/behavior/misbehavior", methods=["DELETE"])
+@idempotent
+def delete_misbehavior() -> Tuple[str, int]:
+    """Restore the nominal behavior of the mock USS."""
+    with db as tx:
+        tx.misbehavior = MisbehaviorProfile()
+        tx.stale_flights = {}
+    return flask.jsonify(db.value.misbehavior)
+
+
+@webapp.before_request
+def delay_astm_responses():
+    """Implements the slow_responder misbehavior profile."""
+    if not flask.request.path.startswith("/mock/scd/"):
+        return
+    misbehavior = db.value.misbehavior
+    if misbehavior.has(MisbehaviorProfileName.SlowResponder):
+        time.sleep(misbehavior.response_delay_seconds)
@@ -0,0 +1,104 @@
+from datetime import datetime
+from typing import Tuple
+import uuid
+
+import flask
+
+from implicitdict import ImplicitDict, StringBasedDateTime
+from monitoring.mock_uss import webapp
+from monitoring.mock_uss.idempotency.replay import idempotent
+from .behavior import (
+    CONFORMANCE_STATES,
+    ConformanceSchedule,
+    ConformanceTransition,
+)
+from .conformance import start_schedule, transition_flight
+from .database import db
+
+
+def _validate(transition: ConformanceTransition) -> None:
+    if transition.state not in CONFORMANCE_STATES:
+        raise ValueError(
+            "Conformance monitoring may only transition flights to {}, not {}".format(
+                ", ".join(sorted(CONFORMANCE_STATES)), transition.state
+            )
+        )
+    if transition.after_seconds < 0:
+        raise ValueError("Transitions may not happen in the past")
+
+
+@webapp.route("/scdsc/conformance/flights/<flight_id>", methods=["PUT"])
+@idempotent
+def set_conformance_schedule(flight_id: str) -> Tuple[str, int]:
+    """Schedule the transitions of a flight determined by simulated conformance
+    monitoring, replacing any transitions previously scheduled."""
+    try:
+        json = flask.request.json
+        if json is None:
+            raise ValueError("Request did not contain a JSON payload")
+        schedule = ImplicitDict.parse(json, ConformanceSchedule)
+        for transition in schedule.transitions:
+            _validate(transition)
+    except ValueError as e:
+        msg = "Schedule conformance of flight {} unable to parse JSON: {}".format(
+            flight_id, e
+        )
+        return msg, 400
+    if flight_id not in db.value.flights:
+        return "Flight {} does not exist".format(flight_id), 404
+
+    schedule.set_at = StringBasedDateTime(datetime.utcnow())
+    schedule.version = str(uuid.uuid4())
+    for transition in schedule.transitions:
+        transition.pop("executed_at", None)
+        transition.pop("notes", None)
+    with db as tx:
+        tx.conformance[flight_id] = schedule
+    start_schedule(flight_id, schedule.version)
+
+    return flask.jsonify(schedule)
+
+
+@webapp.route("/scdsc/conformance/flights/<flight_id>", methods=["GET"])
+def get_conformance_schedule(flight_id: str) -> Tuple[str, int]:
+    """Get the scheduled transitions of a flight and which were executed."""
+    schedule = db.value.conformance.get(flight_id, None)
+    if schedule is None:
+        return "No conformance transitions scheduled for {}".format(flight_id), 404
+    return flask.jsonify(schedule)
+
+
+@webapp.route("/scdsc/conformance/flights/<flight_id>", methods=["DELETE"])
+@idempotent
+def delete_conformance_schedule(flight_id: str) -> Tuple[str, int]:
+    """Cancel the scheduled transitions of a flight not yet executed."""
+    with db as tx:
+        schedule = tx.conformance.pop(flight_id, None)
+    if schedule is None:
+        return "No conformance transitions scheduled for {}".format(flight_id), 404
+    return flask.jsonify(schedule)
+
+
+@webapp.route("/scdsc/conformance/flights/<flight_id>/transition", methods=["POST"])
+@idempotent
+def trigger_conformance_transition(flight_id: str) -> Tuple[str, int]:
+    """Immediately transition a flight as determined by simulated conformance
+    monitoring."""
+    try:
+        json = flask.request.json
+        if json is None:
+            raise ValueError("Request did not contain a JSON payload")
+        transition = ImplicitDict.parse(json, ConformanceTransition)
+        _validate(transition)
+    except ValueError as e:
+        msg = "Transition flight {} unable to parse JSON: {}".format(flight_id, e)
+        return msg, 400
+    if flight_id not in db.value.flights:
+        return "Flight {} does not exist".format(flight_id), 404
+
+    notes = transition_flight(flight_id, transition)
+    transition.executed_at = StringBasedDateTime(datetime.utcnow())
+    if notes is not None:
+        transition.notes = notes
+        return flask.jsonify(transition), 412
+    return flask.jsonify(transition)
@@ -0,0 +1,329 @@
+from typing import Optional, Tuple
+import uuid
+
+import flask
+import requests.exceptions
+
+from monitoring.monitorlib import scd
+from monitoring.monitorlib.clients import scd as scd_client
+from monitoring.monitorlib.flight_planning.api import (
+    SCOPE_PLAN,
+    UpsertFlightPlanRequest,
+    UpsertFlightPlanResponse,
+    DeleteFlightPlanResponse,
+    PlanningActivityResult,
+    FlightPlanStatus,
+    UsageState,
+    UasState,
+)
+from monitoring.monitorlib.scd_automated_testing.scd_injection_api import (
+    OperationalIntentTestInjection,
+)
+from implicitdict import ImplicitDict
+from monitoring.mock_uss import config, identities, resources, webapp
+from monitoring.mock_uss.auth import requires_scope
+from monitoring.mock_uss.clock import database as clock
+from monitoring.mock_uss.scdsc import database, notifications
+from monitoring.mock_uss.scdsc.database import db
+from monitoring.mock_uss.scdsc.routes_injection import query_operational_intents
+
+# Operational intent state corresponding to each usage and UAS state of a
+# flight plan
+OP_INTENT_STATES = {
+    (UsageState.Planned, UasState.Nominal): "Accepted",
+    (UsageState.InUse, UasState.Nominal): "Activated",
+    (UsageState.InUse, UasState.OffNominal): "Nonconforming",
+    (UsageState.InUse, UasState.Contingent): "Contingent",
+}
+
+FLIGHT_PLAN_STATUSES = {
+    "Accepted": FlightPlanStatus.Planned,
+    "Activated": FlightPlanStatus.OkToFly,
+    "Nonconforming": FlightPlanStatus.OffNominal,
+    "Contingent": FlightPlanStatus.OffNominal,
+}
+
+DSS_ERRORS = (
+    ValueError,
+    scd_client.OperationError,
+    requests.exceptions.ConnectionError,
+    ConnectionError,
+)
+
+
+def _replay(request_id: str, flight_plan_id: str) -> Optional[Tuple[str, int]]:
+    """Return the outcome of a previous request with request_id, if any."""
+    record = db.value.flight_planning_requests.get(request_id, None)
+    if record is None:
+        return None
+    if record.flight_plan_id != flight_plan_id:
+        msg = "Request ID {} was already used for flight plan {}".format(
+            request_id, record.flight_plan_id
+        )
+        return msg, 400
+    return flask.jsonify(record.response), record.status_code
+
+
+def _record(
+    request_id: str, flight_plan_id: str, response: ImplicitDict
+) -> Tuple[str, int]:
+    """Record the outcome of a request so that repeating it replays the outcome."""
+    with db as tx:
+        tx.flight_planning_requests[request_id] = database.FlightPlanningRequestRecord(
+            flight_plan_id=flight_plan_id, response=response, status_code=200
+        )
+    return flask.jsonify(response), 200
+
+
+def _current_status(flight: Optional[database.FlightRecord]) -> FlightPlanStatus:
+    if flight is None:
+        return FlightPlanStatus.NotPlanned
+    return FLIGHT_PLAN_STATUSES[flight.op_intent_reference.state]
+
+
+@webapp.route("/flight_planning/v1/flight_plans/<flight_plan_id>", methods=["PUT"])
+@requires_scope([SCOPE_PLAN])
+def upsert_flight_plan(flight_plan_id: str) -> Tuple[str, int]:
+    """Plans, activates, modifies or closes a flight, creating, updating or
+    deleting its operational intent in the DSS accordingly."""
+    try:
+        json = flask.request.json
+        if json is None:
+            raise ValueError("Request did not contain a JSON payload")
+        req_body: UpsertFlightPlanRequest = ImplicitDict.parse(
+            json, UpsertFlightPlanRequest
+        )
+    except ValueError as e:
+        msg = "Upsert flight plan {} unable to parse JSON: {}".format(
+            flight_plan_id, e
+        )
+        return msg, 400
+
+    replayed = _replay(req_body.request_id, flight_plan_id)
+    if replayed is not None:
+        return replayed
+
+    flight = db.value.flights.get(flight_plan_id, None)
+    info = req_body.flight_plan.basic_information
+
+    def respond(
+        result: PlanningActivityResult,
+        status: FlightPlanStatus,
+        notes: Optional[str] = None,
+        op_intent_id: Optional[str] = None,
+    ) -> Tuple[str, int]:
+        kwargs = {
+            "request_id": req_body.request_id,
+            "planning_result": result,
+            "flight_plan_status": status,
+        }
+        if notes is not None:
+            kwargs["notes"] = notes
+        if op_intent_id is not None:
+            kwargs["operational_intent_id"] = op_intent_id
+        return _record(
+            req_body.request_id, flight_plan_id, UpsertFlightPlanResponse(**kwargs)
+        )
+
+    if info.usage_state == UsageState.Closed:
+        notes = _close_flight(flight_plan_id, flight)
+        if notes is not None:
+            return respond(
+                PlanningActivityResult.Failed, _current_status(flight), notes
+            )
+        return respond(PlanningActivityResult.Completed, FlightPlanStatus.Closed)
+
+    state = OP_INTENT_STATES.get((info.usage_state, info.uas_state), None)
+    if state is None:
+        notes = "A flight plan may not be {} while its UAS is {}".format(
+            info.usage_state, info.uas_state
+        )
+        return respond(
+            PlanningActivityResult.NotSupported, _current_status(flight), notes
+        )
+    if flight is None and state != "Accepted":
+        notes = "A new flight plan must be Planned with a Nominal UAS before it is used"
+        return respond(
+            PlanningActivityResult.Rejected, FlightPlanStatus.NotPlanned, notes
+        )
+
+    # Check for operational intents in the DSS, at intent times following the
+    # virtual clock of mock_uss
+    volumes = info.area
+    clock_offset = clock.offset()
+    if clock_offset:
+        scd.offset_time(volumes, clock_offset)
+    start_time = scd.start_of(volumes)
+    end_time = scd.end_of(volumes)
+    area = scd.rect_bounds_of(volumes)
+    alt_lo, alt_hi = scd.meter_altitude_bounds_of(volumes)
+    vol4 = scd.make_vol4(
+        start_time, end_time, alt_lo, alt_hi, polygon=scd.make_polygon(latlngrect=area)
+    )
+    try:
+        op_intents = query_operational_intents(vol4)
+    except DSS_ERRORS as e:
+        notes = "Error querying operational intents: {}".format(e)
+        return respond(PlanningActivityResult.Failed, _current_status(flight), notes)
+
+    # Check for intersections, unless the flight is off-nominal
+    own_id = flight.op_intent_reference.id if flight is not None else None
+    if info.uas_state == UasState.Nominal:
+        for op_intent in op_intents:
+            if op_intent.reference.id == own_id:
+                continue
+            if req_body.flight_plan.priority > op_intent.details.priority:
+                continue
+            if webapp.config[
+                config.KEY_BEHAVIOR_LOCALITY
+            ].allow_same_priority_intersections:
+                continue
+            v2a = op_intent.details.volumes
+            v2b = op_intent.details.off_nominal_volumes
+            if scd.vol4s_intersect(volumes, v2a) or scd.vol4s_intersect(
+                volumes, v2b
+            ):
+                notes = "Requested flight intersected {}'s operational intent {}".format(
+                    op_intent.reference.manager, op_intent.reference.id
+                )
+                return respond(
+                    PlanningActivityResult.Rejected, _current_status(flight), notes
+                )
+
+    # Create or update operational intent in DSS
+    base_url = "{}/mock/scd".format(identities.base_url())
+    req = scd.PutOperationalIntentReferenceParameters(
+        extents=volumes,
+        key=[op.reference.ovn for op in op_intents],
+        state=state,
+        uss_base_url=base_url,
+    )
+    injection = OperationalIntentTestInjection(
+        state=state,
+        priority=req_body.flight_plan.priority,
+        volumes=volumes,
+        off_nominal_volumes=[],
+    )
+    try:
+        if flight is None:
+            id = str(uuid.uuid4())
+            req.new_subscription = scd.ImplicitSubscriptionParameters(
+                uss_base_url=base_url
+            )
+            result = scd_client.create_operational_intent_reference(
+                resources.utm_client, id, req
+            )
+        else:
+            req.subscription_id = flight.op_intent_reference.subscription_id
+            result = scd_client.update_operational_intent_reference(
+                resources.utm_client,
+                flight.op_intent_reference.id,
+                flight.op_intent_reference.ovn,
+                req,
+            )
+    except DSS_ERRORS as e:
+        notes = "Error {} operational intent: {}".format(
+            "creating" if flight is None else "updating", e
+        )
+        return respond(PlanningActivityResult.Failed, _current_status(flight), notes)
+    notifications.notify_subscribers(
+        result.operational_intent_reference.id,
+        scd.OperationalIntent(
+            reference=result.operational_intent_reference,
+            details=scd.OperationalIntentDetails(
+                volumes=injection.volumes,
+                off_nominal_volumes=injection.off_nominal_volumes,
+                priority=injection.priority,
+            ),
+        ),
+        result.subscribers,
+    )
+
+    # Store flight in database
+    record = database.FlightRecord(
+        op_intent_reference=result.operational_intent_reference,
+        op_intent_injection=injection,
+    )
+    with db as tx:
+        tx.flights[flight_plan_id] = record
+
+    return respond(
+        PlanningActivityResult.Completed,
+        FLIGHT_PLAN_STATUSES[state],
+        op_intent_id=result.operational_intent_reference.id,
+    )
+
+
+@webapp.route("/flight_planning/v1/flight_plans/<flight_plan_id>", methods=["DELETE"])
+@requires_scope([SCOPE_PLAN])
+def delete_flight_plan(flight_plan_id: str) -> Tuple[str, int]:
+    """Closes a flight, deleting its operational intent from the DSS.  The
+    request_id query parameter makes the request idempotent."""
+    request_id = flask.request.args.get("request_id", None)
+    if request_id is not None:
+        replayed = _replay(request_id, flight_plan_id)
+        if replayed is not None:
+            return replayed
+
+    flight = db.value.flights.get(flight_plan_id, None)
+    kwargs = {}
+    if request_id is not None:
+        kwargs["request_id"] = request_id
+    if flight is None:
+        response = DeleteFlightPlanResponse(
+            planning_result=PlanningActivityResult.Failed,
+            notes="Flight plan {} does not exist".format(flight_plan_id),
+            flight_plan_status=FlightPlanStatus.NotPlanned,
+            **kwargs,
+        )
+    else:
+        notes = _close_flight(flight_plan_id, flight)
+        if notes is None:
+            response = DeleteFlightPlanResponse(
+                planning_result=PlanningActivityResult.Completed,
+                flight_plan_status=FlightPlanStatus.Closed,
+                **kwargs,
+            )
+        else:
+            response = DeleteFlightPlanResponse(
+                planning_result=PlanningActivityResult.Failed,
+                notes=notes,
+                flight_plan_status=_current_status(flight),
+                **kwargs,
+            )
+
+    if request_id is None:
+        return flask.jsonify(response), 200
+    return _record(request_id, flight_plan_id, response)
+
+
+def _close_flight(
+    flight_plan_id: str, flight: Optional[database.FlightRecord]
+) -> Optional[str]:
+    """Delete the operational intent of flight from the DSS, and the flight from
+    the database.
+
+    :return: None on success, otherwise notes describing the failure
+    """
+    if flight is None:
+        return None
+
+    try:
+        result = scd_client.delete_operational_intent_reference(
+            resources.utm_client,
+            flight.op_intent_reference.id,
+            flight.op_intent_reference.ovn,
+        )
+    except DSS_ERRORS as e:
+        return "Error deleting operational intent: {}".format(e)
+    notifications.notify_subscribers(
+        result.operational_intent_reference.id,
+        None,
+        result.subscribers,
+    )
+
+    with db as tx:
+        tx.flights.pop(flight_plan_id, None)
+        tx.behaviors.pop(flight_plan_id, None)
+        tx.conformance.pop(flight_plan_id, None)
+    return None
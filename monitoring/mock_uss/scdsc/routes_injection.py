@@ -22,11 +22,16 @@ from monitoring.monitorlib.scd_automated_testing.scd_injection_api import (
     CapabilitiesResponse,
 )
 from implicitdict import ImplicitDict, StringBasedDateTime
-from monitoring.mock_uss import config, resources, webapp
+from monitoring.mock_uss import config, identities, resources, webapp
 from monitoring.mock_uss.auth import requires_scope
-from monitoring.mock_uss.scdsc import database
+from monitoring.mock_uss.clock import database as clock
+from monitoring.mock_uss.flight_authorisation.database import (
+    db as flight_authorisation_db,
+)
+from monitoring.mock_uss.flight_authorisation.evaluation import evaluate
+from monitoring.mock_uss.scdsc import database, notifications
 from monitoring.mock_uss.scdsc.database import db
-from monitoring.monitorlib.uspace import problems_with_flight_authorisation
+from monitoring.mock_uss.idempotency.replay import idempotent
 
 
 def query_operational_intents(
@@ -90,6 +95,7 @@ def scd_capabilities() -> Tuple[str, int]:
 
 @webapp.route("/scdsc/v1/flights/<flight_id>", methods=["PUT"])
 @requires_scope([SCOPE_SCD_QUALIFIER_INJECT])
+@idempotent
 def inject_flight(flight_id: str) -> Tuple[str, int]:
     """Implements flight injection in SCD automated testing injection API."""
     try:
@@ -101,16 +107,38 @@ def inject_flight(flight_id: str) -> Tuple[str, int]:
         msg = "Create flight {} unable to parse JSON: {}".format(flight_id, e)
         return msg, 400
 
+    response, _ = plan_flight(flight_id, req_body)
+    return flask.jsonify(response), 200
+
+
+def plan_flight(
+    flight_id: str, req_body: InjectFlightRequest
+) -> Tuple[InjectFlightResponse, List[scd.OperationalIntent]]:
+    """Plan an injected flight, creating its operational intent in the DSS.
+
+    :return: Outcome of the injection, and the operational intents of lower priority intersecting the flight once planned
+    """
     if webapp.config[config.KEY_BEHAVIOR_LOCALITY].is_uspace_applicable:
         # Validate flight authorisation
-        problems = problems_with_flight_authorisation(req_body.flight_authorisation)
-        if problems:
-            return flask.jsonify(
+        decision = evaluate(
+            flight_authorisation_db.value.rules,
+            req_body.flight_authorisation,
+            req_body.operational_intent,
+        )
+        if not decision.approved:
+            return (
                 InjectFlightResponse(
-                    result=InjectFlightResult.Rejected, notes=", ".join(problems)
-                )
+                    result=InjectFlightResult.Rejected, notes=decision.notes
+                ),
+                [],
             )
 
+    # Intent times follow the virtual clock of mock_uss
+    clock_offset = clock.offset()
+    if clock_offset:
+        scd.offset_time(req_body.operational_intent.volumes, clock_offset)
+        scd.offset_time(req_body.operational_intent.off_nominal_volumes, clock_offset)
+
     # Check for operational intents in the DSS
     start_time = scd.start_of(req_body.operational_intent.volumes)
     end_time = scd.end_of(req_body.operational_intent.volumes)
@@ -128,39 +156,35 @@ def inject_flight(flight_id: str) -> Tuple[str, int]:
         ConnectionError,
     ) as e:
         notes = "Error querying operational intents: {}".format(e)
-        return (
-            flask.jsonify(
-                InjectFlightResponse(result=InjectFlightResult.Failed, notes=notes)
-            ),
-            200,
-        )
+        return InjectFlightResponse(result=InjectFlightResult.Failed, notes=notes), []
 
     # Check for intersections
     v1 = req_body.operational_intent.volumes
+    lower_priority = []
     for op_intent in op_intents:
+        v2a = op_intent.details.volumes
+        v2b = op_intent.details.off_nominal_volumes
+        if not scd.vol4s_intersect(v1, v2a) and not scd.vol4s_intersect(v1, v2b):
+            continue
         if req_body.operational_intent.priority > op_intent.details.priority:
+            lower_priority.append(op_intent)
             continue
         if webapp.config[
             config.KEY_BEHAVIOR_LOCALITY
         ].allow_same_priority_intersections:
             continue
-        v2a = op_intent.details.volumes
-        v2b = op_intent.details.off_nominal_volumes
-        if scd.vol4s_intersect(v1, v2a) or scd.vol4s_intersect(v1, v2b):
-            notes = "Requested flight intersected {}'s operational intent {}".format(
-                op_intent.reference.manager, op_intent.reference.id
-            )
-            return (
-                flask.jsonify(
-                    InjectFlightResponse(
-                        result=InjectFlightResult.ConflictWithFlight, notes=notes
-                    )
-                ),
-                200,
-            )
+        notes = "Requested flight intersected {}'s operational intent {}".format(
+            op_intent.reference.manager, op_intent.reference.id
+        )
+        return (
+            InjectFlightResponse(
+                result=InjectFlightResult.ConflictWithFlight, notes=notes
+            ),
+            [],
+        )
 
     # Create operational intent in DSS
-    base_url = "{}/mock/scd".format(webapp.config[config.KEY_BASE_URL])
+    base_url = "{}/mock/scd".format(identities.base_url())
     req = scd.PutOperationalIntentReferenceParameters(
         extents=req_body.operational_intent.volumes,
         key=[op.reference.ovn for op in op_intents],
@@ -180,14 +204,8 @@ def inject_flight(flight_id: str) -> Tuple[str, int]:
         ConnectionError,
     ) as e:
         notes = "Error creating operational intent: {}".format(e)
-        return (
-            flask.jsonify(
-                InjectFlightResponse(result=InjectFlightResult.Failed, notes=notes)
-            ),
-            200,
-        )
-    scd_client.notify_subscribers(
-        resources.utm_client,
+        return InjectFlightResponse(result=InjectFlightResult.Failed, notes=notes), []
+    notifications.notify_subscribers(
         result.operational_intent_reference.id,
         scd.OperationalIntent(
             reference=result.operational_intent_reference,
@@ -205,20 +223,24 @@ def inject_flight(flight_id: str) -> Tuple[str, int]:
     with db as tx:
         tx.flights[flight_id] = record
 
-    return flask.jsonify(
+    return (
         InjectFlightResponse(
             result=InjectFlightResult.Planned, operational_intent_id=id
-        )
+        ),
+        lower_priority,
     )
 
 
 @webapp.route("/scdsc/v1/flights/<flight_id>", methods=["DELETE"])
 @requires_scope([SCOPE_SCD_QUALIFIER_INJECT])
+@idempotent
 def delete_flight(flight_id: str) -> Tuple[str, int]:
     """Implements flight deletion in SCD automated testing injection API."""
 
     with db as tx:
         flight = tx.flights.pop(flight_id, None)
+        tx.behaviors.pop(flight_id, None)
+        tx.conformance.pop(flight_id, None)
 
     if flight is None:
         return (
@@ -251,8 +273,7 @@ def delete_flight(flight_id: str) -> Tuple[str, int]:
             ),
             200,
         )
-    scd_client.notify_subscribers(
-        resources.utm_client,
+    notifications.notify_subscribers(
         result.operational_intent_reference.id,
         None,
         result.subscribers,
@@ -263,6 +284,7 @@ def delete_flight(flight_id: str) -> Tuple[str, int]:
 
 @webapp.route("/scdsc/v1/clear_area_requests", methods=["POST"])
 @requires_scope([SCOPE_SCD_QUALIFIER_INJECT])
+@idempotent
 def clear_area() -> Tuple[str, int]:
     try:
         json = flask.request.json
@@ -327,6 +349,8 @@ def clear_area() -> Tuple[str, int]:
                 flights_to_delete.append(flight_id)
         for flight_id in flights_to_delete:
             del tx.flights[flight_id]
+            tx.behaviors.pop(flight_id, None)
+            tx.conformance.pop(flight_id, None)
 
         cache_deletions = []
         for op_intent_id in deleted:
@@ -0,0 +1,61 @@
+from typing import Tuple
+
+import flask
+
+from implicitdict import ImplicitDict
+from monitoring.monitorlib.scd_automated_testing.scd_injection_api import (
+    InjectFlightRequest,
+    InjectFlightResult,
+    SCOPE_SCD_QUALIFIER_INJECT,
+)
+from monitoring.mock_uss import webapp
+from monitoring.mock_uss.auth import requires_scope
+from monitoring.mock_uss.idempotency.replay import idempotent
+from .database import db
+from .priority import supersede, SUPERSEDE_CLOSE, SUPERSEDE_MODES
+from .routes_injection import plan_flight
+
+
+@webapp.route("/scdsc/priority/flights/<flight_id>", methods=["PUT"])
+@requires_scope([SCOPE_SCD_QUALIFIER_INJECT])
+@idempotent
+def inject_priority_flight(flight_id: str) -> Tuple[str, int]:
+    """Inject a flight superseding the intersecting flights of lower priority.
+    The supersede query parameter selects whether the superseded flights of this
+    USS are closed (`close`, the default) or kept (`keep`)."""
+    mode = flask.request.args.get("supersede", SUPERSEDE_CLOSE)
+    try:
+        if mode not in SUPERSEDE_MODES:
+            raise ValueError(
+                "supersede must be one of {}".format(", ".join(sorted(SUPERSEDE_MODES)))
+            )
+        json = flask.request.json
+        if json is None:
+            raise ValueError("Request did not contain a JSON payload")
+        req_body: InjectFlightRequest = ImplicitDict.parse(json, InjectFlightRequest)
+    except ValueError as e:
+        msg = "Create priority flight {} unable to parse JSON: {}".format(flight_id, e)
+        return msg, 400
+
+    response, lower_priority = plan_flight(flight_id, req_body)
+    superseded = {}
+    if response.result == InjectFlightResult.Planned:
+        superseded = supersede(flight_id, lower_priority, mode)
+    own_ids = {flight.op_intent_reference.id for flight in superseded.values()}
+    return flask.jsonify(
+        {
+            "injection": response,
+            "superseded_flights": superseded,
+            "superseded_peer_operational_intents": [
+                op_intent.reference.id
+                for op_intent in lower_priority
+                if op_intent.reference.id not in own_ids
+            ],
+        }
+    )
+
+
+@webapp.route("/scdsc/priority/superseded_flights", methods=["GET"])
+def list_superseded_flights() -> Tuple[str, int]:
+    """List the flights of this USS superseded by higher-priority flights."""
+    return flask.jsonify({"superseded_flights": db.value.superseded})
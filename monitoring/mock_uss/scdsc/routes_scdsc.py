@@ -1,9 +1,21 @@
+import time
+from typing import Optional, Tuple
+
 import flask
 
 from monitoring.monitorlib import scd
-from monitoring.mock_uss import webapp
+from implicitdict import ImplicitDict
+from monitoring.mock_uss import webapp, SERVICE_SCDSC
 from monitoring.mock_uss.auth import requires_scope
-from monitoring.mock_uss.scdsc.database import db
+from monitoring.mock_uss.scdsc.behavior import (
+    adjust_details,
+    adjust_reference,
+    is_off_nominal,
+    wrong_ovn,
+    MisbehaviorProfileName,
+)
+from monitoring.mock_uss.notification_callbacks.receipts import report_notification
+from monitoring.mock_uss.scdsc.database import db, FlightRecord
 
 
 @webapp.route("/mock/scd/uss/v1/operational_intents/<entityid>", methods=["GET"])
@@ -12,45 +24,132 @@ def get_operational_intent_details(entityid: str):
     """Implements getOperationalIntentDetails in ASTM SCD API."""
 
     # Look up entityid in database
-    tx = db.value
-    flight = None
-    for f in tx.flights.values():
-        if f.op_intent_reference.id == entityid:
-            flight = f
-            break
+    flight_id, flight = _find_flight(entityid)
+
+    # If requested operational intent doesn't exist, return 404
+    if flight is None:
+        return _unknown_operational_intent(entityid)
+
+    behavior = db.value.behaviors.get(flight_id, None)
+    if behavior is not None:
+        if behavior.details_delay_seconds > 0:
+            time.sleep(behavior.details_delay_seconds)
+        if behavior.details_status_code != 200:
+            return (
+                flask.jsonify(
+                    scd.ErrorResponse(
+                        message="Details of operational intent {} are configured to fail".format(
+                            entityid
+                        )
+                    )
+                ),
+                behavior.details_status_code,
+            )
+    misbehavior = db.value.misbehavior
+    if misbehavior.has(MisbehaviorProfileName.StaleDetails):
+        flight = db.value.stale_flights.get(flight_id, flight)
+
+    # Return nominal response with details
+    details = scd.OperationalIntentDetails(
+        volumes=flight.op_intent_injection.volumes,
+        off_nominal_volumes=flight.op_intent_injection.off_nominal_volumes,
+        priority=flight.op_intent_injection.priority,
+    )
+    reference = adjust_reference(flight.op_intent_reference, behavior)
+    if misbehavior.has(MisbehaviorProfileName.WrongOvnReturner):
+        reference = wrong_ovn(reference)
+    response = scd.GetOperationalIntentDetailsResponse(
+        operational_intent=scd.OperationalIntent(
+            reference=reference,
+            details=adjust_details(flight.op_intent_reference, details, behavior),
+        )
+    )
+    return flask.jsonify(response), 200
+
+
+@webapp.route(
+    "/mock/scd/uss/v1/operational_intents/<entityid>/telemetry", methods=["GET"]
+)
+@requires_scope([scd.SCOPE_CM_SA])
+def get_operational_intent_telemetry(entityid: str):
+    """Implements getOperationalIntentTelemetry in ASTM SCD API."""
+
+    # Look up entityid in database
+    flight_id, flight = _find_flight(entityid)
 
     # If requested operational intent doesn't exist, return 404
     if flight is None:
+        return _unknown_operational_intent(entityid)
+
+    # Telemetry is only provided for off-nominal operational intents
+    if not is_off_nominal(flight.op_intent_reference):
         return (
             flask.jsonify(
                 scd.ErrorResponse(
-                    message="Operational intent {} not known by this USS".format(
-                        entityid
+                    message="Operational intent {} is {} and therefore does not provide telemetry".format(
+                        entityid, flight.op_intent_reference.state
                     )
                 )
             ),
-            404,
+            412,
         )
 
-    # Return nominal response with details
-    response = scd.GetOperationalIntentDetailsResponse(
-        operational_intent=scd.OperationalIntent(
-            reference=flight.op_intent_reference,
-            details=scd.OperationalIntentDetails(
-                volumes=flight.op_intent_injection.volumes,
-                off_nominal_volumes=flight.op_intent_injection.off_nominal_volumes,
-                priority=flight.op_intent_injection.priority,
+    behavior = db.value.behaviors.get(flight_id, None)
+    if behavior is not None and behavior.telemetry_status_code != 200:
+        return (
+            flask.jsonify(
+                scd.ErrorResponse(
+                    message="Telemetry for operational intent {} is configured to fail".format(
+                        entityid
+                    )
+                )
             ),
+            behavior.telemetry_status_code,
         )
-    )
+
+    response = scd.GetOperationalIntentTelemetryResponse(operational_intent_id=entityid)
+    if behavior is not None:
+        if behavior.has_field_with_value("telemetry"):
+            response.telemetry = behavior.telemetry
+        if behavior.has_field_with_value("next_telemetry_opportunity"):
+            response.next_telemetry_opportunity = behavior.next_telemetry_opportunity
     return flask.jsonify(response), 200
 
 
+def _find_flight(entityid: str) -> Tuple[Optional[str], Optional[FlightRecord]]:
+    tx = db.value
+    for flight_id, f in tx.flights.items():
+        if f.op_intent_reference.id == entityid:
+            return flight_id, f
+    return None, None
+
+
+def _unknown_operational_intent(entityid: str):
+    return (
+        flask.jsonify(
+            scd.ErrorResponse(
+                message="Operational intent {} not known by this USS".format(entityid)
+            )
+        ),
+        404,
+    )
+
+
 @webapp.route("/mock/scd/uss/v1/operational_intents", methods=["POST"])
 @requires_scope([scd.SCOPE_SC])
 def notify_operational_intent_details_changed():
     """Implements notifyOperationalIntentDetailsChanged in ASTM SCD API."""
 
+    problems = []
+    try:
+        json = flask.request.get_json(silent=True)
+        if json is None:
+            raise ValueError("Notification did not contain a JSON payload")
+        ImplicitDict.parse(json, scd.PutOperationalIntentDetailsParameters)
+    except ValueError as e:
+        problems.append(str(e))
+    report_notification(SERVICE_SCDSC, problems)
+
     # Do nothing because this USS is unsophisticated and polls the DSS for every
     # change in its operational intents
     return "", 204
@@ -58,7 +58,7 @@ class NoAuth(AuthAdapter):
 
     # Overrides method in AuthAdapter
     def issue_token(self, intended_audience: str, scopes: List[str]) -> str:
-        timestamp = int((datetime.datetime.utcnow() - _UNIX_EPOCH).total_seconds())
+        timestamp = int((self.clock() - _UNIX_EPOCH).total_seconds())
         jwt = jwcrypto.jwt.JWT(
             header={"typ": "JWT", "alg": "RS256"},
             claims={
@@ -309,7 +309,7 @@ class SignedRequest(AuthAdapter):
             "client_id": self._client_id,
             "scope": " ".join(scopes),
             "resource": intended_audience,
-            "current_timestamp": datetime.datetime.utcnow().isoformat() + "Z",
+            "current_timestamp": self.clock().isoformat() + "Z",
         }
         payload = "&".join([k + "=" + v for k, v in query.items()])
 
@@ -357,7 +357,7 @@ class SignedRequest(AuthAdapter):
                 ),
                 "@signature-params": "({});created={}".format(
                     " ".join('"{}"'.format(c) for c in components),
-                    int(datetime.datetime.utcnow().timestamp()),
+                    int((self.clock() - _UNIX_EPOCH).total_seconds()),
                 ),
             }
             components.append("@signature-params")
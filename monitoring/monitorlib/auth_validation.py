@@ -1,5 +1,5 @@
 import json
-from typing import List, NamedTuple
+from typing import Callable, List, NamedTuple, Union
 from functools import wraps
 
 import flask
@@ -30,14 +30,19 @@ class ConfigurationError(Exception):
         self.message = message
 
 
-def requires_scope_decorator(public_key: str, audience: str):
+def requires_scope_decorator(
+    public_key: Union[str, Callable[[], str]],
+    audience: Union[str, Callable[[], str]],
+):
     """Function that produces a decorator to protect a Flask endpoint.
 
     If you decorate an endpoint with a decorator produced by this function, it
     will ensure that the requester has a valid access token with the required
     scope before allowing the endpoint to be called.
+
+    public_key and audience may be functions returning the current values, for
+    servers whose configuration changes while they run.
     """
-    audiences = audience.split(",") if audience else []
 
     def decorator(permitted_scopes):
         def outer_wrapper(fn):
@@ -60,8 +65,11 @@ def requires_scope_decorator(public_key: str, audience: str):
                     if token is None:
                         raise InvalidAccessTokenError("Missing Authorization header")
                     token = token.replace("Bearer ", "")
+                    key = public_key() if callable(public_key) else public_key
+                    aud = audience() if callable(audience) else audience
+                    audiences = aud.split(",") if aud else []
                     try:
-                        if not public_key:
+                        if not key:
                             raise ConfigurationError(
                                 "Public key for access tokens is not configured on server"
                             )
@@ -71,7 +79,7 @@ def requires_scope_decorator(public_key: str, audience: str):
                             )
                         r = jwt.decode(
                             token,
-                            public_key,
+                            key,
                             algorithms="RS256",
                             options={"verify_aud": False},
                         )
@@ -55,6 +55,26 @@ def create_operational_intent_reference(
     return ImplicitDict.parse(resp.json(), scd.ChangeOperationalIntentReferenceResponse)
 
 
+def update_operational_intent_reference(
+    utm_client: UTMClientSession,
+    id: str,
+    ovn: str,
+    req: scd.PutOperationalIntentReferenceParameters,
+) -> scd.ChangeOperationalIntentReferenceResponse:
+    resp = utm_client.put(
+        "/dss/v1/operational_intent_references/{}/{}".format(id, ovn),
+        json=req,
+        scope=scd.SCOPE_SC,
+    )
+    if resp.status_code != 200:
+        raise OperationError(
+            "updateOperationalIntentReference failed {}:\n{}".format(
+                resp.status_code, resp.content.decode("utf-8")
+            )
+        )
+    return ImplicitDict.parse(resp.json(), scd.ChangeOperationalIntentReferenceResponse)
+
+
 def delete_operational_intent_reference(
     utm_client: UTMClientSession, id: str, ovn: str
 ) -> scd.ChangeOperationalIntentReferenceResponse:
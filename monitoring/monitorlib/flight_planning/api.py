@@ -0,0 +1,94 @@
+from enum import Enum
+from typing import List, Optional
+
+from implicitdict import ImplicitDict
+
+from monitoring.monitorlib.scd import Volume4D
+
+SCOPE_PLAN = "interuss.flight_planning.plan"
+
+## Definitions around flight plans submitted to the flight planning interface
+
+
+class UsageState(str, Enum):
+    Planned = "Planned"
+    """The flight is planned but the aircraft is not in use."""
+
+    InUse = "InUse"
+    """The aircraft is in use for the flight."""
+
+    Closed = "Closed"
+    """The flight has ended, or will not take place."""
+
+
+class UasState(str, Enum):
+    Nominal = "Nominal"
+    """The flight conforms to its plan."""
+
+    OffNominal = "OffNominal"
+    """The flight no longer conforms to its plan."""
+
+    Contingent = "Contingent"
+    """The flight no longer conforms to its plan and its conformance cannot be
+    restored in a timely manner."""
+
+
+class BasicFlightPlanInformation(ImplicitDict):
+    usage_state: UsageState
+    uas_state: UasState = UasState.Nominal
+    area: List[Volume4D]
+    """Volumes the flight is planned to occupy."""
+
+
+class FlightPlan(ImplicitDict):
+    basic_information: BasicFlightPlanInformation
+    priority: int = 0
+
+
+### End of definitions around flight plans
+
+
+class UpsertFlightPlanRequest(ImplicitDict):
+    request_id: str
+    """Identifier of the request; repeating a request with the same ID returns
+    the outcome of the original request without acting on it again."""
+
+    flight_plan: FlightPlan
+
+
+class PlanningActivityResult(str, Enum):
+    Completed = "Completed"
+    """The requested activity was completed."""
+
+    Rejected = "Rejected"
+    """The requested activity was rejected, for instance because the flight
+    would conflict with another flight."""
+
+    Failed = "Failed"
+    """The requested activity could not be completed because of an error."""
+
+    NotSupported = "NotSupported"
+    """The requested activity is not supported by this USS."""
+
+
+class FlightPlanStatus(str, Enum):
+    NotPlanned = "NotPlanned"
+    Planned = "Planned"
+    OkToFly = "OkToFly"
+    OffNominal = "OffNominal"
+    Closed = "Closed"
+
+
+class UpsertFlightPlanResponse(ImplicitDict):
+    request_id: str
+    planning_result: PlanningActivityResult
+    notes: Optional[str]
+    flight_plan_status: FlightPlanStatus
+    operational_intent_id: Optional[str]
+
+
+class DeleteFlightPlanResponse(ImplicitDict):
+    request_id: Optional[str]
+    planning_result: PlanningActivityResult
+    notes: Optional[str]
+    flight_plan_status: FlightPlanStatus
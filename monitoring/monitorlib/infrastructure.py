@@ -1,7 +1,7 @@
 import asyncio
 import datetime
 import functools
-from typing import Dict, List, Optional
+from typing import Callable, Dict, List, Optional
 import urllib.parse
 from aiohttp import ClientSession
 
@@ -27,6 +27,10 @@ class AuthAdapter(object):
     def __init__(self):
         self._tokens = {}
 
+        # Source of the current (UTC) time used for the timestamps of token
+        # requests and token expiration; may be replaced to simulate clock skew
+        self.clock: Callable[[], datetime.datetime] = datetime.datetime.utcnow
+
     def issue_token(self, intended_audience: str, scopes: List[str]) -> str:
         """Subclasses must return a bearer token for the given audience."""
 
@@ -45,7 +49,7 @@ class AuthAdapter(object):
             token = self._tokens[intended_audience][scope_string]
         payload = jwt.decode(token, options={"verify_signature": False})
         expires = EPOCH + datetime.timedelta(seconds=payload["exp"])
-        if datetime.datetime.utcnow() > expires - TOKEN_REFRESH_MARGIN:
+        if self.clock() > expires - TOKEN_REFRESH_MARGIN:
             token = self.issue_token(intended_audience, scopes)
         self._tokens[intended_audience][scope_string] = token
         return {"Authorization": "Bearer " + token}
@@ -1,4 +1,5 @@
 import json
+import os
 from typing import Any, Callable, Optional
 
 import multiprocessing
@@ -26,6 +27,7 @@ class SynchronizedValue(object):
     _encoder: Callable[[Any], bytes]
     _decoder: Callable[[bytes], Any]
     _current_value: Any
+    _persistence_path: Optional[str]
 
     def __init__(
         self,
@@ -33,6 +35,7 @@ class SynchronizedValue(object):
         capacity_bytes: int = 10e6,
         encoder: Optional[Callable[[Any], bytes]] = None,
         decoder: Optional[Callable[[bytes], Any]] = None,
+        persistence_path: Optional[str] = None,
     ):
         """Creates a value synchronized across multiple processes.
 
@@ -40,6 +43,7 @@ class SynchronizedValue(object):
         :param capacity_bytes: Maximum number of bytes required to represent this value
         :param encoder: Function that converts this value into bytes
         :param decoder: Function that converts bytes into this value
+        :param persistence_path: When specified, path of the file to which the value is written after each transaction, and from which it is restored (instead of initial_value) when the file exists
         """
         self._lock = multiprocessing.RLock()
         self._shared_memory = multiprocessing.shared_memory.SharedMemory(
@@ -54,6 +58,18 @@ class SynchronizedValue(object):
             decoder if decoder is not None else lambda b: json.loads(b.decode("utf-8"))
         )
         self._current_value = None
+        self._persistence_path = persistence_path
+        if persistence_path is not None and os.path.exists(persistence_path):
+            with open(persistence_path, "rb") as f:
+                content = f.read()
+            try:
+                initial_value = self._decoder(content)
+            except ValueError as e:
+                raise RuntimeError(
+                    "Unable to restore SynchronizedValue from {}: {}".format(
+                        persistence_path, e
+                    )
+                )
         self._set_value(initial_value)
 
     def _get_value(self):
@@ -78,6 +94,15 @@ class SynchronizedValue(object):
             )
         self._shared_memory.buf[0:4] = content_len.to_bytes(4, "big")
         self._shared_memory.buf[4 : content_len + 4] = content
+        if self._persistence_path is not None:
+            # Replace the file atomically so that a crash never leaves it
+            # partially written
+            tmp_path = self._persistence_path + ".tmp"
+            with open(tmp_path, "wb") as f:
+                f.write(content)
+                f.flush()
+                os.fsync(f.fileno())
+            os.replace(tmp_path, self._persistence_path)
 
     @property
     def value(self):
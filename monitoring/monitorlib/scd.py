@@ -189,6 +189,33 @@ class GetOperationalIntentDetailsResponse(ImplicitDict):
     operational_intent: OperationalIntent
 
 
+class Position(ImplicitDict):
+    longitude: float
+    latitude: float
+    accuracy_h: str
+    accuracy_v: str
+    extrapolated: Optional[bool]
+    altitude: Altitude
+
+
+class Velocity(ImplicitDict):
+    speed: float
+    units_speed: str
+    track: Optional[float]
+
+
+class VehicleTelemetry(ImplicitDict):
+    time_measured: Time
+    position: Optional[Position]
+    velocity: Optional[Velocity]
+
+
+class GetOperationalIntentTelemetryResponse(ImplicitDict):
+    operational_intent_id: str
+    telemetry: Optional[VehicleTelemetry]
+    next_telemetry_opportunity: Optional[Time]
+
+
 class PutOperationalIntentDetailsParameters(ImplicitDict):
     operational_intent_id: str
     operational_intent: Optional[OperationalIntent]
@@ -0,0 +1,129 @@
+"""Watch of the changes of references in an area:
+
+  - find the latest change in the area
+  - create, mutate and delete a Constraint
+  - watch the change of each step
+  - wait for changes when there are none
+  - error responses
+"""
+
+import datetime
+import time
+
+from monitoring.monitorlib.infrastructure import default_scope
+from monitoring.monitorlib import scd
+from monitoring.monitorlib.scd import SCOPE_CM, SCOPE_AA
+from monitoring.prober.infrastructure import depends_on, register_resource_type
+from monitoring.prober.scd import actions
+
+import pytest
+
+
+BASE_URL = 'https://example.com/uss'
+CONSTRAINT_TYPE = register_resource_type(372, 'Watched constraint')
+
+LAT, LNG = -23.5, 154.2
+AREA = 'circle:{},{},300'.format(LAT, LNG)
+
+# Cursor of the latest change seen in AREA
+_cursor = ''
+
+
+def _make_c1_request():
+  time_start = datetime.datetime.utcnow()
+  time_end = time_start + datetime.timedelta(minutes=60)
+  return {
+    'extents': [scd.make_vol4(time_start, time_end, 0, 120, scd.make_circle(LAT, LNG, 50))],
+    'uss_base_url': BASE_URL,
+  }
+
+
+def _watch(aux_scd_session, wait_seconds=0):
+  resp = aux_scd_session.get('/scd/changes?area={}&cursor={}&wait_seconds={}'.format(AREA, _cursor, wait_seconds))
+  assert resp.status_code == 200, resp.content
+  return resp.json()
+
+
+def _expect_change(ids, aux_scd_session, change):
+  global _cursor
+  data = _watch(aux_scd_session, wait_seconds=5)
+  changes = [(c['entity_id'], c['change']) for c in data.get('changes', [])]
+  assert (ids(CONSTRAINT_TYPE), change) in changes, data
+  _cursor = data['cursor']
+
+
+def test_ensure_clean_workspace(ids, scd_api, scd_session, scd_session_cm):
+  if not scd_session_cm:
+    pytest.skip('SCD auth1 not enabled for constraint management')
+  actions.delete_constraint_reference_if_exists(ids(CONSTRAINT_TYPE), scd_session, scd_api)
+
+
+@default_scope(SCOPE_CM)
+@depends_on(test_ensure_clean_workspace)
+def test_latest_change(aux_scd_session):
+  global _cursor
+  while True:
+    data = _watch(aux_scd_session)
+    if not data.get('changes', []):
+      break
+    _cursor = data['cursor']
+
+
+@default_scope(SCOPE_CM)
+@depends_on(test_latest_change)
+def test_watch_creation(ids, scd_session, aux_scd_session):
+  resp = scd_session.put('/constraint_references/{}'.format(ids(CONSTRAINT_TYPE)), json=_make_c1_request())
+  assert resp.status_code == 200, resp.content
+  _expect_change(ids, aux_scd_session, 'Created')
+
+
+@default_scope(SCOPE_CM)
+@depends_on(test_watch_creation)
+def test_watch_mutation(ids, scd_session, aux_scd_session):
+  resp = scd_session.get('/constraint_references/{}'.format(ids(CONSTRAINT_TYPE)))
+  assert resp.status_code == 200, resp.content
+  ovn = resp.json()['constraint_reference']['ovn']
+
+  resp = scd_session.put('/constraint_references/{}/{}'.format(ids(CONSTRAINT_TYPE), ovn), json=_make_c1_request())
+  assert resp.status_code == 200, resp.content
+  _expect_change(ids, aux_scd_session, 'Updated')
+
+
+@default_scope(SCOPE_CM)
+@depends_on(test_watch_mutation)
+def test_watch_deletion(ids, scd_api, scd_session, aux_scd_session):
+  actions.delete_constraint_reference_if_exists(ids(CONSTRAINT_TYPE), scd_session, scd_api)
+  _expect_change(ids, aux_scd_session, 'Deleted')
+
+
+@default_scope(SCOPE_CM)
+@depends_on(test_watch_deletion)
+def test_wait_without_changes(aux_scd_session):
+  t0 = time.monotonic()
+  data = _watch(aux_scd_session, wait_seconds=2)
+  if data.get('changes', []):
+    # Another client changed a reference in the area meanwhile
+    return
+  assert time.monotonic() - t0 >= 2
+  assert data['cursor'] == _cursor
+
+
+@default_scope(SCOPE_CM)
+def test_watch_invalid_requests(aux_scd_session):
+  resp = aux_scd_session.get('/scd/changes')
+  assert resp.status_code == 400, resp.content
+
+  resp = aux_scd_session.get('/scd/changes?area={}&cursor=not_a_cursor'.format(AREA))
+  assert resp.status_code == 400, resp.content
+
+  resp = aux_scd_session.get('/scd/changes?area={}&wait_seconds=-1'.format(AREA))
+  assert resp.status_code == 400, resp.content
+
+
+def test_watch_wrong_scope(aux_scd_session):
+  resp = aux_scd_session.get('/scd/changes?area={}'.format(AREA), scope=SCOPE_AA)
+  assert resp.status_code == 403, resp.content
+
+
+def test_final_cleanup(ids, scd_api, scd_session, scd_session_cm):
+  test_ensure_clean_workspace(ids, scd_api, scd_session, scd_session_cm)
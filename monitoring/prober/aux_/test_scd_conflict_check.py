@@ -0,0 +1,89 @@
+"""Pre-flight conflict check tests:
+
+  - create a Constraint
+  - check volumes overlapping the Constraint
+  - check volumes away from the Constraint
+  - check volumes as another USS
+  - error responses
+  - delete the Constraint
+"""
+
+import datetime
+
+from monitoring.monitorlib.infrastructure import default_scope
+from monitoring.monitorlib import scd
+from monitoring.monitorlib.scd import SCOPE_SC, SCOPE_CM, SCOPE_CP
+from monitoring.prober.infrastructure import depends_on, register_resource_type
+from monitoring.prober.scd import actions
+
+import pytest
+
+
+BASE_URL = 'https://example.com/uss'
+CONSTRAINT_TYPE = register_resource_type(367, 'Constraint in conflict with checked volumes')
+
+
+def _make_extents(lat=-23.5, lng=151.2, radius=50):
+  time_start = datetime.datetime.utcnow()
+  time_end = time_start + datetime.timedelta(minutes=60)
+  return [scd.make_vol4(time_start, time_end, 0, 120, scd.make_circle(lat, lng, radius))]
+
+
+def test_ensure_clean_workspace(ids, scd_api, scd_session, scd_session_cm):
+  if not scd_session_cm:
+    pytest.skip('SCD auth1 not enabled for constraint management')
+  actions.delete_constraint_reference_if_exists(ids(CONSTRAINT_TYPE), scd_session, scd_api)
+
+
+@default_scope(SCOPE_CM)
+@depends_on(test_ensure_clean_workspace)
+def test_create_constraint(ids, scd_session):
+  resp = scd_session.put('/constraint_references/{}'.format(ids(CONSTRAINT_TYPE)), json={
+    'extents': _make_extents(),
+    'uss_base_url': BASE_URL,
+  })
+  assert resp.status_code == 200, resp.content
+
+
+@default_scope(SCOPE_SC)
+@depends_on(test_create_constraint)
+def test_check_overlapping(ids, aux_scd_session):
+  resp = aux_scd_session.post('/scd/conflict_check', json={'extents': _make_extents(radius=300)})
+  assert resp.status_code == 200, resp.content
+  constraints = {c['id']: c for c in resp.json().get('constraint_references', [])}
+  assert ids(CONSTRAINT_TYPE) in constraints
+  # The caller manages the Constraint, so its OVN is disclosed
+  assert constraints[ids(CONSTRAINT_TYPE)]['ovn'] not in scd.NO_OVN_PHRASES
+
+
+@default_scope(SCOPE_SC)
+@depends_on(test_create_constraint)
+def test_check_elsewhere(ids, aux_scd_session):
+  resp = aux_scd_session.post('/scd/conflict_check', json={'extents': _make_extents(lat=-24.5)})
+  assert resp.status_code == 200, resp.content
+  assert ids(CONSTRAINT_TYPE) not in [c['id'] for c in resp.json().get('constraint_references', [])]
+
+
+@default_scope(SCOPE_SC)
+@depends_on(test_create_constraint)
+def test_check_as_other_uss(ids, aux_scd_session2):
+  resp = aux_scd_session2.post('/scd/conflict_check', json={'extents': _make_extents(radius=300)})
+  assert resp.status_code == 200, resp.content
+  constraints = {c['id']: c for c in resp.json().get('constraint_references', [])}
+  assert ids(CONSTRAINT_TYPE) in constraints
+  assert constraints[ids(CONSTRAINT_TYPE)].get('ovn', '') in scd.NO_OVN_PHRASES
+
+
+@default_scope(SCOPE_SC)
+def test_check_missing_extents(aux_scd_session):
+  resp = aux_scd_session.post('/scd/conflict_check', json={})
+  assert resp.status_code == 400, resp.content
+
+
+def test_check_wrong_scope(aux_scd_session):
+  resp = aux_scd_session.post('/scd/conflict_check', json={'extents': _make_extents()}, scope=SCOPE_CP)
+  assert resp.status_code == 403, resp.content
+
+
+def test_final_cleanup(ids, scd_api, scd_session, scd_session_cm):
+  test_ensure_clean_workspace(ids, scd_api, scd_session, scd_session_cm)
@@ -0,0 +1,97 @@
+"""Consistency check of the references stored in an area:
+
+  - create a Constraint
+  - check that the key of the area includes its current OVN
+  - check that the key of the area follows its mutation
+  - error responses
+  - delete the Constraint
+"""
+
+import datetime
+
+from monitoring.monitorlib.infrastructure import default_scope
+from monitoring.monitorlib import scd
+from monitoring.monitorlib.scd import SCOPE_CM, SCOPE_SC
+from monitoring.prober.infrastructure import depends_on, register_resource_type
+from monitoring.prober.scd import actions
+
+import pytest
+
+
+SCOPE_ADMIN = 'dss.admin'
+
+BASE_URL = 'https://example.com/uss'
+CONSTRAINT_TYPE = register_resource_type(373, 'Constraint in the checked area')
+
+LAT, LNG = -23.5, 155.2
+AREA = 'circle:{},{},300'.format(LAT, LNG)
+
+
+def _make_c1_request():
+  time_start = datetime.datetime.utcnow()
+  time_end = time_start + datetime.timedelta(minutes=60)
+  return {
+    'extents': [scd.make_vol4(time_start, time_end, 0, 120, scd.make_circle(LAT, LNG, 50))],
+    'uss_base_url': BASE_URL,
+  }
+
+
+def _current_ovn(id, scd_session):
+  resp = scd_session.get('/constraint_references/{}'.format(id), scope=SCOPE_CM)
+  assert resp.status_code == 200, resp.content
+  return resp.json()['constraint_reference']['ovn']
+
+
+def _check(id, ovn, aux_scd_session):
+  resp = aux_scd_session.get('/scd/consistency_check?area={}'.format(AREA))
+  assert resp.status_code == 200, resp.content
+  data = resp.json()
+  key = {k['entity_id']: k for k in data.get('key', [])}
+  assert id in key, resp.content
+  assert key[id]['ovn'] == ovn
+  assert key[id]['entity_type'] == 'Constraint'
+  assert id not in [i['entity_id'] for i in data.get('inconsistencies', [])], resp.content
+
+
+def test_ensure_clean_workspace(ids, scd_api, scd_session, scd_session_cm):
+  if not scd_session_cm:
+    pytest.skip('SCD auth1 not enabled for constraint management')
+  actions.delete_constraint_reference_if_exists(ids(CONSTRAINT_TYPE), scd_session, scd_api)
+
+
+@default_scope(SCOPE_CM)
+@depends_on(test_ensure_clean_workspace)
+def test_create_constraint(ids, scd_session):
+  resp = scd_session.put('/constraint_references/{}'.format(ids(CONSTRAINT_TYPE)), json=_make_c1_request())
+  assert resp.status_code == 200, resp.content
+
+
+@default_scope(SCOPE_ADMIN)
+@depends_on(test_create_constraint)
+def test_check_area(ids, scd_session, aux_scd_session):
+  id = ids(CONSTRAINT_TYPE)
+  _check(id, _current_ovn(id, scd_session), aux_scd_session)
+
+
+@default_scope(SCOPE_ADMIN)
+@depends_on(test_check_area)
+def test_check_area_after_mutation(ids, scd_session, aux_scd_session):
+  id = ids(CONSTRAINT_TYPE)
+  resp = scd_session.put('/constraint_references/{}/{}'.format(id, _current_ovn(id, scd_session)), json=_make_c1_request(), scope=SCOPE_CM)
+  assert resp.status_code == 200, resp.content
+  _check(id, resp.json()['constraint_reference']['ovn'], aux_scd_session)
+
+
+@default_scope(SCOPE_ADMIN)
+def test_check_missing_area(aux_scd_session):
+  resp = aux_scd_session.get('/scd/consistency_check')
+  assert resp.status_code == 400, resp.content
+
+
+def test_check_without_admin_scope(aux_scd_session):
+  resp = aux_scd_session.get('/scd/consistency_check?area={}'.format(AREA), scope=SCOPE_SC)
+  assert resp.status_code == 403, resp.content
+
+
+def test_final_cleanup(ids, scd_api, scd_session, scd_session_cm):
+  test_ensure_clean_workspace(ids, scd_api, scd_session, scd_session_cm)
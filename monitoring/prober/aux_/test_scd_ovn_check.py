@@ -0,0 +1,98 @@
+"""OVN verification tests:
+
+  - create a Constraint
+  - verify its current OVN
+  - verify a stale OVN and the OVN of a missing reference
+  - error responses
+  - delete the Constraint
+"""
+
+import datetime
+
+from monitoring.monitorlib.infrastructure import default_scope
+from monitoring.monitorlib import scd
+from monitoring.monitorlib.scd import SCOPE_SC, SCOPE_CM, SCOPE_CP
+from monitoring.prober.infrastructure import depends_on, register_resource_type
+from monitoring.prober.scd import actions
+
+import pytest
+
+
+BASE_URL = 'https://example.com/uss'
+CONSTRAINT_TYPE = register_resource_type(368, 'Constraint whose OVN is verified')
+MISSING_TYPE = register_resource_type(369, 'Reference never created')
+
+
+def _make_c1_request():
+  time_start = datetime.datetime.utcnow()
+  time_end = time_start + datetime.timedelta(minutes=60)
+  return {
+    'extents': [scd.make_vol4(time_start, time_end, 0, 120, scd.make_circle(-23.5, 152.2, 50))],
+    'uss_base_url': BASE_URL,
+  }
+
+
+def _current_ovn(id, scd_session):
+  resp = scd_session.get('/constraint_references/{}'.format(id), scope=SCOPE_CM)
+  assert resp.status_code == 200, resp.content
+  return resp.json()['constraint_reference']['ovn']
+
+
+def test_ensure_clean_workspace(ids, scd_api, scd_session, scd_session_cm):
+  if not scd_session_cm:
+    pytest.skip('SCD auth1 not enabled for constraint management')
+  actions.delete_constraint_reference_if_exists(ids(CONSTRAINT_TYPE), scd_session, scd_api)
+
+
+@default_scope(SCOPE_CM)
+@depends_on(test_ensure_clean_workspace)
+def test_create_constraint(ids, scd_session):
+  resp = scd_session.put('/constraint_references/{}'.format(ids(CONSTRAINT_TYPE)), json=_make_c1_request())
+  assert resp.status_code == 200, resp.content
+
+
+@default_scope(SCOPE_SC)
+@depends_on(test_create_constraint)
+def test_current_ovn(ids, scd_session, aux_scd_session):
+  resp = aux_scd_session.post('/scd/ovn_check', json={'entities': [
+    {'entity_id': ids(CONSTRAINT_TYPE), 'ovn': _current_ovn(ids(CONSTRAINT_TYPE), scd_session)},
+  ]})
+  assert resp.status_code == 200, resp.content
+  assert not resp.json().get('stale', [])
+
+
+@default_scope(SCOPE_SC)
+@depends_on(test_create_constraint)
+def test_stale_ovns(ids, scd_session, aux_scd_session):
+  resp = aux_scd_session.post('/scd/ovn_check', json={'entities': [
+    {'entity_id': ids(CONSTRAINT_TYPE), 'ovn': _current_ovn(ids(CONSTRAINT_TYPE), scd_session)},
+    {'entity_id': ids(CONSTRAINT_TYPE), 'ovn': 'stale_ovn'},
+    {'entity_id': ids(MISSING_TYPE), 'ovn': 'any_ovn'},
+  ]})
+  assert resp.status_code == 200, resp.content
+  stale = resp.json().get('stale', [])
+  assert [(s['entity_id'], s['ovn']) for s in stale] == [
+    (ids(CONSTRAINT_TYPE), 'stale_ovn'),
+    (ids(MISSING_TYPE), 'any_ovn'),
+  ], resp.content
+  assert stale[0]['entity_type'] == 'Constraint'
+  assert not stale[1].get('entity_type', '')
+
+
+@default_scope(SCOPE_SC)
+def test_invalid_entity_id(aux_scd_session):
+  resp = aux_scd_session.post('/scd/ovn_check', json={'entities': [
+    {'entity_id': 'not_a_uuid', 'ovn': 'any_ovn'},
+  ]})
+  assert resp.status_code == 400, resp.content
+
+
+def test_wrong_scope(ids, aux_scd_session):
+  resp = aux_scd_session.post('/scd/ovn_check', json={'entities': [
+    {'entity_id': ids(MISSING_TYPE), 'ovn': 'any_ovn'},
+  ]}, scope=SCOPE_CP)
+  assert resp.status_code == 403, resp.content
+
+
+def test_final_cleanup(ids, scd_api, scd_session, scd_session_cm):
+  test_ensure_clean_workspace(ids, scd_api, scd_session, scd_session_cm)
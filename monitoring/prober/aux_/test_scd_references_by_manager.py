@@ -0,0 +1,110 @@
+"""Listing of the references managed by a USS:
+
+  - create two Constraints
+  - list them one page at a time
+  - list operational intent references
+  - error responses
+  - delete the Constraints
+"""
+
+import datetime
+
+from monitoring.monitorlib.infrastructure import default_scope
+from monitoring.monitorlib import scd
+from monitoring.monitorlib.scd import SCOPE_CM
+from monitoring.prober.infrastructure import depends_on, register_resource_type
+from monitoring.prober.scd import actions
+
+import pytest
+
+
+SCOPE_ADMIN = 'dss.admin'
+
+BASE_URL = 'https://example.com/uss'
+CONSTRAINT_TYPES = [register_resource_type(370 + i, 'Listed constraint {}'.format(i)) for i in range(2)]
+
+
+def _make_c1_request():
+  time_start = datetime.datetime.utcnow()
+  time_end = time_start + datetime.timedelta(minutes=60)
+  return {
+    'extents': [scd.make_vol4(time_start, time_end, 0, 120, scd.make_circle(-23.5, 153.2, 50))],
+    'uss_base_url': BASE_URL,
+  }
+
+
+def _manager(ids, scd_session):
+  resp = scd_session.get('/constraint_references/{}'.format(ids(CONSTRAINT_TYPES[0])), scope=SCOPE_CM)
+  assert resp.status_code == 200, resp.content
+  return resp.json()['constraint_reference']['manager']
+
+
+def test_ensure_clean_workspace(ids, scd_api, scd_session, scd_session_cm):
+  if not scd_session_cm:
+    pytest.skip('SCD auth1 not enabled for constraint management')
+  for constraint_type in CONSTRAINT_TYPES:
+    actions.delete_constraint_reference_if_exists(ids(constraint_type), scd_session, scd_api)
+
+
+@default_scope(SCOPE_CM)
+@depends_on(test_ensure_clean_workspace)
+def test_create_constraints(ids, scd_session):
+  for constraint_type in CONSTRAINT_TYPES:
+    resp = scd_session.put('/constraint_references/{}'.format(ids(constraint_type)), json=_make_c1_request())
+    assert resp.status_code == 200, resp.content
+
+
+@default_scope(SCOPE_ADMIN)
+@depends_on(test_create_constraints)
+def test_list_constraints_by_page(ids, scd_session, aux_scd_session):
+  url = '/scd/managers/{}/constraint_references'.format(_manager(ids, scd_session))
+
+  resp = aux_scd_session.get('{}?page_size=1'.format(url))
+  assert resp.status_code == 200, resp.content
+  listed = [c['id'] for c in resp.json().get('constraint_references', [])]
+  assert len(listed) == 1, resp.content
+  page_token = resp.json().get('next_page_token', '')
+  assert page_token, resp.content
+
+  # The remaining pages list the other references, in EntityID order
+  while page_token:
+    resp = aux_scd_session.get('{}?page_size=1000&page_token={}'.format(url, page_token))
+    assert resp.status_code == 200, resp.content
+    page = [c['id'] for c in resp.json().get('constraint_references', [])]
+    assert page and page[0] > listed[-1], resp.content
+    listed.extend(page)
+    page_token = resp.json().get('next_page_token', '')
+  for constraint_type in CONSTRAINT_TYPES:
+    assert ids(constraint_type) in listed
+  assert listed == sorted(listed)
+
+
+@default_scope(SCOPE_ADMIN)
+@depends_on(test_create_constraints)
+def test_list_operational_intents(ids, scd_session, aux_scd_session):
+  resp = aux_scd_session.get('/scd/managers/{}/operational_intent_references'.format(_manager(ids, scd_session)))
+  assert resp.status_code == 200, resp.content
+  listed = [op['id'] for op in resp.json().get('operational_intent_references', [])]
+  for constraint_type in CONSTRAINT_TYPES:
+    assert ids(constraint_type) not in listed
+
+
+@default_scope(SCOPE_ADMIN)
+def test_list_invalid_page(aux_scd_session):
+  resp = aux_scd_session.get('/scd/managers/uss1/constraint_references?page_size=-1')
+  assert resp.status_code == 400, resp.content
+
+  resp = aux_scd_session.get('/scd/managers/uss1/constraint_references?page_token=not_a_uuid')
+  assert resp.status_code == 400, resp.content
+
+
+def test_list_without_admin_scope(aux_scd_session):
+  resp = aux_scd_session.get('/scd/managers/uss1/constraint_references', scope=SCOPE_CM)
+  assert resp.status_code == 403, resp.content
+
+  resp = aux_scd_session.get('/scd/managers/uss1/operational_intent_references', scope=SCOPE_CM)
+  assert resp.status_code == 403, resp.content
+
+
+def test_final_cleanup(ids, scd_api, scd_session, scd_session_cm):
+  test_ensure_clean_workspace(ids, scd_api, scd_session, scd_session_cm)
@@ -145,6 +145,18 @@ def aux_session(pytestconfig) -> UTMClientSession:
   return make_session(pytestconfig, BASE_URL_AUX, OPT_RID_AUTH)
 
 
+@pytest.fixture(scope='session')
+def aux_scd_session(pytestconfig) -> UTMClientSession:
+  """Session for the aux endpoints of the DSS as the SCD auth1 user"""
+  return make_session(pytestconfig, BASE_URL_AUX, OPT_SCD_AUTH1)
+
+
+@pytest.fixture(scope='session')
+def aux_scd_session2(pytestconfig) -> UTMClientSession:
+  """Session for the aux endpoints of the DSS as the SCD auth2 user"""
+  return make_session(pytestconfig, BASE_URL_AUX, OPT_SCD_AUTH2)
+
+
 @pytest.fixture(scope='session')
 def scd_session(pytestconfig) -> UTMClientSession:
   return make_session(pytestconfig, BASE_URL_SCD, OPT_SCD_AUTH1)
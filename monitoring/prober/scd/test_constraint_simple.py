@@ -4,6 +4,7 @@
   - create the Constraint with a 60 minute length
   - get by ID
   - search with earliest_time and latest_time
+  - search with altitudes
   - mutate
   - delete
 """
@@ -120,6 +121,14 @@ def test_create_constraint(ids, scd_api, scd_session):
   assert constraint['version'] == 1
 
 
+@for_api_versions(scd.API_0_3_17)
+@default_scope(SCOPE_CM)
+@depends_on(test_create_constraint)
+def test_create_existing_constraint_without_ovn(ids, scd_api, scd_session):
+  resp = scd_session.put('/constraint_references/{}'.format(ids(CONSTRAINT_TYPE)), json=_make_c1_request())
+  assert resp.status_code == 409, resp.content
+
+
 @for_api_versions(scd.API_0_3_17)
 @depends_on(test_create_constraint)
 def test_get_constraint_by_id(ids, scd_api, scd_session):
@@ -208,6 +217,28 @@ def test_get_constraint_by_search_latest_time_excluded(ids, scd_api, scd_session
   assert ids(CONSTRAINT_TYPE) not in [x['id'] for x in resp.json()['constraint_references']]
 
 
+@for_api_versions(scd.API_0_3_17)
+@default_scope(SCOPE_CM)
+@depends_on(test_create_constraint)
+def test_get_constraint_by_search_altitude_included(ids, scd_api, scd_session):
+  resp = scd_session.post('/constraint_references/query', json={
+    'area_of_interest': scd.make_vol4(None, None, 100, 5000, scd.make_circle(-56, 178, 300))
+  })
+  assert resp.status_code == 200, resp.content
+  assert ids(CONSTRAINT_TYPE) in [x['id'] for x in resp.json()['constraint_references']]
+
+
+@for_api_versions(scd.API_0_3_17)
+@default_scope(SCOPE_CM)
+@depends_on(test_create_constraint)
+def test_get_constraint_by_search_altitude_excluded(ids, scd_api, scd_session):
+  resp = scd_session.post('/constraint_references/query', json={
+    'area_of_interest': scd.make_vol4(None, None, 200, 5000, scd.make_circle(-56, 178, 300))
+  })
+  assert resp.status_code == 200, resp.content
+  assert ids(CONSTRAINT_TYPE) not in [x['id'] for x in resp.json()['constraint_references']]
+
+
 @for_api_versions(scd.API_0_3_17)
 @depends_on(test_create_constraint)
 def test_mutate_constraint(ids, scd_api, scd_session):
@@ -251,6 +282,18 @@ def test_mutate_constraint(ids, scd_api, scd_session):
   assert constraint['version'] == 2
 
 
+@for_api_versions(scd.API_0_3_17)
+@default_scope(SCOPE_CM)
+@depends_on(test_mutate_constraint)
+def test_delete_constraint_wrong_ovn(ids, scd_api, scd_session):
+  id = ids(CONSTRAINT_TYPE)
+  resp = scd_session.delete('/constraint_references/{}/{}'.format(id, 'wrong_ovn'))
+  assert resp.status_code == 409, resp.content
+
+  resp = scd_session.get('/constraint_references/{}'.format(id))
+  assert resp.status_code == 200, resp.content
+
+
 @for_api_versions(scd.API_0_3_17)
 @depends_on(test_mutate_constraint)
 def test_delete_constraint(ids, scd_api, scd_session):
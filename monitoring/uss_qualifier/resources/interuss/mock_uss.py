@@ -28,6 +28,14 @@ class MockUSSClient(object):
         resp = self.session.get("/scdsc/v1/status", scope=SCOPE_SCD_QUALIFIER_INJECT)
         return fetch.describe_query(resp, initiated_at)
 
+    def get_capabilities(self) -> fetch.Query:
+        """Retrieve the features, versions and configuration hash of the mock USS"""
+        initiated_at = arrow.utcnow().datetime
+        resp = self.session.get(
+            "/mock_uss/capabilities", scope=SCOPE_SCD_QUALIFIER_INJECT
+        )
+        return fetch.describe_query(resp, initiated_at)
+
     # TODO: Add other methods to interact with the mock USS in other ways (like starting/stopping message signing data collection)
 
 
@@ -9,7 +9,10 @@ package auxpb
 import (
 	context "context"
 	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	scdpb "github.com/interuss/dss/pkg/api/v1/scdpb"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -166,17 +169,14 @@ func (x *GetVersionResponse) GetVersion() *Version {
 	return nil
 }
 
-type ValidateOauthRequest struct {
+type GetStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Validate the owner claim matches the provided owner.
-	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
-func (x *ValidateOauthRequest) Reset() {
-	*x = ValidateOauthRequest{}
+func (x *GetStatusRequest) Reset() {
+	*x = GetStatusRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -184,13 +184,13 @@ func (x *ValidateOauthRequest) Reset() {
 	}
 }
 
-func (x *ValidateOauthRequest) String() string {
+func (x *GetStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidateOauthRequest) ProtoMessage() {}
+func (*GetStatusRequest) ProtoMessage() {}
 
-func (x *ValidateOauthRequest) ProtoReflect() protoreflect.Message {
+func (x *GetStatusRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -202,27 +202,34 @@ func (x *ValidateOauthRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidateOauthRequest.ProtoReflect.Descriptor instead.
-func (*ValidateOauthRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetStatusRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ValidateOauthRequest) GetOwner() string {
-	if x != nil {
-		return x.Owner
-	}
-	return ""
-}
-
-// Validate Oauth token response
-type ValidateOauthResponse struct {
+// SchemaCompatibility pairs the schema version of a database with the schema
+// versions supported by the DSS.
+type SchemaCompatibility struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Name of the database.
+	Database string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	// Schema version of the database; empty when it could not be read.
+	SchemaVersion string `protobuf:"bytes,2,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	// Oldest schema version supported by the DSS.
+	MinimumSchemaVersion string `protobuf:"bytes,3,opt,name=minimum_schema_version,json=minimumSchemaVersion,proto3" json:"minimum_schema_version,omitempty"`
+	// Latest schema version supported by the DSS.
+	MaximumSchemaVersion string `protobuf:"bytes,4,opt,name=maximum_schema_version,json=maximumSchemaVersion,proto3" json:"maximum_schema_version,omitempty"`
+	// Whether the schema version of the database is supported by the DSS.
+	Compatible bool `protobuf:"varint,5,opt,name=compatible,proto3" json:"compatible,omitempty"`
+	// Why the schema version of the database could not be read.
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *ValidateOauthResponse) Reset() {
-	*x = ValidateOauthResponse{}
+func (x *SchemaCompatibility) Reset() {
+	*x = SchemaCompatibility{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -230,13 +237,13 @@ func (x *ValidateOauthResponse) Reset() {
 	}
 }
 
-func (x *ValidateOauthResponse) String() string {
+func (x *SchemaCompatibility) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidateOauthResponse) ProtoMessage() {}
+func (*SchemaCompatibility) ProtoMessage() {}
 
-func (x *ValidateOauthResponse) ProtoReflect() protoreflect.Message {
+func (x *SchemaCompatibility) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -248,36 +255,71 @@ func (x *ValidateOauthResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidateOauthResponse.ProtoReflect.Descriptor instead.
-func (*ValidateOauthResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SchemaCompatibility.ProtoReflect.Descriptor instead.
+func (*SchemaCompatibility) Descriptor() ([]byte, []int) {
 	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{4}
 }
 
-// Error response format for most errors
-type StandardErrorResponse struct {
+func (x *SchemaCompatibility) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *SchemaCompatibility) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+func (x *SchemaCompatibility) GetMinimumSchemaVersion() string {
+	if x != nil {
+		return x.MinimumSchemaVersion
+	}
+	return ""
+}
+
+func (x *SchemaCompatibility) GetMaximumSchemaVersion() string {
+	if x != nil {
+		return x.MaximumSchemaVersion
+	}
+	return ""
+}
+
+func (x *SchemaCompatibility) GetCompatible() bool {
+	if x != nil {
+		return x.Compatible
+	}
+	return false
+}
+
+func (x *SchemaCompatibility) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// HealthCheck is the result of a check of a dependency of the DSS.
+type HealthCheck struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Human-readable error message; should be identical to `message` content.
-	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
-	// Code for error category; uses standard gRPC response codes*, plus some
-	// additional custom codes defined for this project (see
-	// pkg/errors/errors.go).
-	//
-	// *https://developers.google.com/maps-booking/reference/grpc-api/status_codes
-	Code int32 `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
-	// Human-readable error message.  Required by ASTM standards' APIs in most
-	// error responses.
-	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	// ID of error in the format E:<UUID> which allows fast lookups in the DSS
-	// logs to relate a client's observed error response from the DSS to the
-	// detailed logs related to that error in the internal DSS logs.
-	ErrorId string `protobuf:"bytes,4,opt,name=error_id,json=errorId,proto3" json:"error_id,omitempty"`
+	// Name of the check, e.g. datastore:rid.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether the check passed.
+	Healthy bool `protobuf:"varint,2,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	// Why the check failed.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// Duration of the check, in milliseconds.
+	DurationMs int64 `protobuf:"varint,4,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
 }
 
-func (x *StandardErrorResponse) Reset() {
-	*x = StandardErrorResponse{}
+func (x *HealthCheck) Reset() {
+	*x = HealthCheck{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -285,13 +327,13 @@ func (x *StandardErrorResponse) Reset() {
 	}
 }
 
-func (x *StandardErrorResponse) String() string {
+func (x *HealthCheck) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StandardErrorResponse) ProtoMessage() {}
+func (*HealthCheck) ProtoMessage() {}
 
-func (x *StandardErrorResponse) ProtoReflect() protoreflect.Message {
+func (x *HealthCheck) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -303,324 +345,5322 @@ func (x *StandardErrorResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StandardErrorResponse.ProtoReflect.Descriptor instead.
-func (*StandardErrorResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use HealthCheck.ProtoReflect.Descriptor instead.
+func (*HealthCheck) Descriptor() ([]byte, []int) {
 	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *StandardErrorResponse) GetError() string {
+func (x *HealthCheck) GetName() string {
 	if x != nil {
-		return x.Error
+		return x.Name
 	}
 	return ""
 }
 
-func (x *StandardErrorResponse) GetCode() int32 {
+func (x *HealthCheck) GetHealthy() bool {
 	if x != nil {
-		return x.Code
+		return x.Healthy
 	}
-	return 0
+	return false
 }
 
-func (x *StandardErrorResponse) GetMessage() string {
+func (x *HealthCheck) GetError() string {
 	if x != nil {
-		return x.Message
+		return x.Error
 	}
 	return ""
 }
 
-func (x *StandardErrorResponse) GetErrorId() string {
+func (x *HealthCheck) GetDurationMs() int64 {
 	if x != nil {
-		return x.ErrorId
+		return x.DurationMs
 	}
-	return ""
+	return 0
 }
 
-var File_pkg_api_v1_auxpb_aux_service_proto protoreflect.FileDescriptor
+type GetStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_pkg_api_v1_auxpb_aux_service_proto_rawDesc = []byte{
-	0x0a, 0x22, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x78,
-	0x70, 0x62, 0x2f, 0x61, 0x75, 0x78, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x61, 0x75, 0x78, 0x70, 0x62, 0x1a, 0x1c, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x26, 0x0a, 0x07, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x73, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x2c, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x22, 0x17, 0x0a, 0x15, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x4f, 0x61, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x76, 0x0a,
-	0x15, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04,
-	0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x49, 0x64, 0x32, 0xd7, 0x01, 0x0a, 0x0d, 0x44, 0x53, 0x53, 0x41, 0x75, 0x78,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5a, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x47, 0x65,
-	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x19, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x11, 0x12, 0x0f, 0x2f, 0x61, 0x75, 0x78, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x6a, 0x0a, 0x0d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f,
-	0x61, 0x75, 0x74, 0x68, 0x12, 0x1b, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x75, 0x78, 0x2f, 0x76, 0x31,
-	0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x61, 0x75, 0x74, 0x68, 0x42,
-	0x12, 0x5a, 0x10, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75,
-	0x78, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	// The version of the DSS.
+	Version *Version `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Whether the DSS is ready to serve requests, i.e. whether the schemas of
+	// all its databases are supported, all its checks pass and it is not
+	// draining.
+	Ready bool `protobuf:"varint,2,opt,name=ready,proto3" json:"ready,omitempty"`
+	// Compatibility of the schema of each database of the DSS, as of the last
+	// check.
+	Schemas []*SchemaCompatibility `protobuf:"bytes,3,rep,name=schemas,proto3" json:"schemas,omitempty"`
+	// Whether the DSS is shutting down, completing the requests in progress
+	// without expecting new ones.
+	Draining bool `protobuf:"varint,4,opt,name=draining,proto3" json:"draining,omitempty"`
+	// Results of the checks of the dependencies of the DSS, such as the
+	// connectivity of its datastores and the availability of the keys verifying
+	// access tokens, performed for this request.
+	Checks []*HealthCheck `protobuf:"bytes,5,rep,name=checks,proto3" json:"checks,omitempty"`
+	// ID of the DSS instance serving the request in its pool.
+	InstanceId string `protobuf:"bytes,6,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	// The maintenance window of the pool which has not ended yet, if any.
+	Maintenance *MaintenanceWindow `protobuf:"bytes,7,opt,name=maintenance,proto3" json:"maintenance,omitempty"`
 }
 
-var (
-	file_pkg_api_v1_auxpb_aux_service_proto_rawDescOnce sync.Once
-	file_pkg_api_v1_auxpb_aux_service_proto_rawDescData = file_pkg_api_v1_auxpb_aux_service_proto_rawDesc
-)
-
-func file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP() []byte {
-	file_pkg_api_v1_auxpb_aux_service_proto_rawDescOnce.Do(func() {
-		file_pkg_api_v1_auxpb_aux_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_api_v1_auxpb_aux_service_proto_rawDescData)
-	})
-	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescData
+func (x *GetStatusResponse) Reset() {
+	*x = GetStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-var file_pkg_api_v1_auxpb_aux_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
-var file_pkg_api_v1_auxpb_aux_service_proto_goTypes = []interface{}{
-	(*Version)(nil),               // 0: auxpb.Version
-	(*GetVersionRequest)(nil),     // 1: auxpb.GetVersionRequest
-	(*GetVersionResponse)(nil),    // 2: auxpb.GetVersionResponse
-	(*ValidateOauthRequest)(nil),  // 3: auxpb.ValidateOauthRequest
-	(*ValidateOauthResponse)(nil), // 4: auxpb.ValidateOauthResponse
-	(*StandardErrorResponse)(nil), // 5: auxpb.StandardErrorResponse
-}
-var file_pkg_api_v1_auxpb_aux_service_proto_depIdxs = []int32{
-	0, // 0: auxpb.GetVersionResponse.version:type_name -> auxpb.Version
-	1, // 1: auxpb.DSSAuxService.GetVersion:input_type -> auxpb.GetVersionRequest
-	3, // 2: auxpb.DSSAuxService.ValidateOauth:input_type -> auxpb.ValidateOauthRequest
-	2, // 3: auxpb.DSSAuxService.GetVersion:output_type -> auxpb.GetVersionResponse
-	4, // 4: auxpb.DSSAuxService.ValidateOauth:output_type -> auxpb.ValidateOauthResponse
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+func (x *GetStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_pkg_api_v1_auxpb_aux_service_proto_init() }
-func file_pkg_api_v1_auxpb_aux_service_proto_init() {
-	if File_pkg_api_v1_auxpb_aux_service_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Version); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVersionRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVersionResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidateOauthRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidateOauthResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StandardErrorResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+func (*GetStatusResponse) ProtoMessage() {}
+
+func (x *GetStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_pkg_api_v1_auxpb_aux_service_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   6,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_pkg_api_v1_auxpb_aux_service_proto_goTypes,
-		DependencyIndexes: file_pkg_api_v1_auxpb_aux_service_proto_depIdxs,
-		MessageInfos:      file_pkg_api_v1_auxpb_aux_service_proto_msgTypes,
-	}.Build()
-	File_pkg_api_v1_auxpb_aux_service_proto = out.File
-	file_pkg_api_v1_auxpb_aux_service_proto_rawDesc = nil
-	file_pkg_api_v1_auxpb_aux_service_proto_goTypes = nil
-	file_pkg_api_v1_auxpb_aux_service_proto_depIdxs = nil
+	return mi.MessageOf(x)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
-
-// DSSAuxServiceClient is the client API for DSSAuxService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type DSSAuxServiceClient interface {
-	// /dss/version
-	//
-	// Queries the version of the DSS.
-	GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*GetVersionResponse, error)
-	// /dss/validate_oauth
-	//
-	// Validate Oauth token against the DSS.
-	ValidateOauth(ctx context.Context, in *ValidateOauthRequest, opts ...grpc.CallOption) (*ValidateOauthResponse, error)
+// Deprecated: Use GetStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetStatusResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{6}
 }
 
-type dSSAuxServiceClient struct {
-	cc grpc.ClientConnInterface
+func (x *GetStatusResponse) GetVersion() *Version {
+	if x != nil {
+		return x.Version
+	}
+	return nil
 }
 
-func NewDSSAuxServiceClient(cc grpc.ClientConnInterface) DSSAuxServiceClient {
-	return &dSSAuxServiceClient{cc}
+func (x *GetStatusResponse) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
 }
 
-func (c *dSSAuxServiceClient) GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*GetVersionResponse, error) {
-	out := new(GetVersionResponse)
+func (x *GetStatusResponse) GetSchemas() []*SchemaCompatibility {
+	if x != nil {
+		return x.Schemas
+	}
+	return nil
+}
+
+func (x *GetStatusResponse) GetDraining() bool {
+	if x != nil {
+		return x.Draining
+	}
+	return false
+}
+
+func (x *GetStatusResponse) GetChecks() []*HealthCheck {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+func (x *GetStatusResponse) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *GetStatusResponse) GetMaintenance() *MaintenanceWindow {
+	if x != nil {
+		return x.Maintenance
+	}
+	return nil
+}
+
+type ValidateOauthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Validate the owner claim matches the provided owner.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (x *ValidateOauthRequest) Reset() {
+	*x = ValidateOauthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateOauthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateOauthRequest) ProtoMessage() {}
+
+func (x *ValidateOauthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateOauthRequest.ProtoReflect.Descriptor instead.
+func (*ValidateOauthRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ValidateOauthRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+// Validate Oauth token response
+type ValidateOauthResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ValidateOauthResponse) Reset() {
+	*x = ValidateOauthResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateOauthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateOauthResponse) ProtoMessage() {}
+
+func (x *ValidateOauthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateOauthResponse.ProtoReflect.Descriptor instead.
+func (*ValidateOauthResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{8}
+}
+
+type RestoreIdentificationServiceAreaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// EntityUUID of the deleted Identification Service Area to restore.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RestoreIdentificationServiceAreaRequest) Reset() {
+	*x = RestoreIdentificationServiceAreaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreIdentificationServiceAreaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreIdentificationServiceAreaRequest) ProtoMessage() {}
+
+func (x *RestoreIdentificationServiceAreaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreIdentificationServiceAreaRequest.ProtoReflect.Descriptor instead.
+func (*RestoreIdentificationServiceAreaRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RestoreIdentificationServiceAreaRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// State of a remote ID Subscription at the time of a change.
+type RIDSubscriptionState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the Subscription.
+	SubscriptionId string `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	// Notification index of the Subscription after the change.
+	NotificationIndex int32 `protobuf:"varint,2,opt,name=notification_index,json=notificationIndex,proto3" json:"notification_index,omitempty"`
+}
+
+func (x *RIDSubscriptionState) Reset() {
+	*x = RIDSubscriptionState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RIDSubscriptionState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RIDSubscriptionState) ProtoMessage() {}
+
+func (x *RIDSubscriptionState) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RIDSubscriptionState.ProtoReflect.Descriptor instead.
+func (*RIDSubscriptionState) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RIDSubscriptionState) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *RIDSubscriptionState) GetNotificationIndex() int32 {
+	if x != nil {
+		return x.NotificationIndex
+	}
+	return 0
+}
+
+// Subscriber to notify of a change to remote ID data.
+type RIDSubscriberToNotify struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The Subscriptions of this subscriber affected by the change.
+	Subscriptions []*RIDSubscriptionState `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	// The endpoint to which notifications should be sent.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *RIDSubscriberToNotify) Reset() {
+	*x = RIDSubscriberToNotify{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RIDSubscriberToNotify) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RIDSubscriberToNotify) ProtoMessage() {}
+
+func (x *RIDSubscriberToNotify) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RIDSubscriberToNotify.ProtoReflect.Descriptor instead.
+func (*RIDSubscriberToNotify) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RIDSubscriberToNotify) GetSubscriptions() []*RIDSubscriptionState {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+func (x *RIDSubscriberToNotify) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// Response to a request restoring a deleted Identification Service Area.
+type RestoreIdentificationServiceAreaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// EntityUUID of the restored Identification Service Area.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Version of the restored Identification Service Area.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Subscribers to notify of the restoration.
+	Subscribers []*RIDSubscriberToNotify `protobuf:"bytes,3,rep,name=subscribers,proto3" json:"subscribers,omitempty"`
+}
+
+func (x *RestoreIdentificationServiceAreaResponse) Reset() {
+	*x = RestoreIdentificationServiceAreaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreIdentificationServiceAreaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreIdentificationServiceAreaResponse) ProtoMessage() {}
+
+func (x *RestoreIdentificationServiceAreaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreIdentificationServiceAreaResponse.ProtoReflect.Descriptor instead.
+func (*RestoreIdentificationServiceAreaResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RestoreIdentificationServiceAreaResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RestoreIdentificationServiceAreaResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *RestoreIdentificationServiceAreaResponse) GetSubscribers() []*RIDSubscriberToNotify {
+	if x != nil {
+		return x.Subscribers
+	}
+	return nil
+}
+
+type ExportRIDRegionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The area to export, as a polygon 'lat0,lng0,lat1,lng1,...', a circle
+	// 'circle:lat,lng,radius' with the radius in meters, or a GeoJSON Polygon or
+	// MultiPolygon.
+	Area string `protobuf:"bytes,1,opt,name=area,proto3" json:"area,omitempty"`
+	// Whether to also export the Subscriptions in the area.
+	IncludeSubscriptions bool `protobuf:"varint,2,opt,name=include_subscriptions,json=includeSubscriptions,proto3" json:"include_subscriptions,omitempty"`
+	// Format of the export: "ndjson" (the default) for newline-delimited JSON,
+	// or "geojson" for a GeoJSON FeatureCollection with a feature per entity,
+	// outlining the cells covering it.
+	Format string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+}
+
+func (x *ExportRIDRegionRequest) Reset() {
+	*x = ExportRIDRegionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportRIDRegionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRIDRegionRequest) ProtoMessage() {}
+
+func (x *ExportRIDRegionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRIDRegionRequest.ProtoReflect.Descriptor instead.
+func (*ExportRIDRegionRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ExportRIDRegionRequest) GetArea() string {
+	if x != nil {
+		return x.Area
+	}
+	return ""
+}
+
+func (x *ExportRIDRegionRequest) GetIncludeSubscriptions() bool {
+	if x != nil {
+		return x.IncludeSubscriptions
+	}
+	return false
+}
+
+func (x *ExportRIDRegionRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type CheckSCDConflictsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The 4D volumes of the planned operational intent.
+	Extents []*scdpb.Volume4D `protobuf:"bytes,1,rep,name=extents,proto3" json:"extents,omitempty"`
+}
+
+func (x *CheckSCDConflictsRequest) Reset() {
+	*x = CheckSCDConflictsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckSCDConflictsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckSCDConflictsRequest) ProtoMessage() {}
+
+func (x *CheckSCDConflictsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckSCDConflictsRequest.ProtoReflect.Descriptor instead.
+func (*CheckSCDConflictsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CheckSCDConflictsRequest) GetExtents() []*scdpb.Volume4D {
+	if x != nil {
+		return x.Extents
+	}
+	return nil
+}
+
+// Response to a pre-flight conflict check.
+type CheckSCDConflictsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Operational intent references overlapping the checked volumes.  OVNs are
+	// only provided for operational intents managed by the caller.
+	OperationalIntentReferences []*scdpb.OperationalIntentReference `protobuf:"bytes,1,rep,name=operational_intent_references,json=operationalIntentReferences,proto3" json:"operational_intent_references,omitempty"`
+	// Constraint references overlapping the checked volumes.  OVNs are only
+	// provided for constraints managed by the caller.
+	ConstraintReferences []*scdpb.ConstraintReference `protobuf:"bytes,2,rep,name=constraint_references,json=constraintReferences,proto3" json:"constraint_references,omitempty"`
+	// Priority levels of the overlapping operational intents, by EntityID.
+	OperationalIntentPriorities map[string]int32 `protobuf:"bytes,3,rep,name=operational_intent_priorities,json=operationalIntentPriorities,proto3" json:"operational_intent_priorities,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *CheckSCDConflictsResponse) Reset() {
+	*x = CheckSCDConflictsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckSCDConflictsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckSCDConflictsResponse) ProtoMessage() {}
+
+func (x *CheckSCDConflictsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckSCDConflictsResponse.ProtoReflect.Descriptor instead.
+func (*CheckSCDConflictsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CheckSCDConflictsResponse) GetOperationalIntentReferences() []*scdpb.OperationalIntentReference {
+	if x != nil {
+		return x.OperationalIntentReferences
+	}
+	return nil
+}
+
+func (x *CheckSCDConflictsResponse) GetConstraintReferences() []*scdpb.ConstraintReference {
+	if x != nil {
+		return x.ConstraintReferences
+	}
+	return nil
+}
+
+func (x *CheckSCDConflictsResponse) GetOperationalIntentPriorities() map[string]int32 {
+	if x != nil {
+		return x.OperationalIntentPriorities
+	}
+	return nil
+}
+
+// An entity reference and the OVN a client believes to be current.
+type EntityOVN struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// EntityID of the operational intent or constraint reference.
+	EntityId string `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	// OVN of the reference known to the client.
+	Ovn string `protobuf:"bytes,2,opt,name=ovn,proto3" json:"ovn,omitempty"`
+}
+
+func (x *EntityOVN) Reset() {
+	*x = EntityOVN{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntityOVN) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityOVN) ProtoMessage() {}
+
+func (x *EntityOVN) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityOVN.ProtoReflect.Descriptor instead.
+func (*EntityOVN) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *EntityOVN) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *EntityOVN) GetOvn() string {
+	if x != nil {
+		return x.Ovn
+	}
+	return ""
+}
+
+type CheckSCDOVNsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The references and OVNs to verify, typically the key of a failed
+	// operational intent reference change.
+	Entities []*EntityOVN `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty"`
+}
+
+func (x *CheckSCDOVNsRequest) Reset() {
+	*x = CheckSCDOVNsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckSCDOVNsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckSCDOVNsRequest) ProtoMessage() {}
+
+func (x *CheckSCDOVNsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckSCDOVNsRequest.ProtoReflect.Descriptor instead.
+func (*CheckSCDOVNsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CheckSCDOVNsRequest) GetEntities() []*EntityOVN {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+// An entity reference whose supplied OVN is not current.
+type StaleOVN struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// EntityID of the reference.
+	EntityId string `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	// OVN supplied by the client.
+	Ovn string `protobuf:"bytes,2,opt,name=ovn,proto3" json:"ovn,omitempty"`
+	// Type of the reference, "OperationalIntent" or "Constraint", or empty if
+	// no reference with this EntityID exists.
+	EntityType string `protobuf:"bytes,3,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+}
+
+func (x *StaleOVN) Reset() {
+	*x = StaleOVN{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StaleOVN) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaleOVN) ProtoMessage() {}
+
+func (x *StaleOVN) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaleOVN.ProtoReflect.Descriptor instead.
+func (*StaleOVN) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *StaleOVN) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *StaleOVN) GetOvn() string {
+	if x != nil {
+		return x.Ovn
+	}
+	return ""
+}
+
+func (x *StaleOVN) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+// Response to an OVN verification request.
+type CheckSCDOVNsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The supplied references whose OVN is not current, in request order.
+	Stale []*StaleOVN `protobuf:"bytes,1,rep,name=stale,proto3" json:"stale,omitempty"`
+}
+
+func (x *CheckSCDOVNsResponse) Reset() {
+	*x = CheckSCDOVNsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckSCDOVNsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckSCDOVNsResponse) ProtoMessage() {}
+
+func (x *CheckSCDOVNsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckSCDOVNsResponse.ProtoReflect.Descriptor instead.
+func (*CheckSCDOVNsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CheckSCDOVNsResponse) GetStale() []*StaleOVN {
+	if x != nil {
+		return x.Stale
+	}
+	return nil
+}
+
+type ListSCDNotificationDeliveriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// SubscriptionID of the Subscription to which notifications were delivered.
+	SubscriptionId string `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+}
+
+func (x *ListSCDNotificationDeliveriesRequest) Reset() {
+	*x = ListSCDNotificationDeliveriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSCDNotificationDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSCDNotificationDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListSCDNotificationDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSCDNotificationDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListSCDNotificationDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListSCDNotificationDeliveriesRequest) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+// Delivery by the DSS of a notification to a Subscription.
+type NotificationDelivery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// SubscriptionID of the notified Subscription.
+	SubscriptionId string `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	// EntityID of the changed entity.
+	EntityId string `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	// Notification index of the Subscription conveyed by the notification.
+	NotificationIndex int32 `protobuf:"varint,3,opt,name=notification_index,json=notificationIndex,proto3" json:"notification_index,omitempty"`
+	// Endpoint to which the notification was delivered.
+	Url string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	// Outcome of the delivery: "Pending", "Delivered" or "Failed".
+	Status string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	// Number of delivery attempts made so far.
+	Attempts int32 `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// Error encountered by the latest failed attempt, if any.
+	LastError string `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// Time at which the notification was triggered.
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Time of the latest delivery attempt.
+	UpdatedAt *timestamp.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *NotificationDelivery) Reset() {
+	*x = NotificationDelivery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationDelivery) ProtoMessage() {}
+
+func (x *NotificationDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationDelivery.ProtoReflect.Descriptor instead.
+func (*NotificationDelivery) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *NotificationDelivery) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *NotificationDelivery) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *NotificationDelivery) GetNotificationIndex() int32 {
+	if x != nil {
+		return x.NotificationIndex
+	}
+	return 0
+}
+
+func (x *NotificationDelivery) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *NotificationDelivery) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *NotificationDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *NotificationDelivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *NotificationDelivery) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *NotificationDelivery) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Response listing the notifications delivered to a Subscription.
+type ListSCDNotificationDeliveriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Notification deliveries, most recent first.
+	Deliveries []*NotificationDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+}
+
+func (x *ListSCDNotificationDeliveriesResponse) Reset() {
+	*x = ListSCDNotificationDeliveriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSCDNotificationDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSCDNotificationDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListSCDNotificationDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSCDNotificationDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListSCDNotificationDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListSCDNotificationDeliveriesResponse) GetDeliveries() []*NotificationDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+type ListSCDDssReportsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only list the reports submitted by this USS, if specified.
+	Reporter string `protobuf:"bytes,1,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	// Only list the reports submitted at or after this time, if specified.
+	EarliestTime *timestamp.Timestamp `protobuf:"bytes,2,opt,name=earliest_time,json=earliestTime,proto3" json:"earliest_time,omitempty"`
+	// Only list the reports submitted at or before this time, if specified.
+	LatestTime *timestamp.Timestamp `protobuf:"bytes,3,opt,name=latest_time,json=latestTime,proto3" json:"latest_time,omitempty"`
+}
+
+func (x *ListSCDDssReportsRequest) Reset() {
+	*x = ListSCDDssReportsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSCDDssReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSCDDssReportsRequest) ProtoMessage() {}
+
+func (x *ListSCDDssReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSCDDssReportsRequest.ProtoReflect.Descriptor instead.
+func (*ListSCDDssReportsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListSCDDssReportsRequest) GetReporter() string {
+	if x != nil {
+		return x.Reporter
+	}
+	return ""
+}
+
+func (x *ListSCDDssReportsRequest) GetEarliestTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EarliestTime
+	}
+	return nil
+}
+
+func (x *ListSCDDssReportsRequest) GetLatestTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.LatestTime
+	}
+	return nil
+}
+
+// State of a reference held by the DSS when a report was submitted.
+type DssReportRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Type of the reference, "OperationalIntent" or "Constraint".
+	EntityType string `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	// EntityID of the reference.
+	EntityId string `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	// USS managing the reference.
+	Manager string `protobuf:"bytes,3,opt,name=manager,proto3" json:"manager,omitempty"`
+	// Version of the reference.
+	Version int32 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	// OVN of the reference.
+	Ovn string `protobuf:"bytes,5,opt,name=ovn,proto3" json:"ovn,omitempty"`
+	// State of the operational intent, if the reference is one.
+	State string `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (x *DssReportRecord) Reset() {
+	*x = DssReportRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DssReportRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DssReportRecord) ProtoMessage() {}
+
+func (x *DssReportRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DssReportRecord.ProtoReflect.Descriptor instead.
+func (*DssReportRecord) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DssReportRecord) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *DssReportRecord) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *DssReportRecord) GetManager() string {
+	if x != nil {
+		return x.Manager
+	}
+	return ""
+}
+
+func (x *DssReportRecord) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *DssReportRecord) GetOvn() string {
+	if x != nil {
+		return x.Ovn
+	}
+	return ""
+}
+
+func (x *DssReportRecord) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+// A report, submitted by a USS, of a discrepancy with the DSS.
+type DssReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID assigned to the report by the DSS.
+	ReportId string `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	// USS which submitted the report.
+	Reporter string `protobuf:"bytes,2,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	// The reported exchange between the USS and the DSS.
+	Exchange *scdpb.ExchangeRecord `protobuf:"bytes,3,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	// References held by the DSS in the area of the reported exchange when the
+	// report was submitted.
+	DssRecords []*DssReportRecord `protobuf:"bytes,4,rep,name=dss_records,json=dssRecords,proto3" json:"dss_records,omitempty"`
+	// Time at which the report was submitted.
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *DssReport) Reset() {
+	*x = DssReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DssReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DssReport) ProtoMessage() {}
+
+func (x *DssReport) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DssReport.ProtoReflect.Descriptor instead.
+func (*DssReport) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DssReport) GetReportId() string {
+	if x != nil {
+		return x.ReportId
+	}
+	return ""
+}
+
+func (x *DssReport) GetReporter() string {
+	if x != nil {
+		return x.Reporter
+	}
+	return ""
+}
+
+func (x *DssReport) GetExchange() *scdpb.ExchangeRecord {
+	if x != nil {
+		return x.Exchange
+	}
+	return nil
+}
+
+func (x *DssReport) GetDssRecords() []*DssReportRecord {
+	if x != nil {
+		return x.DssRecords
+	}
+	return nil
+}
+
+func (x *DssReport) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Response listing DSS reports.
+type ListSCDDssReportsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// DSS reports, most recent first.
+	Reports []*DssReport `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+}
+
+func (x *ListSCDDssReportsResponse) Reset() {
+	*x = ListSCDDssReportsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSCDDssReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSCDDssReportsResponse) ProtoMessage() {}
+
+func (x *ListSCDDssReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSCDDssReportsResponse.ProtoReflect.Descriptor instead.
+func (*ListSCDDssReportsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListSCDDssReportsResponse) GetReports() []*DssReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+type ListSCDReferencesByManagerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// USS managing the listed references.
+	Manager string `protobuf:"bytes,1,opt,name=manager,proto3" json:"manager,omitempty"`
+	// Maximum number of references to list; defaults to 100 and may not exceed
+	// 1000.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Token of the page to list, from the next_page_token of the previous
+	// page; the first page is listed if empty.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListSCDReferencesByManagerRequest) Reset() {
+	*x = ListSCDReferencesByManagerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSCDReferencesByManagerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSCDReferencesByManagerRequest) ProtoMessage() {}
+
+func (x *ListSCDReferencesByManagerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSCDReferencesByManagerRequest.ProtoReflect.Descriptor instead.
+func (*ListSCDReferencesByManagerRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListSCDReferencesByManagerRequest) GetManager() string {
+	if x != nil {
+		return x.Manager
+	}
+	return ""
+}
+
+func (x *ListSCDReferencesByManagerRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSCDReferencesByManagerRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// Response listing a page of the operational intent references managed by a
+// USS.
+type ListSCDOperationalIntentReferencesByManagerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Operational intent references, in EntityID order.
+	OperationalIntentReferences []*scdpb.OperationalIntentReference `protobuf:"bytes,1,rep,name=operational_intent_references,json=operationalIntentReferences,proto3" json:"operational_intent_references,omitempty"`
+	// Token of the next page, or empty if this is the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListSCDOperationalIntentReferencesByManagerResponse) Reset() {
+	*x = ListSCDOperationalIntentReferencesByManagerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSCDOperationalIntentReferencesByManagerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSCDOperationalIntentReferencesByManagerResponse) ProtoMessage() {}
+
+func (x *ListSCDOperationalIntentReferencesByManagerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSCDOperationalIntentReferencesByManagerResponse.ProtoReflect.Descriptor instead.
+func (*ListSCDOperationalIntentReferencesByManagerResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListSCDOperationalIntentReferencesByManagerResponse) GetOperationalIntentReferences() []*scdpb.OperationalIntentReference {
+	if x != nil {
+		return x.OperationalIntentReferences
+	}
+	return nil
+}
+
+func (x *ListSCDOperationalIntentReferencesByManagerResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Response listing a page of the constraint references managed by a USS.
+type ListSCDConstraintReferencesByManagerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Constraint references, in EntityID order.
+	ConstraintReferences []*scdpb.ConstraintReference `protobuf:"bytes,1,rep,name=constraint_references,json=constraintReferences,proto3" json:"constraint_references,omitempty"`
+	// Token of the next page, or empty if this is the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListSCDConstraintReferencesByManagerResponse) Reset() {
+	*x = ListSCDConstraintReferencesByManagerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSCDConstraintReferencesByManagerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSCDConstraintReferencesByManagerResponse) ProtoMessage() {}
+
+func (x *ListSCDConstraintReferencesByManagerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSCDConstraintReferencesByManagerResponse.ProtoReflect.Descriptor instead.
+func (*ListSCDConstraintReferencesByManagerResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListSCDConstraintReferencesByManagerResponse) GetConstraintReferences() []*scdpb.ConstraintReference {
+	if x != nil {
+		return x.ConstraintReferences
+	}
+	return nil
+}
+
+func (x *ListSCDConstraintReferencesByManagerResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Request to delete the remote ID and strategic conflict detection entities
+// managed by a USS.
+type ResetManagerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// USS whose entities are deleted.
+	Manager string `protobuf:"bytes,1,opt,name=manager,proto3" json:"manager,omitempty"`
+	// If set, only the entities intersecting this area are deleted; as a
+	// polygon 'lat0,lng0,lat1,lng1,...' or a circle 'circle:lat,lng,radius'
+	// with the radius in meters.
+	Area string `protobuf:"bytes,2,opt,name=area,proto3" json:"area,omitempty"`
+	// Must repeat manager, to guard against accidental resets.
+	Confirm string `protobuf:"bytes,3,opt,name=confirm,proto3" json:"confirm,omitempty"`
+}
+
+func (x *ResetManagerRequest) Reset() {
+	*x = ResetManagerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetManagerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetManagerRequest) ProtoMessage() {}
+
+func (x *ResetManagerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetManagerRequest.ProtoReflect.Descriptor instead.
+func (*ResetManagerRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ResetManagerRequest) GetManager() string {
+	if x != nil {
+		return x.Manager
+	}
+	return ""
+}
+
+func (x *ResetManagerRequest) GetArea() string {
+	if x != nil {
+		return x.Area
+	}
+	return ""
+}
+
+func (x *ResetManagerRequest) GetConfirm() string {
+	if x != nil {
+		return x.Confirm
+	}
+	return ""
+}
+
+// Response listing the entities deleted.
+type ResetManagerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OperationalIntentIds []string `protobuf:"bytes,1,rep,name=operational_intent_ids,json=operationalIntentIds,proto3" json:"operational_intent_ids,omitempty"`
+	ConstraintIds        []string `protobuf:"bytes,2,rep,name=constraint_ids,json=constraintIds,proto3" json:"constraint_ids,omitempty"`
+	// Strategic conflict detection subscriptions.
+	ScdSubscriptionIds           []string `protobuf:"bytes,3,rep,name=scd_subscription_ids,json=scdSubscriptionIds,proto3" json:"scd_subscription_ids,omitempty"`
+	IdentificationServiceAreaIds []string `protobuf:"bytes,4,rep,name=identification_service_area_ids,json=identificationServiceAreaIds,proto3" json:"identification_service_area_ids,omitempty"`
+	// Remote ID subscriptions.
+	RidSubscriptionIds []string `protobuf:"bytes,5,rep,name=rid_subscription_ids,json=ridSubscriptionIds,proto3" json:"rid_subscription_ids,omitempty"`
+}
+
+func (x *ResetManagerResponse) Reset() {
+	*x = ResetManagerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetManagerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetManagerResponse) ProtoMessage() {}
+
+func (x *ResetManagerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetManagerResponse.ProtoReflect.Descriptor instead.
+func (*ResetManagerResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ResetManagerResponse) GetOperationalIntentIds() []string {
+	if x != nil {
+		return x.OperationalIntentIds
+	}
+	return nil
+}
+
+func (x *ResetManagerResponse) GetConstraintIds() []string {
+	if x != nil {
+		return x.ConstraintIds
+	}
+	return nil
+}
+
+func (x *ResetManagerResponse) GetScdSubscriptionIds() []string {
+	if x != nil {
+		return x.ScdSubscriptionIds
+	}
+	return nil
+}
+
+func (x *ResetManagerResponse) GetIdentificationServiceAreaIds() []string {
+	if x != nil {
+		return x.IdentificationServiceAreaIds
+	}
+	return nil
+}
+
+func (x *ResetManagerResponse) GetRidSubscriptionIds() []string {
+	if x != nil {
+		return x.RidSubscriptionIds
+	}
+	return nil
+}
+
+// Request to watch the changes of strategic conflict detection entities in an
+// area.
+type WatchSCDChangesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The area watched, as a polygon 'lat0,lng0,lat1,lng1,...' or a circle
+	// 'circle:lat,lng,radius' with the radius in meters.
+	Area string `protobuf:"bytes,1,opt,name=area,proto3" json:"area,omitempty"`
+	// Cursor of the last change already seen, from the cursor of a previous
+	// response; changes are listed from the oldest recorded if empty.
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Number of seconds to wait for changes if there are none yet; may not
+	// exceed 60.
+	WaitSeconds int32 `protobuf:"varint,3,opt,name=wait_seconds,json=waitSeconds,proto3" json:"wait_seconds,omitempty"`
+}
+
+func (x *WatchSCDChangesRequest) Reset() {
+	*x = WatchSCDChangesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchSCDChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchSCDChangesRequest) ProtoMessage() {}
+
+func (x *WatchSCDChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchSCDChangesRequest.ProtoReflect.Descriptor instead.
+func (*WatchSCDChangesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *WatchSCDChangesRequest) GetArea() string {
+	if x != nil {
+		return x.Area
+	}
+	return ""
+}
+
+func (x *WatchSCDChangesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *WatchSCDChangesRequest) GetWaitSeconds() int32 {
+	if x != nil {
+		return x.WaitSeconds
+	}
+	return 0
+}
+
+// Change made to an operational intent or constraint reference.
+type SCDEntityChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Cursor of this change.
+	Cursor string `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// `OperationalIntent` or `Constraint`.
+	EntityType string `protobuf:"bytes,2,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	EntityId   string `protobuf:"bytes,3,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	// `Created`, `Updated` or `Deleted`.
+	Change string `protobuf:"bytes,4,opt,name=change,proto3" json:"change,omitempty"`
+	// USS managing the entity.
+	Manager string `protobuf:"bytes,5,opt,name=manager,proto3" json:"manager,omitempty"`
+	// Version of the entity after the change, or before its deletion.
+	Version    int32                `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	OccurredAt *timestamp.Timestamp `protobuf:"bytes,7,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+}
+
+func (x *SCDEntityChange) Reset() {
+	*x = SCDEntityChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SCDEntityChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SCDEntityChange) ProtoMessage() {}
+
+func (x *SCDEntityChange) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SCDEntityChange.ProtoReflect.Descriptor instead.
+func (*SCDEntityChange) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SCDEntityChange) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *SCDEntityChange) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *SCDEntityChange) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *SCDEntityChange) GetChange() string {
+	if x != nil {
+		return x.Change
+	}
+	return ""
+}
+
+func (x *SCDEntityChange) GetManager() string {
+	if x != nil {
+		return x.Manager
+	}
+	return ""
+}
+
+func (x *SCDEntityChange) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SCDEntityChange) GetOccurredAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// Response listing the changes of strategic conflict detection entities in an
+// area.
+type WatchSCDChangesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Changes in the order they were recorded.
+	Changes []*SCDEntityChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	// Cursor to watch the changes following these; the requested cursor if
+	// there are no changes.
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *WatchSCDChangesResponse) Reset() {
+	*x = WatchSCDChangesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchSCDChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchSCDChangesResponse) ProtoMessage() {}
+
+func (x *WatchSCDChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchSCDChangesResponse.ProtoReflect.Descriptor instead.
+func (*WatchSCDChangesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *WatchSCDChangesResponse) GetChanges() []*SCDEntityChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *WatchSCDChangesResponse) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+// Request to check the consistency of the strategic conflict detection
+// entities stored in an area.
+type CheckSCDConsistencyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The area checked, as a polygon 'lat0,lng0,lat1,lng1,...' or a circle
+	// 'circle:lat,lng,radius' with the radius in meters.
+	Area string `protobuf:"bytes,1,opt,name=area,proto3" json:"area,omitempty"`
+}
+
+func (x *CheckSCDConsistencyRequest) Reset() {
+	*x = CheckSCDConsistencyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckSCDConsistencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckSCDConsistencyRequest) ProtoMessage() {}
+
+func (x *CheckSCDConsistencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckSCDConsistencyRequest.ProtoReflect.Descriptor instead.
+func (*CheckSCDConsistencyRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CheckSCDConsistencyRequest) GetArea() string {
+	if x != nil {
+		return x.Area
+	}
+	return ""
+}
+
+// Entity whose OVN a new plan in the checked area would need.
+type SCDKeyEntity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntityId string `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Ovn      string `protobuf:"bytes,2,opt,name=ovn,proto3" json:"ovn,omitempty"`
+	// `OperationalIntent` or `Constraint`.
+	EntityType string `protobuf:"bytes,3,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	// USS managing the entity.
+	Manager string `protobuf:"bytes,4,opt,name=manager,proto3" json:"manager,omitempty"`
+}
+
+func (x *SCDKeyEntity) Reset() {
+	*x = SCDKeyEntity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SCDKeyEntity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SCDKeyEntity) ProtoMessage() {}
+
+func (x *SCDKeyEntity) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SCDKeyEntity.ProtoReflect.Descriptor instead.
+func (*SCDKeyEntity) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SCDKeyEntity) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *SCDKeyEntity) GetOvn() string {
+	if x != nil {
+		return x.Ovn
+	}
+	return ""
+}
+
+func (x *SCDKeyEntity) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *SCDKeyEntity) GetManager() string {
+	if x != nil {
+		return x.Manager
+	}
+	return ""
+}
+
+// Internal inconsistency of the stored strategic conflict detection entities.
+type SCDInconsistency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Machine-readable kind of inconsistency, e.g. `missing_ovn`,
+	// `duplicate_key`, `stale_ovn`, `missing_entity`, `missing_subscription` or
+	// `invalid_time_range`.
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// `OperationalIntent` or `Constraint`.
+	EntityType string `protobuf:"bytes,2,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	EntityId   string `protobuf:"bytes,3,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	// Human-readable description of the inconsistency.
+	Detail string `protobuf:"bytes,4,opt,name=detail,proto3" json:"detail,omitempty"`
+}
+
+func (x *SCDInconsistency) Reset() {
+	*x = SCDInconsistency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SCDInconsistency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SCDInconsistency) ProtoMessage() {}
+
+func (x *SCDInconsistency) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SCDInconsistency.ProtoReflect.Descriptor instead.
+func (*SCDInconsistency) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SCDInconsistency) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SCDInconsistency) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *SCDInconsistency) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *SCDInconsistency) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+// Response reporting the consistency of the strategic conflict detection
+// entities stored in an area.
+type CheckSCDConsistencyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Entities whose OVNs a new plan in the area would need, over all times and
+	// altitudes.
+	Key []*SCDKeyEntity `protobuf:"bytes,1,rep,name=key,proto3" json:"key,omitempty"`
+	// Inconsistencies found; empty if the stored entities are consistent.
+	Inconsistencies []*SCDInconsistency `protobuf:"bytes,2,rep,name=inconsistencies,proto3" json:"inconsistencies,omitempty"`
+}
+
+func (x *CheckSCDConsistencyResponse) Reset() {
+	*x = CheckSCDConsistencyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckSCDConsistencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckSCDConsistencyResponse) ProtoMessage() {}
+
+func (x *CheckSCDConsistencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckSCDConsistencyResponse.ProtoReflect.Descriptor instead.
+func (*CheckSCDConsistencyResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CheckSCDConsistencyResponse) GetKey() []*SCDKeyEntity {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *CheckSCDConsistencyResponse) GetInconsistencies() []*SCDInconsistency {
+	if x != nil {
+		return x.Inconsistencies
+	}
+	return nil
+}
+
+// Request to reload the runtime configuration of the DSS instance.
+type ReloadConfigurationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReloadConfigurationRequest) Reset() {
+	*x = ReloadConfigurationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReloadConfigurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadConfigurationRequest) ProtoMessage() {}
+
+func (x *ReloadConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*ReloadConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{39}
+}
+
+// New value applied to a setting of the DSS instance.
+type ConfigurationChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the flag of the setting.
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	OldValue string `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue string `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+}
+
+func (x *ConfigurationChange) Reset() {
+	*x = ConfigurationChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigurationChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigurationChange) ProtoMessage() {}
+
+func (x *ConfigurationChange) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigurationChange.ProtoReflect.Descriptor instead.
+func (*ConfigurationChange) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ConfigurationChange) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConfigurationChange) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *ConfigurationChange) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+// Response describing the changes of the configuration of the DSS instance.
+type ReloadConfigurationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Changes applied to the reloadable settings.
+	Changes []*ConfigurationChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	// Flags of the other settings whose values changed, which are only applied
+	// upon restart.
+	RestartRequired []string `protobuf:"bytes,2,rep,name=restart_required,json=restartRequired,proto3" json:"restart_required,omitempty"`
+}
+
+func (x *ReloadConfigurationResponse) Reset() {
+	*x = ReloadConfigurationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReloadConfigurationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadConfigurationResponse) ProtoMessage() {}
+
+func (x *ReloadConfigurationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadConfigurationResponse.ProtoReflect.Descriptor instead.
+func (*ReloadConfigurationResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ReloadConfigurationResponse) GetChanges() []*ConfigurationChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *ReloadConfigurationResponse) GetRestartRequired() []string {
+	if x != nil {
+		return x.RestartRequired
+	}
+	return nil
+}
+
+// Request to list the DSS instances of the pool.
+type ListPoolInstancesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListPoolInstancesRequest) Reset() {
+	*x = ListPoolInstancesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPoolInstancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPoolInstancesRequest) ProtoMessage() {}
+
+func (x *ListPoolInstancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPoolInstancesRequest.ProtoReflect.Descriptor instead.
+func (*ListPoolInstancesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{42}
+}
+
+// DSS instance of the pool, as of its latest heartbeat.
+type PoolInstance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the instance in the pool.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Identifies the process running as the instance; changes upon restart.
+	Process  string `protobuf:"bytes,2,opt,name=process,proto3" json:"process,omitempty"`
+	Hostname string `protobuf:"bytes,3,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	// Locality of the instance, as written in the records it creates.
+	Locality string `protobuf:"bytes,4,opt,name=locality,proto3" json:"locality,omitempty"`
+	// Version and commit of the build run by the instance.
+	Version string `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+	Commit  string `protobuf:"bytes,6,opt,name=commit,proto3" json:"commit,omitempty"`
+	// When the process of the instance started, per its clock.
+	StartedAt *timestamp.Timestamp `protobuf:"bytes,7,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// When the latest heartbeat of the instance was received, per the clock of
+	// the datastore.
+	LastSeenAt *timestamp.Timestamp `protobuf:"bytes,8,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	// Number of seconds since last_seen_at, per the clock of the datastore.
+	SecondsSinceSeen float64 `protobuf:"fixed64,9,opt,name=seconds_since_seen,json=secondsSinceSeen,proto3" json:"seconds_since_seen,omitempty"`
+	// By how many milliseconds the clock of the instance was ahead of the clock
+	// of the datastore upon its latest heartbeat, including the latency of the
+	// heartbeat.
+	ClockSkewMs int64 `protobuf:"varint,10,opt,name=clock_skew_ms,json=clockSkewMs,proto3" json:"clock_skew_ms,omitempty"`
+	// Whether the instance missed several heartbeats, e.g. because it stopped
+	// or cannot reach the datastore.
+	Stale bool `protobuf:"varint,11,opt,name=stale,proto3" json:"stale,omitempty"`
+	// Whether this is the instance serving the request.
+	Self bool `protobuf:"varint,12,opt,name=self,proto3" json:"self,omitempty"`
+}
+
+func (x *PoolInstance) Reset() {
+	*x = PoolInstance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolInstance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolInstance) ProtoMessage() {}
+
+func (x *PoolInstance) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolInstance.ProtoReflect.Descriptor instead.
+func (*PoolInstance) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PoolInstance) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PoolInstance) GetProcess() string {
+	if x != nil {
+		return x.Process
+	}
+	return ""
+}
+
+func (x *PoolInstance) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *PoolInstance) GetLocality() string {
+	if x != nil {
+		return x.Locality
+	}
+	return ""
+}
+
+func (x *PoolInstance) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *PoolInstance) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *PoolInstance) GetStartedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *PoolInstance) GetLastSeenAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return nil
+}
+
+func (x *PoolInstance) GetSecondsSinceSeen() float64 {
+	if x != nil {
+		return x.SecondsSinceSeen
+	}
+	return 0
+}
+
+func (x *PoolInstance) GetClockSkewMs() int64 {
+	if x != nil {
+		return x.ClockSkewMs
+	}
+	return 0
+}
+
+func (x *PoolInstance) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+func (x *PoolInstance) GetSelf() bool {
+	if x != nil {
+		return x.Self
+	}
+	return false
+}
+
+// Response listing the DSS instances of the pool.
+type ListPoolInstancesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the instance serving the request.
+	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	// Current time per the clock of the datastore.
+	DatastoreTime *timestamp.Timestamp `protobuf:"bytes,2,opt,name=datastore_time,json=datastoreTime,proto3" json:"datastore_time,omitempty"`
+	Instances     []*PoolInstance      `protobuf:"bytes,3,rep,name=instances,proto3" json:"instances,omitempty"`
+}
+
+func (x *ListPoolInstancesResponse) Reset() {
+	*x = ListPoolInstancesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPoolInstancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPoolInstancesResponse) ProtoMessage() {}
+
+func (x *ListPoolInstancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPoolInstancesResponse.ProtoReflect.Descriptor instead.
+func (*ListPoolInstancesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListPoolInstancesResponse) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *ListPoolInstancesResponse) GetDatastoreTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.DatastoreTime
+	}
+	return nil
+}
+
+func (x *ListPoolInstancesResponse) GetInstances() []*PoolInstance {
+	if x != nil {
+		return x.Instances
+	}
+	return nil
+}
+
+// Maintenance window of the pool, during which its DSS instances reject
+// mutations, and reads unless reads_available, with 503 responses.
+type MaintenanceWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// When the window starts; immediately when absent upon scheduling.
+	StartTime *timestamp.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// When the window ends; the window lasts until cancelled when absent.
+	EndTime *timestamp.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Whether the DSS keeps serving reads during the window.
+	ReadsAvailable bool `protobuf:"varint,3,opt,name=reads_available,json=readsAvailable,proto3" json:"reads_available,omitempty"`
+	// Explanation of the maintenance for clients, included in the rejections.
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// Subject of the access token which scheduled the window.  Output only.
+	ScheduledBy string `protobuf:"bytes,5,opt,name=scheduled_by,json=scheduledBy,proto3" json:"scheduled_by,omitempty"`
+	// When the window was scheduled.  Output only.
+	ScheduledAt *timestamp.Timestamp `protobuf:"bytes,6,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	// Whether the window is in progress.  Output only.
+	Active bool `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (x *MaintenanceWindow) Reset() {
+	*x = MaintenanceWindow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaintenanceWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceWindow) ProtoMessage() {}
+
+func (x *MaintenanceWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceWindow.ProtoReflect.Descriptor instead.
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *MaintenanceWindow) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *MaintenanceWindow) GetEndTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *MaintenanceWindow) GetReadsAvailable() bool {
+	if x != nil {
+		return x.ReadsAvailable
+	}
+	return false
+}
+
+func (x *MaintenanceWindow) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetScheduledBy() string {
+	if x != nil {
+		return x.ScheduledBy
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetScheduledAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *MaintenanceWindow) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+// Request to get the maintenance window of the pool.
+type GetMaintenanceWindowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetMaintenanceWindowRequest) Reset() {
+	*x = GetMaintenanceWindowRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMaintenanceWindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaintenanceWindowRequest) ProtoMessage() {}
+
+func (x *GetMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
+func (*GetMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{46}
+}
+
+type GetMaintenanceWindowResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The maintenance window of the pool which has not ended yet, absent when
+	// none is scheduled.
+	Window *MaintenanceWindow `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (x *GetMaintenanceWindowResponse) Reset() {
+	*x = GetMaintenanceWindowResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMaintenanceWindowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaintenanceWindowResponse) ProtoMessage() {}
+
+func (x *GetMaintenanceWindowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaintenanceWindowResponse.ProtoReflect.Descriptor instead.
+func (*GetMaintenanceWindowResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetMaintenanceWindowResponse) GetWindow() *MaintenanceWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+// Request to schedule the maintenance window of the pool, replacing any
+// previous one.
+type ScheduleMaintenanceWindowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Window *MaintenanceWindow `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (x *ScheduleMaintenanceWindowRequest) Reset() {
+	*x = ScheduleMaintenanceWindowRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleMaintenanceWindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleMaintenanceWindowRequest) ProtoMessage() {}
+
+func (x *ScheduleMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ScheduleMaintenanceWindowRequest) GetWindow() *MaintenanceWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+type ScheduleMaintenanceWindowResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Window *MaintenanceWindow `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (x *ScheduleMaintenanceWindowResponse) Reset() {
+	*x = ScheduleMaintenanceWindowResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleMaintenanceWindowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleMaintenanceWindowResponse) ProtoMessage() {}
+
+func (x *ScheduleMaintenanceWindowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleMaintenanceWindowResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleMaintenanceWindowResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ScheduleMaintenanceWindowResponse) GetWindow() *MaintenanceWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+// Request to cancel the maintenance window of the pool, ending it if in
+// progress.
+type CancelMaintenanceWindowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelMaintenanceWindowRequest) Reset() {
+	*x = CancelMaintenanceWindowRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelMaintenanceWindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelMaintenanceWindowRequest) ProtoMessage() {}
+
+func (x *CancelMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
+func (*CancelMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{50}
+}
+
+type CancelMaintenanceWindowResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelMaintenanceWindowResponse) Reset() {
+	*x = CancelMaintenanceWindowResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelMaintenanceWindowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelMaintenanceWindowResponse) ProtoMessage() {}
+
+func (x *CancelMaintenanceWindowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelMaintenanceWindowResponse.ProtoReflect.Descriptor instead.
+func (*CancelMaintenanceWindowResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{51}
+}
+
+// Error response format for most errors
+type StandardErrorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Human-readable error message; should be identical to `message` content.
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	// Code for error category; uses standard gRPC response codes*, plus some
+	// additional custom codes defined for this project (see
+	// pkg/errors/errors.go).
+	//
+	// *https://developers.google.com/maps-booking/reference/grpc-api/status_codes
+	Code int32 `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	// Human-readable error message.  Required by ASTM standards' APIs in most
+	// error responses.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// ID of error in the format E:<UUID> which allows fast lookups in the DSS
+	// logs to relate a client's observed error response from the DSS to the
+	// detailed logs related to that error in the internal DSS logs.
+	ErrorId string `protobuf:"bytes,4,opt,name=error_id,json=errorId,proto3" json:"error_id,omitempty"`
+	// Machine-readable reason for the error, when one is known; for instance
+	// the volume validation rule broken by a submitted volume.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Stable machine-readable code of the error category, e.g. AREA_TOO_LARGE
+	// or VERSION_CONFLICT (see pkg/errors/catalog.go), for clients to act upon
+	// instead of matching messages.
+	ErrorCode string `protobuf:"bytes,6,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+}
+
+func (x *StandardErrorResponse) Reset() {
+	*x = StandardErrorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StandardErrorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StandardErrorResponse) ProtoMessage() {}
+
+func (x *StandardErrorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StandardErrorResponse.ProtoReflect.Descriptor instead.
+func (*StandardErrorResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *StandardErrorResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *StandardErrorResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *StandardErrorResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *StandardErrorResponse) GetErrorId() string {
+	if x != nil {
+		return x.ErrorId
+	}
+	return ""
+}
+
+func (x *StandardErrorResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StandardErrorResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+var File_pkg_api_v1_auxpb_aux_service_proto protoreflect.FileDescriptor
+
+var file_pkg_api_v1_auxpb_aux_service_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x78,
+	0x70, 0x62, 0x2f, 0x61, 0x75, 0x78, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x61, 0x75, 0x78, 0x70, 0x62, 0x1a, 0x1c, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x74, 0x74, 0x70, 0x62, 0x6f, 0x64, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2f, 0x73, 0x63, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x26, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09,
+	0x61, 0x73, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x61, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3e,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x12,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0xfa, 0x01, 0x0a, 0x13, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a,
+	0x16, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x6d,
+	0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x72, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x73, 0x22, 0xae, 0x02, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x75, 0x78,
+	0x70, 0x62, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x12, 0x34, 0x0a, 0x07, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x75, 0x78,
+	0x70, 0x62, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x07, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x2a, 0x0a, 0x06, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x75,
+	0x78, 0x70, 0x62, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52,
+	0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x0b, 0x6d, 0x61, 0x69, 0x6e,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x22, 0x2c, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x4f, 0x61, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x22, 0x17, 0x0a, 0x15, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x61,
+	0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x0a, 0x27, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x6e, 0x0a, 0x14, 0x52, 0x49, 0x44, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x27,
+	0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x6e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x11, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x6c, 0x0a, 0x15, 0x52, 0x49, 0x44, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x54, 0x6f, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12,
+	0x41, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x52,
+	0x49, 0x44, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x6c, 0x22, 0x94, 0x01, 0x0a, 0x28, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x0b, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x52, 0x49, 0x44, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x54, 0x6f, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x0b,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x22, 0x79, 0x0a, 0x16, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x49, 0x44, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x65, 0x61, 0x12, 0x33, 0x0a, 0x15, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x45, 0x0a, 0x18, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53,
+	0x43, 0x44, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x29, 0x0a, 0x07, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x34, 0x44, 0x52, 0x07, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xab, 0x03,
+	0x0a, 0x19, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x43, 0x44, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x1d, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x1b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x12, 0x4f, 0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74,
+	0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x14, 0x63,
+	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x12, 0x85, 0x01, 0x0a, 0x1d, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x61, 0x75,
+	0x78, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x43, 0x44, 0x43, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x50,
+	0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x1b,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x1a, 0x4e, 0x0a, 0x20, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3a, 0x0a, 0x09, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x4f, 0x56, 0x4e, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x76, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6f, 0x76, 0x6e, 0x22, 0x43, 0x0a, 0x13, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x53, 0x43, 0x44, 0x4f, 0x56, 0x4e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c,
+	0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4f,
+	0x56, 0x4e, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x5a, 0x0a, 0x08,
+	0x53, 0x74, 0x61, 0x6c, 0x65, 0x4f, 0x56, 0x4e, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x76, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6f, 0x76, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3d, 0x0a, 0x14, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x53, 0x43, 0x44, 0x4f, 0x56, 0x4e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x25, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x4f, 0x56, 0x4e,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x22, 0x4f, 0x0a, 0x24, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x43, 0x44, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xe6, 0x02, 0x0a, 0x14, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x6e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x11, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x64, 0x0a, 0x25, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x43, 0x44, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x0a, 0x64, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0xb4, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x43, 0x44, 0x44, 0x73, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
+	0x12, 0x3f, 0x0a, 0x0d, 0x65, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0c, 0x65, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xab,
+	0x01, 0x0a, 0x0f, 0x44, 0x73, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x76, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6f, 0x76, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0xeb, 0x01, 0x0a,
+	0x09, 0x44, 0x73, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x45, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x08, 0x65, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x37, 0x0a, 0x0b, 0x64, 0x73, 0x73, 0x5f, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x75,
+	0x78, 0x70, 0x62, 0x2e, 0x44, 0x73, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x52, 0x0a, 0x64, 0x73, 0x73, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12,
+	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x47, 0x0a, 0x19, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x43, 0x44, 0x44, 0x73, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62,
+	0x2e, 0x44, 0x73, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x22, 0x79, 0x0a, 0x21, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x43, 0x44, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xc4,
+	0x01, 0x0a, 0x33, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x43, 0x44, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x1d, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x1b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x26, 0x0a,
+	0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xa7, 0x01, 0x0a, 0x2c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x43,
+	0x44, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x6f,
+	0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x14, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x5d, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x61, 0x72, 0x65, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x22, 0x9e,
+	0x02, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x74, 0x49, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x63, 0x64, 0x5f, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x12, 0x73, 0x63, 0x64, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x45, 0x0a, 0x1f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x61, 0x72, 0x65, 0x61, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x1c, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x49, 0x64, 0x73, 0x12, 0x30, 0x0a,
+	0x14, 0x72, 0x69, 0x64, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x72, 0x69, 0x64,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x22,
+	0x67, 0x0a, 0x16, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x43, 0x44, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x65, 0x61, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x77, 0x61, 0x69,
+	0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0xf0, 0x01, 0x0a, 0x0f, 0x53, 0x43, 0x44,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3b,
+	0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74, 0x22, 0x63, 0x0a, 0x17, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x53, 0x43, 0x44, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e,
+	0x53, 0x43, 0x44, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x22, 0x30, 0x0a, 0x1a, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x43, 0x44, 0x43, 0x6f, 0x6e, 0x73,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72,
+	0x65, 0x61, 0x22, 0x78, 0x0a, 0x0c, 0x53, 0x43, 0x44, 0x4b, 0x65, 0x79, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12,
+	0x10, 0x0a, 0x03, 0x6f, 0x76, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x76,
+	0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x22, 0x7c, 0x0a, 0x10,
+	0x53, 0x43, 0x44, 0x49, 0x6e, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x87, 0x01, 0x0a, 0x1b, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x53, 0x43, 0x44, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e,
+	0x53, 0x43, 0x44, 0x4b, 0x65, 0x79, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x41, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x63, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x75, 0x78,
+	0x70, 0x62, 0x2e, 0x53, 0x43, 0x44, 0x49, 0x6e, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x63, 0x69, 0x65, 0x73, 0x22, 0x1c, 0x0a, 0x1a, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x63, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x6f, 0x6c, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65,
+	0x77, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e,
+	0x65, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x7e, 0x0a, 0x1b, 0x52, 0x65, 0x6c, 0x6f, 0x61,
+	0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10,
+	0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x97, 0x03, 0x0a, 0x0c, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x3c, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e,
+	0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x41,
+	0x74, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x5f, 0x73, 0x69, 0x6e,
+	0x63, 0x65, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x65, 0x6e, 0x12,
+	0x22, 0x0a, 0x0d, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x6b, 0x65, 0x77, 0x5f, 0x6d, 0x73,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x6b, 0x65,
+	0x77, 0x4d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x6c,
+	0x66, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x65, 0x6c, 0x66, 0x22, 0xb2, 0x01,
+	0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x0e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x31, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x22, 0xc2, 0x02, 0x0a, 0x11, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65,
+	0x61, 0x64, 0x73, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0e, 0x72, 0x65, 0x61, 0x64, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x42, 0x79,
+	0x12, 0x3d, 0x0a, 0x0c, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x1d, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4d, 0x61,
+	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x50, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x4d,
+	0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x54, 0x0a, 0x20, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61,
+	0x75, 0x78, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x55,
+	0x0a, 0x21, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x69, 0x6e,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x06, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x20, 0x0a, 0x1e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d,
+	0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x21, 0x0a, 0x1f, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xad, 0x01, 0x0a, 0x15, 0x53,
+	0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x32, 0xad, 0x14, 0x0a, 0x0d, 0x44,
+	0x53, 0x53, 0x41, 0x75, 0x78, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5a, 0x0a, 0x0a,
+	0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x61, 0x75, 0x78,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x61, 0x75, 0x78, 0x2f, 0x76, 0x31,
+	0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x56, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10,
+	0x12, 0x0e, 0x2f, 0x61, 0x75, 0x78, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x6a, 0x0a, 0x0d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x61, 0x75, 0x74,
+	0x68, 0x12, 0x1b, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f,
+	0x61, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x75, 0x78, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x61, 0x75, 0x74, 0x68, 0x12, 0xc5, 0x01, 0x0a,
+	0x20, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65,
+	0x61, 0x12, 0x2e, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2f, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x40, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3a, 0x22, 0x35, 0x2f, 0x61, 0x75, 0x78,
+	0x2f, 0x76, 0x31, 0x2f, 0x72, 0x69, 0x64, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61,
+	0x72, 0x65, 0x61, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x3a, 0x01, 0x2a, 0x12, 0x62, 0x0a, 0x0f, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x49,
+	0x44, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x49, 0x44, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x22, 0x1a, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x61, 0x75, 0x78, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x69,
+	0x64, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x7d, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x53, 0x43, 0x44, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x12, 0x1f, 0x2e,
+	0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x43, 0x44, 0x43, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x43, 0x44, 0x43,
+	0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22, 0x1a, 0x2f, 0x61, 0x75, 0x78, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x63, 0x64, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x5f, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x3a, 0x01, 0x2a, 0x12, 0x69, 0x0a, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x53, 0x43, 0x44, 0x4f, 0x56, 0x4e, 0x73, 0x12, 0x1a, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x43, 0x44, 0x4f, 0x56, 0x4e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x53, 0x43, 0x44, 0x4f, 0x56, 0x4e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x61, 0x75, 0x78, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x63, 0x64, 0x2f, 0x6f, 0x76, 0x6e, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x3a,
+	0x01, 0x2a, 0x12, 0xc7, 0x01, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x43, 0x44, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x43, 0x44, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2c, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x43,
+	0x44, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x4b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x45, 0x12, 0x43, 0x2f, 0x61, 0x75, 0x78, 0x2f, 0x76, 0x31,
+	0x2f, 0x73, 0x63, 0x64, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2f, 0x7b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x73, 0x0a, 0x11,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x43, 0x44, 0x44, 0x73, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x12, 0x1f, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x43,
+	0x44, 0x44, 0x73, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x43, 0x44, 0x44, 0x73, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x61,
+	0x75, 0x78, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x63, 0x64, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x12, 0xd9, 0x01, 0x0a, 0x2b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x43, 0x44, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x12, 0x28, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x43,
+	0x44, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x4d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x61, 0x75,
+	0x78, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x43, 0x44, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3e, 0x12,
+	0x3c, 0x2f, 0x61, 0x75, 0x78, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x63, 0x64, 0x2f, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x7d, 0x2f,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0xc3, 0x01,
+	0x0a, 0x24, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x43, 0x44, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x4d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x28, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x43, 0x44, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x42, 0x79, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x33, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x43, 0x44,
+	0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x42, 0x79, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x12, 0x34, 0x2f,
+	0x61, 0x75, 0x78, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x63, 0x64, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x72, 0x73, 0x2f, 0x7b, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x7d, 0x2f, 0x63, 0x6f,
+	0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x12, 0x74, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x65,
+	0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x25, 0x22, 0x20, 0x2f, 0x61, 0x75, 0x78, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x7d,
+	0x2f, 0x72, 0x65, 0x73, 0x65, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x6d, 0x0a, 0x0f, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x53, 0x43, 0x44, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x61,
+	0x75, 0x78, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x43, 0x44, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x75,
+	0x78, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x43, 0x44, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x61, 0x75, 0x78, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x63, 0x64,
+	0x2f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x83, 0x01, 0x0a, 0x13, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x53, 0x43, 0x44, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x12, 0x21, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x43,
+	0x44, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x53, 0x43, 0x44, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12,
+	0x1d, 0x2f, 0x61, 0x75, 0x78, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x63, 0x64, 0x2f, 0x63, 0x6f, 0x6e,
+	0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x85,
+	0x01, 0x0a, 0x13, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x75, 0x78, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x21, 0x22, 0x1c, 0x2f, 0x61, 0x75, 0x78, 0x2f, 0x76, 0x31, 0x2f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x72, 0x65, 0x6c,
+	0x6f, 0x61, 0x64, 0x3a, 0x01, 0x2a, 0x12, 0x76, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f,
+	0x6f, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x61, 0x75,
+	0x78, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61,
+	0x75, 0x78, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x75, 0x78, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x7c,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x22, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x75, 0x78,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x61, 0x75, 0x78, 0x2f, 0x76, 0x31,
+	0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x93, 0x01, 0x0a,
+	0x19, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x27, 0x2e, 0x61, 0x75, 0x78,
+	0x70, 0x62, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x1a, 0x13, 0x2f, 0x61, 0x75, 0x78, 0x2f, 0x76, 0x31, 0x2f, 0x6d,
+	0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x3a, 0x06, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x12, 0x85, 0x01, 0x0a, 0x17, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x25,
+	0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x15, 0x2a, 0x13, 0x2f, 0x61, 0x75, 0x78, 0x2f, 0x76, 0x31, 0x2f, 0x6d,
+	0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x12, 0x5a, 0x10, 0x70, 0x6b,
+	0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x78, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_api_v1_auxpb_aux_service_proto_rawDescOnce sync.Once
+	file_pkg_api_v1_auxpb_aux_service_proto_rawDescData = file_pkg_api_v1_auxpb_aux_service_proto_rawDesc
+)
+
+func file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP() []byte {
+	file_pkg_api_v1_auxpb_aux_service_proto_rawDescOnce.Do(func() {
+		file_pkg_api_v1_auxpb_aux_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_api_v1_auxpb_aux_service_proto_rawDescData)
+	})
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescData
+}
+
+var file_pkg_api_v1_auxpb_aux_service_proto_msgTypes = make([]protoimpl.MessageInfo, 54)
+var file_pkg_api_v1_auxpb_aux_service_proto_goTypes = []interface{}{
+	(*Version)(nil),                                             // 0: auxpb.Version
+	(*GetVersionRequest)(nil),                                   // 1: auxpb.GetVersionRequest
+	(*GetVersionResponse)(nil),                                  // 2: auxpb.GetVersionResponse
+	(*GetStatusRequest)(nil),                                    // 3: auxpb.GetStatusRequest
+	(*SchemaCompatibility)(nil),                                 // 4: auxpb.SchemaCompatibility
+	(*HealthCheck)(nil),                                         // 5: auxpb.HealthCheck
+	(*GetStatusResponse)(nil),                                   // 6: auxpb.GetStatusResponse
+	(*ValidateOauthRequest)(nil),                                // 7: auxpb.ValidateOauthRequest
+	(*ValidateOauthResponse)(nil),                               // 8: auxpb.ValidateOauthResponse
+	(*RestoreIdentificationServiceAreaRequest)(nil),             // 9: auxpb.RestoreIdentificationServiceAreaRequest
+	(*RIDSubscriptionState)(nil),                                // 10: auxpb.RIDSubscriptionState
+	(*RIDSubscriberToNotify)(nil),                               // 11: auxpb.RIDSubscriberToNotify
+	(*RestoreIdentificationServiceAreaResponse)(nil),            // 12: auxpb.RestoreIdentificationServiceAreaResponse
+	(*ExportRIDRegionRequest)(nil),                              // 13: auxpb.ExportRIDRegionRequest
+	(*CheckSCDConflictsRequest)(nil),                            // 14: auxpb.CheckSCDConflictsRequest
+	(*CheckSCDConflictsResponse)(nil),                           // 15: auxpb.CheckSCDConflictsResponse
+	(*EntityOVN)(nil),                                           // 16: auxpb.EntityOVN
+	(*CheckSCDOVNsRequest)(nil),                                 // 17: auxpb.CheckSCDOVNsRequest
+	(*StaleOVN)(nil),                                            // 18: auxpb.StaleOVN
+	(*CheckSCDOVNsResponse)(nil),                                // 19: auxpb.CheckSCDOVNsResponse
+	(*ListSCDNotificationDeliveriesRequest)(nil),                // 20: auxpb.ListSCDNotificationDeliveriesRequest
+	(*NotificationDelivery)(nil),                                // 21: auxpb.NotificationDelivery
+	(*ListSCDNotificationDeliveriesResponse)(nil),               // 22: auxpb.ListSCDNotificationDeliveriesResponse
+	(*ListSCDDssReportsRequest)(nil),                            // 23: auxpb.ListSCDDssReportsRequest
+	(*DssReportRecord)(nil),                                     // 24: auxpb.DssReportRecord
+	(*DssReport)(nil),                                           // 25: auxpb.DssReport
+	(*ListSCDDssReportsResponse)(nil),                           // 26: auxpb.ListSCDDssReportsResponse
+	(*ListSCDReferencesByManagerRequest)(nil),                   // 27: auxpb.ListSCDReferencesByManagerRequest
+	(*ListSCDOperationalIntentReferencesByManagerResponse)(nil), // 28: auxpb.ListSCDOperationalIntentReferencesByManagerResponse
+	(*ListSCDConstraintReferencesByManagerResponse)(nil),        // 29: auxpb.ListSCDConstraintReferencesByManagerResponse
+	(*ResetManagerRequest)(nil),                                 // 30: auxpb.ResetManagerRequest
+	(*ResetManagerResponse)(nil),                                // 31: auxpb.ResetManagerResponse
+	(*WatchSCDChangesRequest)(nil),                              // 32: auxpb.WatchSCDChangesRequest
+	(*SCDEntityChange)(nil),                                     // 33: auxpb.SCDEntityChange
+	(*WatchSCDChangesResponse)(nil),                             // 34: auxpb.WatchSCDChangesResponse
+	(*CheckSCDConsistencyRequest)(nil),                          // 35: auxpb.CheckSCDConsistencyRequest
+	(*SCDKeyEntity)(nil),                                        // 36: auxpb.SCDKeyEntity
+	(*SCDInconsistency)(nil),                                    // 37: auxpb.SCDInconsistency
+	(*CheckSCDConsistencyResponse)(nil),                         // 38: auxpb.CheckSCDConsistencyResponse
+	(*ReloadConfigurationRequest)(nil),                          // 39: auxpb.ReloadConfigurationRequest
+	(*ConfigurationChange)(nil),                                 // 40: auxpb.ConfigurationChange
+	(*ReloadConfigurationResponse)(nil),                         // 41: auxpb.ReloadConfigurationResponse
+	(*ListPoolInstancesRequest)(nil),                            // 42: auxpb.ListPoolInstancesRequest
+	(*PoolInstance)(nil),                                        // 43: auxpb.PoolInstance
+	(*ListPoolInstancesResponse)(nil),                           // 44: auxpb.ListPoolInstancesResponse
+	(*MaintenanceWindow)(nil),                                   // 45: auxpb.MaintenanceWindow
+	(*GetMaintenanceWindowRequest)(nil),                         // 46: auxpb.GetMaintenanceWindowRequest
+	(*GetMaintenanceWindowResponse)(nil),                        // 47: auxpb.GetMaintenanceWindowResponse
+	(*ScheduleMaintenanceWindowRequest)(nil),                    // 48: auxpb.ScheduleMaintenanceWindowRequest
+	(*ScheduleMaintenanceWindowResponse)(nil),                   // 49: auxpb.ScheduleMaintenanceWindowResponse
+	(*CancelMaintenanceWindowRequest)(nil),                      // 50: auxpb.CancelMaintenanceWindowRequest
+	(*CancelMaintenanceWindowResponse)(nil),                     // 51: auxpb.CancelMaintenanceWindowResponse
+	(*StandardErrorResponse)(nil),                               // 52: auxpb.StandardErrorResponse
+	nil,                                                         // 53: auxpb.CheckSCDConflictsResponse.OperationalIntentPrioritiesEntry
+	(*scdpb.Volume4D)(nil),                                      // 54: scdpb.Volume4D
+	(*scdpb.OperationalIntentReference)(nil),                    // 55: scdpb.OperationalIntentReference
+	(*scdpb.ConstraintReference)(nil),                           // 56: scdpb.ConstraintReference
+	(*timestamp.Timestamp)(nil),                                 // 57: google.protobuf.Timestamp
+	(*scdpb.ExchangeRecord)(nil),                                // 58: scdpb.ExchangeRecord
+	(*httpbody.HttpBody)(nil),                                   // 59: google.api.HttpBody
+}
+var file_pkg_api_v1_auxpb_aux_service_proto_depIdxs = []int32{
+	0,  // 0: auxpb.GetVersionResponse.version:type_name -> auxpb.Version
+	0,  // 1: auxpb.GetStatusResponse.version:type_name -> auxpb.Version
+	4,  // 2: auxpb.GetStatusResponse.schemas:type_name -> auxpb.SchemaCompatibility
+	5,  // 3: auxpb.GetStatusResponse.checks:type_name -> auxpb.HealthCheck
+	45, // 4: auxpb.GetStatusResponse.maintenance:type_name -> auxpb.MaintenanceWindow
+	10, // 5: auxpb.RIDSubscriberToNotify.subscriptions:type_name -> auxpb.RIDSubscriptionState
+	11, // 6: auxpb.RestoreIdentificationServiceAreaResponse.subscribers:type_name -> auxpb.RIDSubscriberToNotify
+	54, // 7: auxpb.CheckSCDConflictsRequest.extents:type_name -> scdpb.Volume4D
+	55, // 8: auxpb.CheckSCDConflictsResponse.operational_intent_references:type_name -> scdpb.OperationalIntentReference
+	56, // 9: auxpb.CheckSCDConflictsResponse.constraint_references:type_name -> scdpb.ConstraintReference
+	53, // 10: auxpb.CheckSCDConflictsResponse.operational_intent_priorities:type_name -> auxpb.CheckSCDConflictsResponse.OperationalIntentPrioritiesEntry
+	16, // 11: auxpb.CheckSCDOVNsRequest.entities:type_name -> auxpb.EntityOVN
+	18, // 12: auxpb.CheckSCDOVNsResponse.stale:type_name -> auxpb.StaleOVN
+	57, // 13: auxpb.NotificationDelivery.created_at:type_name -> google.protobuf.Timestamp
+	57, // 14: auxpb.NotificationDelivery.updated_at:type_name -> google.protobuf.Timestamp
+	21, // 15: auxpb.ListSCDNotificationDeliveriesResponse.deliveries:type_name -> auxpb.NotificationDelivery
+	57, // 16: auxpb.ListSCDDssReportsRequest.earliest_time:type_name -> google.protobuf.Timestamp
+	57, // 17: auxpb.ListSCDDssReportsRequest.latest_time:type_name -> google.protobuf.Timestamp
+	58, // 18: auxpb.DssReport.exchange:type_name -> scdpb.ExchangeRecord
+	24, // 19: auxpb.DssReport.dss_records:type_name -> auxpb.DssReportRecord
+	57, // 20: auxpb.DssReport.created_at:type_name -> google.protobuf.Timestamp
+	25, // 21: auxpb.ListSCDDssReportsResponse.reports:type_name -> auxpb.DssReport
+	55, // 22: auxpb.ListSCDOperationalIntentReferencesByManagerResponse.operational_intent_references:type_name -> scdpb.OperationalIntentReference
+	56, // 23: auxpb.ListSCDConstraintReferencesByManagerResponse.constraint_references:type_name -> scdpb.ConstraintReference
+	57, // 24: auxpb.SCDEntityChange.occurred_at:type_name -> google.protobuf.Timestamp
+	33, // 25: auxpb.WatchSCDChangesResponse.changes:type_name -> auxpb.SCDEntityChange
+	36, // 26: auxpb.CheckSCDConsistencyResponse.key:type_name -> auxpb.SCDKeyEntity
+	37, // 27: auxpb.CheckSCDConsistencyResponse.inconsistencies:type_name -> auxpb.SCDInconsistency
+	40, // 28: auxpb.ReloadConfigurationResponse.changes:type_name -> auxpb.ConfigurationChange
+	57, // 29: auxpb.PoolInstance.started_at:type_name -> google.protobuf.Timestamp
+	57, // 30: auxpb.PoolInstance.last_seen_at:type_name -> google.protobuf.Timestamp
+	57, // 31: auxpb.ListPoolInstancesResponse.datastore_time:type_name -> google.protobuf.Timestamp
+	43, // 32: auxpb.ListPoolInstancesResponse.instances:type_name -> auxpb.PoolInstance
+	57, // 33: auxpb.MaintenanceWindow.start_time:type_name -> google.protobuf.Timestamp
+	57, // 34: auxpb.MaintenanceWindow.end_time:type_name -> google.protobuf.Timestamp
+	57, // 35: auxpb.MaintenanceWindow.scheduled_at:type_name -> google.protobuf.Timestamp
+	45, // 36: auxpb.GetMaintenanceWindowResponse.window:type_name -> auxpb.MaintenanceWindow
+	45, // 37: auxpb.ScheduleMaintenanceWindowRequest.window:type_name -> auxpb.MaintenanceWindow
+	45, // 38: auxpb.ScheduleMaintenanceWindowResponse.window:type_name -> auxpb.MaintenanceWindow
+	1,  // 39: auxpb.DSSAuxService.GetVersion:input_type -> auxpb.GetVersionRequest
+	3,  // 40: auxpb.DSSAuxService.GetStatus:input_type -> auxpb.GetStatusRequest
+	7,  // 41: auxpb.DSSAuxService.ValidateOauth:input_type -> auxpb.ValidateOauthRequest
+	9,  // 42: auxpb.DSSAuxService.RestoreIdentificationServiceArea:input_type -> auxpb.RestoreIdentificationServiceAreaRequest
+	13, // 43: auxpb.DSSAuxService.ExportRIDRegion:input_type -> auxpb.ExportRIDRegionRequest
+	14, // 44: auxpb.DSSAuxService.CheckSCDConflicts:input_type -> auxpb.CheckSCDConflictsRequest
+	17, // 45: auxpb.DSSAuxService.CheckSCDOVNs:input_type -> auxpb.CheckSCDOVNsRequest
+	20, // 46: auxpb.DSSAuxService.ListSCDNotificationDeliveries:input_type -> auxpb.ListSCDNotificationDeliveriesRequest
+	23, // 47: auxpb.DSSAuxService.ListSCDDssReports:input_type -> auxpb.ListSCDDssReportsRequest
+	27, // 48: auxpb.DSSAuxService.ListSCDOperationalIntentReferencesByManager:input_type -> auxpb.ListSCDReferencesByManagerRequest
+	27, // 49: auxpb.DSSAuxService.ListSCDConstraintReferencesByManager:input_type -> auxpb.ListSCDReferencesByManagerRequest
+	30, // 50: auxpb.DSSAuxService.ResetManager:input_type -> auxpb.ResetManagerRequest
+	32, // 51: auxpb.DSSAuxService.WatchSCDChanges:input_type -> auxpb.WatchSCDChangesRequest
+	35, // 52: auxpb.DSSAuxService.CheckSCDConsistency:input_type -> auxpb.CheckSCDConsistencyRequest
+	39, // 53: auxpb.DSSAuxService.ReloadConfiguration:input_type -> auxpb.ReloadConfigurationRequest
+	42, // 54: auxpb.DSSAuxService.ListPoolInstances:input_type -> auxpb.ListPoolInstancesRequest
+	46, // 55: auxpb.DSSAuxService.GetMaintenanceWindow:input_type -> auxpb.GetMaintenanceWindowRequest
+	48, // 56: auxpb.DSSAuxService.ScheduleMaintenanceWindow:input_type -> auxpb.ScheduleMaintenanceWindowRequest
+	50, // 57: auxpb.DSSAuxService.CancelMaintenanceWindow:input_type -> auxpb.CancelMaintenanceWindowRequest
+	2,  // 58: auxpb.DSSAuxService.GetVersion:output_type -> auxpb.GetVersionResponse
+	6,  // 59: auxpb.DSSAuxService.GetStatus:output_type -> auxpb.GetStatusResponse
+	8,  // 60: auxpb.DSSAuxService.ValidateOauth:output_type -> auxpb.ValidateOauthResponse
+	12, // 61: auxpb.DSSAuxService.RestoreIdentificationServiceArea:output_type -> auxpb.RestoreIdentificationServiceAreaResponse
+	59, // 62: auxpb.DSSAuxService.ExportRIDRegion:output_type -> google.api.HttpBody
+	15, // 63: auxpb.DSSAuxService.CheckSCDConflicts:output_type -> auxpb.CheckSCDConflictsResponse
+	19, // 64: auxpb.DSSAuxService.CheckSCDOVNs:output_type -> auxpb.CheckSCDOVNsResponse
+	22, // 65: auxpb.DSSAuxService.ListSCDNotificationDeliveries:output_type -> auxpb.ListSCDNotificationDeliveriesResponse
+	26, // 66: auxpb.DSSAuxService.ListSCDDssReports:output_type -> auxpb.ListSCDDssReportsResponse
+	28, // 67: auxpb.DSSAuxService.ListSCDOperationalIntentReferencesByManager:output_type -> auxpb.ListSCDOperationalIntentReferencesByManagerResponse
+	29, // 68: auxpb.DSSAuxService.ListSCDConstraintReferencesByManager:output_type -> auxpb.ListSCDConstraintReferencesByManagerResponse
+	31, // 69: auxpb.DSSAuxService.ResetManager:output_type -> auxpb.ResetManagerResponse
+	34, // 70: auxpb.DSSAuxService.WatchSCDChanges:output_type -> auxpb.WatchSCDChangesResponse
+	38, // 71: auxpb.DSSAuxService.CheckSCDConsistency:output_type -> auxpb.CheckSCDConsistencyResponse
+	41, // 72: auxpb.DSSAuxService.ReloadConfiguration:output_type -> auxpb.ReloadConfigurationResponse
+	44, // 73: auxpb.DSSAuxService.ListPoolInstances:output_type -> auxpb.ListPoolInstancesResponse
+	47, // 74: auxpb.DSSAuxService.GetMaintenanceWindow:output_type -> auxpb.GetMaintenanceWindowResponse
+	49, // 75: auxpb.DSSAuxService.ScheduleMaintenanceWindow:output_type -> auxpb.ScheduleMaintenanceWindowResponse
+	51, // 76: auxpb.DSSAuxService.CancelMaintenanceWindow:output_type -> auxpb.CancelMaintenanceWindowResponse
+	58, // [58:77] is the sub-list for method output_type
+	39, // [39:58] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
+}
+
+func init() { file_pkg_api_v1_auxpb_aux_service_proto_init() }
+func file_pkg_api_v1_auxpb_aux_service_proto_init() {
+	if File_pkg_api_v1_auxpb_aux_service_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Version); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVersionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVersionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchemaCompatibility); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HealthCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateOauthRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateOauthResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreIdentificationServiceAreaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RIDSubscriptionState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RIDSubscriberToNotify); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreIdentificationServiceAreaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportRIDRegionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckSCDConflictsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckSCDConflictsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EntityOVN); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckSCDOVNsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StaleOVN); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckSCDOVNsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSCDNotificationDeliveriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationDelivery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSCDNotificationDeliveriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSCDDssReportsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DssReportRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DssReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSCDDssReportsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSCDReferencesByManagerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSCDOperationalIntentReferencesByManagerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSCDConstraintReferencesByManagerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetManagerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetManagerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchSCDChangesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SCDEntityChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchSCDChangesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckSCDConsistencyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SCDKeyEntity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SCDInconsistency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckSCDConsistencyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReloadConfigurationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigurationChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReloadConfigurationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPoolInstancesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolInstance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPoolInstancesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaintenanceWindow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMaintenanceWindowRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMaintenanceWindowResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleMaintenanceWindowRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleMaintenanceWindowResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelMaintenanceWindowRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelMaintenanceWindowResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StandardErrorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_api_v1_auxpb_aux_service_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   54,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pkg_api_v1_auxpb_aux_service_proto_goTypes,
+		DependencyIndexes: file_pkg_api_v1_auxpb_aux_service_proto_depIdxs,
+		MessageInfos:      file_pkg_api_v1_auxpb_aux_service_proto_msgTypes,
+	}.Build()
+	File_pkg_api_v1_auxpb_aux_service_proto = out.File
+	file_pkg_api_v1_auxpb_aux_service_proto_rawDesc = nil
+	file_pkg_api_v1_auxpb_aux_service_proto_goTypes = nil
+	file_pkg_api_v1_auxpb_aux_service_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// DSSAuxServiceClient is the client API for DSSAuxService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DSSAuxServiceClient interface {
+	// /dss/version
+	//
+	// Queries the version of the DSS.
+	GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*GetVersionResponse, error)
+	// /aux/v1/status
+	//
+	// Queries the version of the DSS, whether the schemas of its databases are
+	// supported and whether its dependencies are healthy.
+	GetStatus(ctx context.Context, in *GetStatusRequest, opts ...grpc.CallOption) (*GetStatusResponse, error)
+	// /dss/validate_oauth
+	//
+	// Validate Oauth token against the DSS.
+	ValidateOauth(ctx context.Context, in *ValidateOauthRequest, opts ...grpc.CallOption) (*ValidateOauthResponse, error)
+	// /dss/rid/identification_service_areas/{id}/restore
+	//
+	// Restore an Identification Service Area deleted within the DSS instance's
+	// recovery window.
+	RestoreIdentificationServiceArea(ctx context.Context, in *RestoreIdentificationServiceAreaRequest, opts ...grpc.CallOption) (*RestoreIdentificationServiceAreaResponse, error)
+	// /dss/rid/export
+	//
+	// Export all active Identification Service Areas (and optionally
+	// Subscriptions) in an area as newline-delimited JSON or GeoJSON.
+	ExportRIDRegion(ctx context.Context, in *ExportRIDRegionRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// /dss/scd/conflict_check
+	//
+	// Find the operational intent and constraint references overlapping a set
+	// of 4D volumes, without changing the state of the DSS.
+	CheckSCDConflicts(ctx context.Context, in *CheckSCDConflictsRequest, opts ...grpc.CallOption) (*CheckSCDConflictsResponse, error)
+	// /dss/scd/ovn_check
+	//
+	// Report which of a set of operational intent and constraint reference OVNs
+	// are not current.
+	CheckSCDOVNs(ctx context.Context, in *CheckSCDOVNsRequest, opts ...grpc.CallOption) (*CheckSCDOVNsResponse, error)
+	// /dss/scd/subscriptions/{subscription_id}/notification_deliveries
+	//
+	// List the notifications the DSS delivered to one of the caller's
+	// Subscriptions, and their outcomes.
+	ListSCDNotificationDeliveries(ctx context.Context, in *ListSCDNotificationDeliveriesRequest, opts ...grpc.CallOption) (*ListSCDNotificationDeliveriesResponse, error)
+	// /dss/scd/reports
+	//
+	// List the reports submitted by USSs about discrepancies with the DSS.
+	ListSCDDssReports(ctx context.Context, in *ListSCDDssReportsRequest, opts ...grpc.CallOption) (*ListSCDDssReportsResponse, error)
+	// /dss/scd/managers/{manager}/operational_intent_references
+	//
+	// List the operational intent references managed by a USS.
+	ListSCDOperationalIntentReferencesByManager(ctx context.Context, in *ListSCDReferencesByManagerRequest, opts ...grpc.CallOption) (*ListSCDOperationalIntentReferencesByManagerResponse, error)
+	// /dss/scd/managers/{manager}/constraint_references
+	//
+	// List the constraint references managed by a USS.
+	ListSCDConstraintReferencesByManager(ctx context.Context, in *ListSCDReferencesByManagerRequest, opts ...grpc.CallOption) (*ListSCDConstraintReferencesByManagerResponse, error)
+	// /dss/managers/{manager}/reset
+	//
+	// Delete the identification service areas, operational intent references,
+	// constraint references and subscriptions managed by a USS, for resetting
+	// test environments.
+	ResetManager(ctx context.Context, in *ResetManagerRequest, opts ...grpc.CallOption) (*ResetManagerResponse, error)
+	// /dss/scd/changes
+	//
+	// Wait for and list the changes of operational intent and constraint
+	// references in an area since a cursor.
+	WatchSCDChanges(ctx context.Context, in *WatchSCDChangesRequest, opts ...grpc.CallOption) (*WatchSCDChangesResponse, error)
+	// /dss/scd/consistency_check
+	//
+	// Recompute the key a new plan in an area would need and cross-check it
+	// against the stored entities.
+	CheckSCDConsistency(ctx context.Context, in *CheckSCDConsistencyRequest, opts ...grpc.CallOption) (*CheckSCDConsistencyResponse, error)
+	// /dss/configuration/reload
+	//
+	// Reload the runtime configuration of the DSS instance serving the
+	// request from its config file and environment.
+	ReloadConfiguration(ctx context.Context, in *ReloadConfigurationRequest, opts ...grpc.CallOption) (*ReloadConfigurationResponse, error)
+	// /dss/pool/instances
+	//
+	// List the DSS instances of the pool registered in its shared remote ID
+	// database, with their versions and latest heartbeats.
+	ListPoolInstances(ctx context.Context, in *ListPoolInstancesRequest, opts ...grpc.CallOption) (*ListPoolInstancesResponse, error)
+	// /dss/maintenance
+	//
+	// Get the maintenance window of the pool.
+	GetMaintenanceWindow(ctx context.Context, in *GetMaintenanceWindowRequest, opts ...grpc.CallOption) (*GetMaintenanceWindowResponse, error)
+	// /dss/maintenance
+	//
+	// Schedule the maintenance window of the pool, e.g. for an upgrade.
+	ScheduleMaintenanceWindow(ctx context.Context, in *ScheduleMaintenanceWindowRequest, opts ...grpc.CallOption) (*ScheduleMaintenanceWindowResponse, error)
+	// /dss/maintenance
+	//
+	// Cancel the maintenance window of the pool.
+	CancelMaintenanceWindow(ctx context.Context, in *CancelMaintenanceWindowRequest, opts ...grpc.CallOption) (*CancelMaintenanceWindowResponse, error)
+}
+
+type dSSAuxServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDSSAuxServiceClient(cc grpc.ClientConnInterface) DSSAuxServiceClient {
+	return &dSSAuxServiceClient{cc}
+}
+
+func (c *dSSAuxServiceClient) GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*GetVersionResponse, error) {
+	out := new(GetVersionResponse)
 	err := c.cc.Invoke(ctx, "/auxpb.DSSAuxService/GetVersion", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *dSSAuxServiceClient) GetStatus(ctx context.Context, in *GetStatusRequest, opts ...grpc.CallOption) (*GetStatusResponse, error) {
+	out := new(GetStatusResponse)
+	err := c.cc.Invoke(ctx, "/auxpb.DSSAuxService/GetStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dSSAuxServiceClient) ValidateOauth(ctx context.Context, in *ValidateOauthRequest, opts ...grpc.CallOption) (*ValidateOauthResponse, error) {
+	out := new(ValidateOauthResponse)
+	err := c.cc.Invoke(ctx, "/auxpb.DSSAuxService/ValidateOauth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dSSAuxServiceClient) RestoreIdentificationServiceArea(ctx context.Context, in *RestoreIdentificationServiceAreaRequest, opts ...grpc.CallOption) (*RestoreIdentificationServiceAreaResponse, error) {
+	out := new(RestoreIdentificationServiceAreaResponse)
+	err := c.cc.Invoke(ctx, "/auxpb.DSSAuxService/RestoreIdentificationServiceArea", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dSSAuxServiceClient) ExportRIDRegion(ctx context.Context, in *ExportRIDRegionRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, "/auxpb.DSSAuxService/ExportRIDRegion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dSSAuxServiceClient) CheckSCDConflicts(ctx context.Context, in *CheckSCDConflictsRequest, opts ...grpc.CallOption) (*CheckSCDConflictsResponse, error) {
+	out := new(CheckSCDConflictsResponse)
+	err := c.cc.Invoke(ctx, "/auxpb.DSSAuxService/CheckSCDConflicts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dSSAuxServiceClient) CheckSCDOVNs(ctx context.Context, in *CheckSCDOVNsRequest, opts ...grpc.CallOption) (*CheckSCDOVNsResponse, error) {
+	out := new(CheckSCDOVNsResponse)
+	err := c.cc.Invoke(ctx, "/auxpb.DSSAuxService/CheckSCDOVNs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dSSAuxServiceClient) ListSCDNotificationDeliveries(ctx context.Context, in *ListSCDNotificationDeliveriesRequest, opts ...grpc.CallOption) (*ListSCDNotificationDeliveriesResponse, error) {
+	out := new(ListSCDNotificationDeliveriesResponse)
+	err := c.cc.Invoke(ctx, "/auxpb.DSSAuxService/ListSCDNotificationDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dSSAuxServiceClient) ListSCDDssReports(ctx context.Context, in *ListSCDDssReportsRequest, opts ...grpc.CallOption) (*ListSCDDssReportsResponse, error) {
+	out := new(ListSCDDssReportsResponse)
+	err := c.cc.Invoke(ctx, "/auxpb.DSSAuxService/ListSCDDssReports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dSSAuxServiceClient) ListSCDOperationalIntentReferencesByManager(ctx context.Context, in *ListSCDReferencesByManagerRequest, opts ...grpc.CallOption) (*ListSCDOperationalIntentReferencesByManagerResponse, error) {
+	out := new(ListSCDOperationalIntentReferencesByManagerResponse)
+	err := c.cc.Invoke(ctx, "/auxpb.DSSAuxService/ListSCDOperationalIntentReferencesByManager", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dSSAuxServiceClient) ListSCDConstraintReferencesByManager(ctx context.Context, in *ListSCDReferencesByManagerRequest, opts ...grpc.CallOption) (*ListSCDConstraintReferencesByManagerResponse, error) {
+	out := new(ListSCDConstraintReferencesByManagerResponse)
+	err := c.cc.Invoke(ctx, "/auxpb.DSSAuxService/ListSCDConstraintReferencesByManager", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dSSAuxServiceClient) ResetManager(ctx context.Context, in *ResetManagerRequest, opts ...grpc.CallOption) (*ResetManagerResponse, error) {
+	out := new(ResetManagerResponse)
+	err := c.cc.Invoke(ctx, "/auxpb.DSSAuxService/ResetManager", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dSSAuxServiceClient) WatchSCDChanges(ctx context.Context, in *WatchSCDChangesRequest, opts ...grpc.CallOption) (*WatchSCDChangesResponse, error) {
+	out := new(WatchSCDChangesResponse)
+	err := c.cc.Invoke(ctx, "/auxpb.DSSAuxService/WatchSCDChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dSSAuxServiceClient) CheckSCDConsistency(ctx context.Context, in *CheckSCDConsistencyRequest, opts ...grpc.CallOption) (*CheckSCDConsistencyResponse, error) {
+	out := new(CheckSCDConsistencyResponse)
+	err := c.cc.Invoke(ctx, "/auxpb.DSSAuxService/CheckSCDConsistency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dSSAuxServiceClient) ReloadConfiguration(ctx context.Context, in *ReloadConfigurationRequest, opts ...grpc.CallOption) (*ReloadConfigurationResponse, error) {
+	out := new(ReloadConfigurationResponse)
+	err := c.cc.Invoke(ctx, "/auxpb.DSSAuxService/ReloadConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dSSAuxServiceClient) ListPoolInstances(ctx context.Context, in *ListPoolInstancesRequest, opts ...grpc.CallOption) (*ListPoolInstancesResponse, error) {
+	out := new(ListPoolInstancesResponse)
+	err := c.cc.Invoke(ctx, "/auxpb.DSSAuxService/ListPoolInstances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dSSAuxServiceClient) GetMaintenanceWindow(ctx context.Context, in *GetMaintenanceWindowRequest, opts ...grpc.CallOption) (*GetMaintenanceWindowResponse, error) {
+	out := new(GetMaintenanceWindowResponse)
+	err := c.cc.Invoke(ctx, "/auxpb.DSSAuxService/GetMaintenanceWindow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dSSAuxServiceClient) ScheduleMaintenanceWindow(ctx context.Context, in *ScheduleMaintenanceWindowRequest, opts ...grpc.CallOption) (*ScheduleMaintenanceWindowResponse, error) {
+	out := new(ScheduleMaintenanceWindowResponse)
+	err := c.cc.Invoke(ctx, "/auxpb.DSSAuxService/ScheduleMaintenanceWindow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dSSAuxServiceClient) CancelMaintenanceWindow(ctx context.Context, in *CancelMaintenanceWindowRequest, opts ...grpc.CallOption) (*CancelMaintenanceWindowResponse, error) {
+	out := new(CancelMaintenanceWindowResponse)
+	err := c.cc.Invoke(ctx, "/auxpb.DSSAuxService/CancelMaintenanceWindow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DSSAuxServiceServer is the server API for DSSAuxService service.
+type DSSAuxServiceServer interface {
+	// /dss/version
+	//
+	// Queries the version of the DSS.
+	GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error)
+	// /aux/v1/status
+	//
+	// Queries the version of the DSS, whether the schemas of its databases are
+	// supported and whether its dependencies are healthy.
+	GetStatus(context.Context, *GetStatusRequest) (*GetStatusResponse, error)
+	// /dss/validate_oauth
+	//
+	// Validate Oauth token against the DSS.
+	ValidateOauth(context.Context, *ValidateOauthRequest) (*ValidateOauthResponse, error)
+	// /dss/rid/identification_service_areas/{id}/restore
+	//
+	// Restore an Identification Service Area deleted within the DSS instance's
+	// recovery window.
+	RestoreIdentificationServiceArea(context.Context, *RestoreIdentificationServiceAreaRequest) (*RestoreIdentificationServiceAreaResponse, error)
+	// /dss/rid/export
+	//
+	// Export all active Identification Service Areas (and optionally
+	// Subscriptions) in an area as newline-delimited JSON or GeoJSON.
+	ExportRIDRegion(context.Context, *ExportRIDRegionRequest) (*httpbody.HttpBody, error)
+	// /dss/scd/conflict_check
+	//
+	// Find the operational intent and constraint references overlapping a set
+	// of 4D volumes, without changing the state of the DSS.
+	CheckSCDConflicts(context.Context, *CheckSCDConflictsRequest) (*CheckSCDConflictsResponse, error)
+	// /dss/scd/ovn_check
+	//
+	// Report which of a set of operational intent and constraint reference OVNs
+	// are not current.
+	CheckSCDOVNs(context.Context, *CheckSCDOVNsRequest) (*CheckSCDOVNsResponse, error)
+	// /dss/scd/subscriptions/{subscription_id}/notification_deliveries
+	//
+	// List the notifications the DSS delivered to one of the caller's
+	// Subscriptions, and their outcomes.
+	ListSCDNotificationDeliveries(context.Context, *ListSCDNotificationDeliveriesRequest) (*ListSCDNotificationDeliveriesResponse, error)
+	// /dss/scd/reports
+	//
+	// List the reports submitted by USSs about discrepancies with the DSS.
+	ListSCDDssReports(context.Context, *ListSCDDssReportsRequest) (*ListSCDDssReportsResponse, error)
+	// /dss/scd/managers/{manager}/operational_intent_references
+	//
+	// List the operational intent references managed by a USS.
+	ListSCDOperationalIntentReferencesByManager(context.Context, *ListSCDReferencesByManagerRequest) (*ListSCDOperationalIntentReferencesByManagerResponse, error)
+	// /dss/scd/managers/{manager}/constraint_references
+	//
+	// List the constraint references managed by a USS.
+	ListSCDConstraintReferencesByManager(context.Context, *ListSCDReferencesByManagerRequest) (*ListSCDConstraintReferencesByManagerResponse, error)
+	// /dss/managers/{manager}/reset
+	//
+	// Delete the identification service areas, operational intent references,
+	// constraint references and subscriptions managed by a USS, for resetting
+	// test environments.
+	ResetManager(context.Context, *ResetManagerRequest) (*ResetManagerResponse, error)
+	// /dss/scd/changes
+	//
+	// Wait for and list the changes of operational intent and constraint
+	// references in an area since a cursor.
+	WatchSCDChanges(context.Context, *WatchSCDChangesRequest) (*WatchSCDChangesResponse, error)
+	// /dss/scd/consistency_check
+	//
+	// Recompute the key a new plan in an area would need and cross-check it
+	// against the stored entities.
+	CheckSCDConsistency(context.Context, *CheckSCDConsistencyRequest) (*CheckSCDConsistencyResponse, error)
+	// /dss/configuration/reload
+	//
+	// Reload the runtime configuration of the DSS instance serving the
+	// request from its config file and environment.
+	ReloadConfiguration(context.Context, *ReloadConfigurationRequest) (*ReloadConfigurationResponse, error)
+	// /dss/pool/instances
+	//
+	// List the DSS instances of the pool registered in its shared remote ID
+	// database, with their versions and latest heartbeats.
+	ListPoolInstances(context.Context, *ListPoolInstancesRequest) (*ListPoolInstancesResponse, error)
+	// /dss/maintenance
+	//
+	// Get the maintenance window of the pool.
+	GetMaintenanceWindow(context.Context, *GetMaintenanceWindowRequest) (*GetMaintenanceWindowResponse, error)
+	// /dss/maintenance
+	//
+	// Schedule the maintenance window of the pool, e.g. for an upgrade.
+	ScheduleMaintenanceWindow(context.Context, *ScheduleMaintenanceWindowRequest) (*ScheduleMaintenanceWindowResponse, error)
+	// /dss/maintenance
+	//
+	// Cancel the maintenance window of the pool.
+	CancelMaintenanceWindow(context.Context, *CancelMaintenanceWindowRequest) (*CancelMaintenanceWindowResponse, error)
+}
+
+// UnimplementedDSSAuxServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedDSSAuxServiceServer struct {
+}
+
+func (*UnimplementedDSSAuxServiceServer) GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVersion not implemented")
+}
+func (*UnimplementedDSSAuxServiceServer) GetStatus(context.Context, *GetStatusRequest) (*GetStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatus not implemented")
+}
+func (*UnimplementedDSSAuxServiceServer) ValidateOauth(context.Context, *ValidateOauthRequest) (*ValidateOauthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateOauth not implemented")
+}
+func (*UnimplementedDSSAuxServiceServer) RestoreIdentificationServiceArea(context.Context, *RestoreIdentificationServiceAreaRequest) (*RestoreIdentificationServiceAreaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreIdentificationServiceArea not implemented")
+}
+func (*UnimplementedDSSAuxServiceServer) ExportRIDRegion(context.Context, *ExportRIDRegionRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportRIDRegion not implemented")
+}
+func (*UnimplementedDSSAuxServiceServer) CheckSCDConflicts(context.Context, *CheckSCDConflictsRequest) (*CheckSCDConflictsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckSCDConflicts not implemented")
+}
+func (*UnimplementedDSSAuxServiceServer) CheckSCDOVNs(context.Context, *CheckSCDOVNsRequest) (*CheckSCDOVNsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckSCDOVNs not implemented")
+}
+func (*UnimplementedDSSAuxServiceServer) ListSCDNotificationDeliveries(context.Context, *ListSCDNotificationDeliveriesRequest) (*ListSCDNotificationDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSCDNotificationDeliveries not implemented")
+}
+func (*UnimplementedDSSAuxServiceServer) ListSCDDssReports(context.Context, *ListSCDDssReportsRequest) (*ListSCDDssReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSCDDssReports not implemented")
+}
+func (*UnimplementedDSSAuxServiceServer) ListSCDOperationalIntentReferencesByManager(context.Context, *ListSCDReferencesByManagerRequest) (*ListSCDOperationalIntentReferencesByManagerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSCDOperationalIntentReferencesByManager not implemented")
+}
+func (*UnimplementedDSSAuxServiceServer) ListSCDConstraintReferencesByManager(context.Context, *ListSCDReferencesByManagerRequest) (*ListSCDConstraintReferencesByManagerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSCDConstraintReferencesByManager not implemented")
+}
+func (*UnimplementedDSSAuxServiceServer) ResetManager(context.Context, *ResetManagerRequest) (*ResetManagerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetManager not implemented")
+}
+func (*UnimplementedDSSAuxServiceServer) WatchSCDChanges(context.Context, *WatchSCDChangesRequest) (*WatchSCDChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WatchSCDChanges not implemented")
+}
+func (*UnimplementedDSSAuxServiceServer) CheckSCDConsistency(context.Context, *CheckSCDConsistencyRequest) (*CheckSCDConsistencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckSCDConsistency not implemented")
+}
+func (*UnimplementedDSSAuxServiceServer) ReloadConfiguration(context.Context, *ReloadConfigurationRequest) (*ReloadConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadConfiguration not implemented")
+}
+func (*UnimplementedDSSAuxServiceServer) ListPoolInstances(context.Context, *ListPoolInstancesRequest) (*ListPoolInstancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPoolInstances not implemented")
+}
+func (*UnimplementedDSSAuxServiceServer) GetMaintenanceWindow(context.Context, *GetMaintenanceWindowRequest) (*GetMaintenanceWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMaintenanceWindow not implemented")
+}
+func (*UnimplementedDSSAuxServiceServer) ScheduleMaintenanceWindow(context.Context, *ScheduleMaintenanceWindowRequest) (*ScheduleMaintenanceWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMaintenanceWindow not implemented")
+}
+func (*UnimplementedDSSAuxServiceServer) CancelMaintenanceWindow(context.Context, *CancelMaintenanceWindowRequest) (*CancelMaintenanceWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelMaintenanceWindow not implemented")
+}
+
+func RegisterDSSAuxServiceServer(s *grpc.Server, srv DSSAuxServiceServer) {
+	s.RegisterService(&_DSSAuxService_serviceDesc, srv)
+}
+
+func _DSSAuxService_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DSSAuxServiceServer).GetVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auxpb.DSSAuxService/GetVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DSSAuxServiceServer).GetVersion(ctx, req.(*GetVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DSSAuxService_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DSSAuxServiceServer).GetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auxpb.DSSAuxService/GetStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DSSAuxServiceServer).GetStatus(ctx, req.(*GetStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DSSAuxService_ValidateOauth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateOauthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DSSAuxServiceServer).ValidateOauth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auxpb.DSSAuxService/ValidateOauth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DSSAuxServiceServer).ValidateOauth(ctx, req.(*ValidateOauthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *dSSAuxServiceClient) ValidateOauth(ctx context.Context, in *ValidateOauthRequest, opts ...grpc.CallOption) (*ValidateOauthResponse, error) {
-	out := new(ValidateOauthResponse)
-	err := c.cc.Invoke(ctx, "/auxpb.DSSAuxService/ValidateOauth", in, out, opts...)
-	if err != nil {
+func _DSSAuxService_RestoreIdentificationServiceArea_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreIdentificationServiceAreaRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(DSSAuxServiceServer).RestoreIdentificationServiceArea(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auxpb.DSSAuxService/RestoreIdentificationServiceArea",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DSSAuxServiceServer).RestoreIdentificationServiceArea(ctx, req.(*RestoreIdentificationServiceAreaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// DSSAuxServiceServer is the server API for DSSAuxService service.
-type DSSAuxServiceServer interface {
-	// /dss/version
-	//
-	// Queries the version of the DSS.
-	GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error)
-	// /dss/validate_oauth
-	//
-	// Validate Oauth token against the DSS.
-	ValidateOauth(context.Context, *ValidateOauthRequest) (*ValidateOauthResponse, error)
+func _DSSAuxService_ExportRIDRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportRIDRegionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DSSAuxServiceServer).ExportRIDRegion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auxpb.DSSAuxService/ExportRIDRegion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DSSAuxServiceServer).ExportRIDRegion(ctx, req.(*ExportRIDRegionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedDSSAuxServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedDSSAuxServiceServer struct {
+func _DSSAuxService_CheckSCDConflicts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckSCDConflictsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DSSAuxServiceServer).CheckSCDConflicts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auxpb.DSSAuxService/CheckSCDConflicts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DSSAuxServiceServer).CheckSCDConflicts(ctx, req.(*CheckSCDConflictsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (*UnimplementedDSSAuxServiceServer) GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVersion not implemented")
+func _DSSAuxService_CheckSCDOVNs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckSCDOVNsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DSSAuxServiceServer).CheckSCDOVNs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auxpb.DSSAuxService/CheckSCDOVNs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DSSAuxServiceServer).CheckSCDOVNs(ctx, req.(*CheckSCDOVNsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedDSSAuxServiceServer) ValidateOauth(context.Context, *ValidateOauthRequest) (*ValidateOauthResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidateOauth not implemented")
+
+func _DSSAuxService_ListSCDNotificationDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSCDNotificationDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DSSAuxServiceServer).ListSCDNotificationDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auxpb.DSSAuxService/ListSCDNotificationDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DSSAuxServiceServer).ListSCDNotificationDeliveries(ctx, req.(*ListSCDNotificationDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterDSSAuxServiceServer(s *grpc.Server, srv DSSAuxServiceServer) {
-	s.RegisterService(&_DSSAuxService_serviceDesc, srv)
+func _DSSAuxService_ListSCDDssReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSCDDssReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DSSAuxServiceServer).ListSCDDssReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auxpb.DSSAuxService/ListSCDDssReports",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DSSAuxServiceServer).ListSCDDssReports(ctx, req.(*ListSCDDssReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _DSSAuxService_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetVersionRequest)
+func _DSSAuxService_ListSCDOperationalIntentReferencesByManager_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSCDReferencesByManagerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DSSAuxServiceServer).GetVersion(ctx, in)
+		return srv.(DSSAuxServiceServer).ListSCDOperationalIntentReferencesByManager(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/auxpb.DSSAuxService/GetVersion",
+		FullMethod: "/auxpb.DSSAuxService/ListSCDOperationalIntentReferencesByManager",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DSSAuxServiceServer).GetVersion(ctx, req.(*GetVersionRequest))
+		return srv.(DSSAuxServiceServer).ListSCDOperationalIntentReferencesByManager(ctx, req.(*ListSCDReferencesByManagerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DSSAuxService_ValidateOauth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ValidateOauthRequest)
+func _DSSAuxService_ListSCDConstraintReferencesByManager_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSCDReferencesByManagerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DSSAuxServiceServer).ValidateOauth(ctx, in)
+		return srv.(DSSAuxServiceServer).ListSCDConstraintReferencesByManager(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/auxpb.DSSAuxService/ValidateOauth",
+		FullMethod: "/auxpb.DSSAuxService/ListSCDConstraintReferencesByManager",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DSSAuxServiceServer).ValidateOauth(ctx, req.(*ValidateOauthRequest))
+		return srv.(DSSAuxServiceServer).ListSCDConstraintReferencesByManager(ctx, req.(*ListSCDReferencesByManagerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DSSAuxService_ResetManager_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetManagerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DSSAuxServiceServer).ResetManager(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auxpb.DSSAuxService/ResetManager",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DSSAuxServiceServer).ResetManager(ctx, req.(*ResetManagerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DSSAuxService_WatchSCDChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchSCDChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DSSAuxServiceServer).WatchSCDChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auxpb.DSSAuxService/WatchSCDChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DSSAuxServiceServer).WatchSCDChanges(ctx, req.(*WatchSCDChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DSSAuxService_CheckSCDConsistency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckSCDConsistencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DSSAuxServiceServer).CheckSCDConsistency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auxpb.DSSAuxService/CheckSCDConsistency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DSSAuxServiceServer).CheckSCDConsistency(ctx, req.(*CheckSCDConsistencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DSSAuxService_ReloadConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DSSAuxServiceServer).ReloadConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auxpb.DSSAuxService/ReloadConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DSSAuxServiceServer).ReloadConfiguration(ctx, req.(*ReloadConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DSSAuxService_ListPoolInstances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPoolInstancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DSSAuxServiceServer).ListPoolInstances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auxpb.DSSAuxService/ListPoolInstances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DSSAuxServiceServer).ListPoolInstances(ctx, req.(*ListPoolInstancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DSSAuxService_GetMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DSSAuxServiceServer).GetMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auxpb.DSSAuxService/GetMaintenanceWindow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DSSAuxServiceServer).GetMaintenanceWindow(ctx, req.(*GetMaintenanceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DSSAuxService_ScheduleMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DSSAuxServiceServer).ScheduleMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auxpb.DSSAuxService/ScheduleMaintenanceWindow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DSSAuxServiceServer).ScheduleMaintenanceWindow(ctx, req.(*ScheduleMaintenanceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DSSAuxService_CancelMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DSSAuxServiceServer).CancelMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auxpb.DSSAuxService/CancelMaintenanceWindow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DSSAuxServiceServer).CancelMaintenanceWindow(ctx, req.(*CancelMaintenanceWindowRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -633,10 +5673,78 @@ var _DSSAuxService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetVersion",
 			Handler:    _DSSAuxService_GetVersion_Handler,
 		},
+		{
+			MethodName: "GetStatus",
+			Handler:    _DSSAuxService_GetStatus_Handler,
+		},
 		{
 			MethodName: "ValidateOauth",
 			Handler:    _DSSAuxService_ValidateOauth_Handler,
 		},
+		{
+			MethodName: "RestoreIdentificationServiceArea",
+			Handler:    _DSSAuxService_RestoreIdentificationServiceArea_Handler,
+		},
+		{
+			MethodName: "ExportRIDRegion",
+			Handler:    _DSSAuxService_ExportRIDRegion_Handler,
+		},
+		{
+			MethodName: "CheckSCDConflicts",
+			Handler:    _DSSAuxService_CheckSCDConflicts_Handler,
+		},
+		{
+			MethodName: "CheckSCDOVNs",
+			Handler:    _DSSAuxService_CheckSCDOVNs_Handler,
+		},
+		{
+			MethodName: "ListSCDNotificationDeliveries",
+			Handler:    _DSSAuxService_ListSCDNotificationDeliveries_Handler,
+		},
+		{
+			MethodName: "ListSCDDssReports",
+			Handler:    _DSSAuxService_ListSCDDssReports_Handler,
+		},
+		{
+			MethodName: "ListSCDOperationalIntentReferencesByManager",
+			Handler:    _DSSAuxService_ListSCDOperationalIntentReferencesByManager_Handler,
+		},
+		{
+			MethodName: "ListSCDConstraintReferencesByManager",
+			Handler:    _DSSAuxService_ListSCDConstraintReferencesByManager_Handler,
+		},
+		{
+			MethodName: "ResetManager",
+			Handler:    _DSSAuxService_ResetManager_Handler,
+		},
+		{
+			MethodName: "WatchSCDChanges",
+			Handler:    _DSSAuxService_WatchSCDChanges_Handler,
+		},
+		{
+			MethodName: "CheckSCDConsistency",
+			Handler:    _DSSAuxService_CheckSCDConsistency_Handler,
+		},
+		{
+			MethodName: "ReloadConfiguration",
+			Handler:    _DSSAuxService_ReloadConfiguration_Handler,
+		},
+		{
+			MethodName: "ListPoolInstances",
+			Handler:    _DSSAuxService_ListPoolInstances_Handler,
+		},
+		{
+			MethodName: "GetMaintenanceWindow",
+			Handler:    _DSSAuxService_GetMaintenanceWindow_Handler,
+		},
+		{
+			MethodName: "ScheduleMaintenanceWindow",
+			Handler:    _DSSAuxService_ScheduleMaintenanceWindow_Handler,
+		},
+		{
+			MethodName: "CancelMaintenanceWindow",
+			Handler:    _DSSAuxService_CancelMaintenanceWindow_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/api/v1/auxpb/aux_service.proto",
@@ -3228,6 +3228,10 @@ type PutSubscriptionResponse struct {
 	// Operational intents in or near the subscription area at the time of creation/update, if `notify_for_operational_intents` is true.
 	OperationalIntentReferences []*OperationalIntentReference `protobuf:"bytes,2,rep,name=operational_intent_references,json=operationalIntentReferences,proto3" json:"operational_intent_references,omitempty"`
 	Subscription                *Subscription                 `protobuf:"bytes,3,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	// Time at which the subscription expires, as applied by the DSS.
+	Expiry *Time `protobuf:"bytes,4,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// True when the DSS truncated the requested end time of the subscription to the maximum subscription duration.
+	ExpiryTruncated bool `protobuf:"varint,5,opt,name=expiry_truncated,json=expiryTruncated,proto3" json:"expiry_truncated,omitempty"`
 }
 
 func (x *PutSubscriptionResponse) Reset() {
@@ -3283,6 +3287,20 @@ func (x *PutSubscriptionResponse) GetSubscription() *Subscription {
 	return nil
 }
 
+func (x *PutSubscriptionResponse) GetExpiry() *Time {
+	if x != nil {
+		return x.Expiry
+	}
+	return nil
+}
+
+func (x *PutSubscriptionResponse) GetExpiryTruncated() bool {
+	if x != nil {
+		return x.ExpiryTruncated
+	}
+	return false
+}
+
 // Parameters for a request to find ConstraintReferences matching the provided criteria.
 type QueryConstraintReferenceParameters struct {
 	state         protoimpl.MessageState
@@ -5527,7 +5545,7 @@ var file_pkg_api_v1_scdpb_scd_proto_rawDesc = []byte{
 	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x0a,
 	0x0c, 0x75, 0x73, 0x73, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x73, 0x42, 0x61, 0x73, 0x65, 0x55, 0x72, 0x6c, 0x22,
-	0x8a, 0x02, 0x0a, 0x17, 0x50, 0x75, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0xda, 0x02, 0x0a, 0x17, 0x50, 0x75, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
 	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x15, 0x63,
 	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
 	0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x63, 0x64,
@@ -5543,7 +5561,12 @@ var file_pkg_api_v1_scdpb_scd_proto_rawDesc = []byte{
 	0x63, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
 	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x63, 0x64, 0x70,
 	0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c,
-	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5f, 0x0a, 0x22,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x06,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x73,
+	0x63, 0x64, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x79, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x74, 0x72, 0x75, 0x6e,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x79, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0x5f, 0x0a, 0x22,
 	0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52,
 	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
 	0x72, 0x73, 0x12, 0x39, 0x0a, 0x10, 0x61, 0x72, 0x65, 0x61, 0x5f, 0x6f, 0x66, 0x5f, 0x69, 0x6e,
@@ -6153,85 +6176,86 @@ var file_pkg_api_v1_scdpb_scd_proto_depIdxs = []int32{
 	9,   // 51: scdpb.PutSubscriptionResponse.constraint_references:type_name -> scdpb.ConstraintReference
 	37,  // 52: scdpb.PutSubscriptionResponse.operational_intent_references:type_name -> scdpb.OperationalIntentReference
 	62,  // 53: scdpb.PutSubscriptionResponse.subscription:type_name -> scdpb.Subscription
-	76,  // 54: scdpb.QueryConstraintReferenceParameters.area_of_interest:type_name -> scdpb.Volume4D
-	49,  // 55: scdpb.QueryConstraintReferencesRequest.params:type_name -> scdpb.QueryConstraintReferenceParameters
-	9,   // 56: scdpb.QueryConstraintReferencesResponse.constraint_references:type_name -> scdpb.ConstraintReference
-	76,  // 57: scdpb.QueryOperationalIntentReferenceParameters.area_of_interest:type_name -> scdpb.Volume4D
-	37,  // 58: scdpb.QueryOperationalIntentReferenceResponse.operational_intent_references:type_name -> scdpb.OperationalIntentReference
-	52,  // 59: scdpb.QueryOperationalIntentReferencesRequest.params:type_name -> scdpb.QueryOperationalIntentReferenceParameters
-	76,  // 60: scdpb.QuerySubscriptionParameters.area_of_interest:type_name -> scdpb.Volume4D
-	55,  // 61: scdpb.QuerySubscriptionsRequest.params:type_name -> scdpb.QuerySubscriptionParameters
-	62,  // 62: scdpb.QuerySubscriptionsResponse.subscriptions:type_name -> scdpb.Subscription
-	60,  // 63: scdpb.SetUssAvailabilityRequest.params:type_name -> scdpb.SetUssAvailabilityStatusParameters
-	63,  // 64: scdpb.SubscriberToNotify.subscriptions:type_name -> scdpb.SubscriptionState
-	64,  // 65: scdpb.Subscription.time_end:type_name -> scdpb.Time
-	64,  // 66: scdpb.Subscription.time_start:type_name -> scdpb.Time
-	78,  // 67: scdpb.Time.value:type_name -> google.protobuf.Timestamp
-	8,   // 68: scdpb.USSLogSet.constraint_provider_associations:type_name -> scdpb.ConstraintProviderAssociation
-	19,  // 69: scdpb.USSLogSet.messages:type_name -> scdpb.ExchangeRecord
-	36,  // 70: scdpb.USSLogSet.operational_intent_positions:type_name -> scdpb.OperationalIntentPositions
-	38,  // 71: scdpb.USSLogSet.operator_associations:type_name -> scdpb.OperatorAssociation
-	69,  // 72: scdpb.USSLogSet.operator_inputs:type_name -> scdpb.UserInputRecord
-	70,  // 73: scdpb.USSLogSet.operator_notifications:type_name -> scdpb.UserNotificationRecord
-	39,  // 74: scdpb.USSLogSet.planning_attempts:type_name -> scdpb.PlanningRecord
-	44,  // 75: scdpb.UpdateConstraintReferenceRequest.params:type_name -> scdpb.PutConstraintReferenceParameters
-	46,  // 76: scdpb.UpdateOperationalIntentReferenceRequest.params:type_name -> scdpb.PutOperationalIntentReferenceParameters
-	47,  // 77: scdpb.UpdateSubscriptionRequest.params:type_name -> scdpb.PutSubscriptionParameters
-	64,  // 78: scdpb.UserInputRecord.triggering_event_time:type_name -> scdpb.Time
-	64,  // 79: scdpb.UserNotificationRecord.notification_time:type_name -> scdpb.Time
-	64,  // 80: scdpb.UserNotificationRecord.triggering_event_time:type_name -> scdpb.Time
-	71,  // 81: scdpb.UssAvailabilityStatusResponse.status:type_name -> scdpb.UssAvailabilityStatus
-	41,  // 82: scdpb.VehicleTelemetry.position:type_name -> scdpb.Position
-	64,  // 83: scdpb.VehicleTelemetry.time_measured:type_name -> scdpb.Time
-	74,  // 84: scdpb.VehicleTelemetry.velocity:type_name -> scdpb.Velocity
-	1,   // 85: scdpb.Volume3D.altitude_lower:type_name -> scdpb.Altitude
-	1,   // 86: scdpb.Volume3D.altitude_upper:type_name -> scdpb.Altitude
-	5,   // 87: scdpb.Volume3D.outline_circle:type_name -> scdpb.Circle
-	40,  // 88: scdpb.Volume3D.outline_polygon:type_name -> scdpb.Polygon
-	64,  // 89: scdpb.Volume4D.time_end:type_name -> scdpb.Time
-	64,  // 90: scdpb.Volume4D.time_start:type_name -> scdpb.Time
-	75,  // 91: scdpb.Volume4D.volume:type_name -> scdpb.Volume3D
-	10,  // 92: scdpb.UTMAPIUSSDSSAndUSSUSSService.CreateConstraintReference:input_type -> scdpb.CreateConstraintReferenceRequest
-	11,  // 93: scdpb.UTMAPIUSSDSSAndUSSUSSService.CreateOperationalIntentReference:input_type -> scdpb.CreateOperationalIntentReferenceRequest
-	12,  // 94: scdpb.UTMAPIUSSDSSAndUSSUSSService.CreateSubscription:input_type -> scdpb.CreateSubscriptionRequest
-	13,  // 95: scdpb.UTMAPIUSSDSSAndUSSUSSService.DeleteConstraintReference:input_type -> scdpb.DeleteConstraintReferenceRequest
-	14,  // 96: scdpb.UTMAPIUSSDSSAndUSSUSSService.DeleteOperationalIntentReference:input_type -> scdpb.DeleteOperationalIntentReferenceRequest
-	15,  // 97: scdpb.UTMAPIUSSDSSAndUSSUSSService.DeleteSubscription:input_type -> scdpb.DeleteSubscriptionRequest
-	22,  // 98: scdpb.UTMAPIUSSDSSAndUSSUSSService.GetConstraintReference:input_type -> scdpb.GetConstraintReferenceRequest
-	25,  // 99: scdpb.UTMAPIUSSDSSAndUSSUSSService.GetOperationalIntentReference:input_type -> scdpb.GetOperationalIntentReferenceRequest
-	28,  // 100: scdpb.UTMAPIUSSDSSAndUSSUSSService.GetSubscription:input_type -> scdpb.GetSubscriptionRequest
-	30,  // 101: scdpb.UTMAPIUSSDSSAndUSSUSSService.GetUssAvailability:input_type -> scdpb.GetUssAvailabilityRequest
-	33,  // 102: scdpb.UTMAPIUSSDSSAndUSSUSSService.MakeDssReport:input_type -> scdpb.MakeDssReportRequest
-	50,  // 103: scdpb.UTMAPIUSSDSSAndUSSUSSService.QueryConstraintReferences:input_type -> scdpb.QueryConstraintReferencesRequest
-	54,  // 104: scdpb.UTMAPIUSSDSSAndUSSUSSService.QueryOperationalIntentReferences:input_type -> scdpb.QueryOperationalIntentReferencesRequest
-	56,  // 105: scdpb.UTMAPIUSSDSSAndUSSUSSService.QuerySubscriptions:input_type -> scdpb.QuerySubscriptionsRequest
-	59,  // 106: scdpb.UTMAPIUSSDSSAndUSSUSSService.SetUssAvailability:input_type -> scdpb.SetUssAvailabilityRequest
-	66,  // 107: scdpb.UTMAPIUSSDSSAndUSSUSSService.UpdateConstraintReference:input_type -> scdpb.UpdateConstraintReferenceRequest
-	67,  // 108: scdpb.UTMAPIUSSDSSAndUSSUSSService.UpdateOperationalIntentReference:input_type -> scdpb.UpdateOperationalIntentReferenceRequest
-	68,  // 109: scdpb.UTMAPIUSSDSSAndUSSUSSService.UpdateSubscription:input_type -> scdpb.UpdateSubscriptionRequest
-	3,   // 110: scdpb.UTMAPIUSSDSSAndUSSUSSService.CreateConstraintReference:output_type -> scdpb.ChangeConstraintReferenceResponse
-	4,   // 111: scdpb.UTMAPIUSSDSSAndUSSUSSService.CreateOperationalIntentReference:output_type -> scdpb.ChangeOperationalIntentReferenceResponse
-	48,  // 112: scdpb.UTMAPIUSSDSSAndUSSUSSService.CreateSubscription:output_type -> scdpb.PutSubscriptionResponse
-	3,   // 113: scdpb.UTMAPIUSSDSSAndUSSUSSService.DeleteConstraintReference:output_type -> scdpb.ChangeConstraintReferenceResponse
-	4,   // 114: scdpb.UTMAPIUSSDSSAndUSSUSSService.DeleteOperationalIntentReference:output_type -> scdpb.ChangeOperationalIntentReferenceResponse
-	16,  // 115: scdpb.UTMAPIUSSDSSAndUSSUSSService.DeleteSubscription:output_type -> scdpb.DeleteSubscriptionResponse
-	23,  // 116: scdpb.UTMAPIUSSDSSAndUSSUSSService.GetConstraintReference:output_type -> scdpb.GetConstraintReferenceResponse
-	26,  // 117: scdpb.UTMAPIUSSDSSAndUSSUSSService.GetOperationalIntentReference:output_type -> scdpb.GetOperationalIntentReferenceResponse
-	29,  // 118: scdpb.UTMAPIUSSDSSAndUSSUSSService.GetSubscription:output_type -> scdpb.GetSubscriptionResponse
-	72,  // 119: scdpb.UTMAPIUSSDSSAndUSSUSSService.GetUssAvailability:output_type -> scdpb.UssAvailabilityStatusResponse
-	17,  // 120: scdpb.UTMAPIUSSDSSAndUSSUSSService.MakeDssReport:output_type -> scdpb.ErrorReport
-	51,  // 121: scdpb.UTMAPIUSSDSSAndUSSUSSService.QueryConstraintReferences:output_type -> scdpb.QueryConstraintReferencesResponse
-	53,  // 122: scdpb.UTMAPIUSSDSSAndUSSUSSService.QueryOperationalIntentReferences:output_type -> scdpb.QueryOperationalIntentReferenceResponse
-	57,  // 123: scdpb.UTMAPIUSSDSSAndUSSUSSService.QuerySubscriptions:output_type -> scdpb.QuerySubscriptionsResponse
-	72,  // 124: scdpb.UTMAPIUSSDSSAndUSSUSSService.SetUssAvailability:output_type -> scdpb.UssAvailabilityStatusResponse
-	3,   // 125: scdpb.UTMAPIUSSDSSAndUSSUSSService.UpdateConstraintReference:output_type -> scdpb.ChangeConstraintReferenceResponse
-	4,   // 126: scdpb.UTMAPIUSSDSSAndUSSUSSService.UpdateOperationalIntentReference:output_type -> scdpb.ChangeOperationalIntentReferenceResponse
-	48,  // 127: scdpb.UTMAPIUSSDSSAndUSSUSSService.UpdateSubscription:output_type -> scdpb.PutSubscriptionResponse
-	110, // [110:128] is the sub-list for method output_type
-	92,  // [92:110] is the sub-list for method input_type
-	92,  // [92:92] is the sub-list for extension type_name
-	92,  // [92:92] is the sub-list for extension extendee
-	0,   // [0:92] is the sub-list for field type_name
+	64,  // 54: scdpb.PutSubscriptionResponse.expiry:type_name -> scdpb.Time
+	76,  // 55: scdpb.QueryConstraintReferenceParameters.area_of_interest:type_name -> scdpb.Volume4D
+	49,  // 56: scdpb.QueryConstraintReferencesRequest.params:type_name -> scdpb.QueryConstraintReferenceParameters
+	9,   // 57: scdpb.QueryConstraintReferencesResponse.constraint_references:type_name -> scdpb.ConstraintReference
+	76,  // 58: scdpb.QueryOperationalIntentReferenceParameters.area_of_interest:type_name -> scdpb.Volume4D
+	37,  // 59: scdpb.QueryOperationalIntentReferenceResponse.operational_intent_references:type_name -> scdpb.OperationalIntentReference
+	52,  // 60: scdpb.QueryOperationalIntentReferencesRequest.params:type_name -> scdpb.QueryOperationalIntentReferenceParameters
+	76,  // 61: scdpb.QuerySubscriptionParameters.area_of_interest:type_name -> scdpb.Volume4D
+	55,  // 62: scdpb.QuerySubscriptionsRequest.params:type_name -> scdpb.QuerySubscriptionParameters
+	62,  // 63: scdpb.QuerySubscriptionsResponse.subscriptions:type_name -> scdpb.Subscription
+	60,  // 64: scdpb.SetUssAvailabilityRequest.params:type_name -> scdpb.SetUssAvailabilityStatusParameters
+	63,  // 65: scdpb.SubscriberToNotify.subscriptions:type_name -> scdpb.SubscriptionState
+	64,  // 66: scdpb.Subscription.time_end:type_name -> scdpb.Time
+	64,  // 67: scdpb.Subscription.time_start:type_name -> scdpb.Time
+	78,  // 68: scdpb.Time.value:type_name -> google.protobuf.Timestamp
+	8,   // 69: scdpb.USSLogSet.constraint_provider_associations:type_name -> scdpb.ConstraintProviderAssociation
+	19,  // 70: scdpb.USSLogSet.messages:type_name -> scdpb.ExchangeRecord
+	36,  // 71: scdpb.USSLogSet.operational_intent_positions:type_name -> scdpb.OperationalIntentPositions
+	38,  // 72: scdpb.USSLogSet.operator_associations:type_name -> scdpb.OperatorAssociation
+	69,  // 73: scdpb.USSLogSet.operator_inputs:type_name -> scdpb.UserInputRecord
+	70,  // 74: scdpb.USSLogSet.operator_notifications:type_name -> scdpb.UserNotificationRecord
+	39,  // 75: scdpb.USSLogSet.planning_attempts:type_name -> scdpb.PlanningRecord
+	44,  // 76: scdpb.UpdateConstraintReferenceRequest.params:type_name -> scdpb.PutConstraintReferenceParameters
+	46,  // 77: scdpb.UpdateOperationalIntentReferenceRequest.params:type_name -> scdpb.PutOperationalIntentReferenceParameters
+	47,  // 78: scdpb.UpdateSubscriptionRequest.params:type_name -> scdpb.PutSubscriptionParameters
+	64,  // 79: scdpb.UserInputRecord.triggering_event_time:type_name -> scdpb.Time
+	64,  // 80: scdpb.UserNotificationRecord.notification_time:type_name -> scdpb.Time
+	64,  // 81: scdpb.UserNotificationRecord.triggering_event_time:type_name -> scdpb.Time
+	71,  // 82: scdpb.UssAvailabilityStatusResponse.status:type_name -> scdpb.UssAvailabilityStatus
+	41,  // 83: scdpb.VehicleTelemetry.position:type_name -> scdpb.Position
+	64,  // 84: scdpb.VehicleTelemetry.time_measured:type_name -> scdpb.Time
+	74,  // 85: scdpb.VehicleTelemetry.velocity:type_name -> scdpb.Velocity
+	1,   // 86: scdpb.Volume3D.altitude_lower:type_name -> scdpb.Altitude
+	1,   // 87: scdpb.Volume3D.altitude_upper:type_name -> scdpb.Altitude
+	5,   // 88: scdpb.Volume3D.outline_circle:type_name -> scdpb.Circle
+	40,  // 89: scdpb.Volume3D.outline_polygon:type_name -> scdpb.Polygon
+	64,  // 90: scdpb.Volume4D.time_end:type_name -> scdpb.Time
+	64,  // 91: scdpb.Volume4D.time_start:type_name -> scdpb.Time
+	75,  // 92: scdpb.Volume4D.volume:type_name -> scdpb.Volume3D
+	10,  // 93: scdpb.UTMAPIUSSDSSAndUSSUSSService.CreateConstraintReference:input_type -> scdpb.CreateConstraintReferenceRequest
+	11,  // 94: scdpb.UTMAPIUSSDSSAndUSSUSSService.CreateOperationalIntentReference:input_type -> scdpb.CreateOperationalIntentReferenceRequest
+	12,  // 95: scdpb.UTMAPIUSSDSSAndUSSUSSService.CreateSubscription:input_type -> scdpb.CreateSubscriptionRequest
+	13,  // 96: scdpb.UTMAPIUSSDSSAndUSSUSSService.DeleteConstraintReference:input_type -> scdpb.DeleteConstraintReferenceRequest
+	14,  // 97: scdpb.UTMAPIUSSDSSAndUSSUSSService.DeleteOperationalIntentReference:input_type -> scdpb.DeleteOperationalIntentReferenceRequest
+	15,  // 98: scdpb.UTMAPIUSSDSSAndUSSUSSService.DeleteSubscription:input_type -> scdpb.DeleteSubscriptionRequest
+	22,  // 99: scdpb.UTMAPIUSSDSSAndUSSUSSService.GetConstraintReference:input_type -> scdpb.GetConstraintReferenceRequest
+	25,  // 100: scdpb.UTMAPIUSSDSSAndUSSUSSService.GetOperationalIntentReference:input_type -> scdpb.GetOperationalIntentReferenceRequest
+	28,  // 101: scdpb.UTMAPIUSSDSSAndUSSUSSService.GetSubscription:input_type -> scdpb.GetSubscriptionRequest
+	30,  // 102: scdpb.UTMAPIUSSDSSAndUSSUSSService.GetUssAvailability:input_type -> scdpb.GetUssAvailabilityRequest
+	33,  // 103: scdpb.UTMAPIUSSDSSAndUSSUSSService.MakeDssReport:input_type -> scdpb.MakeDssReportRequest
+	50,  // 104: scdpb.UTMAPIUSSDSSAndUSSUSSService.QueryConstraintReferences:input_type -> scdpb.QueryConstraintReferencesRequest
+	54,  // 105: scdpb.UTMAPIUSSDSSAndUSSUSSService.QueryOperationalIntentReferences:input_type -> scdpb.QueryOperationalIntentReferencesRequest
+	56,  // 106: scdpb.UTMAPIUSSDSSAndUSSUSSService.QuerySubscriptions:input_type -> scdpb.QuerySubscriptionsRequest
+	59,  // 107: scdpb.UTMAPIUSSDSSAndUSSUSSService.SetUssAvailability:input_type -> scdpb.SetUssAvailabilityRequest
+	66,  // 108: scdpb.UTMAPIUSSDSSAndUSSUSSService.UpdateConstraintReference:input_type -> scdpb.UpdateConstraintReferenceRequest
+	67,  // 109: scdpb.UTMAPIUSSDSSAndUSSUSSService.UpdateOperationalIntentReference:input_type -> scdpb.UpdateOperationalIntentReferenceRequest
+	68,  // 110: scdpb.UTMAPIUSSDSSAndUSSUSSService.UpdateSubscription:input_type -> scdpb.UpdateSubscriptionRequest
+	3,   // 111: scdpb.UTMAPIUSSDSSAndUSSUSSService.CreateConstraintReference:output_type -> scdpb.ChangeConstraintReferenceResponse
+	4,   // 112: scdpb.UTMAPIUSSDSSAndUSSUSSService.CreateOperationalIntentReference:output_type -> scdpb.ChangeOperationalIntentReferenceResponse
+	48,  // 113: scdpb.UTMAPIUSSDSSAndUSSUSSService.CreateSubscription:output_type -> scdpb.PutSubscriptionResponse
+	3,   // 114: scdpb.UTMAPIUSSDSSAndUSSUSSService.DeleteConstraintReference:output_type -> scdpb.ChangeConstraintReferenceResponse
+	4,   // 115: scdpb.UTMAPIUSSDSSAndUSSUSSService.DeleteOperationalIntentReference:output_type -> scdpb.ChangeOperationalIntentReferenceResponse
+	16,  // 116: scdpb.UTMAPIUSSDSSAndUSSUSSService.DeleteSubscription:output_type -> scdpb.DeleteSubscriptionResponse
+	23,  // 117: scdpb.UTMAPIUSSDSSAndUSSUSSService.GetConstraintReference:output_type -> scdpb.GetConstraintReferenceResponse
+	26,  // 118: scdpb.UTMAPIUSSDSSAndUSSUSSService.GetOperationalIntentReference:output_type -> scdpb.GetOperationalIntentReferenceResponse
+	29,  // 119: scdpb.UTMAPIUSSDSSAndUSSUSSService.GetSubscription:output_type -> scdpb.GetSubscriptionResponse
+	72,  // 120: scdpb.UTMAPIUSSDSSAndUSSUSSService.GetUssAvailability:output_type -> scdpb.UssAvailabilityStatusResponse
+	17,  // 121: scdpb.UTMAPIUSSDSSAndUSSUSSService.MakeDssReport:output_type -> scdpb.ErrorReport
+	51,  // 122: scdpb.UTMAPIUSSDSSAndUSSUSSService.QueryConstraintReferences:output_type -> scdpb.QueryConstraintReferencesResponse
+	53,  // 123: scdpb.UTMAPIUSSDSSAndUSSUSSService.QueryOperationalIntentReferences:output_type -> scdpb.QueryOperationalIntentReferenceResponse
+	57,  // 124: scdpb.UTMAPIUSSDSSAndUSSUSSService.QuerySubscriptions:output_type -> scdpb.QuerySubscriptionsResponse
+	72,  // 125: scdpb.UTMAPIUSSDSSAndUSSUSSService.SetUssAvailability:output_type -> scdpb.UssAvailabilityStatusResponse
+	3,   // 126: scdpb.UTMAPIUSSDSSAndUSSUSSService.UpdateConstraintReference:output_type -> scdpb.ChangeConstraintReferenceResponse
+	4,   // 127: scdpb.UTMAPIUSSDSSAndUSSUSSService.UpdateOperationalIntentReference:output_type -> scdpb.ChangeOperationalIntentReferenceResponse
+	48,  // 128: scdpb.UTMAPIUSSDSSAndUSSUSSService.UpdateSubscription:output_type -> scdpb.PutSubscriptionResponse
+	111, // [111:129] is the sub-list for method output_type
+	93,  // [93:111] is the sub-list for method input_type
+	93,  // [93:93] is the sub-list for extension type_name
+	93,  // [93:93] is the sub-list for extension extendee
+	0,   // [0:93] is the sub-list for field type_name
 }
 
 func init() { file_pkg_api_v1_scdpb_scd_proto_init() }
@@ -17,6 +17,8 @@ import (
 	dsserr "github.com/interuss/dss/pkg/errors"
 	"github.com/interuss/dss/pkg/logging"
 	"github.com/interuss/dss/pkg/models"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 
 	"github.com/golang-jwt/jwt"
 	"github.com/interuss/stacktrace"
@@ -29,6 +31,11 @@ import (
 var (
 	// ContextKeyOwner is the key to an owner value.
 	ContextKeyOwner ContextKey = "owner"
+
+	authOutcomes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "dss_auth_requests_total",
+		Help: "Number of requests checked by the authorizer, by outcome (authorized, missing_token, invalid_token, invalid_audience or missing_scopes).",
+	}, []string{"outcome"})
 )
 
 // ContextKey models auth-specific keys in a context.
@@ -155,6 +162,30 @@ func (r *JWKSResolver) ResolveKeys(ctx context.Context) ([]interface{}, error) {
 	return keys, nil
 }
 
+// NewKeyResolver returns the KeyResolver of the comma-separated
+// publicKeyFiles, or else of the comma-separated jwksKeyIDs served at
+// jwksEndpoint, or nil when neither is specified.
+func NewKeyResolver(publicKeyFiles, jwksEndpoint, jwksKeyIDs string) (KeyResolver, error) {
+	switch {
+	case publicKeyFiles != "":
+		return &FromFileKeyResolver{
+			KeyFiles: strings.Split(publicKeyFiles, ","),
+		}, nil
+	case jwksEndpoint != "" && jwksKeyIDs != "":
+		u, err := url.Parse(jwksEndpoint)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Error parsing JWKS URL")
+		}
+
+		return &JWKSResolver{
+			Endpoint: u,
+			KeyIDs:   strings.Split(jwksKeyIDs, ","),
+		}, nil
+	default:
+		return nil, nil
+	}
+}
+
 // KeyClaimedScopesValidator validates a set of scopes claimed by an incoming
 // JWT.
 type KeyClaimedScopesValidator interface {
@@ -254,11 +285,16 @@ func RequireAnyScope(scopes ...Scope) KeyClaimedScopesValidator {
 
 // Authorizer authorizes incoming requests.
 type Authorizer struct {
-	logger            *zap.Logger
-	keys              []interface{}
+	logger        *zap.Logger
+	keys          []interface{}
+	keyRefreshErr error
+	keyResolver   KeyResolver
+	// keyGuard guards keys, keyRefreshErr, keyResolver and acceptedAudiences,
+	// which may be reconfigured while requests are served.
 	keyGuard          sync.RWMutex
 	scopesValidators  map[Operation]KeyClaimedScopesValidator
 	acceptedAudiences map[string]bool
+	unauthenticated   map[Operation]bool
 }
 
 // Configuration bundles up creation-time parameters for an Authorizer instance.
@@ -267,6 +303,7 @@ type Configuration struct {
 	KeyRefreshTimeout time.Duration                           // Keys are refreshed on this cadence.
 	ScopesValidators  map[Operation]KeyClaimedScopesValidator // ScopesValidators are used to enforce authorization for operations.
 	AcceptedAudiences []string                                // AcceptedAudiences enforces the aud keyClaim on the jwt. An empty string allows no aud keyClaim.
+	Unauthenticated   []Operation                             // Unauthenticated operations are served without access token, e.g. for health checks.
 }
 
 // NewRSAAuthorizer returns an Authorizer instance using values from configuration.
@@ -278,16 +315,18 @@ func NewRSAAuthorizer(ctx context.Context, configuration Configuration) (*Author
 		return nil, stacktrace.Propagate(err, "Unable to resolve keys")
 	}
 
-	auds := make(map[string]bool)
-	for _, s := range configuration.AcceptedAudiences {
-		auds[s] = true
+	unauthenticated := make(map[Operation]bool)
+	for _, op := range configuration.Unauthenticated {
+		unauthenticated[op] = true
 	}
 
 	authorizer := &Authorizer{
 		scopesValidators:  configuration.ScopesValidators,
-		acceptedAudiences: auds,
+		acceptedAudiences: audienceSet(configuration.AcceptedAudiences),
+		unauthenticated:   unauthenticated,
 		logger:            logger,
 		keys:              keys,
+		keyResolver:       configuration.KeyResolver,
 	}
 
 	go func() {
@@ -297,9 +336,16 @@ func NewRSAAuthorizer(ctx context.Context, configuration Configuration) (*Author
 		for {
 			select {
 			case <-ticker.C:
-				keys, err := configuration.KeyResolver.ResolveKeys(ctx)
+				authorizer.keyGuard.RLock()
+				resolver := authorizer.keyResolver
+				authorizer.keyGuard.RUnlock()
+				keys, err := resolver.ResolveKeys(ctx)
 				if err != nil {
-					logger.Panic("failed to refresh key", zap.Error(err))
+					// Keep verifying access tokens with the previous keys,
+					// while reporting the DSS unhealthy through CheckKeys.
+					logger.Error("failed to refresh key", zap.Error(err))
+					authorizer.setKeyRefreshError(err)
+					continue
 				}
 
 				authorizer.setKeys(keys)
@@ -313,26 +359,113 @@ func NewRSAAuthorizer(ctx context.Context, configuration Configuration) (*Author
 	return authorizer, nil
 }
 
+func audienceSet(audiences []string) map[string]bool {
+	auds := make(map[string]bool)
+	for _, s := range audiences {
+		auds[s] = true
+	}
+	return auds
+}
+
+// SetAcceptedAudiences replaces the audiences accepted in the aud claim of
+// access tokens.
+func (a *Authorizer) SetAcceptedAudiences(audiences []string) {
+	auds := audienceSet(audiences)
+	a.keyGuard.Lock()
+	a.acceptedAudiences = auds
+	a.keyGuard.Unlock()
+}
+
+// SetKeyResolver resolves the keys of resolver, then verifies access tokens
+// with them and refreshes them from resolver from now on.  The previous keys
+// remain in use if resolver fails.
+func (a *Authorizer) SetKeyResolver(ctx context.Context, resolver KeyResolver) error {
+	keys, err := resolver.ResolveKeys(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "Unable to resolve keys")
+	}
+	a.keyGuard.Lock()
+	a.keys = keys
+	a.keyRefreshErr = nil
+	a.keyResolver = resolver
+	a.keyGuard.Unlock()
+	return nil
+}
+
 func (a *Authorizer) setKeys(keys []interface{}) {
 	a.keyGuard.Lock()
 	a.keys = keys
+	a.keyRefreshErr = nil
+	a.keyGuard.Unlock()
+}
+
+func (a *Authorizer) setKeyRefreshError(err error) {
+	a.keyGuard.Lock()
+	a.keyRefreshErr = err
 	a.keyGuard.Unlock()
 }
 
+// CheckKeys returns why the keys verifying access tokens are unavailable:
+// because there are none, or because their last refresh failed, e.g. when the
+// JWKS endpoint is unreachable.
+func (a *Authorizer) CheckKeys(context.Context) error {
+	a.keyGuard.RLock()
+	defer a.keyGuard.RUnlock()
+	if a.keyRefreshErr != nil {
+		return stacktrace.Propagate(a.keyRefreshErr, "Failed to refresh the keys verifying access tokens")
+	}
+	if len(a.keys) == 0 {
+		return stacktrace.NewError("No keys to verify access tokens")
+	}
+	return nil
+}
+
 // AuthInterceptor intercepts incoming gRPC requests and extracts and verifies
 // accompanying bearer tokens.
 func (a *Authorizer) AuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if a.unauthenticated[Operation(info.FullMethod)] {
+		return handler(ctx, req)
+	}
 
 	tknStr, ok := getToken(ctx)
 	if !ok {
+		authOutcomes.WithLabelValues("missing_token").Inc()
 		return nil, stacktrace.NewErrorWithCode(dsserr.Unauthenticated, "Missing access token")
 	}
 
+	a.keyGuard.RLock()
+	acceptedAudiences := a.acceptedAudiences
+	a.keyGuard.RUnlock()
+	keyClaims, err := a.verifyToken(tknStr)
+	if err != nil {
+		authOutcomes.WithLabelValues("invalid_token").Inc()
+		return nil, stacktrace.PropagateWithCode(err, dsserr.Unauthenticated, "Access token validation failed")
+	}
+
+	if !acceptedAudiences[keyClaims.Audience] {
+		authOutcomes.WithLabelValues("invalid_audience").Inc()
+		return nil, stacktrace.NewErrorWithCode(dsserr.Unauthenticated,
+			"Invalid access token audience: %v", keyClaims.Audience)
+	}
+
+	expectation, err := a.validateKeyClaimedScopes(ctx, info, keyClaims.Scopes)
+	if err != nil {
+		authOutcomes.WithLabelValues("missing_scopes").Inc()
+		return nil, stacktrace.NewErrorWithCode(dsserr.PermissionDenied, "Access token missing scopes; found %v while expecting %v", scopeSetToString(keyClaims.Scopes, ", "), expectation)
+	}
+
+	authOutcomes.WithLabelValues("authorized").Inc()
+	logging.SetAccessSubject(ctx, keyClaims.Subject)
+	return handler(ContextWithOwner(ctx, models.Owner(keyClaims.Subject)), req)
+}
+
+// verifyToken returns the claims of tknStr once its signature is verified with
+// one of the keys of a and its claims are valid.
+func (a *Authorizer) verifyToken(tknStr string) (claims, error) {
 	a.keyGuard.RLock()
 	keys := a.keys
 	a.keyGuard.RUnlock()
-	validated := false
-	var err error
+	err := stacktrace.NewError("No keys to verify access tokens")
 	var keyClaims claims
 
 	for _, key := range keys {
@@ -342,25 +475,22 @@ func (a *Authorizer) AuthInterceptor(ctx context.Context, req interface{}, info
 			return key, nil
 		})
 		if err == nil {
-			validated = true
-			break
+			return keyClaims, nil
 		}
 	}
-	if !validated {
-		return nil, stacktrace.PropagateWithCode(err, dsserr.Unauthenticated, "Access token validation failed")
-	}
-
-	if !a.acceptedAudiences[keyClaims.Audience] {
-		return nil, stacktrace.NewErrorWithCode(dsserr.Unauthenticated,
-			"Invalid access token audience: %v", keyClaims.Audience)
-	}
+	return claims{}, err
+}
 
-	expectation, err := a.validateKeyClaimedScopes(ctx, info, keyClaims.Scopes)
+// TokenSubject returns the subject of the access token tknStr once its
+// signature and claims are verified like the access tokens of requests,
+// whatever its audience and scopes, e.g. to identify the clients of
+// unauthenticated proxies.
+func (a *Authorizer) TokenSubject(tknStr string) (string, error) {
+	keyClaims, err := a.verifyToken(tknStr)
 	if err != nil {
-		return nil, stacktrace.NewErrorWithCode(dsserr.PermissionDenied, "Access token missing scopes; found %v while expecting %v", scopeSetToString(keyClaims.Scopes, ", "), expectation)
+		return "", stacktrace.Propagate(err, "Access token validation failed")
 	}
-
-	return handler(ContextWithOwner(ctx, models.Owner(keyClaims.Subject)), req)
+	return keyClaims.Subject, nil
 }
 
 // Matches keyClaimedScopes against the required scopes and returns nil, nil if
@@ -125,6 +125,17 @@ func TestRSAAuthInterceptor(t *testing.T) {
 	}
 }
 
+func TestUnauthenticatedOperations(t *testing.T) {
+	a := &Authorizer{unauthenticated: map[Operation]bool{"/dss.SyncService/GetStatus": true}}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+
+	_, err := a.AuthInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/dss.SyncService/GetStatus"}, handler)
+	require.NoError(t, err)
+
+	_, err = a.AuthInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/dss.SyncService/PutFoo"}, handler)
+	require.Equal(t, dsserr.Unauthenticated, stacktrace.GetCode(err))
+}
+
 func TestMissingScopes(t *testing.T) {
 	ac := &Authorizer{scopesValidators: map[Operation]KeyClaimedScopesValidator{
 		"/dss.SyncService/PutFoo": RequireAnyScope(("required1"), Scope("required2")),
@@ -210,3 +221,20 @@ func TestContextWithOwner(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, models.Owner("real_owner"), owner)
 }
+
+func TestCheckKeys(t *testing.T) {
+	ctx := context.Background()
+	a := &Authorizer{}
+	require.Error(t, a.CheckKeys(ctx))
+
+	a.setKeys([]interface{}{"key"})
+	require.NoError(t, a.CheckKeys(ctx))
+
+	// A failed refresh leaves the previous keys in use, but is reported.
+	a.setKeyRefreshError(stacktrace.NewError("JWKS endpoint unreachable"))
+	require.Error(t, a.CheckKeys(ctx))
+	require.Len(t, a.keys, 1)
+
+	a.setKeys([]interface{}{"key"})
+	require.NoError(t, a.CheckKeys(ctx))
+}
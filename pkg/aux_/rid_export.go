@@ -0,0 +1,140 @@
+package aux
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/golang/geo/s2"
+	"github.com/interuss/dss/pkg/api/v1/auxpb"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/dss/pkg/geo"
+	apiv2 "github.com/interuss/dss/pkg/rid/models/api/v2"
+	"github.com/interuss/stacktrace"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	ndjsonContentType  = "application/x-ndjson"
+	geojsonContentType = "application/geo+json"
+
+	exportFormatNDJSON  = "ndjson"
+	exportFormatGeoJSON = "geojson"
+)
+
+var exportMarshaler = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}
+
+// exportWriter writes the records of an export.
+type exportWriter interface {
+	// write writes entity, of kind, covered by cells.
+	write(kind string, entity proto.Message, cells s2.CellUnion) error
+	// body returns the written export.
+	body() (*httpbody.HttpBody, error)
+}
+
+// ExportRIDRegion exports the active ISAs (and optionally Subscriptions) in
+// an area as newline-delimited JSON, one entity per line keyed by its type, or
+// as a GeoJSON FeatureCollection.
+func (a *Server) ExportRIDRegion(ctx context.Context, req *auxpb.ExportRIDRegionRequest) (*httpbody.HttpBody, error) {
+	var w exportWriter
+	switch req.GetFormat() {
+	case "", exportFormatNDJSON:
+		w = &ndjsonExport{}
+	case exportFormatGeoJSON:
+		w = &geojsonExport{collection: geo.NewGeoJSONFeatureCollection()}
+	default:
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Invalid format `%s`; expected %s or %s", req.GetFormat(), exportFormatNDJSON, exportFormatGeoJSON)
+	}
+	cells, err := geo.AreaToCellIDs(req.GetArea())
+	if err != nil {
+		if errors.Is(err, geo.ErrAreaTooLarge) {
+			return nil, stacktrace.Propagate(err, "Invalid area")
+		}
+		return nil, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid area")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, a.Timeout)
+	defer cancel()
+
+	isas, err := a.RIDApp.SearchISAs(ctx, cells, nil, nil)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Unable to search ISAs")
+	}
+	for _, isa := range isas {
+		if err := w.write("identification_service_area", apiv2.ToIdentificationServiceArea(isa), isa.Cells); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.GetIncludeSubscriptions() {
+		subs, err := a.RIDApp.SearchSubscriptions(ctx, cells)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Unable to search Subscriptions")
+		}
+		for _, sub := range subs {
+			if err := w.write("subscription", apiv2.ToSubscription(sub), sub.Cells); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return w.body()
+}
+
+// ndjsonExport writes each entity as a single JSON line keyed by its kind.
+type ndjsonExport struct {
+	buf bytes.Buffer
+}
+
+func (e *ndjsonExport) write(kind string, entity proto.Message, cells s2.CellUnion) error {
+	serialized, err := exportMarshaler.Marshal(entity)
+	if err != nil {
+		return stacktrace.Propagate(err, "Error serializing %s", kind)
+	}
+	line, err := json.Marshal(map[string]json.RawMessage{kind: serialized})
+	if err != nil {
+		return stacktrace.Propagate(err, "Error serializing %s record", kind)
+	}
+	e.buf.Write(line)
+	e.buf.WriteByte('\n')
+	return nil
+}
+
+func (e *ndjsonExport) body() (*httpbody.HttpBody, error) {
+	return &httpbody.HttpBody{
+		ContentType: ndjsonContentType,
+		Data:        e.buf.Bytes(),
+	}, nil
+}
+
+// geojsonExport writes each entity as a feature outlining the cells covering
+// it, whose properties are its kind and the entity.
+type geojsonExport struct {
+	collection *geo.GeoJSONFeatureCollection
+}
+
+func (e *geojsonExport) write(kind string, entity proto.Message, cells s2.CellUnion) error {
+	serialized, err := exportMarshaler.Marshal(entity)
+	if err != nil {
+		return stacktrace.Propagate(err, "Error serializing %s", kind)
+	}
+	e.collection.Features = append(e.collection.Features, geo.NewGeoJSONFeature(geo.CellsToGeoJSON(cells), map[string]interface{}{
+		"entity_type": kind,
+		kind:          json.RawMessage(serialized),
+	}))
+	return nil
+}
+
+func (e *geojsonExport) body() (*httpbody.HttpBody, error) {
+	data, err := json.Marshal(e.collection)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error serializing GeoJSON export")
+	}
+	return &httpbody.HttpBody{
+		ContentType: geojsonContentType,
+		Data:        data,
+	}, nil
+}
@@ -2,22 +2,132 @@ package aux
 
 import (
 	"context"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/golang/geo/s2"
 	"github.com/interuss/dss/pkg/api/v1/auxpb"
 	"github.com/interuss/dss/pkg/auth"
+	"github.com/interuss/dss/pkg/cockroach"
+	"github.com/interuss/dss/pkg/config"
 	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/dss/pkg/geo"
+	"github.com/interuss/dss/pkg/maintenance"
+	"github.com/interuss/dss/pkg/membership"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	"github.com/interuss/dss/pkg/rid/application"
 	ridserver "github.com/interuss/dss/pkg/rid/server/v1"
+	"github.com/interuss/dss/pkg/scd"
+	scdmodels "github.com/interuss/dss/pkg/scd/models"
 	"github.com/interuss/dss/pkg/version"
 	"github.com/interuss/stacktrace"
+	tspb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
+const (
+	// AdminScope is required to access the administrative endpoints of the DSS.
+	AdminScope auth.Scope = "dss.admin"
+
+	// healthCheckTimeout bounds the duration of each health check, so that
+	// status requests are answered while a dependency is unresponsive.
+	healthCheckTimeout = 3 * time.Second
+)
+
+// HealthCheck checks a dependency of the DSS, returning why it is unhealthy.
+type HealthCheck func(ctx context.Context) error
+
 // Server implements auxpb.DSSAuxService.
-type Server struct{}
+type Server struct {
+	// RIDApp is the remote ID application backing the remote ID auxiliary
+	// endpoints.
+	RIDApp application.App
+
+	// SCD is the strategic conflict detection server backing the strategic
+	// conflict detection auxiliary endpoints, or nil if strategic conflict
+	// detection is disabled.
+	SCD *scd.Server
+
+	// EnableManagerReset allows the deletion of all the remote ID and
+	// strategic conflict detection entities managed by a USS, which should
+	// only be allowed in test environments.
+	EnableManagerReset bool
+
+	// Schemas monitors the compatibility of the database schemas with this
+	// DSS, or nil when the DSS uses no database.
+	Schemas *cockroach.SchemaMonitor
+
+	// HealthChecks are the checks of the dependencies of the DSS performed
+	// for each status request, by name; the DSS is not ready while any fails.
+	HealthChecks map[string]HealthCheck
+
+	// InstanceID identifies this DSS instance in its pool.
+	InstanceID string
+
+	// Members registers the DSS instances of the pool, or is nil when their
+	// datastore does not support it.
+	Members *membership.Registry
+
+	// Maintenance tracks the maintenance window of the pool, or is nil when
+	// its datastore does not support it.
+	Maintenance *maintenance.Mode
+
+	// Reload reloads the runtime configuration of the DSS, or is nil when
+	// the DSS does not support reloading it.
+	Reload func(ctx context.Context) (config.ReloadResult, error)
+
+	Timeout time.Duration
+
+	draining int32
+}
+
+// Drain reports the DSS not ready from now on, as it is shutting down.
+func (a *Server) Drain() {
+	atomic.StoreInt32(&a.draining, 1)
+}
+
+// Draining returns whether Drain was called.
+func (a *Server) Draining() bool {
+	return atomic.LoadInt32(&a.draining) != 0
+}
 
 // AuthScopes returns a map of endpoint to required Oauth scope.
 func (a *Server) AuthScopes() map[auth.Operation]auth.KeyClaimedScopesValidator {
 	return map[auth.Operation]auth.KeyClaimedScopesValidator{
-		"/auxpb.DSSAuxService/ValidateOauth": auth.RequireAnyScope(ridserver.Scopes.ISA.Read, ridserver.Scopes.ISA.Write),
+		"/auxpb.DSSAuxService/ValidateOauth":                               auth.RequireAnyScope(ridserver.Scopes.ISA.Read, ridserver.Scopes.ISA.Write),
+		"/auxpb.DSSAuxService/RestoreIdentificationServiceArea":            auth.RequireAllScopes(ridserver.Scopes.ISA.Write),
+		"/auxpb.DSSAuxService/ExportRIDRegion":                             auth.RequireAllScopes(AdminScope),
+		"/auxpb.DSSAuxService/CheckSCDConflicts":                           scd.ConflictCheckScopes,
+		"/auxpb.DSSAuxService/CheckSCDOVNs":                                scd.ConflictCheckScopes,
+		"/auxpb.DSSAuxService/ListSCDNotificationDeliveries":               scd.SubscriptionReadScopes,
+		"/auxpb.DSSAuxService/ListSCDDssReports":                           auth.RequireAllScopes(AdminScope),
+		"/auxpb.DSSAuxService/ListSCDOperationalIntentReferencesByManager": auth.RequireAllScopes(AdminScope),
+		"/auxpb.DSSAuxService/ListSCDConstraintReferencesByManager":        auth.RequireAllScopes(AdminScope),
+		"/auxpb.DSSAuxService/ResetManager":                                auth.RequireAllScopes(AdminScope),
+		"/auxpb.DSSAuxService/WatchSCDChanges":                             scd.ChangeReadScopes,
+		"/auxpb.DSSAuxService/CheckSCDConsistency":                         auth.RequireAllScopes(AdminScope),
+		"/auxpb.DSSAuxService/ReloadConfiguration":                         auth.RequireAllScopes(AdminScope),
+		"/auxpb.DSSAuxService/ListPoolInstances":                           auth.RequireAllScopes(AdminScope),
+		"/auxpb.DSSAuxService/GetMaintenanceWindow":                        auth.RequireAllScopes(AdminScope),
+		"/auxpb.DSSAuxService/ScheduleMaintenanceWindow":                   auth.RequireAllScopes(AdminScope),
+		"/auxpb.DSSAuxService/CancelMaintenanceWindow":                     auth.RequireAllScopes(AdminScope),
+	}
+}
+
+// UnauthenticatedOperations returns the endpoints served without access token.
+func (a *Server) UnauthenticatedOperations() []auth.Operation {
+	return []auth.Operation{"/auxpb.DSSAuxService/GetStatus"}
+}
+
+// ReportOperations returns the endpoints producing reports of the entities
+// of the DSS, whose cost grows with the number of entities.
+func (a *Server) ReportOperations() []auth.Operation {
+	return []auth.Operation{
+		"/auxpb.DSSAuxService/ExportRIDRegion",
+		"/auxpb.DSSAuxService/ListSCDDssReports",
+		"/auxpb.DSSAuxService/CheckSCDConsistency",
 	}
 }
 
@@ -30,6 +140,84 @@ func (a *Server) GetVersion(context.Context, *auxpb.GetVersionRequest) (*auxpb.G
 	}, nil
 }
 
+// runHealthChecks performs the health checks of a concurrently, and returns
+// their results sorted by name and whether they all passed.
+func (a *Server) runHealthChecks(ctx context.Context) ([]*auxpb.HealthCheck, bool) {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	var (
+		wg      sync.WaitGroup
+		results = make([]*auxpb.HealthCheck, 0, len(a.HealthChecks))
+		mu      sync.Mutex
+	)
+	for name, check := range a.HealthChecks {
+		wg.Add(1)
+		go func(name string, check HealthCheck) {
+			defer wg.Done()
+			start := time.Now()
+			result := &auxpb.HealthCheck{Name: name, Healthy: true}
+			if err := check(ctx); err != nil {
+				result.Healthy = false
+				result.Error = err.Error()
+			}
+			result.DurationMs = time.Since(start).Milliseconds()
+			mu.Lock()
+			results = append(results, result)
+			mu.Unlock()
+		}(name, check)
+	}
+	wg.Wait()
+
+	sort.Slice(results, func(i, j int) bool { return results[i].Name < results[j].Name })
+	healthy := true
+	for _, result := range results {
+		healthy = healthy && result.Healthy
+	}
+	return results, healthy
+}
+
+// GetStatus returns the version of the server, the compatibility of its
+// database schemas as of the last check, the results of its health checks,
+// and whether it is draining.
+func (a *Server) GetStatus(ctx context.Context, _ *auxpb.GetStatusRequest) (*auxpb.GetStatusResponse, error) {
+	schemas, compatible := a.Schemas.Status()
+	draining := a.Draining()
+	var (
+		checks  []*auxpb.HealthCheck
+		healthy = true
+	)
+	if !draining {
+		checks, healthy = a.runHealthChecks(ctx)
+	}
+	response := &auxpb.GetStatusResponse{
+		Version: &auxpb.Version{
+			AsString: version.Current().String(),
+		},
+		Ready:      compatible && healthy && !draining,
+		Draining:   draining,
+		Checks:     checks,
+		InstanceId: a.InstanceID,
+	}
+	if a.Maintenance != nil {
+		response.Maintenance = a.maintenanceWindow(a.Maintenance.Window())
+	}
+	for _, c := range schemas {
+		schema := &auxpb.SchemaCompatibility{
+			Database:             c.Database,
+			MinimumSchemaVersion: c.Minimum.String(),
+			MaximumSchemaVersion: c.Maximum.String(),
+			Compatible:           c.Compatible(),
+			Error:                c.Error,
+		}
+		if c.Current != nil {
+			schema.SchemaVersion = c.Current.String()
+		}
+		response.Schemas = append(response.Schemas, schema)
+	}
+	return response, nil
+}
+
 // ValidateOauth will exercise validating the Oauth token
 func (a *Server) ValidateOauth(ctx context.Context, req *auxpb.ValidateOauthRequest) (*auxpb.ValidateOauthResponse, error) {
 	owner, ok := auth.OwnerFromContext(ctx)
@@ -41,3 +229,472 @@ func (a *Server) ValidateOauth(ctx context.Context, req *auxpb.ValidateOauthRequ
 	}
 	return &auxpb.ValidateOauthResponse{}, nil
 }
+
+// RestoreIdentificationServiceArea restores an ISA deleted within the recovery
+// window.
+func (a *Server) RestoreIdentificationServiceArea(ctx context.Context, req *auxpb.RestoreIdentificationServiceAreaRequest) (*auxpb.RestoreIdentificationServiceAreaResponse, error) {
+	owner, ok := auth.OwnerFromContext(ctx)
+	if !ok {
+		return nil, stacktrace.NewErrorWithCode(dsserr.PermissionDenied, "Missing owner from context")
+	}
+	id, err := dssmodels.IDFromString(req.Id)
+	if err != nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Invalid ID format")
+	}
+	ctx, cancel := context.WithTimeout(ctx, a.Timeout)
+	defer cancel()
+	isa, subscribers, err := a.RIDApp.RestoreISA(ctx, id, owner)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Could not restore ISA")
+	}
+
+	sp := make([]*auxpb.RIDSubscriberToNotify, len(subscribers))
+	for i, sub := range subscribers {
+		sp[i] = &auxpb.RIDSubscriberToNotify{
+			Url: sub.URL,
+			Subscriptions: []*auxpb.RIDSubscriptionState{
+				{
+					NotificationIndex: int32(sub.NotificationIndex),
+					SubscriptionId:    sub.ID.String(),
+				},
+			},
+		}
+	}
+
+	return &auxpb.RestoreIdentificationServiceAreaResponse{
+		Id:          isa.ID.String(),
+		Version:     isa.Version.String(),
+		Subscribers: sp,
+	}, nil
+}
+
+// CheckSCDConflicts returns the operational intent and constraint references
+// overlapping the requested volumes without changing the state of the DSS.
+func (a *Server) CheckSCDConflicts(ctx context.Context, req *auxpb.CheckSCDConflictsRequest) (*auxpb.CheckSCDConflictsResponse, error) {
+	if a.SCD == nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.NotFound, "Strategic conflict detection is not enabled on this DSS instance")
+	}
+	ctx, cancel := context.WithTimeout(ctx, a.Timeout)
+	defer cancel()
+	ops, constraints, priorities, err := a.SCD.CheckConflicts(ctx, req.GetExtents())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Could not check for conflicts")
+	}
+	return &auxpb.CheckSCDConflictsResponse{
+		OperationalIntentReferences: ops,
+		ConstraintReferences:        constraints,
+		OperationalIntentPriorities: priorities,
+	}, nil
+}
+
+// CheckSCDOVNs reports which of the supplied operational intent and constraint
+// reference OVNs are not current.
+func (a *Server) CheckSCDOVNs(ctx context.Context, req *auxpb.CheckSCDOVNsRequest) (*auxpb.CheckSCDOVNsResponse, error) {
+	if a.SCD == nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.NotFound, "Strategic conflict detection is not enabled on this DSS instance")
+	}
+	entities := make([]scd.EntityOVN, len(req.GetEntities()))
+	for i, entity := range req.GetEntities() {
+		id, err := dssmodels.IDFromString(entity.GetEntityId())
+		if err != nil {
+			return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Invalid ID format: `%s`", entity.GetEntityId())
+		}
+		entities[i] = scd.EntityOVN{ID: id, OVN: scdmodels.OVN(entity.GetOvn())}
+	}
+	ctx, cancel := context.WithTimeout(ctx, a.Timeout)
+	defer cancel()
+	stale, err := a.SCD.CheckOVNs(ctx, entities)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Could not check OVNs")
+	}
+
+	response := &auxpb.CheckSCDOVNsResponse{}
+	for _, s := range stale {
+		response.Stale = append(response.Stale, &auxpb.StaleOVN{
+			EntityId:   s.ID.String(),
+			Ovn:        s.OVN.String(),
+			EntityType: s.EntityType,
+		})
+	}
+	return response, nil
+}
+
+// ListSCDNotificationDeliveries lists the notifications the DSS delivered to
+// one of the caller's Subscriptions.
+func (a *Server) ListSCDNotificationDeliveries(ctx context.Context, req *auxpb.ListSCDNotificationDeliveriesRequest) (*auxpb.ListSCDNotificationDeliveriesResponse, error) {
+	if a.SCD == nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.NotFound, "Strategic conflict detection is not enabled on this DSS instance")
+	}
+	ctx, cancel := context.WithTimeout(ctx, a.Timeout)
+	defer cancel()
+	deliveries, err := a.SCD.ListNotificationDeliveries(ctx, req.GetSubscriptionId())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Could not list notification deliveries")
+	}
+
+	response := &auxpb.ListSCDNotificationDeliveriesResponse{}
+	for _, d := range deliveries {
+		response.Deliveries = append(response.Deliveries, &auxpb.NotificationDelivery{
+			SubscriptionId:    d.SubscriptionID.String(),
+			EntityId:          d.EntityID.String(),
+			NotificationIndex: int32(d.NotificationIndex),
+			Url:               d.URL,
+			Status:            d.Status.String(),
+			Attempts:          int32(d.Attempts),
+			LastError:         d.LastError,
+			CreatedAt:         tspb.New(d.CreatedAt),
+			UpdatedAt:         tspb.New(d.UpdatedAt),
+		})
+	}
+	return response, nil
+}
+
+// ListSCDDssReports lists the reports submitted by USSs about discrepancies
+// with the DSS.
+func (a *Server) ListSCDDssReports(ctx context.Context, req *auxpb.ListSCDDssReportsRequest) (*auxpb.ListSCDDssReportsResponse, error) {
+	if a.SCD == nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.NotFound, "Strategic conflict detection is not enabled on this DSS instance")
+	}
+	var earliest, latest *time.Time
+	if ts := req.GetEarliestTime(); ts != nil {
+		t := ts.AsTime()
+		earliest = &t
+	}
+	if ts := req.GetLatestTime(); ts != nil {
+		t := ts.AsTime()
+		latest = &t
+	}
+	ctx, cancel := context.WithTimeout(ctx, a.Timeout)
+	defer cancel()
+	reports, err := a.SCD.ListDssReports(ctx, dssmodels.Manager(req.GetReporter()), earliest, latest)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Could not list DSS reports")
+	}
+
+	response := &auxpb.ListSCDDssReportsResponse{}
+	for _, r := range reports {
+		report := &auxpb.DssReport{
+			ReportId:  r.ID.String(),
+			Reporter:  r.Reporter.String(),
+			Exchange:  r.Exchange,
+			CreatedAt: tspb.New(r.CreatedAt),
+		}
+		for _, record := range r.DssRecords {
+			report.DssRecords = append(report.DssRecords, &auxpb.DssReportRecord{
+				EntityType: record.EntityType,
+				EntityId:   record.ID.String(),
+				Manager:    record.Manager.String(),
+				Version:    int32(record.Version),
+				Ovn:        record.OVN.String(),
+				State:      record.State,
+			})
+		}
+		response.Reports = append(response.Reports, report)
+	}
+	return response, nil
+}
+
+// ListSCDOperationalIntentReferencesByManager lists a page of the operational
+// intent references managed by a USS.
+func (a *Server) ListSCDOperationalIntentReferencesByManager(ctx context.Context, req *auxpb.ListSCDReferencesByManagerRequest) (*auxpb.ListSCDOperationalIntentReferencesByManagerResponse, error) {
+	if a.SCD == nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.NotFound, "Strategic conflict detection is not enabled on this DSS instance")
+	}
+	ctx, cancel := context.WithTimeout(ctx, a.Timeout)
+	defer cancel()
+	refs, nextPageToken, err := a.SCD.ListOperationalIntentsByManager(ctx, dssmodels.Manager(req.GetManager()), req.GetPageToken(), req.GetPageSize())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Could not list operational intent references")
+	}
+	return &auxpb.ListSCDOperationalIntentReferencesByManagerResponse{
+		OperationalIntentReferences: refs,
+		NextPageToken:               nextPageToken,
+	}, nil
+}
+
+// ListSCDConstraintReferencesByManager lists a page of the constraint
+// references managed by a USS.
+func (a *Server) ListSCDConstraintReferencesByManager(ctx context.Context, req *auxpb.ListSCDReferencesByManagerRequest) (*auxpb.ListSCDConstraintReferencesByManagerResponse, error) {
+	if a.SCD == nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.NotFound, "Strategic conflict detection is not enabled on this DSS instance")
+	}
+	ctx, cancel := context.WithTimeout(ctx, a.Timeout)
+	defer cancel()
+	refs, nextPageToken, err := a.SCD.ListConstraintsByManager(ctx, dssmodels.Manager(req.GetManager()), req.GetPageToken(), req.GetPageSize())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Could not list constraint references")
+	}
+	return &auxpb.ListSCDConstraintReferencesByManagerResponse{
+		ConstraintReferences: refs,
+		NextPageToken:        nextPageToken,
+	}, nil
+}
+
+func idStrings(ids []dssmodels.ID) []string {
+	result := make([]string, len(ids))
+	for i, id := range ids {
+		result[i] = id.String()
+	}
+	return result
+}
+
+// ResetManager deletes the identification service areas, operational intent
+// references, constraint references and subscriptions managed by a USS.
+// Remote ID entities are deleted first, in their own transaction.
+func (a *Server) ResetManager(ctx context.Context, req *auxpb.ResetManagerRequest) (*auxpb.ResetManagerResponse, error) {
+	if !a.EnableManagerReset {
+		return nil, stacktrace.NewErrorWithCode(dsserr.PermissionDenied, "Resetting the entities of a USS is not enabled on this DSS instance")
+	}
+	if req.GetManager() == "" {
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Missing manager")
+	}
+	if req.GetConfirm() != req.GetManager() {
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "confirm must repeat the manager whose entities are deleted")
+	}
+	var area s2.CellUnion
+	if req.GetArea() != "" {
+		cells, err := geo.AreaToCellIDs(req.GetArea())
+		if err != nil {
+			return nil, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid area")
+		}
+		area = cells
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, a.Timeout)
+	defer cancel()
+	response := &auxpb.ResetManagerResponse{}
+	if a.RIDApp != nil {
+		deleted, err := a.RIDApp.DeleteOwnerEntities(ctx, dssmodels.Owner(req.GetManager()), area)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Could not delete remote ID entities of %s", req.GetManager())
+		}
+		response.IdentificationServiceAreaIds = idStrings(deleted.ISAs)
+		response.RidSubscriptionIds = idStrings(deleted.Subscriptions)
+	}
+	if a.SCD != nil {
+		deleted, err := a.SCD.DeleteManagerEntities(ctx, dssmodels.Manager(req.GetManager()), area)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Could not delete strategic conflict detection entities of %s", req.GetManager())
+		}
+		response.OperationalIntentIds = idStrings(deleted.OperationalIntents)
+		response.ConstraintIds = idStrings(deleted.Constraints)
+		response.ScdSubscriptionIds = idStrings(deleted.Subscriptions)
+	}
+	return response, nil
+}
+
+// WatchSCDChanges waits for and lists the changes of operational intent and
+// constraint references in an area.
+func (a *Server) WatchSCDChanges(ctx context.Context, req *auxpb.WatchSCDChangesRequest) (*auxpb.WatchSCDChangesResponse, error) {
+	if a.SCD == nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.NotFound, "Strategic conflict detection is not enabled on this DSS instance")
+	}
+	area, err := geo.AreaToCellIDs(req.GetArea())
+	if err != nil {
+		return nil, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid area")
+	}
+	var after int64
+	if req.GetCursor() != "" {
+		after, err = strconv.ParseInt(req.GetCursor(), 10, 64)
+		if err != nil {
+			return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Invalid cursor: `%s`", req.GetCursor())
+		}
+	}
+	wait := time.Duration(req.GetWaitSeconds()) * time.Second
+
+	// Allow for the wait on top of the usual processing time
+	ctx, cancel := context.WithTimeout(ctx, a.Timeout+wait)
+	defer cancel()
+	changes, err := a.SCD.WatchChanges(ctx, area, after, wait)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Could not watch entity changes")
+	}
+
+	result := &auxpb.WatchSCDChangesResponse{Cursor: req.GetCursor()}
+	for _, c := range changes {
+		result.Changes = append(result.Changes, &auxpb.SCDEntityChange{
+			Cursor:     strconv.FormatInt(c.Cursor, 10),
+			EntityType: c.EntityType,
+			EntityId:   c.EntityID.String(),
+			Change:     c.Change.String(),
+			Manager:    c.Manager.String(),
+			Version:    int32(c.Version),
+			OccurredAt: tspb.New(c.OccurredAt),
+		})
+		result.Cursor = strconv.FormatInt(c.Cursor, 10)
+	}
+	return result, nil
+}
+
+// CheckSCDConsistency reports the key a new plan in an area would need and the
+// internal inconsistencies of the entities stored in that area.
+func (a *Server) CheckSCDConsistency(ctx context.Context, req *auxpb.CheckSCDConsistencyRequest) (*auxpb.CheckSCDConsistencyResponse, error) {
+	if a.SCD == nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.NotFound, "Strategic conflict detection is not enabled on this DSS instance")
+	}
+	area, err := geo.AreaToCellIDs(req.GetArea())
+	if err != nil {
+		return nil, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid area")
+	}
+	ctx, cancel := context.WithTimeout(ctx, a.Timeout)
+	defer cancel()
+	key, issues, err := a.SCD.CheckConsistency(ctx, area)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Could not check consistency")
+	}
+
+	response := &auxpb.CheckSCDConsistencyResponse{}
+	for _, k := range key {
+		response.Key = append(response.Key, &auxpb.SCDKeyEntity{
+			EntityId:   k.ID.String(),
+			Ovn:        k.OVN.String(),
+			EntityType: k.EntityType,
+			Manager:    k.Manager.String(),
+		})
+	}
+	for _, issue := range issues {
+		response.Inconsistencies = append(response.Inconsistencies, &auxpb.SCDInconsistency{
+			Kind:       issue.Kind,
+			EntityType: issue.EntityType,
+			EntityId:   issue.EntityID.String(),
+			Detail:     issue.Detail,
+		})
+	}
+	return response, nil
+}
+
+// ReloadConfiguration reloads the runtime configuration of the DSS instance
+// serving the request.
+func (a *Server) ReloadConfiguration(ctx context.Context, req *auxpb.ReloadConfigurationRequest) (*auxpb.ReloadConfigurationResponse, error) {
+	if a.Reload == nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.NotFound, "Reloading the configuration is not supported by this DSS instance")
+	}
+	result, err := a.Reload(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Could not reload configuration")
+	}
+	resp := &auxpb.ReloadConfigurationResponse{RestartRequired: result.RestartRequired}
+	for _, c := range result.Changes {
+		resp.Changes = append(resp.Changes, &auxpb.ConfigurationChange{Name: c.Name, OldValue: c.Old, NewValue: c.New})
+	}
+	return resp, nil
+}
+
+// ListPoolInstances lists the DSS instances of the pool registered in their
+// shared remote ID database.
+func (a *Server) ListPoolInstances(ctx context.Context, req *auxpb.ListPoolInstancesRequest) (*auxpb.ListPoolInstancesResponse, error) {
+	if a.Members == nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.NotFound, "Pool membership requires a remote ID database with schema 4.3.0 or later")
+	}
+	ctx, cancel := context.WithTimeout(ctx, a.Timeout)
+	defer cancel()
+	members, now, err := a.Members.List(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Could not list pool instances")
+	}
+	resp := &auxpb.ListPoolInstancesResponse{InstanceId: a.InstanceID}
+	if !now.IsZero() {
+		resp.DatastoreTime = tspb.New(now)
+	}
+	for _, m := range members {
+		resp.Instances = append(resp.Instances, &auxpb.PoolInstance{
+			Id:               m.ID,
+			Process:          m.Process,
+			Hostname:         m.Hostname,
+			Locality:         m.Locality,
+			Version:          m.Version,
+			Commit:           m.Commit,
+			StartedAt:        tspb.New(m.StartedAt),
+			LastSeenAt:       tspb.New(m.LastSeenAt),
+			SecondsSinceSeen: m.SinceSeen.Seconds(),
+			ClockSkewMs:      m.ClockSkew().Milliseconds(),
+			Stale:            m.Stale,
+			Self:             m.ID == a.InstanceID && m.Process == a.Members.Self.Process,
+		})
+	}
+	return resp, nil
+}
+
+// maintenanceWindow converts w to its API representation, or nil if w is.
+func (a *Server) maintenanceWindow(w *maintenance.Window) *auxpb.MaintenanceWindow {
+	if w == nil {
+		return nil
+	}
+	result := &auxpb.MaintenanceWindow{
+		StartTime:      tspb.New(w.Start),
+		ReadsAvailable: w.ReadsAvailable,
+		Message:        w.Message,
+		ScheduledBy:    w.ScheduledBy,
+		ScheduledAt:    tspb.New(w.ScheduledAt),
+		Active:         w.Active(a.Maintenance.Clock.Now()),
+	}
+	if !w.End.IsZero() {
+		result.EndTime = tspb.New(w.End)
+	}
+	return result
+}
+
+// GetMaintenanceWindow returns the maintenance window of the pool which has
+// not ended yet, if any.
+func (a *Server) GetMaintenanceWindow(ctx context.Context, req *auxpb.GetMaintenanceWindowRequest) (*auxpb.GetMaintenanceWindowResponse, error) {
+	if a.Maintenance == nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.NotFound, "Maintenance windows require a remote ID database with schema 4.5.0 or later")
+	}
+	ctx, cancel := context.WithTimeout(ctx, a.Timeout)
+	defer cancel()
+	if err := a.Maintenance.Refresh(ctx); err != nil {
+		return nil, stacktrace.Propagate(err, "Could not get maintenance window")
+	}
+	return &auxpb.GetMaintenanceWindowResponse{Window: a.maintenanceWindow(a.Maintenance.Window())}, nil
+}
+
+// ScheduleMaintenanceWindow schedules the maintenance window of the pool,
+// replacing any previous one.
+func (a *Server) ScheduleMaintenanceWindow(ctx context.Context, req *auxpb.ScheduleMaintenanceWindowRequest) (*auxpb.ScheduleMaintenanceWindowResponse, error) {
+	if a.Maintenance == nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.NotFound, "Maintenance windows require a remote ID database with schema 4.5.0 or later")
+	}
+	owner, ok := auth.OwnerFromContext(ctx)
+	if !ok {
+		return nil, stacktrace.NewErrorWithCode(dsserr.PermissionDenied, "Missing owner from context")
+	}
+	params := req.GetWindow()
+	w := maintenance.Window{
+		ReadsAvailable: params.GetReadsAvailable(),
+		Message:        params.GetMessage(),
+		ScheduledBy:    owner.String(),
+	}
+	if params.GetStartTime() != nil {
+		if err := params.StartTime.CheckValid(); err != nil {
+			return nil, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid start_time")
+		}
+		w.Start = params.StartTime.AsTime()
+	}
+	if params.GetEndTime() != nil {
+		if err := params.EndTime.CheckValid(); err != nil {
+			return nil, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid end_time")
+		}
+		w.End = params.EndTime.AsTime()
+	}
+	ctx, cancel := context.WithTimeout(ctx, a.Timeout)
+	defer cancel()
+	scheduled, err := a.Maintenance.Schedule(ctx, w)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Could not schedule maintenance window")
+	}
+	return &auxpb.ScheduleMaintenanceWindowResponse{Window: a.maintenanceWindow(scheduled)}, nil
+}
+
+// CancelMaintenanceWindow cancels the maintenance window of the pool, ending
+// it if in progress.
+func (a *Server) CancelMaintenanceWindow(ctx context.Context, req *auxpb.CancelMaintenanceWindowRequest) (*auxpb.CancelMaintenanceWindowResponse, error) {
+	if a.Maintenance == nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.NotFound, "Maintenance windows require a remote ID database with schema 4.5.0 or later")
+	}
+	ctx, cancel := context.WithTimeout(ctx, a.Timeout)
+	defer cancel()
+	if err := a.Maintenance.Cancel(ctx); err != nil {
+		return nil, stacktrace.Propagate(err, "Could not cancel maintenance window")
+	}
+	return &auxpb.CancelMaintenanceWindowResponse{}, nil
+}
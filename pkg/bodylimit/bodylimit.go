@@ -0,0 +1,72 @@
+package bodylimit
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/stacktrace"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+)
+
+// ExceededReason is the reason of the errors of requests whose body exceeds
+// the maximum size of their endpoint.
+const ExceededReason = "body_too_large"
+
+var oversizedRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "dss_http_oversized_requests_total",
+	Help: "Number of HTTP requests rejected because their body exceeded the maximum size of their endpoint, by endpoint class.",
+}, []string{"class"})
+
+// Middleware serves the requests whose body does not exceed maxBytes[class(r)]
+// with handler, and responds 413 to the others, stating the limit.  The
+// bodies of the requests of classes absent from maxBytes are not limited.
+// Bodies are read in full before serving requests, which the JSON decoding
+// of requests requires anyway.
+func Middleware(logger *zap.Logger, class func(r *http.Request) string, maxBytes map[string]int64, handler http.Handler) (http.Handler, error) {
+	for c, max := range maxBytes {
+		if max <= 0 {
+			return nil, stacktrace.NewError("Maximum body size of %s requests must be positive", c)
+		}
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c := class(r)
+		max, ok := maxBytes[c]
+		if !ok || r.Body == nil || r.Body == http.NoBody {
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		tooLarge := r.ContentLength > max
+		var body []byte
+		if !tooLarge {
+			var err error
+			body, err = ioutil.ReadAll(io.LimitReader(r.Body, max+1))
+			if err != nil {
+				if err := dsserr.WriteHTTPError(w, http.StatusBadRequest, dsserr.BadRequest, dsserr.MakeErrID(), "",
+					"Error reading request body"); err != nil {
+					logger.Error("Error writing body read error response", zap.Error(err))
+				}
+				return
+			}
+			tooLarge = int64(len(body)) > max
+		}
+		if tooLarge {
+			oversizedRequests.WithLabelValues(c).Inc()
+			if err := dsserr.WriteHTTPError(w, http.StatusRequestEntityTooLarge, dsserr.RequestTooLarge, dsserr.MakeErrID(), ExceededReason,
+				fmt.Sprintf("Request body exceeds the limit of %d bytes of %s requests", max, c)); err != nil {
+				logger.Error("Error writing oversized request response", zap.Error(err))
+			}
+			return
+		}
+
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		r.ContentLength = int64(len(body))
+		handler.ServeHTTP(w, r)
+	}), nil
+}
@@ -0,0 +1,61 @@
+package bodylimit
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestMiddleware(t *testing.T) {
+	class := func(r *http.Request) string {
+		if r.Method == http.MethodPut {
+			return "entity"
+		}
+		return "other"
+	}
+	handler, err := Middleware(zap.NewNop(), class, map[string]int64{"entity": 10}, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		_, _ = w.Write(body)
+	}))
+	require.NoError(t, err)
+
+	serve := func(method, body string, chunked bool) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, "/", strings.NewReader(body))
+		if chunked {
+			// The size of the body is not declared.
+			r.ContentLength = -1
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	w := serve(http.MethodPut, "0123456789", false)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "0123456789", w.Body.String())
+
+	w = serve(http.MethodPut, "0123456789a", false)
+	require.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	require.Contains(t, w.Body.String(), "limit of 10 bytes of entity requests")
+	require.Contains(t, w.Body.String(), `"error_code":"REQUEST_TOO_LARGE"`)
+	require.Contains(t, w.Body.String(), `"reason":"body_too_large"`)
+
+	w = serve(http.MethodPut, "0123456789a", true)
+	require.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+
+	// Other classes are not limited.
+	w = serve(http.MethodPost, strings.Repeat("x", 100), false)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	require.Equal(t, float64(2), testutil.ToFloat64(oversizedRequests.WithLabelValues("entity")))
+
+	_, err = Middleware(zap.NewNop(), class, map[string]int64{"entity": 0}, handler)
+	require.Error(t, err)
+}
@@ -0,0 +1,3 @@
+// Package bodylimit bounds the sizes of the bodies of the requests to HTTP
+// servers, per class of endpoint.
+package bodylimit
@@ -0,0 +1,185 @@
+package celllevel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/geo/s2"
+	"github.com/interuss/dss/pkg/cockroach"
+	"github.com/interuss/dss/pkg/geo"
+	"github.com/interuss/stacktrace"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+)
+
+// batchSize is the number of rows releveled per transaction.
+const batchSize = 500
+
+// Table is a table of the DSS storing the S2 cells of its entities in a cells
+// column.
+type Table struct {
+	Name string
+	// IDType is the SQL type of the id primary key of the table.
+	IDType string
+}
+
+var tables = map[string][]Table{
+	"rid": {
+		{Name: "identification_service_areas", IDType: "UUID"},
+		{Name: "subscriptions", IDType: "UUID"},
+	},
+	"scd": {
+		{Name: "scd_operations", IDType: "UUID"},
+		{Name: "scd_subscriptions", IDType: "UUID"},
+		{Name: "scd_constraints", IDType: "UUID"},
+		{Name: "scd_entity_changes", IDType: "INT8"},
+	},
+}
+
+// Tables returns the tables of the database dbName storing cells.
+func Tables(dbName string) []Table {
+	if dbName == "defaultdb" {
+		// The remote ID database was named defaultdb before schema 4.0.0.
+		dbName = "rid"
+	}
+	return tables[dbName]
+}
+
+// exists returns whether table exists in db, since older schemas lack some of
+// the tables.
+func exists(ctx context.Context, db *cockroach.DB, table string) (bool, error) {
+	const query = `SELECT count(*) FROM information_schema.tables WHERE table_schema = 'public' AND table_name = $1`
+	var count int
+	if err := db.Pool.QueryRow(ctx, query, table).Scan(&count); err != nil {
+		return false, stacktrace.Propagate(err, "Error in query: %s", query)
+	}
+	return count > 0, nil
+}
+
+// StoredLevel returns the level of a cell of a row of table in db, and
+// whether the table has any row.
+func StoredLevel(ctx context.Context, db *cockroach.DB, table Table) (int, bool, error) {
+	ok, err := exists(ctx, db, table.Name)
+	if err != nil || !ok {
+		return 0, false, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	query := fmt.Sprintf(`SELECT cells[1] FROM %s WHERE array_length(cells, 1) > 0 LIMIT 1`, pgx.Identifier{table.Name}.Sanitize())
+	var cell int64
+	switch err := db.Pool.QueryRow(ctx, query).Scan(&cell); {
+	case err == pgx.ErrNoRows:
+		return 0, false, nil
+	case err != nil:
+		return 0, false, stacktrace.Propagate(err, "Error in query: %s", query)
+	}
+	return s2.CellID(cell).Level(), true, nil
+}
+
+// Check returns an error if the cells stored in the tables of the database
+// dbName of db are not at the cell level of the DSS, as their entities would
+// not match the searches of the DSS.
+func Check(ctx context.Context, db *cockroach.DB, dbName string) error {
+	for _, table := range Tables(dbName) {
+		level, ok, err := StoredLevel(ctx, db, table)
+		if err != nil {
+			return stacktrace.Propagate(err, "Failed to read cell level of %s", table.Name)
+		}
+		if ok && level != geo.CellLevel() {
+			return stacktrace.NewError("Cells of %s are stored at level %d, but the cell level of the DSS is %d; relevel them with db-manager --relevel_cells=%d or set the cell level to %d", table.Name, level, geo.CellLevel(), geo.CellLevel(), level)
+		}
+	}
+	return nil
+}
+
+// Relevel replaces the cells of the rows of table in db with the cells at
+// level covering them, in batches, and returns the number of rows updated.
+// The DSS instances must not write to the table meanwhile.
+func Relevel(ctx context.Context, db *cockroach.DB, table Table, level int) (int, error) {
+	ok, err := exists(ctx, db, table.Name)
+	if err != nil || !ok {
+		return 0, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	name := pgx.Identifier{table.Name}.Sanitize()
+	var (
+		selectFirstQuery = fmt.Sprintf(`SELECT id::STRING, cells FROM %s ORDER BY id LIMIT %d`, name, batchSize)
+		selectNextQuery  = fmt.Sprintf(`SELECT id::STRING, cells FROM %s WHERE id > $1::%s ORDER BY id LIMIT %d`, name, table.IDType, batchSize)
+		updateQuery      = fmt.Sprintf(`UPDATE %s SET cells = $2 WHERE id = $1::%s`, name, table.IDType)
+	)
+
+	updated := 0
+	last := ""
+	for {
+		var batch int
+		err := db.Pool.BeginFunc(ctx, func(tx pgx.Tx) error {
+			var rows pgx.Rows
+			var err error
+			if last == "" {
+				rows, err = tx.Query(ctx, selectFirstQuery)
+			} else {
+				rows, err = tx.Query(ctx, selectNextQuery, last)
+			}
+			if err != nil {
+				return stacktrace.Propagate(err, "Error in query of %s", table.Name)
+			}
+			type row struct {
+				id    string
+				cells s2.CellUnion
+			}
+			var changed []row
+			batch = 0
+			for rows.Next() {
+				var (
+					id    string
+					cells []int64
+				)
+				if err := rows.Scan(&id, &cells); err != nil {
+					rows.Close()
+					return stacktrace.Propagate(err, "Error scanning row of %s", table.Name)
+				}
+				batch++
+				last = id
+				releveled := geo.RelevelCells(geo.CellUnionFromInt64(cells), level)
+				if !sameCells(cells, releveled) {
+					changed = append(changed, row{id: id, cells: releveled})
+				}
+			}
+			rows.Close()
+			if err := rows.Err(); err != nil {
+				return stacktrace.Propagate(err, "Error reading rows of %s", table.Name)
+			}
+			for _, r := range changed {
+				ids := make([]int64, len(r.cells))
+				for i, cell := range r.cells {
+					ids[i] = int64(cell)
+				}
+				var pgCells pgtype.Int8Array
+				if err := pgCells.Set(ids); err != nil {
+					return stacktrace.Propagate(err, "Failed to convert array to jackc/pgtype")
+				}
+				if _, err := tx.Exec(ctx, updateQuery, r.id, pgCells); err != nil {
+					return stacktrace.Propagate(err, "Error in query: %s", updateQuery)
+				}
+			}
+			updated += len(changed)
+			return nil
+		})
+		if err != nil {
+			return updated, stacktrace.Propagate(err, "Failed to relevel cells of %s", table.Name)
+		}
+		if batch < batchSize {
+			return updated, nil
+		}
+	}
+}
+
+// sameCells returns whether cells are the releveled cells.
+func sameCells(cells []int64, releveled s2.CellUnion) bool {
+	if len(cells) != len(releveled) {
+		return false
+	}
+	for i, cell := range cells {
+		if s2.CellID(cell) != releveled[i] {
+			return false
+		}
+	}
+	return true
+}
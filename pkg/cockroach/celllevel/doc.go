@@ -0,0 +1,4 @@
+// Package celllevel checks that the S2 cells of the entities stored by the
+// DSS are at the cell level of the DSS pool, and migrates them to another
+// cell level.
+package celllevel
@@ -2,7 +2,11 @@ package cockroach
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
+	"io/ioutil"
+	"net"
+	"net/url"
 	"sort"
 	"strconv"
 	"strings"
@@ -10,6 +14,7 @@ import (
 
 	"github.com/coreos/go-semver/semver"
 	"github.com/interuss/stacktrace"
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 )
 
@@ -22,16 +27,30 @@ type (
 	Credentials struct {
 		Username string
 		Password string
+		// PasswordFile is the path to a file holding the password, e.g. an
+		// access token issued by a managed database offering, read again
+		// before each new connection so that it may be rotated.
+		PasswordFile string
 	}
 
 	// SSL models SSL configuration parameters.
 	SSL struct {
 		Mode string
 		Dir  string
+		// RootCert is the path to the certificate authority verifying the
+		// server when client certificates are not used, i.e. when Dir is
+		// empty.
+		RootCert string
 	}
 
 	// ConnectParameters bundles up parameters used for connecting to a CRDB instance.
 	ConnectParameters struct {
+		// URL, when set, is a postgres:// connection string specifying the
+		// host, port, user, password and SSL options of the connection, such as
+		// those of managed database offerings, in place of Host, Port,
+		// Credentials.Username, Credentials.Password and SSL.  Its database is
+		// replaced by DBName when set.
+		URL                string
 		ApplicationName    string
 		Host               string
 		Port               int
@@ -39,14 +58,44 @@ type (
 		Credentials        Credentials
 		SSL                SSL
 		MaxOpenConns       int
+		MinOpenConns       int
 		MaxConnIdleSeconds int
-		MaxRetries         int
+		// MaxConnLifetimeSeconds is the age after which connections are
+		// closed and replaced; the pgx default of an hour applies when 0.
+		MaxConnLifetimeSeconds int
+		// HealthCheckPeriodSeconds is the period at which idle connections
+		// are checked and the pool is replenished to MinOpenConns.
+		HealthCheckPeriodSeconds int
+		MaxRetries               int
+		// RetryInitialBackoff and RetryMaxBackoff bound the exponentially
+		// growing delay between retries of a transaction after contention.
+		RetryInitialBackoff time.Duration
+		RetryMaxBackoff     time.Duration
+		// RetryBudgets overrides MaxRetries for specific operations, as a
+		// comma-separated list of operation=retries.
+		RetryBudgets string
+		// SlowStatementThreshold is the duration beyond which statements are
+		// logged, disabled when 0.
+		SlowStatementThreshold time.Duration
+		Dialect                Dialect
 	}
 )
 
-// DB models a connection to a CRDB instance.
+// DB models a connection to a CRDB (or PostgreSQL or YugabyteDB) instance.
 type DB struct {
-	Pool *pgxpool.Pool
+	Pool    *pgxpool.Pool
+	Dialect Dialect
+
+	// retryPolicy governs the retries of transactions run by ExecuteTx.
+	retryPolicy retryPolicy
+
+	// slowStatementThreshold is the duration beyond which the statements of
+	// instrumented Queryables are logged, disabled when 0.
+	slowStatementThreshold time.Duration
+
+	// poolMetrics holds the pool statistics last exported by
+	// RecordPoolMetrics.
+	poolMetrics poolMetricsState
 }
 
 func parseIntOrDefault(port string, defaultPort int64) int64 {
@@ -88,8 +137,48 @@ func formatDSN(dsnMap map[string]string) string {
 	return strings.Join(d, " ")
 }
 
+// passwordAuth returns whether cp authenticates with a password rather than a
+// client certificate.
+func (cp ConnectParameters) passwordAuth() bool {
+	return cp.Credentials.Password != "" || cp.Credentials.PasswordFile != ""
+}
+
+// buildURL returns cp.URL with the database, application name and pool size of
+// cp.
+func (cp ConnectParameters) buildURL() (string, error) {
+	u, err := url.Parse(cp.URL)
+	if err != nil {
+		// The URL may hold a password, so it is not part of the error
+		return "", stacktrace.NewError("Malformed crdb connection URL")
+	}
+	if u.Scheme != "postgres" && u.Scheme != "postgresql" {
+		return "", stacktrace.NewError("Unsupported crdb connection URL scheme `%s`; expected postgres or postgresql", u.Scheme)
+	}
+	if u.Host == "" {
+		return "", stacktrace.NewError("Missing crdb hostname in connection URL")
+	}
+	if cp.DBName != "" {
+		u.Path = "/" + cp.DBName
+	}
+	query := u.Query()
+	if query.Get("application_name") == "" {
+		an := cp.ApplicationName
+		if an == "" {
+			an = "dss"
+		}
+		query.Set("application_name", an)
+	}
+	query.Set("pool_max_conns", fmt.Sprintf("%d", cp.MaxOpenConns))
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
 // BuildURI returns a URI built from p.
 func (cp ConnectParameters) BuildDSN() (string, error) {
+	if cp.URL != "" {
+		return cp.buildURL()
+	}
+
 	dsnMap := make(map[string]string)
 
 	u := cp.Credentials.Username
@@ -132,7 +221,13 @@ func (cp ConnectParameters) BuildDSN() (string, error) {
 
 	dir := cp.SSL.Dir
 	if dir == "" {
-		return "", stacktrace.NewError("Missing crdb ssl_dir")
+		if !cp.passwordAuth() {
+			return "", stacktrace.NewError("Missing crdb ssl_dir")
+		}
+		// Without client certificates, the server is verified with the
+		// configured certificate authority, or the system ones
+		dsnMap["sslrootcert"] = cp.SSL.RootCert
+		return formatDSN(dsnMap), nil
 	}
 	dsnMap["sslrootcert"] = fmt.Sprintf("%s/ca.crt", dir)
 	dsnMap["sslcert"] = fmt.Sprintf("%s/client.%s.crt", dir, u)
@@ -145,6 +240,11 @@ func (cp ConnectParameters) BuildDSN() (string, error) {
 // "uri".
 // https://www.cockroachlabs.com/docs/stable/connection-parameters.html
 func Dial(ctx context.Context, connParams ConnectParameters) (*DB, error) {
+	dialect, err := DialectFromString(string(connParams.Dialect))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Invalid datastore dialect")
+	}
+
 	dsn, err := connParams.BuildDSN()
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to create connection config for pgx")
@@ -158,10 +258,33 @@ func Dial(ctx context.Context, connParams ConnectParameters) (*DB, error) {
 	if connParams.SSL.Mode == "enable" {
 		config.ConnConfig.TLSConfig.ServerName = connParams.Host
 	}
-	config.MaxConns = int32(connParams.MaxOpenConns)
-	config.MaxConnIdleTime = (time.Duration(connParams.MaxConnIdleSeconds) * time.Second)
-	config.HealthCheckPeriod = (1 * time.Second)
-	config.MinConns = 1
+	// Proxies of managed database offerings route connections according to
+	// their server name indication, which is only set by pgx when verifying
+	// the server
+	setServerName(config.ConnConfig.TLSConfig, config.ConnConfig.Host)
+	for _, fallback := range config.ConnConfig.Fallbacks {
+		setServerName(fallback.TLSConfig, fallback.Host)
+	}
+	if connParams.Credentials.Password != "" {
+		config.ConnConfig.Password = connParams.Credentials.Password
+	}
+	if passwordFile := connParams.Credentials.PasswordFile; passwordFile != "" {
+		config.BeforeConnect = func(_ context.Context, cc *pgx.ConnConfig) error {
+			password, err := ioutil.ReadFile(passwordFile)
+			if err != nil {
+				return stacktrace.Propagate(err, "Failed to read crdb password file")
+			}
+			cc.Password = strings.TrimSpace(string(password))
+			return nil
+		}
+	}
+	if err := connParams.configurePool(config); err != nil {
+		return nil, stacktrace.Propagate(err, "Invalid connection pool configuration")
+	}
+	policy, err := connParams.retryPolicy()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Invalid transaction retry configuration")
+	}
 
 	db, err := pgxpool.ConnectConfig(ctx, config)
 	if err != nil {
@@ -169,35 +292,77 @@ func Dial(ctx context.Context, connParams ConnectParameters) (*DB, error) {
 	}
 
 	return &DB{
-		Pool: db,
+		Pool:                   db,
+		Dialect:                dialect,
+		retryPolicy:            policy,
+		slowStatementThreshold: connParams.SlowStatementThreshold,
 	}, nil
 }
 
+// setServerName makes config indicate host as the server name, unless it
+// already indicates one.
+func setServerName(config *tls.Config, host string) {
+	if config != nil && config.ServerName == "" && net.ParseIP(host) == nil && !strings.HasPrefix(host, "/") {
+		config.ServerName = host
+	}
+}
+
+// configurePool applies the connection pool parameters of cp to config.
+func (cp ConnectParameters) configurePool(config *pgxpool.Config) error {
+	if cp.MaxOpenConns <= 0 {
+		return stacktrace.NewError("Maximum number of open connections must be positive")
+	}
+	if cp.MinOpenConns < 0 || cp.MinOpenConns > cp.MaxOpenConns {
+		return stacktrace.NewError("Minimum number of open connections must be between 0 and the maximum of %d", cp.MaxOpenConns)
+	}
+	if cp.MaxConnIdleSeconds < 0 || cp.MaxConnLifetimeSeconds < 0 || cp.HealthCheckPeriodSeconds < 0 {
+		return stacktrace.NewError("Connection durations must not be negative")
+	}
+
+	config.MaxConns = int32(cp.MaxOpenConns)
+	config.MinConns = int32(cp.MinOpenConns)
+	config.MaxConnIdleTime = (time.Duration(cp.MaxConnIdleSeconds) * time.Second)
+	if cp.MaxConnLifetimeSeconds > 0 {
+		config.MaxConnLifetime = (time.Duration(cp.MaxConnLifetimeSeconds) * time.Second)
+	}
+	config.HealthCheckPeriod = (1 * time.Second)
+	if cp.HealthCheckPeriodSeconds > 0 {
+		config.HealthCheckPeriod = (time.Duration(cp.HealthCheckPeriodSeconds) * time.Second)
+	}
+	return nil
+}
+
 // GetVersion returns the Schema Version of the requested DB Name
 func (db *DB) GetVersion(ctx context.Context, dbName string) (*semver.Version, error) {
 	if dbName == "" {
 		return nil, stacktrace.NewError("GetVersion was provided with an empty database name")
 	}
+	// PostgreSQL cannot qualify names with a database, so tables are found in
+	// the database db is connected to.
+	prefix := dbName + "."
+	if !db.Dialect.IsCockroachDB() {
+		prefix = ""
+	}
 	var (
 		checkTableQuery = fmt.Sprintf(`
       SELECT EXISTS (
         SELECT
           *
         FROM
-          %s.information_schema.tables
+          %sinformation_schema.tables
         WHERE
           table_name = 'schema_versions'
         AND
           table_catalog = $1
-      )`, dbName)
+      )`, prefix)
 		exists          bool
 		getVersionQuery = fmt.Sprintf(`
       SELECT
         schema_version
       FROM
-        %s.schema_versions
+        %sschema_versions
       WHERE
-        onerow_enforcer = TRUE`, dbName)
+        onerow_enforcer = TRUE`, prefix)
 	)
 
 	if err := db.Pool.QueryRow(ctx, checkTableQuery, dbName).Scan(&exists); err != nil {
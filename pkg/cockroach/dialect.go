@@ -0,0 +1,134 @@
+package cockroach
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/interuss/dss/pkg/tracing"
+	"github.com/interuss/stacktrace"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+var yugabyteRetryableMessage = regexp.MustCompile(`(?i)restart read required|try again|transaction aborted|conflicts with (higher|lower) priority transaction`)
+
+// Dialect identifies the SQL database product a DB is connected to.
+type Dialect string
+
+const (
+	// DialectCockroachDB is the dialect of CockroachDB, the default datastore.
+	DialectCockroachDB Dialect = "cockroachdb"
+
+	// DialectPostgres is the dialect of vanilla PostgreSQL, suitable for
+	// single-region deployments and CI environments.
+	DialectPostgres Dialect = "postgres"
+
+	// DialectYugabyte is the dialect of the PostgreSQL-compatible YSQL API of
+	// YugabyteDB.
+	DialectYugabyte Dialect = "yugabyte"
+)
+
+// DialectFromString returns the Dialect named s.
+func DialectFromString(s string) (Dialect, error) {
+	switch d := Dialect(strings.ToLower(s)); d {
+	case DialectCockroachDB, DialectPostgres, DialectYugabyte:
+		return d, nil
+	case "":
+		return DialectCockroachDB, nil
+	default:
+		return "", stacktrace.NewError("Unknown datastore dialect `%s`; must be %s, %s or %s", s, DialectCockroachDB, DialectPostgres, DialectYugabyte)
+	}
+}
+
+// IsCockroachDB returns whether d is the dialect of CockroachDB; the other
+// dialects follow PostgreSQL, which lacks the UPSERT and USE statements and
+// database-qualified names.
+func (d Dialect) IsCockroachDB() bool {
+	return d != DialectPostgres && d != DialectYugabyte
+}
+
+// traceSystem returns the db.system attribute of the trace spans of the
+// statements executed in d.
+func (d Dialect) traceSystem() string {
+	switch d {
+	case DialectPostgres:
+		return "postgresql"
+	case DialectYugabyte:
+		// YugabyteDB has no well-known db.system value.
+		return "other_sql"
+	default:
+		return "cockroachdb"
+	}
+}
+
+// UpsertClauses returns the clause starting a statement that inserts the
+// columns (a comma-separated list) of a row into table, and the clause to
+// follow its VALUES that updates the existing row with the same keyColumns
+// instead, if any.
+func (d Dialect) UpsertClauses(table string, keyColumns string, columns string) (string, string) {
+	if d.IsCockroachDB() {
+		return fmt.Sprintf("UPSERT INTO %s (%s)", table, columns), ""
+	}
+
+	keys := map[string]bool{}
+	for _, key := range strings.Split(keyColumns, ",") {
+		keys[strings.TrimSpace(key)] = true
+	}
+	updates := make([]string, 0)
+	for _, column := range strings.Split(columns, ",") {
+		column = strings.TrimSpace(column)
+		if !keys[column] {
+			updates = append(updates, fmt.Sprintf("%s = excluded.%s", column, column))
+		}
+	}
+	return fmt.Sprintf("INSERT INTO %s (%s)", table, columns),
+		fmt.Sprintf("ON CONFLICT (%s) DO UPDATE SET %s", keyColumns, strings.Join(updates, ", "))
+}
+
+// ExecuteTx runs fn in a serializable transaction, retrying the transaction as
+// a whole with exponential backoff when it fails due to contention.  The
+// transaction is retried up to maxRetries times, unless the retry policy of db
+// specifies another budget for the operation of ctx; once the budget is
+// exhausted, the error is reported as Unavailable so that clients may retry
+// later.
+//
+// The transaction is traced in a span recording each retry as an event, so
+// that slow transactions can be told apart from contended ones.
+func (db *DB) ExecuteTx(ctx context.Context, maxRetries int, fn func(pgx.Tx) error) error {
+	ctx, span := tracing.Tracer().Start(ctx, "transaction "+OperationFromContext(ctx))
+	defer span.End()
+
+	attempts := 0
+	err := db.retryPolicy.retry(ctx, maxRetries, db.Dialect.isRetryable, func() error {
+		attempts++
+		// Transactions in PostgreSQL and YugabyteDB are not serializable by
+		// default.
+		return db.Pool.BeginTxFunc(ctx, pgx.TxOptions{IsoLevel: pgx.Serializable}, fn)
+	})
+	span.SetAttributes(attribute.Int("db.transaction.attempts", attempts))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return err // No need to Propagate this error as this is not a useful stacktrace line
+}
+
+// isRetryable returns whether err is a serialization failure or deadlock,
+// after which the transaction may succeed if retried.
+func (d Dialect) isRetryable(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+	if pgErr.Code == "40001" || pgErr.Code == "40P01" {
+		return true
+	}
+	// YugabyteDB reports some conflicts, such as read restarts and aborted
+	// distributed transactions, with internal error codes.
+	return d == DialectYugabyte && pgErr.Code == "XX000" && yugabyteRetryableMessage.MatchString(pgErr.Message)
+}
@@ -0,0 +1,50 @@
+package cockroach
+
+import (
+	"errors"
+	"github.com/jackc/pgconn"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestDialectFromString(t *testing.T) {
+	d, err := DialectFromString("")
+	require.NoError(t, err)
+	require.Equal(t, DialectCockroachDB, d)
+
+	d, err = DialectFromString("Postgres")
+	require.NoError(t, err)
+	require.Equal(t, DialectPostgres, d)
+
+	d, err = DialectFromString("yugabyte")
+	require.NoError(t, err)
+	require.Equal(t, DialectYugabyte, d)
+	require.False(t, d.IsCockroachDB())
+
+	_, err = DialectFromString("mysql")
+	require.Error(t, err)
+}
+
+func TestUpsertClauses(t *testing.T) {
+	upsert, onConflict := DialectCockroachDB.UpsertClauses("t", "id", "id,a,b")
+	require.Equal(t, "UPSERT INTO t (id,a,b)", upsert)
+	require.Equal(t, "", onConflict)
+
+	upsert, onConflict = DialectPostgres.UpsertClauses("t", "id", "id,a,b")
+	require.Equal(t, "INSERT INTO t (id,a,b)", upsert)
+	require.Equal(t, "ON CONFLICT (id) DO UPDATE SET a = excluded.a, b = excluded.b", onConflict)
+}
+
+func TestIsRetryable(t *testing.T) {
+	serialization := &pgconn.PgError{Code: "40001"}
+	readRestart := &pgconn.PgError{Code: "XX000", Message: "Restart read required at: { read: ... }"}
+	other := &pgconn.PgError{Code: "23505"}
+
+	for _, d := range []Dialect{DialectPostgres, DialectYugabyte} {
+		require.True(t, d.isRetryable(serialization))
+		require.False(t, d.isRetryable(other))
+		require.False(t, d.isRetryable(errors.New("40001")))
+	}
+	require.False(t, DialectPostgres.isRetryable(readRestart))
+	require.True(t, DialectYugabyte.isRetryable(readRestart))
+}
@@ -1,3 +1,4 @@
 // Package cockroach bundles up types and functions required to connect to
-// CRDB instance.
+// CRDB instance, or to a vanilla PostgreSQL or YugabyteDB instance using the
+// postgres or yugabyte Dialect.
 package cockroach
@@ -2,16 +2,19 @@ package flags
 
 import (
 	"flag"
+	"time"
 
 	"github.com/interuss/dss/pkg/cockroach"
 )
 
 var (
 	connectParameters cockroach.ConnectParameters
+	dialect           string
 )
 
 // ConnectParameters returns a ConnectParameters instance that gets populated from well-known CLI flags.
 func ConnectParameters() cockroach.ConnectParameters {
+	connectParameters.Dialect = cockroach.Dialect(dialect)
 	return connectParameters
 }
 
@@ -23,7 +26,18 @@ func init() {
 	flag.StringVar(&connectParameters.SSL.Mode, "cockroach_ssl_mode", "disable", "cockroach sslmode")
 	flag.StringVar(&connectParameters.SSL.Dir, "cockroach_ssl_dir", "", "directory to ssl certificates. Must contain files: ca.crt, client.<user>.crt, client.<user>.key")
 	flag.StringVar(&connectParameters.Credentials.Username, "cockroach_user", "root", "cockroach user to authenticate as")
+	flag.StringVar(&connectParameters.Credentials.PasswordFile, "cockroach_password_file", "", "path to a file holding the password or access token to authenticate with instead of client certificates, read again before each new connection so that it may be rotated")
+	flag.StringVar(&connectParameters.SSL.RootCert, "cockroach_ssl_root_cert", "", "path to the certificate authority verifying the server when authenticating with a password instead of the client certificates of cockroach_ssl_dir; the system certificate authorities are used when empty")
+	flag.StringVar(&connectParameters.URL, "cockroach_url", "", "postgres:// connection string, e.g. of a managed cluster, with its host, port, user, password and options such as sslmode, sslrootcert or options=--cluster=<routing ID>, used instead of cockroach_host, cockroach_port, cockroach_user and the cockroach_ssl_* flags; its database is replaced by the DSS databases")
 	flag.IntVar(&connectParameters.MaxOpenConns, "max_open_conns", 4, "maximum number of open connections to the database, default is 4")
+	flag.IntVar(&connectParameters.MinOpenConns, "min_open_conns", 1, "minimum number of open connections to the database kept ready for use, default is 1")
 	flag.IntVar(&connectParameters.MaxConnIdleSeconds, "max_conn_idle_secs", 30, "maximum amount of time in seconds a connection may be idle, default is 30 seconds")
+	flag.IntVar(&connectParameters.MaxConnLifetimeSeconds, "max_conn_lifetime_secs", 3600, "maximum amount of time in seconds a connection may be reused before it is replaced, default is 3600 seconds")
+	flag.IntVar(&connectParameters.HealthCheckPeriodSeconds, "conn_health_check_period_secs", 1, "period in seconds at which idle connections are checked and the pool is replenished, default is 1 second")
 	flag.IntVar(&connectParameters.MaxRetries, "cockroach_max_retries", 100, "maximum number of attempts to retry a query in case of contention, default is 100")
+	flag.DurationVar(&connectParameters.RetryInitialBackoff, "cockroach_retry_initial_backoff", 10*time.Millisecond, "delay before the first retry of a transaction in case of contention, doubled at each subsequent retry")
+	flag.DurationVar(&connectParameters.RetryMaxBackoff, "cockroach_retry_max_backoff", time.Second, "maximum delay between retries of a transaction in case of contention")
+	flag.StringVar(&connectParameters.RetryBudgets, "cockroach_retry_budgets", "", "comma-separated list of operation=retries overriding cockroach_max_retries for specific operations, named after their gRPC methods (e.g. PutOperationalIntentReference=20)")
+	flag.DurationVar(&connectParameters.SlowStatementThreshold, "cockroach_slow_statement_threshold", 0, "duration beyond which datastore statements are logged along with their latency, disabled when 0")
+	flag.StringVar(&dialect, "datastore_dialect", string(cockroach.DialectCockroachDB), "SQL database product to connect to with the cockroach_* flags: cockroachdb, postgres for a vanilla PostgreSQL instance, or yugabyte for the YSQL API of YugabyteDB")
 }
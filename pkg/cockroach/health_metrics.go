@@ -0,0 +1,62 @@
+package cockroach
+
+import (
+	"context"
+	"time"
+
+	"github.com/interuss/stacktrace"
+	"github.com/jackc/pgx/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	// healthCheckTimeout bounds the ping of RecordHealth, so that an
+	// unresponsive database is reported down rather than blocking the refresh.
+	healthCheckTimeout = 5 * time.Second
+)
+
+var (
+	databaseUp = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dss_db_up",
+		Help: "Whether the database answered the latest health check (1) or not (0).",
+	}, []string{"database"})
+	databasePingSeconds = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dss_db_ping_seconds",
+		Help: "Duration of the latest successful health check of the database.",
+	}, []string{"database"})
+	databaseEntities = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dss_db_entities",
+		Help: "Number of entities stored in the database, by type, as of the latest refresh.",
+	}, []string{"database", "entity"})
+)
+
+// RecordHealth pings db and updates its health metrics, labeled with
+// databaseName.
+func (db *DB) RecordHealth(ctx context.Context, databaseName string) {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	start := time.Now()
+	if err := db.Pool.Ping(ctx); err != nil {
+		databaseUp.WithLabelValues(databaseName).Set(0)
+		return
+	}
+	databaseUp.WithLabelValues(databaseName).Set(1)
+	databasePingSeconds.WithLabelValues(databaseName).Set(time.Since(start).Seconds())
+}
+
+// RecordEntityCounts counts the rows of the tables of db storing each entity
+// type of entityTables, keyed by entity type, and updates the entity metrics
+// labeled with databaseName.
+func (db *DB) RecordEntityCounts(ctx context.Context, databaseName string, entityTables map[string]string) error {
+	for entity, table := range entityTables {
+		var count int64
+		query := "SELECT count(*) FROM " + pgx.Identifier{table}.Sanitize()
+		if err := db.Pool.QueryRow(ctx, query).Scan(&count); err != nil {
+			return stacktrace.Propagate(err, "Failed to count rows of %s", table)
+		}
+		databaseEntities.WithLabelValues(databaseName, entity).Set(float64(count))
+	}
+	return nil
+}
@@ -0,0 +1,4 @@
+// Package indexes verifies that the indexes of DSS databases serve the query
+// shapes of the DSS, such as cell lookups, time filters and owner filters,
+// and recommends creating missing indexes and dropping unused ones.
+package indexes
@@ -0,0 +1,360 @@
+package indexes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/interuss/dss/pkg/cockroach"
+	"github.com/interuss/stacktrace"
+	"github.com/jackc/pgx/v4"
+)
+
+// Shape is a query shape of the DSS, served by an index whose leading key
+// columns are Columns.
+type Shape struct {
+	Table   string
+	Columns []string
+	// Inverted shapes look up array elements, such as S2 cells.
+	Inverted bool
+	Purpose  string
+}
+
+func cellLookup(table string) Shape {
+	return Shape{Table: table, Columns: []string{"cells"}, Inverted: true, Purpose: "cell lookups"}
+}
+
+func timeFilter(table, column string) Shape {
+	return Shape{Table: table, Columns: []string{column}, Purpose: "time filters"}
+}
+
+func ownerFilter(table string) Shape {
+	return Shape{Table: table, Columns: []string{"owner"}, Purpose: "owner filters"}
+}
+
+// expectedShapes are the query shapes of the DSS, by database.
+var expectedShapes = map[string][]Shape{
+	"rid": {
+		cellLookup("identification_service_areas"),
+		timeFilter("identification_service_areas", "ends_at"),
+		ownerFilter("identification_service_areas"),
+		{Table: "identification_service_areas", Columns: []string{"deleted_at"}, Purpose: "tombstone purges"},
+		cellLookup("subscriptions"),
+		timeFilter("subscriptions", "ends_at"),
+		ownerFilter("subscriptions"),
+	},
+	"scd": {
+		cellLookup("scd_operations"),
+		timeFilter("scd_operations", "ends_at"),
+		ownerFilter("scd_operations"),
+		{Table: "scd_operations", Columns: []string{"subscription_id"}, Purpose: "dependent OperationalIntent lookups"},
+		cellLookup("scd_subscriptions"),
+		timeFilter("scd_subscriptions", "ends_at"),
+		ownerFilter("scd_subscriptions"),
+		cellLookup("scd_constraints"),
+		timeFilter("scd_constraints", "ends_at"),
+		ownerFilter("scd_constraints"),
+		{Table: "scd_notification_deliveries", Columns: []string{"subscription_id"}, Purpose: "delivery history lookups"},
+		timeFilter("scd_notification_deliveries", "created_at"),
+		{Table: "scd_dss_reports", Columns: []string{"reporter"}, Purpose: "reporter filters"},
+		timeFilter("scd_dss_reports", "created_at"),
+		cellLookup("scd_entity_changes"),
+		timeFilter("scd_entity_changes", "occurred_at"),
+	},
+}
+
+// ExpectedShapes returns the query shapes of the DSS served by the database
+// dbName.
+func ExpectedShapes(dbName string) []Shape {
+	if dbName == "defaultdb" {
+		// The remote ID database was named defaultdb before schema 4.0.0.
+		dbName = "rid"
+	}
+	return expectedShapes[dbName]
+}
+
+// Index is an index of a database.
+type Index struct {
+	Table string
+	Name  string
+	// Columns are the key columns of the index, in order.
+	Columns  []string
+	Inverted bool
+	Unique   bool
+	// Reads is the number of reads of the index since the statistics of the
+	// database were reset, nil when unknown.
+	Reads *int64
+}
+
+// Schema describes the tables and indexes of a database.
+type Schema struct {
+	// Columns are the columns of each table, by table name.
+	Columns map[string]map[string]bool
+	Indexes []*Index
+}
+
+func (s *Schema) index(table, name string) *Index {
+	for _, index := range s.Indexes {
+		if index.Table == table && index.Name == name {
+			return index
+		}
+	}
+	index := &Index{Table: table, Name: name}
+	s.Indexes = append(s.Indexes, index)
+	return index
+}
+
+// Read returns the schema of the database crdb is connected to.
+func Read(ctx context.Context, crdb *cockroach.DB) (*Schema, error) {
+	schema := &Schema{Columns: map[string]map[string]bool{}}
+
+	const columnsQuery = `SELECT table_name, column_name FROM information_schema.columns WHERE table_schema = 'public'`
+	rows, err := crdb.Pool.Query(ctx, columnsQuery)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error in query: %s", columnsQuery)
+	}
+	for rows.Next() {
+		var table, column string
+		if err := rows.Scan(&table, &column); err != nil {
+			rows.Close()
+			return nil, stacktrace.Propagate(err, "Error scanning column")
+		}
+		if schema.Columns[table] == nil {
+			schema.Columns[table] = map[string]bool{}
+		}
+		schema.Columns[table][column] = true
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, stacktrace.Propagate(err, "Error in rows query result")
+	}
+
+	if crdb.Dialect.IsCockroachDB() {
+		err = readCockroachDBIndexes(ctx, crdb, schema)
+	} else {
+		err = readPostgresIndexes(ctx, crdb, schema)
+	}
+	if err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	sort.Slice(schema.Indexes, func(i, j int) bool {
+		if schema.Indexes[i].Table != schema.Indexes[j].Table {
+			return schema.Indexes[i].Table < schema.Indexes[j].Table
+		}
+		return schema.Indexes[i].Name < schema.Indexes[j].Name
+	})
+	return schema, nil
+}
+
+func readCockroachDBIndexes(ctx context.Context, crdb *cockroach.DB, schema *Schema) error {
+	const (
+		columnsQuery = `
+			SELECT table_name, index_name, column_name
+			FROM [SHOW INDEXES FROM DATABASE %s]
+			WHERE schema_name = 'public' AND NOT storing AND NOT implicit
+			ORDER BY table_name, index_name, seq_in_index`
+		typesQuery = `
+			SELECT descriptor_name, index_name, index_type = 'inverted', is_unique
+			FROM crdb_internal.table_indexes`
+		usageQuery = `
+			SELECT ti.descriptor_name, ti.index_name, us.total_reads
+			FROM crdb_internal.index_usage_statistics AS us
+			JOIN crdb_internal.table_indexes AS ti
+			ON us.table_id = ti.descriptor_id AND us.index_id = ti.index_id`
+	)
+
+	var dbName string
+	if err := crdb.Pool.QueryRow(ctx, `SELECT current_database()`).Scan(&dbName); err != nil {
+		return stacktrace.Propagate(err, "Error determining current database")
+	}
+	query := fmt.Sprintf(columnsQuery, pgx.Identifier{dbName}.Sanitize())
+	if err := scanRows(ctx, crdb, query, func(rows pgx.Rows) error {
+		var table, name, column string
+		if err := rows.Scan(&table, &name, &column); err != nil {
+			return err
+		}
+		index := schema.index(table, name)
+		index.Columns = append(index.Columns, column)
+		return nil
+	}); err != nil {
+		return err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+
+	if err := scanRows(ctx, crdb, typesQuery, func(rows pgx.Rows) error {
+		var (
+			table, name      string
+			inverted, unique bool
+		)
+		if err := rows.Scan(&table, &name, &inverted, &unique); err != nil {
+			return err
+		}
+		if _, ok := schema.Columns[table]; ok {
+			index := schema.index(table, name)
+			index.Inverted, index.Unique = inverted, unique
+		}
+		return nil
+	}); err != nil {
+		return err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+
+	// Index usage statistics are missing from older CockroachDB versions, in
+	// which unused indexes are not reported.
+	_ = scanRows(ctx, crdb, usageQuery, func(rows pgx.Rows) error {
+		var (
+			table, name string
+			reads       int64
+		)
+		if err := rows.Scan(&table, &name, &reads); err != nil {
+			return err
+		}
+		if _, ok := schema.Columns[table]; ok {
+			schema.index(table, name).Reads = &reads
+		}
+		return nil
+	})
+	return nil
+}
+
+func readPostgresIndexes(ctx context.Context, crdb *cockroach.DB, schema *Schema) error {
+	const query = `
+		SELECT t.relname, i.relname, am.amname IN ('gin', 'ybgin'), ix.indisunique, a.attname, COALESCE(s.idx_scan, 0)
+		FROM pg_index AS ix
+		JOIN pg_class AS i ON i.oid = ix.indexrelid
+		JOIN pg_class AS t ON t.oid = ix.indrelid
+		JOIN pg_namespace AS n ON n.oid = t.relnamespace
+		JOIN pg_am AS am ON am.oid = i.relam
+		JOIN LATERAL unnest(ix.indkey::int2[]) WITH ORDINALITY AS k(attnum, ord) ON k.ord <= ix.indnkeyatts
+		JOIN pg_attribute AS a ON a.attrelid = t.oid AND a.attnum = k.attnum
+		LEFT JOIN pg_stat_user_indexes AS s ON s.indexrelid = ix.indexrelid
+		WHERE n.nspname = 'public'
+		ORDER BY t.relname, i.relname, k.ord`
+
+	return scanRows(ctx, crdb, query, func(rows pgx.Rows) error {
+		var (
+			table, name, column string
+			inverted, unique    bool
+			reads               int64
+		)
+		if err := rows.Scan(&table, &name, &inverted, &unique, &column, &reads); err != nil {
+			return err
+		}
+		index := schema.index(table, name)
+		index.Columns = append(index.Columns, column)
+		index.Inverted, index.Unique, index.Reads = inverted, unique, &reads
+		return nil
+	})
+}
+
+// scanRows calls scan for each row returned by query.
+func scanRows(ctx context.Context, crdb *cockroach.DB, query string, scan func(pgx.Rows) error) error {
+	rows, err := crdb.Pool.Query(ctx, query)
+	if err != nil {
+		return stacktrace.Propagate(err, "Error in query: %s", query)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		if err := scan(rows); err != nil {
+			return stacktrace.Propagate(err, "Error scanning rows of query: %s", query)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return stacktrace.Propagate(err, "Error in rows query result")
+	}
+	return nil
+}
+
+// serves returns whether index serves shape.
+func (index *Index) serves(shape Shape) bool {
+	if index.Table != shape.Table || index.Inverted != shape.Inverted || len(index.Columns) < len(shape.Columns) {
+		return false
+	}
+	for i, column := range shape.Columns {
+		if index.Columns[i] != column {
+			return false
+		}
+	}
+	return true
+}
+
+// Finding is an index issue of a database, along with the statement
+// recommended to address it.
+type Finding struct {
+	Table          string
+	Message        string
+	Recommendation string
+}
+
+// Check returns the findings of the indexes of schema against shapes: the
+// shapes which no index serves, and the indexes which were never read and
+// serve no shape.  Shapes of tables or columns missing from schema, e.g.
+// introduced by a newer schema version, are ignored.
+func Check(schema *Schema, shapes []Shape, dialect cockroach.Dialect) []Finding {
+	var findings []Finding
+	for _, shape := range shapes {
+		columns, ok := schema.Columns[shape.Table]
+		if !ok {
+			continue
+		}
+		present := true
+		for _, column := range shape.Columns {
+			present = present && columns[column]
+		}
+		if !present {
+			continue
+		}
+		served := false
+		for _, index := range schema.Indexes {
+			served = served || index.serves(shape)
+		}
+		if !served {
+			findings = append(findings, Finding{
+				Table:          shape.Table,
+				Message:        fmt.Sprintf("No index on (%s) serves %s", strings.Join(shape.Columns, ", "), shape.Purpose),
+				Recommendation: createStatement(shape, dialect),
+			})
+		}
+	}
+
+	for _, index := range schema.Indexes {
+		if index.Unique || index.Reads == nil || *index.Reads > 0 {
+			continue
+		}
+		needed := false
+		for _, shape := range shapes {
+			needed = needed || index.serves(shape)
+		}
+		if !needed {
+			findings = append(findings, Finding{
+				Table:          index.Table,
+				Message:        fmt.Sprintf("Index %s on (%s) was never read and serves no DSS query shape", index.Name, strings.Join(index.Columns, ", ")),
+				Recommendation: dropStatement(index, dialect),
+			})
+		}
+	}
+	return findings
+}
+
+func createStatement(shape Shape, dialect cockroach.Dialect) string {
+	name := pgx.Identifier{shape.Table + "_" + strings.Join(shape.Columns, "_") + "_idx"}.Sanitize()
+	table := pgx.Identifier{shape.Table}.Sanitize()
+	columns := make([]string, len(shape.Columns))
+	for i, column := range shape.Columns {
+		columns[i] = pgx.Identifier{column}.Sanitize()
+	}
+	switch {
+	case shape.Inverted && dialect.IsCockroachDB():
+		return fmt.Sprintf("CREATE INVERTED INDEX IF NOT EXISTS %s ON %s (%s)", name, table, strings.Join(columns, ", "))
+	case shape.Inverted:
+		return fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s ON %s USING GIN (%s)", name, table, strings.Join(columns, ", "))
+	default:
+		return fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s ON %s (%s)", name, table, strings.Join(columns, ", "))
+	}
+}
+
+func dropStatement(index *Index, dialect cockroach.Dialect) string {
+	if dialect.IsCockroachDB() {
+		return fmt.Sprintf("DROP INDEX IF EXISTS %s@%s", pgx.Identifier{index.Table}.Sanitize(), pgx.Identifier{index.Name}.Sanitize())
+	}
+	return fmt.Sprintf("DROP INDEX IF EXISTS %s", pgx.Identifier{index.Name}.Sanitize())
+}
@@ -0,0 +1,67 @@
+package indexes
+
+import (
+	"testing"
+
+	"github.com/interuss/dss/pkg/cockroach"
+	"github.com/stretchr/testify/require"
+)
+
+func reads(n int64) *int64 {
+	return &n
+}
+
+func TestCheck(t *testing.T) {
+	schema := &Schema{
+		Columns: map[string]map[string]bool{
+			"scd_constraints": {"id": true, "owner": true, "cells": true, "ends_at": true},
+		},
+		Indexes: []*Index{
+			{Table: "scd_constraints", Name: "primary", Columns: []string{"id"}, Unique: true, Reads: reads(0)},
+			{Table: "scd_constraints", Name: "cells_idx", Columns: []string{"cells"}, Inverted: true, Reads: reads(0)},
+			{Table: "scd_constraints", Name: "ends_at_idx", Columns: []string{"ends_at"}, Reads: reads(12)},
+			{Table: "scd_constraints", Name: "owner_cells_idx", Columns: []string{"cells", "owner"}, Reads: reads(0)},
+		},
+	}
+
+	findings := Check(schema, ExpectedShapes("scd"), cockroach.DialectCockroachDB)
+	require.Equal(t, []Finding{
+		{
+			Table:          "scd_constraints",
+			Message:        "No index on (owner) serves owner filters",
+			Recommendation: `CREATE INDEX IF NOT EXISTS "scd_constraints_owner_idx" ON "scd_constraints" ("owner")`,
+		},
+		{
+			Table:          "scd_constraints",
+			Message:        "Index owner_cells_idx on (cells, owner) was never read and serves no DSS query shape",
+			Recommendation: `DROP INDEX IF EXISTS "scd_constraints"@"owner_cells_idx"`,
+		},
+	}, findings)
+
+	schema.Indexes = schema.Indexes[:2]
+	findings = Check(schema, ExpectedShapes("scd"), cockroach.DialectPostgres)
+	require.Len(t, findings, 2)
+	require.Equal(t, `CREATE INDEX IF NOT EXISTS "scd_constraints_ends_at_idx" ON "scd_constraints" ("ends_at")`, findings[0].Recommendation)
+	require.Equal(t, `CREATE INDEX IF NOT EXISTS "scd_constraints_owner_idx" ON "scd_constraints" ("owner")`, findings[1].Recommendation)
+}
+
+func TestCheckInvertedShapes(t *testing.T) {
+	schema := &Schema{
+		Columns: map[string]map[string]bool{
+			"subscriptions": {"id": true, "owner": true, "cells": true, "ends_at": true},
+		},
+		Indexes: []*Index{
+			// A regular index on an array column does not serve cell lookups.
+			{Table: "subscriptions", Name: "cells_idx", Columns: []string{"cells"}},
+			{Table: "subscriptions", Name: "ends_at_idx", Columns: []string{"ends_at", "owner"}},
+			{Table: "subscriptions", Name: "owner_idx", Columns: []string{"owner"}},
+		},
+	}
+
+	findings := Check(schema, ExpectedShapes("defaultdb"), cockroach.DialectPostgres)
+	require.Equal(t, []Finding{{
+		Table:          "subscriptions",
+		Message:        "No index on (cells) serves cell lookups",
+		Recommendation: `CREATE INDEX IF NOT EXISTS "subscriptions_cells_idx" ON "subscriptions" USING GIN ("cells")`,
+	}}, findings)
+}
@@ -0,0 +1,3 @@
+// Package migration applies the schema migration steps defined by SQL files to
+// the databases of the DSS.
+package migration
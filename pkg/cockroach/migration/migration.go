@@ -0,0 +1,394 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/coreos/go-semver/semver"
+	"github.com/interuss/dss/pkg/cockroach"
+	"github.com/interuss/stacktrace"
+)
+
+var (
+	// Pattern to match files describing migration steps
+	migrationStepRegexp = regexp.MustCompile("(upto|downfrom)-v(\\d+\\.\\d+\\.\\d+)-(.*)\\.sql")
+)
+
+// Step migrates a schema up to its version from the previous version, and
+// back down.
+type Step struct {
+	Version      semver.Version
+	UpToFile     string
+	DownFromFile string
+}
+
+// EnumerateSteps returns the migration steps defined by the files of dir, in
+// ascending version order, preceded by the 0.0.0 version of an empty
+// database.
+func EnumerateSteps(dir string) ([]Step, error) {
+	steps := make(map[semver.Version]Step)
+
+	// Identify files defining version migration steps
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to read schema files directory")
+	}
+	for _, file := range files {
+		if !file.IsDir() {
+			match := migrationStepRegexp.FindStringSubmatch(file.Name())
+			if len(match) > 0 {
+				v := *semver.New(match[2])
+				step := steps[v]
+				step.Version = v
+				if match[1] == "upto" {
+					step.UpToFile = file.Name()
+				} else if match[1] == "downfrom" {
+					step.DownFromFile = file.Name()
+				} else {
+					return nil, stacktrace.NewError("Unexpected migration step prefix: %s", match[1])
+				}
+				steps[v] = step
+			}
+		}
+	}
+
+	// Sort versions in ascending order
+	versions := make([]*semver.Version, 0, len(steps))
+	for k := range steps {
+		v := steps[k].Version
+		versions = append(versions, &v)
+	}
+	semver.Sort(versions)
+
+	// Render sorted step list
+	result := make([]Step, len(versions)+1)
+	result[0].Version = *semver.New("0.0.0")
+	for i := 0; i < len(versions); i++ {
+		result[i+1] = steps[*versions[i]]
+	}
+
+	return result, nil
+}
+
+// Migrator migrates the schema of the database named after the base name of
+// its SchemasDir.
+type Migrator struct {
+	// SchemasDir holds the files defining the migration steps of the schema.
+	SchemasDir string
+	// ConnectParameters describe the database server; their DBName is
+	// ignored.
+	ConnectParameters cockroach.ConnectParameters
+	// Logf reports the progress of migrations.
+	Logf func(format string, args ...interface{})
+	// PoolTables lists, by schema version, the tables created by the
+	// migration step up to that version which are shared by all the DSS
+	// instances of a pool, whatever the APIs they serve.
+	PoolTables map[semver.Version][]string
+	// AllowPoolTableRemoval allows Migrate to migrate the schema down from the
+	// versions of PoolTables, removing their tables.
+	AllowPoolTableRemoval bool
+}
+
+// LatestVersion returns the latest schema version defined in m.SchemasDir.
+func (m *Migrator) LatestVersion() (*semver.Version, error) {
+	steps, err := m.steps()
+	if err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	return &steps[len(steps)-1].Version, nil
+}
+
+func (m *Migrator) steps() ([]Step, error) {
+	steps, err := EnumerateSteps(m.SchemasDir)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to read schema version migration definitions")
+	}
+	if len(steps) <= 1 {
+		return nil, stacktrace.NewError("No migration definitions found in %s", m.SchemasDir)
+	}
+	return steps, nil
+}
+
+// PlannedStep is a migration step to be executed against a database.
+type PlannedStep struct {
+	// Database is the name of the database the step is executed against.
+	Database string
+	// File is the name of the file in SchemasDir defining the step.
+	File string
+	// From and To are the schema versions before and after the step.
+	From, To semver.Version
+	// SQL holds the exact statements executed by the step.
+	SQL string
+	// RemovedPoolTables lists the tables shared by the DSS instances of the
+	// pool which the step removes.
+	RemovedPoolTables []string
+}
+
+// Plan describes how Migrate would change a database, without changing it.
+type Plan struct {
+	// Database is the name of the database to be migrated.
+	Database string
+	// CurrentVersion is the schema version of the database; 0.0.0 when the
+	// database does not exist yet.
+	CurrentVersion semver.Version
+	// CreateDatabase holds the statement creating the database when it does
+	// not exist yet, and is empty otherwise.
+	CreateDatabase string
+	// Steps are the migration steps to execute, in order.
+	Steps []PlannedStep
+}
+
+// connect connects to the database server described by m.ConnectParameters,
+// using database dbName.
+func (m *Migrator) connect(ctx context.Context, dbName string) (*cockroach.DB, error) {
+	connectParameters := m.ConnectParameters
+	connectParameters.DBName = dbName
+	crdb, err := cockroach.Dial(ctx, connectParameters)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to connect to database %s", dbName)
+	}
+	return crdb, nil
+}
+
+// Plan determines the current schema version of the database and the
+// migration steps which would bring it to targetVersion, without changing the
+// database.  When targetVersion is nil, no steps are planned.
+func (m *Migrator) Plan(ctx context.Context, targetVersion *semver.Version) (*Plan, error) {
+	steps, err := m.steps()
+	if err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	dbName := filepath.Base(m.SchemasDir)
+
+	// Connect to database server, using an initial database that is known to
+	// always be present
+	crdb, err := m.connect(ctx, "postgres")
+	if err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	defer func() {
+		crdb.Pool.Close()
+	}()
+	isCockroachDB := crdb.Dialect.IsCockroachDB()
+
+	// Check whether specified database exists
+	exists, err := doesDatabaseExist(ctx, crdb, dbName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to check whether database %s exists", dbName)
+	}
+	if !exists && dbName == "rid" && isCockroachDB {
+		// In the special case of rid, the database was previously named defaultdb
+		m.Logf("Database %s does not exist; checking for older \"defaultdb\" database", dbName)
+		exists, err = doesDatabaseExist(ctx, crdb, "defaultdb")
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Failed to check whether old defaultdb database exists")
+		}
+		if exists {
+			dbName = "defaultdb"
+		}
+	}
+	plan := &Plan{Database: dbName}
+
+	// Read current schema version of database
+	var currentVersion *semver.Version
+	if !exists {
+		m.Logf("Database %s does not exist", dbName)
+		if isCockroachDB {
+			plan.CreateDatabase = fmt.Sprintf("CREATE DATABASE IF NOT EXISTS %s", dbName)
+		} else {
+			// PostgreSQL does not support IF NOT EXISTS when creating a database
+			plan.CreateDatabase = fmt.Sprintf("CREATE DATABASE %s", dbName)
+		}
+		currentVersion = cockroach.UnknownVersion
+	} else {
+		m.Logf("Database %s already exists; reading current state", dbName)
+		if !isCockroachDB {
+			// PostgreSQL cannot read the schema version of another database
+			crdb.Pool.Close()
+			crdb, err = m.connect(ctx, dbName)
+			if err != nil {
+				return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+			}
+		}
+		currentVersion, err = crdb.GetVersion(ctx, dbName)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Failed to get current database version for %s", dbName)
+		}
+	}
+	plan.CurrentVersion = *currentVersion
+	m.Logf("Initial %s database schema version is %v, target is %v", dbName, currentVersion, targetVersion)
+	if targetVersion == nil {
+		return plan, nil
+	}
+
+	// Compute index of current and target versions
+	currentStepIndex, targetStepIndex := -1, -1
+	for i, step := range steps {
+		if step.Version == *currentVersion {
+			currentStepIndex = i
+		}
+		if step.Version == *targetVersion {
+			targetStepIndex = i
+		}
+	}
+	if currentStepIndex < 0 {
+		return nil, stacktrace.NewError("Current %s schema version %v has no migration definition in %s", dbName, currentVersion, m.SchemasDir)
+	}
+	if targetStepIndex < 0 {
+		return nil, stacktrace.NewError("Target %s schema version %v has no migration definition in %s", dbName, targetVersion, m.SchemasDir)
+	}
+
+	// Plan migration steps until current version matches target version
+	for currentStepIndex != targetStepIndex {
+		// Compute which migration step to run next and how it will change the schema version
+		var newCurrentStepIndex int
+		var sqlFile string
+		var removedPoolTables []string
+		if currentStepIndex < targetStepIndex {
+			// Migrate up to next version
+			newCurrentStepIndex = currentStepIndex + 1
+			sqlFile = steps[newCurrentStepIndex].UpToFile
+		} else {
+			// Migrate down from current version
+			newCurrentStepIndex = currentStepIndex - 1
+			sqlFile = steps[currentStepIndex].DownFromFile
+			removedPoolTables = m.PoolTables[steps[currentStepIndex].Version]
+		}
+		if sqlFile == "" {
+			return nil, stacktrace.NewError("No migration definition in %s leads from %s schema version %v to %v", m.SchemasDir, dbName, steps[currentStepIndex].Version, steps[newCurrentStepIndex].Version)
+		}
+
+		// Read migration SQL into string
+		fullFilePath := filepath.Join(m.SchemasDir, sqlFile)
+		rawMigrationSQL, err := ioutil.ReadFile(fullFilePath)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Failed to load SQL content from %s", fullFilePath)
+		}
+		migrationSQL := string(rawMigrationSQL)
+		if isCockroachDB {
+			migrationSQL = fmt.Sprintf("USE %s;\n", dbName) + migrationSQL
+		}
+		plan.Steps = append(plan.Steps, PlannedStep{
+			Database:          dbName,
+			File:              sqlFile,
+			From:              steps[currentStepIndex].Version,
+			To:                steps[newCurrentStepIndex].Version,
+			SQL:               migrationSQL,
+			RemovedPoolTables: removedPoolTables,
+		})
+
+		dbName = databaseAfterStep(dbName, steps[currentStepIndex].Version, steps[newCurrentStepIndex].Version)
+		currentStepIndex = newCurrentStepIndex
+	}
+
+	return plan, nil
+}
+
+// databaseAfterStep returns the name of database dbName after the migration
+// of its schema from version from to version to.
+func databaseAfterStep(dbName string, from, to semver.Version) string {
+	switch {
+	case dbName == "defaultdb" && to.String() == "4.0.0" && from.LessThan(to):
+		// RID database changes from `defaultdb` to `rid` when moving up to 4.0.0
+		return "rid"
+	case dbName == "rid" && from.String() == "4.0.0" && to.LessThan(from):
+		// RID database changes from `rid` to `defaultdb` when moving down from 4.0.0
+		return "defaultdb"
+	default:
+		return dbName
+	}
+}
+
+// checkPoolTableRemoval returns an error if plan removes tables shared by the
+// DSS instances of the pool while m does not allow it.
+func (m *Migrator) checkPoolTableRemoval(plan *Plan) error {
+	if m.AllowPoolTableRemoval {
+		return nil
+	}
+	for _, step := range plan.Steps {
+		if len(step.RemovedPoolTables) > 0 {
+			return stacktrace.NewError("Migrating %s schema down from %v removes %s, shared by all the DSS instances of the pool; allow the removal of pool tables once no DSS instance of the pool needs them", step.Database, step.From, strings.Join(step.RemovedPoolTables, ", "))
+		}
+	}
+	return nil
+}
+
+// Migrate creates the database if it does not exist yet, and migrates its
+// schema to targetVersion following m.Plan.  When targetVersion is nil, the
+// schema is left unchanged.  Migrate refuses plans removing PoolTables unless
+// m.AllowPoolTableRemoval.  Migrate returns the final schema version of the
+// database.
+func (m *Migrator) Migrate(ctx context.Context, targetVersion *semver.Version) (*semver.Version, error) {
+	plan, err := m.Plan(ctx, targetVersion)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	if err := m.checkPoolTableRemoval(plan); err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	dbName := plan.Database
+
+	if plan.CreateDatabase != "" {
+		crdb, err := m.connect(ctx, "postgres")
+		if err != nil {
+			return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+		}
+		m.Logf("Creating database %s", dbName)
+		_, err = crdb.Pool.Exec(ctx, plan.CreateDatabase)
+		crdb.Pool.Close()
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Failed to create new database %s", dbName)
+		}
+	}
+	currentVersion := plan.CurrentVersion
+	if len(plan.Steps) == 0 {
+		return &currentVersion, nil
+	}
+
+	crdb, err := m.connect(ctx, dbName)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	defer func() {
+		crdb.Pool.Close()
+	}()
+
+	// Perform migration steps until current version matches target version
+	for _, step := range plan.Steps {
+		m.Logf("Running %s to migrate %v to %v", step.File, step.From, step.To)
+		if _, err := crdb.Pool.Exec(ctx, step.SQL); err != nil {
+			return nil, stacktrace.Propagate(err, "Failed to execute %s migration step %s", step.Database, step.File)
+		}
+
+		dbName = databaseAfterStep(step.Database, step.From, step.To)
+		actualVersion, err := crdb.GetVersion(ctx, dbName)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Failed to get current database version for %s", dbName)
+		}
+		if !actualVersion.Equal(step.To) {
+			return nil, stacktrace.NewError("Migration %s should have migrated %s schema version %v to %v, but instead resulted in %v", step.File, step.Database, step.From, step.To, actualVersion)
+		}
+		currentVersion = *actualVersion
+	}
+
+	m.Logf("Final %s version: %v", dbName, currentVersion)
+	return &currentVersion, nil
+}
+
+func doesDatabaseExist(ctx context.Context, crdb *cockroach.DB, database string) (bool, error) {
+	const checkDbQuery = `
+		SELECT EXISTS (
+			SELECT * FROM pg_database WHERE datname = $1
+		)`
+
+	var exists bool
+	if err := crdb.Pool.QueryRow(ctx, checkDbQuery, database).Scan(&exists); err != nil {
+		return false, stacktrace.Propagate(err, "Error in query: %s", checkDbQuery)
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,67 @@
+package migration
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/coreos/go-semver/semver"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnumerateSteps(t *testing.T) {
+	dir, err := ioutil.TempDir("", "schemas")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	for _, name := range []string{
+		"upto-v1.0.0-create_tables.sql",
+		"downfrom-v1.0.0-drop_tables.sql",
+		"upto-v1.10.0-add_index.sql",
+		"upto-v1.2.0-add_column.sql",
+		"downfrom-v1.2.0-drop_column.sql",
+		"README.md",
+	} {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), nil, 0644))
+	}
+
+	steps, err := EnumerateSteps(dir)
+	require.NoError(t, err)
+	require.Equal(t, []Step{
+		{Version: *semver.New("0.0.0")},
+		{Version: *semver.New("1.0.0"), UpToFile: "upto-v1.0.0-create_tables.sql", DownFromFile: "downfrom-v1.0.0-drop_tables.sql"},
+		{Version: *semver.New("1.2.0"), UpToFile: "upto-v1.2.0-add_column.sql", DownFromFile: "downfrom-v1.2.0-drop_column.sql"},
+		{Version: *semver.New("1.10.0"), UpToFile: "upto-v1.10.0-add_index.sql"},
+	}, steps)
+
+	latest, err := (&Migrator{SchemasDir: dir}).LatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, *semver.New("1.10.0"), *latest)
+}
+
+func TestLatestVersionWithoutSteps(t *testing.T) {
+	dir, err := ioutil.TempDir("", "schemas")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	_, err = (&Migrator{SchemasDir: dir}).LatestVersion()
+	require.Error(t, err)
+}
+
+func TestDatabaseAfterStep(t *testing.T) {
+	require.Equal(t, "rid", databaseAfterStep("defaultdb", *semver.New("3.1.1"), *semver.New("4.0.0")))
+	require.Equal(t, "defaultdb", databaseAfterStep("rid", *semver.New("4.0.0"), *semver.New("3.1.1")))
+	require.Equal(t, "rid", databaseAfterStep("rid", *semver.New("4.0.0"), *semver.New("4.2.0")))
+	require.Equal(t, "scd", databaseAfterStep("scd", *semver.New("3.4.0"), *semver.New("3.5.0")))
+}
+
+func TestCheckPoolTableRemoval(t *testing.T) {
+	plan := &Plan{Steps: []PlannedStep{
+		{Database: "rid", From: *semver.New("4.3.0"), To: *semver.New("4.2.0")},
+		{Database: "rid", From: *semver.New("4.2.0"), To: *semver.New("4.1.0"), RemovedPoolTables: []string{"job_leases"}},
+	}}
+	require.Error(t, (&Migrator{}).checkPoolTableRemoval(plan))
+	require.NoError(t, (&Migrator{AllowPoolTableRemoval: true}).checkPoolTableRemoval(plan))
+	require.NoError(t, (&Migrator{}).checkPoolTableRemoval(&Plan{Steps: plan.Steps[:1]}))
+}
@@ -0,0 +1,67 @@
+package cockroach
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	poolConnections = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dss_db_pool_connections",
+		Help: "Number of connections of the database connection pool, by state (acquired, idle or constructing).",
+	}, []string{"database", "state"})
+	poolMaxConnections = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dss_db_pool_max_connections",
+		Help: "Maximum number of connections of the database connection pool.",
+	}, []string{"database"})
+	poolAcquires = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "dss_db_pool_acquires_total",
+		Help: "Number of connections acquired from the database connection pool, by whether the acquisition had to wait for a connection to become available.",
+	}, []string{"database", "waited"})
+	poolCanceledAcquires = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "dss_db_pool_canceled_acquires_total",
+		Help: "Number of acquisitions of connections from the database connection pool canceled by their context, e.g. on timeout.",
+	}, []string{"database"})
+	poolAcquireSeconds = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "dss_db_pool_acquire_seconds_total",
+		Help: "Total time spent acquiring connections from the database connection pool, including waiting for connections to become available.",
+	}, []string{"database"})
+)
+
+// poolMetricsState holds the cumulative pool statistics already added to the
+// counters of a database.
+type poolMetricsState struct {
+	mu              sync.Mutex
+	acquires        int64
+	emptyAcquires   int64
+	canceled        int64
+	acquireDuration time.Duration
+}
+
+// RecordPoolMetrics updates the metrics of the connection pool of db, labeled
+// with databaseName.  Counters are increased by the activity of the pool since
+// the previous call.
+func (db *DB) RecordPoolMetrics(databaseName string) {
+	stat := db.Pool.Stat()
+
+	poolConnections.WithLabelValues(databaseName, "acquired").Set(float64(stat.AcquiredConns()))
+	poolConnections.WithLabelValues(databaseName, "idle").Set(float64(stat.IdleConns()))
+	poolConnections.WithLabelValues(databaseName, "constructing").Set(float64(stat.ConstructingConns()))
+	poolMaxConnections.WithLabelValues(databaseName).Set(float64(stat.MaxConns()))
+
+	s := &db.poolMetrics
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	emptyAcquires := stat.EmptyAcquireCount()
+	poolAcquires.WithLabelValues(databaseName, "false").Add(float64((stat.AcquireCount() - emptyAcquires) - (s.acquires - s.emptyAcquires)))
+	poolAcquires.WithLabelValues(databaseName, "true").Add(float64(emptyAcquires - s.emptyAcquires))
+	poolCanceledAcquires.WithLabelValues(databaseName).Add(float64(stat.CanceledAcquireCount() - s.canceled))
+	poolAcquireSeconds.WithLabelValues(databaseName).Add((stat.AcquireDuration() - s.acquireDuration).Seconds())
+	s.acquires = stat.AcquireCount()
+	s.emptyAcquires = emptyAcquires
+	s.canceled = stat.CanceledAcquireCount()
+	s.acquireDuration = stat.AcquireDuration()
+}
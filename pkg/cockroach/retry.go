@@ -0,0 +1,180 @@
+package cockroach
+
+import (
+	"context"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/stacktrace"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+)
+
+const (
+	// defaultOperation labels the transactions of contexts without an
+	// operation, such as those of background jobs.
+	defaultOperation = "other"
+
+	defaultRetryInitialBackoff = 10 * time.Millisecond
+	defaultRetryMaxBackoff     = time.Second
+)
+
+var (
+	transactionRetries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "dss_db_transaction_retries_total",
+		Help: "Number of database transactions retried after a serialization conflict, by operation.",
+	}, []string{"operation"})
+	transactionGiveUps = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "dss_db_transaction_give_ups_total",
+		Help: "Number of database transactions abandoned after exhausting their retry budget, by operation.",
+	}, []string{"operation"})
+)
+
+type operationKey struct{}
+
+// WithOperation returns a copy of ctx in which transactions are attributed to
+// operation, for their retry budget and metrics.
+func WithOperation(ctx context.Context, operation string) context.Context {
+	return context.WithValue(ctx, operationKey{}, operation)
+}
+
+// OperationFromContext returns the operation to which the transactions of ctx
+// are attributed.
+func OperationFromContext(ctx context.Context) string {
+	if operation, ok := ctx.Value(operationKey{}).(string); ok && operation != "" {
+		return operation
+	}
+	return defaultOperation
+}
+
+// OperationInterceptor returns a grpc.UnaryServerInterceptor attributing the
+// transactions of each call to the name of its method.
+func OperationInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		operation := info.FullMethod
+		if i := strings.LastIndex(operation, "/"); i >= 0 {
+			operation = operation[i+1:]
+		}
+		return handler(WithOperation(ctx, operation), req)
+	}
+}
+
+// retryPolicy governs the retries of transactions after serialization
+// conflicts.
+type retryPolicy struct {
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	// budgets are the maximum numbers of retries of the transactions of
+	// specific operations.
+	budgets map[string]int
+}
+
+// retryPolicy returns the retry policy described by cp.
+func (cp ConnectParameters) retryPolicy() (retryPolicy, error) {
+	policy := retryPolicy{
+		initialBackoff: cp.RetryInitialBackoff,
+		maxBackoff:     cp.RetryMaxBackoff,
+	}
+	if policy.initialBackoff <= 0 {
+		policy.initialBackoff = defaultRetryInitialBackoff
+	}
+	if policy.maxBackoff <= 0 {
+		policy.maxBackoff = defaultRetryMaxBackoff
+	}
+	if policy.maxBackoff < policy.initialBackoff {
+		return retryPolicy{}, stacktrace.NewError("Maximum retry backoff %s must not be shorter than the initial backoff %s", policy.maxBackoff, policy.initialBackoff)
+	}
+	budgets, err := parseRetryBudgets(cp.RetryBudgets)
+	if err != nil {
+		return retryPolicy{}, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	policy.budgets = budgets
+	return policy, nil
+}
+
+// parseRetryBudgets parses a comma-separated list of operation=retries
+// budgets.
+func parseRetryBudgets(s string) (map[string]int, error) {
+	budgets := map[string]int{}
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return nil, stacktrace.NewError("Retry budget `%s` is not of the form operation=retries", entry)
+		}
+		retries, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+		if err != nil || retries < 0 {
+			return nil, stacktrace.NewError("Retry budget of %s must be a non-negative integer", parts[0])
+		}
+		budgets[strings.TrimSpace(parts[0])] = retries
+	}
+	return budgets, nil
+}
+
+// budget returns the maximum number of retries of the transactions of
+// operation, defaultBudget unless the policy specifies one.
+func (p retryPolicy) budget(operation string, defaultBudget int) int {
+	if budget, ok := p.budgets[operation]; ok {
+		return budget
+	}
+	return defaultBudget
+}
+
+// backoff returns the delay before the retry following attempt (0 for the
+// first attempt): an exponentially growing delay up to the maximum backoff,
+// with full jitter so that conflicting transactions do not retry in lockstep.
+func (p retryPolicy) backoff(attempt int) time.Duration {
+	initialBackoff, maxBackoff := p.initialBackoff, p.maxBackoff
+	if initialBackoff <= 0 {
+		initialBackoff = defaultRetryInitialBackoff
+	}
+	if maxBackoff < initialBackoff {
+		maxBackoff = initialBackoff
+	}
+	backoff := initialBackoff
+	for i := 0; i < attempt && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+	return time.Duration(rand.Int63n(int64(backoff)) + 1)
+}
+
+// retry runs attempt until it succeeds, fails with an error on which
+// retryable returns false, or fails after maxRetries retries, waiting with
+// exponential backoff between attempts.
+func (p retryPolicy) retry(ctx context.Context, maxRetries int, retryable func(error) bool, attempt func() error) error {
+	operation := OperationFromContext(ctx)
+	budget := p.budget(operation, maxRetries)
+	for i := 0; ; i++ {
+		err := attempt()
+		if err == nil || !retryable(err) {
+			return err // No need to Propagate this error as this is not a useful stacktrace line
+		}
+		if i >= budget {
+			transactionGiveUps.WithLabelValues(operation).Inc()
+			return stacktrace.PropagateWithCode(err, dsserr.Unavailable, "Transaction for %s abandoned after %d retries due to contention", operation, budget)
+		}
+		transactionRetries.WithLabelValues(operation).Inc()
+		backoff := p.backoff(i)
+		trace.SpanFromContext(ctx).AddEvent("retry", trace.WithAttributes(
+			attribute.Int("attempt", i+1),
+			attribute.String("error", err.Error()),
+			attribute.Int64("backoff_ms", backoff.Milliseconds())))
+		select {
+		case <-ctx.Done():
+			return stacktrace.Propagate(ctx.Err(), "Context ended while waiting to retry transaction for %s", operation)
+		case <-time.After(backoff):
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package cockroach
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/stacktrace"
+	"github.com/jackc/pgconn"
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestParseRetryBudgets(t *testing.T) {
+	budgets, err := parseRetryBudgets("")
+	require.NoError(t, err)
+	require.Empty(t, budgets)
+
+	budgets, err = parseRetryBudgets("PutOperationalIntentReference=20, SearchSubscriptions=0,")
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"PutOperationalIntentReference": 20, "SearchSubscriptions": 0}, budgets)
+
+	for _, invalid := range []string{"PutOperationalIntentReference", "=3", "SearchSubscriptions=-1", "SearchSubscriptions=x"} {
+		_, err = parseRetryBudgets(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestRetryPolicy(t *testing.T) {
+	policy, err := ConnectParameters{RetryBudgets: "Op=3"}.retryPolicy()
+	require.NoError(t, err)
+	require.Equal(t, defaultRetryInitialBackoff, policy.initialBackoff)
+	require.Equal(t, defaultRetryMaxBackoff, policy.maxBackoff)
+	require.Equal(t, 3, policy.budget("Op", 100))
+	require.Equal(t, 100, policy.budget("Other", 100))
+
+	_, err = ConnectParameters{RetryInitialBackoff: time.Second, RetryMaxBackoff: time.Millisecond}.retryPolicy()
+	require.Error(t, err)
+}
+
+func TestBackoff(t *testing.T) {
+	policy := retryPolicy{initialBackoff: 10 * time.Millisecond, maxBackoff: 50 * time.Millisecond}
+	for attempt, bound := range []time.Duration{10, 20, 40, 50, 50} {
+		for i := 0; i < 100; i++ {
+			backoff := policy.backoff(attempt)
+			require.True(t, backoff > 0 && backoff <= bound*time.Millisecond, "backoff %s after attempt %d", backoff, attempt)
+		}
+	}
+}
+
+func TestRetry(t *testing.T) {
+	policy := retryPolicy{initialBackoff: time.Microsecond, maxBackoff: time.Microsecond, budgets: map[string]int{"Op": 1}}
+	conflict := &pgconn.PgError{Code: "40001"}
+	retryable := DialectCockroachDB.isRetryable
+
+	attempts := 0
+	err := policy.retry(context.Background(), 5, retryable, func() error {
+		attempts++
+		if attempts < 3 {
+			return conflict
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, attempts)
+
+	attempts = 0
+	err = policy.retry(context.Background(), 5, retryable, func() error {
+		attempts++
+		return errors.New("not a conflict")
+	})
+	require.Error(t, err)
+	require.Equal(t, 1, attempts)
+
+	attempts = 0
+	err = policy.retry(WithOperation(context.Background(), "Op"), 5, retryable, func() error {
+		attempts++
+		return conflict
+	})
+	require.Error(t, err)
+	require.Equal(t, 2, attempts)
+	require.Equal(t, dsserr.Unavailable, stacktrace.GetCode(err))
+}
+
+func TestRetryEvents(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	ctx, span := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test").Start(context.Background(), "transaction")
+	policy := retryPolicy{initialBackoff: time.Microsecond, maxBackoff: time.Microsecond}
+
+	attempts := 0
+	require.NoError(t, policy.retry(ctx, 5, DialectCockroachDB.isRetryable, func() error {
+		attempts++
+		if attempts < 3 {
+			return &pgconn.PgError{Code: "40001"}
+		}
+		return nil
+	}))
+	span.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	require.Len(t, spans[0].Events(), 2)
+	require.Equal(t, "retry", spans[0].Events()[0].Name)
+}
+
+func TestOperationFromContext(t *testing.T) {
+	require.Equal(t, defaultOperation, OperationFromContext(context.Background()))
+	require.Equal(t, "Op", OperationFromContext(WithOperation(context.Background(), "Op")))
+}
@@ -0,0 +1,99 @@
+package cockroach
+
+import (
+	"context"
+	"sync"
+
+	"github.com/coreos/go-semver/semver"
+	"github.com/interuss/stacktrace"
+)
+
+// SchemaCompatibility pairs the schema version of a database with the range of
+// schema versions supported by this binary.
+type SchemaCompatibility struct {
+	Database string
+	// Current is the schema version of the database, nil when it could not be
+	// read.
+	Current *semver.Version
+	Minimum semver.Version
+	Maximum semver.Version
+	// Error describes why Current could not be read.
+	Error string
+}
+
+// Compatible returns whether the schema version of the database is supported.
+func (c *SchemaCompatibility) Compatible() bool {
+	return c.Current != nil && c.Current != UnknownVersion &&
+		!c.Current.LessThan(c.Minimum) && !c.Maximum.LessThan(*c.Current)
+}
+
+type monitoredSchema struct {
+	db       *DB
+	dbName   string
+	min, max semver.Version
+}
+
+// SchemaMonitor periodically pairs the schema versions of databases with the
+// schema versions supported by this binary, so that a DSS instance whose
+// databases were migrated beyond (or not up to) what it supports, e.g. during a
+// mixed-version rollout of a pool, stops reporting itself ready.
+type SchemaMonitor struct {
+	mu      sync.RWMutex
+	schemas []monitoredSchema
+	latest  []*SchemaCompatibility
+}
+
+// Add monitors the schema of database dbName, which db is connected to, which
+// this binary supports from schema version min to max.
+func (m *SchemaMonitor) Add(db *DB, dbName string, min, max semver.Version) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.schemas = append(m.schemas, monitoredSchema{db: db, dbName: dbName, min: min, max: max})
+}
+
+// Refresh reads the schema versions of the monitored databases, and returns an
+// error if any of them is not supported.
+func (m *SchemaMonitor) Refresh(ctx context.Context) error {
+	m.mu.RLock()
+	schemas := append([]monitoredSchema{}, m.schemas...)
+	m.mu.RUnlock()
+
+	var (
+		latest       = make([]*SchemaCompatibility, 0, len(schemas))
+		incompatible error
+	)
+	for _, s := range schemas {
+		c := &SchemaCompatibility{Database: s.dbName, Minimum: s.min, Maximum: s.max}
+		vs, err := s.db.GetVersion(ctx, s.dbName)
+		if err != nil {
+			c.Error = err.Error()
+		} else {
+			c.Current = vs
+		}
+		if !c.Compatible() && incompatible == nil {
+			incompatible = stacktrace.NewError("Schema version %v of database %s is outside of the range %s to %s supported by this DSS", c.Current, s.dbName, &c.Minimum, &c.Maximum)
+		}
+		latest = append(latest, c)
+	}
+
+	m.mu.Lock()
+	m.latest = latest
+	m.mu.Unlock()
+	return incompatible
+}
+
+// Status returns the compatibility of the monitored databases as of the last
+// Refresh, and whether all of them are compatible.  A nil SchemaMonitor
+// monitors no database.
+func (m *SchemaMonitor) Status() ([]*SchemaCompatibility, bool) {
+	if m == nil {
+		return nil, true
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	compatible := len(m.latest) == len(m.schemas)
+	for _, c := range m.latest {
+		compatible = compatible && c.Compatible()
+	}
+	return m.latest, compatible
+}
@@ -0,0 +1,25 @@
+package cockroach
+
+import (
+	"testing"
+
+	"github.com/coreos/go-semver/semver"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemaCompatibility(t *testing.T) {
+	compatibility := func(current *semver.Version) *SchemaCompatibility {
+		return &SchemaCompatibility{Database: "scd", Current: current, Minimum: *semver.New("3.0.0"), Maximum: *semver.New("3.5.0")}
+	}
+	require.True(t, compatibility(semver.New("3.0.0")).Compatible())
+	require.True(t, compatibility(semver.New("3.5.0")).Compatible())
+	require.False(t, compatibility(semver.New("2.9.0")).Compatible())
+	require.False(t, compatibility(semver.New("3.6.0")).Compatible())
+	require.False(t, compatibility(UnknownVersion).Compatible())
+	require.False(t, compatibility(nil).Compatible())
+
+	var m *SchemaMonitor
+	schemas, compatible := m.Status()
+	require.Empty(t, schemas)
+	require.True(t, compatible)
+}
@@ -0,0 +1,173 @@
+package snapshot
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"github.com/interuss/dss/pkg/cockroach"
+	"github.com/interuss/stacktrace"
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	// DebugFormat identifies the format of the debug snapshots of this
+	// package.
+	DebugFormat = "dss-debug-snapshot/v1"
+
+	// ManifestFile is the name of the file describing a debug snapshot, in
+	// the directory of the snapshot.
+	ManifestFile = "manifest.json"
+)
+
+// systemTimeRegexp matches the decimal cluster timestamps of SystemTime.
+var systemTimeRegexp = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?$`)
+
+// Manifest describes a debug snapshot: a directory holding the rows of each
+// table of DSS databases in a newline-delimited JSON file.
+type Manifest struct {
+	Format    string    `json:"format"`
+	CreatedAt time.Time `json:"created_at"`
+	// AsOfSystemTime is the CockroachDB cluster timestamp at which all the
+	// databases were read.  It is empty for other datastores, whose databases
+	// are each read from their own consistent snapshot.
+	AsOfSystemTime string              `json:"as_of_system_time,omitempty"`
+	Databases      []*ManifestDatabase `json:"databases"`
+}
+
+// ManifestDatabase describes the tables of a database in a debug snapshot.
+type ManifestDatabase struct {
+	Name          string           `json:"name"`
+	SchemaVersion string           `json:"schema_version"`
+	Tables        []*ManifestTable `json:"tables"`
+}
+
+// ManifestTable describes the file holding the rows of a table in a debug
+// snapshot.  Each line of the file is a JSON object mapping each column to
+// its value in the text format of its column type, or null.
+type ManifestTable struct {
+	Name    string   `json:"name"`
+	File    string   `json:"file"`
+	Columns []string `json:"columns"`
+	Rows    int      `json:"rows"`
+	SHA256  string   `json:"sha256"`
+}
+
+// SystemTime returns the CockroachDB cluster timestamp age ago, at which
+// Capture reads all databases consistently.
+func SystemTime(ctx context.Context, crdb *cockroach.DB, age time.Duration) (string, error) {
+	if !crdb.Dialect.IsCockroachDB() {
+		return "", stacktrace.NewError("AS OF SYSTEM TIME is only supported by CockroachDB")
+	}
+	const query = `SELECT CAST(cluster_logical_timestamp() - CAST($1 AS DECIMAL) AS STRING)`
+	var ts string
+	if err := crdb.Pool.QueryRow(ctx, query, age.Nanoseconds()).Scan(&ts); err != nil {
+		return "", stacktrace.Propagate(err, "Error in query: %s", query)
+	}
+	return ts, nil
+}
+
+// Capture writes the rows of each table of the database dbName, which crdb
+// must be connected to, to a file in dir, and returns their description.
+// Tables are read AS OF SYSTEM TIME asOf on CockroachDB, from a single
+// read-only transaction otherwise.
+func Capture(ctx context.Context, crdb *cockroach.DB, dbName string, asOf string, dir string) (*ManifestDatabase, error) {
+	version, err := crdb.GetVersion(ctx, dbName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to get schema version of %s", dbName)
+	}
+	result := &ManifestDatabase{Name: dbName, SchemaVersion: version.String()}
+
+	options := pgx.TxOptions{AccessMode: pgx.ReadOnly}
+	if !crdb.Dialect.IsCockroachDB() {
+		options.IsoLevel = pgx.RepeatableRead
+	} else if asOf == "" {
+		return nil, stacktrace.NewError("Capture requires a system time on CockroachDB")
+	}
+	tx, err := crdb.Pool.BeginTx(ctx, options)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to begin transaction")
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+	if crdb.Dialect.IsCockroachDB() {
+		if !systemTimeRegexp.MatchString(asOf) {
+			return nil, stacktrace.NewError("Invalid system time `%s`", asOf)
+		}
+		if _, err := tx.Exec(ctx, fmt.Sprintf("SET TRANSACTION AS OF SYSTEM TIME '%s'", asOf)); err != nil {
+			return nil, stacktrace.Propagate(err, "Failed to read as of system time %s", asOf)
+		}
+	}
+
+	names, err := tableNames(ctx, tx, dbName)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	// The schema versions are part of debug snapshots, to interpret them
+	names = append([]string{schemaVersionsTable}, names...)
+
+	if err := os.MkdirAll(filepath.Join(dir, dbName), 0700); err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to create directory of %s", dbName)
+	}
+	for _, name := range names {
+		table, err := captureTable(ctx, tx, dbName, name, dir)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Failed to capture table %s of %s", name, dbName)
+		}
+		result.Tables = append(result.Tables, table)
+	}
+	return result, nil
+}
+
+func captureTable(ctx context.Context, tx pgx.Tx, dbName string, name string, dir string) (*ManifestTable, error) {
+	columns, err := tableColumns(ctx, tx, dbName, name)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	table := &ManifestTable{
+		Name:    name,
+		File:    filepath.ToSlash(filepath.Join(dbName, name+".ndjson")),
+		Columns: columns,
+	}
+
+	f, err := os.OpenFile(filepath.Join(dir, table.File), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to create %s", table.File)
+	}
+	defer f.Close()
+	hash := sha256.New()
+	w := bufio.NewWriter(io.MultiWriter(f, hash))
+	encoder := json.NewEncoder(w)
+
+	err = scanTable(ctx, tx, name, columns, func(values []*string) error {
+		row := make(map[string]*string, len(columns))
+		for i, column := range columns {
+			row[column] = values[i]
+		}
+		if err := encoder.Encode(row); err != nil {
+			return stacktrace.Propagate(err, "Failed to write row to %s", table.File)
+		}
+		table.Rows++
+		return nil
+	})
+	if err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	if err := w.Flush(); err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to write %s", table.File)
+	}
+	if err := f.Close(); err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to close %s", table.File)
+	}
+	table.SHA256 = hex.EncodeToString(hash.Sum(nil))
+	return table, nil
+}
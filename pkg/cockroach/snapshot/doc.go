@@ -0,0 +1,6 @@
+// Package snapshot exports the content of DSS databases to a portable format,
+// and restores it into other databases with the same schema version, for
+// environment cloning and disaster recovery drills.  It also captures
+// consistent debug snapshots of DSS databases, as newline-delimited JSON files
+// described by a manifest, to reproduce reported inconsistencies offline.
+package snapshot
@@ -0,0 +1,380 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/interuss/dss/pkg/cockroach"
+	"github.com/interuss/stacktrace"
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	// Format identifies the format of the snapshots of this package.
+	Format = "dss-snapshot/v1"
+
+	// schemaVersionsTable is not part of snapshots: the schema of the
+	// restored database is migrated separately, and must match.
+	schemaVersionsTable = "schema_versions"
+
+	// maxRetries is the number of times exports and restores are retried
+	// after contention.
+	maxRetries = 10
+)
+
+// Snapshot is a portable copy of the content of DSS databases.
+type Snapshot struct {
+	Format    string      `json:"format"`
+	CreatedAt time.Time   `json:"created_at"`
+	Databases []*Database `json:"databases"`
+}
+
+// Database holds the content of the tables of a database, in an order such
+// that rows are only referenced by rows of later tables.
+type Database struct {
+	Name          string   `json:"name"`
+	SchemaVersion string   `json:"schema_version"`
+	Tables        []*Table `json:"tables"`
+}
+
+// Table holds the rows of a table.  Each value is held in the text format of
+// its column type, so that rows (including the timestamps from which versions
+// and OVNs are derived) are restored exactly; nil denotes NULL.
+type Table struct {
+	Name    string      `json:"name"`
+	Columns []string    `json:"columns"`
+	Rows    [][]*string `json:"rows"`
+}
+
+// Database returns the content of the database named name in s, or nil if s
+// does not hold it.
+func (s *Snapshot) Database(name string) *Database {
+	for _, db := range s.Databases {
+		if db.Name == name {
+			return db
+		}
+	}
+	return nil
+}
+
+// Export copies the content of the database dbName, which crdb must be
+// connected to.
+func Export(ctx context.Context, crdb *cockroach.DB, dbName string) (*Database, error) {
+	version, err := crdb.GetVersion(ctx, dbName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to get schema version of %s", dbName)
+	}
+	if version == cockroach.UnknownVersion {
+		return nil, stacktrace.NewError("Database %s has not been bootstrapped with Schema Manager", dbName)
+	}
+	result := &Database{Name: dbName, SchemaVersion: version.String()}
+
+	err = crdb.ExecuteTx(ctx, maxRetries, func(tx pgx.Tx) error {
+		// Export all tables from a single transaction, so that the snapshot is
+		// consistent
+		names, err := tableNames(ctx, tx, dbName)
+		if err != nil {
+			return err // No need to Propagate this error as this is not a useful stacktrace line
+		}
+		result.Tables = nil
+		for _, name := range names {
+			table, err := exportTable(ctx, tx, dbName, name)
+			if err != nil {
+				return stacktrace.Propagate(err, "Failed to export table %s of %s", name, dbName)
+			}
+			result.Tables = append(result.Tables, table)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	return result, nil
+}
+
+// Restore inserts the content of snapshot into the database dbName, which
+// crdb must be connected to.  The schema of the database must be at the
+// version of snapshot, and its tables must be empty.
+func Restore(ctx context.Context, crdb *cockroach.DB, dbName string, snapshot *Database) error {
+	version, err := crdb.GetVersion(ctx, dbName)
+	if err != nil {
+		return stacktrace.Propagate(err, "Failed to get schema version of %s", dbName)
+	}
+	if version.String() != snapshot.SchemaVersion {
+		return stacktrace.NewError("Snapshot of %s has schema version %s, but database %s has schema version %s; migrate its schema to %s first", snapshot.Name, snapshot.SchemaVersion, dbName, version, snapshot.SchemaVersion)
+	}
+
+	return crdb.ExecuteTx(ctx, maxRetries, func(tx pgx.Tx) error {
+		for _, table := range snapshot.Tables {
+			if err := restoreTable(ctx, tx, table); err != nil {
+				return stacktrace.Propagate(err, "Failed to restore table %s of %s", table.Name, dbName)
+			}
+		}
+		if !crdb.Dialect.IsCockroachDB() {
+			// Identity columns of PostgreSQL must not generate the restored
+			// values again
+			for _, table := range snapshot.Tables {
+				if err := advanceIdentities(ctx, tx, table.Name); err != nil {
+					return stacktrace.Propagate(err, "Failed to advance identity columns of table %s of %s", table.Name, dbName)
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// tableNames returns the names of the tables of database dbName, except
+// schemaVersionsTable, in an order such that tables referencing other tables
+// come after them.
+func tableNames(ctx context.Context, tx pgx.Tx, dbName string) ([]string, error) {
+	const tablesQuery = `
+		SELECT table_name
+		FROM information_schema.tables
+		WHERE table_catalog = $1 AND table_schema = 'public' AND table_type = 'BASE TABLE'`
+	rows, err := tx.Query(ctx, tablesQuery, dbName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error in query: %s", tablesQuery)
+	}
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			rows.Close()
+			return nil, stacktrace.Propagate(err, "Error scanning table name")
+		}
+		if name != schemaVersionsTable {
+			names = append(names, name)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, stacktrace.Propagate(err, "Error in rows query result")
+	}
+
+	const referencesQuery = `
+		SELECT tc.table_name, ccu.table_name
+		FROM information_schema.table_constraints AS tc
+		JOIN information_schema.constraint_column_usage AS ccu
+			ON tc.constraint_name = ccu.constraint_name AND tc.table_schema = ccu.table_schema
+		WHERE tc.table_catalog = $1 AND tc.table_schema = 'public' AND tc.constraint_type = 'FOREIGN KEY'`
+	rows, err = tx.Query(ctx, referencesQuery, dbName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error in query: %s", referencesQuery)
+	}
+	defer rows.Close()
+	references := map[string][]string{}
+	for rows.Next() {
+		var table, referenced string
+		if err := rows.Scan(&table, &referenced); err != nil {
+			return nil, stacktrace.Propagate(err, "Error scanning table reference")
+		}
+		if table != referenced {
+			references[table] = append(references[table], referenced)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, stacktrace.Propagate(err, "Error in rows query result")
+	}
+
+	return sortTables(names, references)
+}
+
+// sortTables sorts names so that each table comes after the tables it
+// references.
+func sortTables(names []string, references map[string][]string) ([]string, error) {
+	sort.Strings(names)
+	const (
+		visiting = iota + 1
+		visited
+	)
+	state := map[string]int{}
+	for _, name := range names {
+		state[name] = 0
+	}
+	result := make([]string, 0, len(names))
+	var visit func(name string) error
+	visit = func(name string) error {
+		s, ok := state[name]
+		if !ok {
+			// References to tables which are not sorted impose no order
+			return nil
+		}
+		switch s {
+		case visiting:
+			return stacktrace.NewError("Table %s references itself through other tables", name)
+		case visited:
+			return nil
+		}
+		state[name] = visiting
+		referenced := append([]string{}, references[name]...)
+		sort.Strings(referenced)
+		for _, r := range referenced {
+			if err := visit(r); err != nil {
+				return err // No need to Propagate this error as this is not a useful stacktrace line
+			}
+		}
+		state[name] = visited
+		result = append(result, name)
+		return nil
+	}
+	for _, name := range names {
+		if err := visit(name); err != nil {
+			return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+		}
+	}
+	return result, nil
+}
+
+func exportTable(ctx context.Context, tx pgx.Tx, dbName string, name string) (*Table, error) {
+	columns, err := tableColumns(ctx, tx, dbName, name)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	table := &Table{Name: name, Columns: columns, Rows: [][]*string{}}
+	err = scanTable(ctx, tx, name, columns, func(values []*string) error {
+		table.Rows = append(table.Rows, values)
+		return nil
+	})
+	if err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	return table, nil
+}
+
+// tableColumns returns the names of the columns of table name of database
+// dbName, in their order.
+func tableColumns(ctx context.Context, tx pgx.Tx, dbName string, name string) ([]string, error) {
+	const columnsQuery = `
+		SELECT column_name
+		FROM information_schema.columns
+		WHERE table_catalog = $1 AND table_schema = 'public' AND table_name = $2
+		ORDER BY ordinal_position`
+	rows, err := tx.Query(ctx, columnsQuery, dbName, name)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error in query: %s", columnsQuery)
+	}
+	defer rows.Close()
+	var columns []string
+	for rows.Next() {
+		var column string
+		if err := rows.Scan(&column); err != nil {
+			return nil, stacktrace.Propagate(err, "Error scanning column name")
+		}
+		columns = append(columns, column)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, stacktrace.Propagate(err, "Error in rows query result")
+	}
+	if len(columns) == 0 {
+		return nil, stacktrace.NewError("Table has no columns")
+	}
+	return columns, nil
+}
+
+// scanTable calls f with the values of columns of each row of table name, in
+// the text format of their column types; nil denotes NULL.
+func scanTable(ctx context.Context, tx pgx.Tx, name string, columns []string, f func(values []*string) error) error {
+	casts := make([]string, len(columns))
+	for i, column := range columns {
+		casts[i] = fmt.Sprintf("CAST(%s AS TEXT)", pgx.Identifier{column}.Sanitize())
+	}
+	rowsQuery := fmt.Sprintf("SELECT %s FROM %s", strings.Join(casts, ", "), pgx.Identifier{name}.Sanitize())
+	rows, err := tx.Query(ctx, rowsQuery)
+	if err != nil {
+		return stacktrace.Propagate(err, "Error in query: %s", rowsQuery)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		values := make([]*string, len(columns))
+		dest := make([]interface{}, len(values))
+		for i := range values {
+			dest[i] = &values[i]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return stacktrace.Propagate(err, "Error scanning row")
+		}
+		if err := f(values); err != nil {
+			return err // No need to Propagate this error as this is not a useful stacktrace line
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return stacktrace.Propagate(err, "Error in rows query result")
+	}
+	return nil
+}
+
+func restoreTable(ctx context.Context, tx pgx.Tx, table *Table) error {
+	tableName := pgx.Identifier{table.Name}.Sanitize()
+	var notEmpty bool
+	emptyQuery := fmt.Sprintf("SELECT EXISTS (SELECT * FROM %s)", tableName)
+	if err := tx.QueryRow(ctx, emptyQuery).Scan(&notEmpty); err != nil {
+		return stacktrace.Propagate(err, "Error in query: %s", emptyQuery)
+	}
+	if notEmpty {
+		return stacktrace.NewError("Table is not empty")
+	}
+
+	columns := make([]string, len(table.Columns))
+	placeholders := make([]string, len(table.Columns))
+	for i, column := range table.Columns {
+		columns[i] = pgx.Identifier{column}.Sanitize()
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+	}
+	insertQuery := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", tableName, strings.Join(columns, ", "), strings.Join(placeholders, ", "))
+	for i, row := range table.Rows {
+		if len(row) != len(table.Columns) {
+			return stacktrace.NewError("Row %d has %d values for %d columns", i, len(row), len(table.Columns))
+		}
+		// Values are sent as text, to be parsed by the database according to
+		// the type of their columns
+		args := make([]interface{}, len(row))
+		for j, value := range row {
+			if value != nil {
+				args[j] = *value
+			}
+		}
+		if _, err := tx.Exec(ctx, insertQuery, args...); err != nil {
+			return stacktrace.Propagate(err, "Failed to insert row %d", i)
+		}
+	}
+	return nil
+}
+
+// advanceIdentities makes the identity columns of table name generate values
+// beyond the values it holds.
+func advanceIdentities(ctx context.Context, tx pgx.Tx, name string) error {
+	const identitiesQuery = `
+		SELECT column_name
+		FROM information_schema.columns
+		WHERE table_schema = 'public' AND table_name = $1 AND is_identity = 'YES'`
+	rows, err := tx.Query(ctx, identitiesQuery, name)
+	if err != nil {
+		return stacktrace.Propagate(err, "Error in query: %s", identitiesQuery)
+	}
+	var columns []string
+	for rows.Next() {
+		var column string
+		if err := rows.Scan(&column); err != nil {
+			rows.Close()
+			return stacktrace.Propagate(err, "Error scanning column name")
+		}
+		columns = append(columns, column)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return stacktrace.Propagate(err, "Error in rows query result")
+	}
+
+	for _, column := range columns {
+		setvalQuery := fmt.Sprintf(
+			"SELECT setval(pg_get_serial_sequence($1, $2), COALESCE((SELECT MAX(%s) FROM %s), 0) + 1, false)",
+			pgx.Identifier{column}.Sanitize(), pgx.Identifier{name}.Sanitize())
+		if _, err := tx.Exec(ctx, setvalQuery, name, column); err != nil {
+			return stacktrace.Propagate(err, "Error in query: %s", setvalQuery)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+package snapshot
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortTables(t *testing.T) {
+	sorted, err := sortTables(
+		[]string{"scd_operations", "scd_subscriptions", "scd_operation_metadata", "scd_uss_availability"},
+		map[string][]string{
+			"scd_operations":         {"scd_subscriptions"},
+			"scd_operation_metadata": {"scd_operations"},
+			"scd_uss_availability":   {"schema_versions"},
+		})
+	require.NoError(t, err)
+	require.Equal(t, []string{"scd_subscriptions", "scd_operations", "scd_operation_metadata", "scd_uss_availability"}, sorted)
+}
+
+func TestSortTablesWithCycle(t *testing.T) {
+	_, err := sortTables([]string{"a", "b"}, map[string][]string{"a": {"b"}, "b": {"a"}})
+	require.Error(t, err)
+}
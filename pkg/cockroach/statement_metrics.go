@@ -0,0 +1,178 @@
+package cockroach
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/interuss/dss/pkg/logging"
+	dsssql "github.com/interuss/dss/pkg/sql"
+	"github.com/interuss/dss/pkg/tracing"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+var statementDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Name: "dss_db_statement_duration_seconds",
+	Help: "Duration of the statements executed against the datastore, from their execution until their results are consumed, by statement name.",
+}, []string{"statement"})
+
+// Instrument returns a Queryable executing statements with q, which records
+// the latency of each statement in a metric and a trace span, and logs the
+// statements slower than the slow statement threshold of db.
+func (db *DB) Instrument(q dsssql.Queryable) dsssql.Queryable {
+	return &instrumentedQueryable{q: q, slowThreshold: db.slowStatementThreshold, system: db.Dialect.traceSystem()}
+}
+
+type instrumentedQueryable struct {
+	q dsssql.Queryable
+	// slowThreshold is the duration beyond which statements are logged,
+	// disabled when 0.
+	slowThreshold time.Duration
+	// system is the db.system attribute of the spans of the statements.
+	system string
+}
+
+// start starts the span of the execution of query.
+func (iq *instrumentedQueryable) start(ctx context.Context, query string) (context.Context, trace.Span) {
+	return tracing.Tracer().Start(ctx, StatementName(query),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemKey.String(iq.system),
+			semconv.DBStatementKey.String(strings.Join(strings.Fields(query), " "))))
+}
+
+func (iq *instrumentedQueryable) observe(ctx context.Context, span trace.Span, query string, start time.Time, err error) {
+	name := StatementName(query)
+	duration := time.Since(start)
+	statementDuration.WithLabelValues(name).Observe(duration.Seconds())
+	if err != nil && err != pgx.ErrNoRows {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+	if iq.slowThreshold > 0 && duration >= iq.slowThreshold {
+		logging.WithValuesFromContext(ctx, logging.Logger).Warn("Slow datastore statement",
+			zap.String("statement", name),
+			zap.Duration("duration", duration),
+			zap.String("query", strings.Join(strings.Fields(query), " ")))
+	}
+}
+
+func (iq *instrumentedQueryable) Query(ctx context.Context, query string, args ...interface{}) (pgx.Rows, error) {
+	start := time.Now()
+	ctx, span := iq.start(ctx, query)
+	rows, err := iq.q.Query(ctx, query, args...)
+	if err != nil {
+		iq.observe(ctx, span, query, start, err)
+		return rows, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	return &instrumentedRows{Rows: rows, span: span, observe: func(err error) { iq.observe(ctx, span, query, start, err) }}, nil
+}
+
+func (iq *instrumentedQueryable) QueryRow(ctx context.Context, query string, args ...interface{}) pgx.Row {
+	start := time.Now()
+	ctx, span := iq.start(ctx, query)
+	return &instrumentedRow{row: iq.q.QueryRow(ctx, query, args...), observe: func(err error) { iq.observe(ctx, span, query, start, err) }}
+}
+
+func (iq *instrumentedQueryable) Exec(ctx context.Context, query string, args ...interface{}) (pgconn.CommandTag, error) {
+	start := time.Now()
+	ctx, span := iq.start(ctx, query)
+	tag, err := iq.q.Exec(ctx, query, args...)
+	if err == nil {
+		span.SetAttributes(attribute.Int64("db.rows_affected", tag.RowsAffected()))
+	}
+	iq.observe(ctx, span, query, start, err)
+	return tag, err // No need to Propagate this error as this is not a useful stacktrace line
+}
+
+// instrumentedRows observes its statement once its results are consumed.
+type instrumentedRows struct {
+	pgx.Rows
+	span     trace.Span
+	observe  func(err error)
+	observed bool
+	rows     int64
+}
+
+func (r *instrumentedRows) Close() {
+	r.Rows.Close()
+	if !r.observed {
+		r.observed = true
+		r.span.SetAttributes(attribute.Int64("db.rows_returned", r.rows))
+		r.observe(r.Rows.Err())
+	}
+}
+
+func (r *instrumentedRows) Next() bool {
+	if r.Rows.Next() {
+		r.rows++
+		return true
+	}
+	// pgx closes rows once they are exhausted, without calling Close.
+	r.Close()
+	return false
+}
+
+// instrumentedRow observes its statement once its result is scanned, which
+// is when pgx executes the statement of a single row.
+type instrumentedRow struct {
+	row     pgx.Row
+	observe func(err error)
+}
+
+func (r *instrumentedRow) Scan(dest ...interface{}) error {
+	err := r.row.Scan(dest...)
+	r.observe(err)
+	return err // No need to Propagate this error as this is not a useful stacktrace line
+}
+
+// statementVerbs are the leading keywords of the statements named by
+// StatementName.
+var statementVerbs = map[string]bool{
+	"SELECT": true,
+	"INSERT": true,
+	"UPSERT": true,
+	"UPDATE": true,
+	"DELETE": true,
+}
+
+// StatementName returns a name identifying the kind of statement of query
+// with a low cardinality, made of its leading verb and the first table it
+// targets, e.g. "SELECT scd_operations".
+func StatementName(query string) string {
+	fields := strings.Fields(query)
+	if len(fields) == 0 {
+		return "unknown"
+	}
+	verb := strings.ToUpper(fields[0])
+	if !statementVerbs[verb] {
+		return verb
+	}
+	for i, field := range fields {
+		keyword := strings.ToUpper(field)
+		if i+1 < len(fields) && (keyword == "FROM" || keyword == "INTO" || (i == 0 && verb == "UPDATE")) {
+			if table := tableName(fields[i+1]); table != "" {
+				return verb + " " + table
+			}
+		}
+	}
+	return verb
+}
+
+// tableName returns the table named by field, without quotes, trailing
+// punctuation or column list.
+func tableName(field string) string {
+	if i := strings.IndexAny(field, "(),;"); i >= 0 {
+		field = field[:i]
+	}
+	return strings.Trim(field, `"`)
+}
@@ -0,0 +1,24 @@
+package cockroach
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatementName(t *testing.T) {
+	for query, name := range map[string]string{
+		`SELECT id, owner FROM scd_operations WHERE id = $1`:                       "SELECT scd_operations",
+		"\n\t\tSELECT\n\t\t\tid\n\t\tFROM\n\t\t\tscd_subscriptions\n\t\tLIMIT $1":  "SELECT scd_subscriptions",
+		`SELECT * FROM (SELECT id FROM identification_service_areas) AS isas`:      "SELECT identification_service_areas",
+		`INSERT INTO scd_cells_operations(cell_id, operation_id) VALUES ($1, $2)`:  "INSERT scd_cells_operations",
+		`UPSERT INTO "subscriptions" (id) VALUES ($1)`:                             "UPSERT subscriptions",
+		`UPDATE scd_subscriptions SET notification_index = notification_index + 1`: "UPDATE scd_subscriptions",
+		`DELETE FROM scd_constraints WHERE id = $1`:                                "DELETE scd_constraints",
+		`SELECT now()`:                         "SELECT",
+		`with x AS (SELECT 1) SELECT * FROM x`: "WITH",
+		``:                                     "unknown",
+	} {
+		require.Equal(t, name, StatementName(query), query)
+	}
+}
@@ -0,0 +1,4 @@
+// Package topology configures CockroachDB multi-region databases for the DSS,
+// following the recommended locality of each DSS table, and verifies their
+// configuration.
+package topology
@@ -0,0 +1,260 @@
+package topology
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/interuss/dss/pkg/cockroach"
+	"github.com/interuss/stacktrace"
+	"github.com/jackc/pgx/v4"
+)
+
+// Locality is the locality of a table of a multi-region database.
+type Locality string
+
+const (
+	// LocalityGlobal serves consistent reads from every region at the cost of
+	// slower writes; it suits small tables read by most requests and rarely
+	// written.
+	LocalityGlobal Locality = "GLOBAL"
+
+	// LocalityPrimaryRegion keeps the leaseholders of a table in the primary
+	// region of its database.
+	LocalityPrimaryRegion Locality = "REGIONAL BY TABLE IN PRIMARY REGION"
+)
+
+// recommendedLocalities are the localities of the DSS tables which do not
+// belong in the primary region.  The entities of the DSS are read and written
+// from every region, so they are not partitioned by region.
+var recommendedLocalities = map[string]Locality{
+	"schema_versions":      LocalityGlobal,
+	"scd_uss_availability": LocalityGlobal,
+}
+
+// RecommendedLocality returns the recommended locality of the DSS table named
+// table.
+func RecommendedLocality(table string) Locality {
+	if locality, ok := recommendedLocalities[table]; ok {
+		return locality
+	}
+	return LocalityPrimaryRegion
+}
+
+// Config describes the multi-region topology of a DSS database.
+type Config struct {
+	// PrimaryRegion holds the leaseholders of the tables not read from every
+	// region.
+	PrimaryRegion string
+	// Regions are the other regions of the database.
+	Regions []string
+	// SurviveRegionFailure makes the database available through the failure
+	// of a whole region rather than of a single availability zone, which
+	// requires at least 3 regions and slows writes down.
+	SurviveRegionFailure bool
+}
+
+// Validate returns an error if c is not a valid topology.
+func (c Config) Validate() error {
+	if c.PrimaryRegion == "" {
+		return stacktrace.NewError("Primary region must be specified")
+	}
+	regions := map[string]bool{c.PrimaryRegion: true}
+	for _, region := range c.Regions {
+		if region == "" {
+			return stacktrace.NewError("Region names must not be empty")
+		}
+		if regions[region] {
+			return stacktrace.NewError("Region %s is specified more than once", region)
+		}
+		regions[region] = true
+	}
+	if c.SurviveRegionFailure && len(regions) < 3 {
+		return stacktrace.NewError("Surviving region failure requires at least 3 regions, but only %d are specified", len(regions))
+	}
+	return nil
+}
+
+func (c Config) survivalGoal() string {
+	if c.SurviveRegionFailure {
+		return "region"
+	}
+	return "zone"
+}
+
+// Statements returns the statements applying c to the database dbName, whose
+// tables are named tables.
+func (c Config) Statements(dbName string, tables []string) []string {
+	db := pgx.Identifier{dbName}.Sanitize()
+	statements := []string{
+		fmt.Sprintf("ALTER DATABASE %s PRIMARY REGION %s", db, pgx.Identifier{c.PrimaryRegion}.Sanitize()),
+	}
+	for _, region := range c.Regions {
+		statements = append(statements, fmt.Sprintf("ALTER DATABASE %s ADD REGION IF NOT EXISTS %s", db, pgx.Identifier{region}.Sanitize()))
+	}
+	statements = append(statements, fmt.Sprintf("ALTER DATABASE %s SURVIVE %s FAILURE", db, strings.ToUpper(c.survivalGoal())))
+	sorted := append([]string{}, tables...)
+	sort.Strings(sorted)
+	for _, table := range sorted {
+		statements = append(statements, fmt.Sprintf("ALTER TABLE %s SET LOCALITY %s", pgx.Identifier{dbName, "public", table}.Sanitize(), RecommendedLocality(table)))
+	}
+	return statements
+}
+
+// State is the multi-region topology of a database.
+type State struct {
+	PrimaryRegion string
+	Regions       []string
+	SurvivalGoal  string
+	// Localities are the localities of the tables of the database, by table
+	// name.
+	Localities map[string]string
+}
+
+// Tables returns the names of the tables of the database dbName, which crdb
+// must be connected to.
+func Tables(ctx context.Context, crdb *cockroach.DB, dbName string) ([]string, error) {
+	state, err := Read(ctx, crdb, dbName)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	tables := make([]string, 0, len(state.Localities))
+	for table := range state.Localities {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	return tables, nil
+}
+
+// Read returns the multi-region topology of the database dbName.
+func Read(ctx context.Context, crdb *cockroach.DB, dbName string) (*State, error) {
+	if !crdb.Dialect.IsCockroachDB() {
+		return nil, stacktrace.NewError("Multi-region topologies are only supported by CockroachDB")
+	}
+	db := pgx.Identifier{dbName}.Sanitize()
+	state := &State{Localities: map[string]string{}}
+
+	regionsQuery := fmt.Sprintf(`SELECT region, "primary" FROM [SHOW REGIONS FROM DATABASE %s]`, db)
+	rows, err := crdb.Pool.Query(ctx, regionsQuery)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error in query: %s", regionsQuery)
+	}
+	for rows.Next() {
+		var (
+			region  string
+			primary bool
+		)
+		if err := rows.Scan(&region, &primary); err != nil {
+			rows.Close()
+			return nil, stacktrace.Propagate(err, "Error scanning region")
+		}
+		if primary {
+			state.PrimaryRegion = region
+		} else {
+			state.Regions = append(state.Regions, region)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, stacktrace.Propagate(err, "Error in rows query result")
+	}
+	sort.Strings(state.Regions)
+
+	survivalQuery := fmt.Sprintf(`SELECT survival_goal FROM [SHOW SURVIVAL GOAL FROM DATABASE %s]`, db)
+	var survivalGoal *string
+	if err := crdb.Pool.QueryRow(ctx, survivalQuery).Scan(&survivalGoal); err != nil {
+		return nil, stacktrace.Propagate(err, "Error in query: %s", survivalQuery)
+	}
+	if survivalGoal != nil {
+		state.SurvivalGoal = *survivalGoal
+	}
+
+	tablesQuery := fmt.Sprintf(`SELECT table_name, locality FROM [SHOW TABLES FROM %s] WHERE schema_name = 'public' AND type = 'table'`, db)
+	rows, err = crdb.Pool.Query(ctx, tablesQuery)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error in query: %s", tablesQuery)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			table    string
+			locality *string
+		)
+		if err := rows.Scan(&table, &locality); err != nil {
+			return nil, stacktrace.Propagate(err, "Error scanning table locality")
+		}
+		state.Localities[table] = ""
+		if locality != nil {
+			state.Localities[table] = *locality
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, stacktrace.Propagate(err, "Error in rows query result")
+	}
+	return state, nil
+}
+
+// Verify returns the discrepancies between state and c, which are empty when
+// state follows c.
+func (c Config) Verify(state *State) []string {
+	var discrepancies []string
+	if state.PrimaryRegion != c.PrimaryRegion {
+		discrepancies = append(discrepancies, fmt.Sprintf("Primary region is `%s` instead of `%s`", state.PrimaryRegion, c.PrimaryRegion))
+	}
+	expectedRegions := append([]string{}, c.Regions...)
+	sort.Strings(expectedRegions)
+	if strings.Join(state.Regions, ",") != strings.Join(expectedRegions, ",") {
+		discrepancies = append(discrepancies, fmt.Sprintf("Other regions are [%s] instead of [%s]", strings.Join(state.Regions, ", "), strings.Join(expectedRegions, ", ")))
+	}
+	if !strings.EqualFold(state.SurvivalGoal, c.survivalGoal()) {
+		discrepancies = append(discrepancies, fmt.Sprintf("Survival goal is `%s` instead of `%s`", state.SurvivalGoal, c.survivalGoal()))
+	}
+	tables := make([]string, 0, len(state.Localities))
+	for table := range state.Localities {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	for _, table := range tables {
+		expected := RecommendedLocality(table)
+		if !localityMatches(state.Localities[table], expected) {
+			discrepancies = append(discrepancies, fmt.Sprintf("Locality of table %s is `%s` instead of `%s`", table, state.Localities[table], expected))
+		}
+	}
+	return discrepancies
+}
+
+// localityMatches returns whether the locality reported by CockroachDB for a
+// table is locality.  CockroachDB reports tables in the primary region as
+// REGIONAL BY TABLE IN PRIMARY REGION, or with the name of the primary region
+// in some versions.
+func localityMatches(reported string, locality Locality) bool {
+	reported = strings.ToUpper(strings.TrimSpace(reported))
+	if reported == string(locality) {
+		return true
+	}
+	return locality == LocalityPrimaryRegion && (reported == "" || reported == "REGIONAL BY TABLE")
+}
+
+// Apply applies c to the database dbName, and returns the discrepancies which
+// remain between the resulting topology and c.
+func (c Config) Apply(ctx context.Context, crdb *cockroach.DB, dbName string, logf func(format string, args ...interface{})) ([]string, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	tables, err := Tables(ctx, crdb, dbName)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	for _, statement := range c.Statements(dbName, tables) {
+		logf("Executing %s", statement)
+		if _, err := crdb.Pool.Exec(ctx, statement); err != nil {
+			return nil, stacktrace.Propagate(err, "Failed to execute %s", statement)
+		}
+	}
+	state, err := Read(ctx, crdb, dbName)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	return c.Verify(state), nil
+}
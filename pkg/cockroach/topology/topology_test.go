@@ -0,0 +1,47 @@
+package topology
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	require.Error(t, Config{}.Validate())
+	require.Error(t, Config{PrimaryRegion: "us-east1", Regions: []string{"us-east1"}}.Validate())
+	require.Error(t, Config{PrimaryRegion: "us-east1", Regions: []string{"us-west1"}, SurviveRegionFailure: true}.Validate())
+	require.NoError(t, Config{PrimaryRegion: "us-east1", Regions: []string{"us-west1"}}.Validate())
+	require.NoError(t, Config{PrimaryRegion: "us-east1", Regions: []string{"us-west1", "europe-west1"}, SurviveRegionFailure: true}.Validate())
+}
+
+func TestStatements(t *testing.T) {
+	c := Config{PrimaryRegion: "us-east1", Regions: []string{"us-west1", "europe-west1"}, SurviveRegionFailure: true}
+	require.Equal(t, []string{
+		`ALTER DATABASE "scd" PRIMARY REGION "us-east1"`,
+		`ALTER DATABASE "scd" ADD REGION IF NOT EXISTS "us-west1"`,
+		`ALTER DATABASE "scd" ADD REGION IF NOT EXISTS "europe-west1"`,
+		`ALTER DATABASE "scd" SURVIVE REGION FAILURE`,
+		`ALTER TABLE "scd"."public"."scd_operations" SET LOCALITY REGIONAL BY TABLE IN PRIMARY REGION`,
+		`ALTER TABLE "scd"."public"."scd_uss_availability" SET LOCALITY GLOBAL`,
+		`ALTER TABLE "scd"."public"."schema_versions" SET LOCALITY GLOBAL`,
+	}, c.Statements("scd", []string{"scd_uss_availability", "scd_operations", "schema_versions"}))
+}
+
+func TestVerify(t *testing.T) {
+	c := Config{PrimaryRegion: "us-east1", Regions: []string{"us-west1"}}
+	state := &State{
+		PrimaryRegion: "us-east1",
+		Regions:       []string{"us-west1"},
+		SurvivalGoal:  "zone",
+		Localities: map[string]string{
+			"schema_versions":      "GLOBAL",
+			"scd_operations":       "REGIONAL BY TABLE IN PRIMARY REGION",
+			"scd_uss_availability": "GLOBAL",
+		},
+	}
+	require.Empty(t, c.Verify(state))
+
+	state.SurvivalGoal = "region"
+	state.Localities["scd_uss_availability"] = "REGIONAL BY TABLE IN PRIMARY REGION"
+	require.Len(t, c.Verify(state), 2)
+}
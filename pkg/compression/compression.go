@@ -0,0 +1,213 @@
+package compression
+
+import (
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/interuss/stacktrace"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	compressedResponses = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "dss_http_compressed_responses_total",
+		Help: "Number of HTTP responses compressed, by route and encoding.",
+	}, []string{"route", "encoding"})
+	uncompressedBytes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "dss_http_compression_uncompressed_bytes_total",
+		Help: "Size of the HTTP responses compressed before their compression, by route and encoding.",
+	}, []string{"route", "encoding"})
+	savedBytes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "dss_http_compression_saved_bytes_total",
+		Help: "Number of bytes saved by compressing HTTP responses, by route and encoding.",
+	}, []string{"route", "encoding"})
+)
+
+const gzipEncoding = "gzip"
+
+var gzipWriters = sync.Pool{New: func() interface{} { return gzip.NewWriter(nil) }}
+
+// AcceptsGzip returns whether the Accept-Encoding header of r accepts gzip
+// encoded responses.
+func AcceptsGzip(r *http.Request) bool {
+	accepted := false
+	for _, header := range r.Header.Values("Accept-Encoding") {
+		for _, coding := range strings.Split(header, ",") {
+			parts := strings.Split(coding, ";")
+			name := strings.ToLower(strings.TrimSpace(parts[0]))
+			if name != gzipEncoding && name != "*" {
+				continue
+			}
+			q := 1.0
+			for _, param := range parts[1:] {
+				param = strings.TrimSpace(param)
+				if strings.HasPrefix(param, "q=") {
+					if v, err := strconv.ParseFloat(param[2:], 64); err == nil {
+						q = v
+					}
+				}
+			}
+			if name == gzipEncoding {
+				// An explicit gzip coding takes precedence over *.
+				return q > 0
+			}
+			accepted = q > 0
+		}
+	}
+	return accepted
+}
+
+// Middleware gzip-compresses the responses of handler of at least minSize
+// bytes for the clients accepting it, and counts the responses compressed and
+// the bytes saved, labeled by route(r), which must take a small number of
+// values.  Responses already encoded and responses to HEAD requests are
+// served as is.
+func Middleware(minSize int, route func(r *http.Request) string, handler http.Handler) (http.Handler, error) {
+	if minSize < 0 {
+		return nil, stacktrace.NewError("Minimum size of compressed responses %d is negative", minSize)
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Accept-Encoding")
+		if r.Method == http.MethodHead || !AcceptsGzip(r) {
+			handler.ServeHTTP(w, r)
+			return
+		}
+		cw := &compressingWriter{ResponseWriter: w, minSize: minSize}
+		defer func() {
+			cw.close()
+			if cw.gz != nil {
+				label := route(r)
+				compressedResponses.WithLabelValues(label, gzipEncoding).Inc()
+				uncompressedBytes.WithLabelValues(label, gzipEncoding).Add(float64(cw.written))
+				savedBytes.WithLabelValues(label, gzipEncoding).Add(float64(cw.written - cw.compressed.n))
+			}
+		}()
+		handler.ServeHTTP(cw, r)
+	}), nil
+}
+
+// countingWriter counts the bytes written to an underlying writer.
+type countingWriter struct {
+	w interface{ Write([]byte) (int, error) }
+	n int
+}
+
+func (c *countingWriter) Write(data []byte) (int, error) {
+	n, err := c.w.Write(data)
+	c.n += n
+	return n, err
+}
+
+// compressingWriter buffers the start of a response until it reaches minSize
+// bytes or completes, to decide whether to compress it.
+type compressingWriter struct {
+	http.ResponseWriter
+	minSize int
+
+	statusCode int
+	buf        bytes.Buffer
+	decided    bool
+	gz         *gzip.Writer
+	compressed countingWriter
+	written    int
+}
+
+func (w *compressingWriter) WriteHeader(statusCode int) {
+	if w.statusCode == 0 {
+		w.statusCode = statusCode
+	}
+}
+
+func (w *compressingWriter) Write(data []byte) (int, error) {
+	if w.statusCode == 0 {
+		w.statusCode = http.StatusOK
+	}
+	w.written += len(data)
+	if !w.decided {
+		w.buf.Write(data)
+		if w.buf.Len() < w.minSize {
+			return len(data), nil
+		}
+		if err := w.decide(); err != nil {
+			return 0, err
+		}
+		return len(data), nil
+	}
+	if w.gz != nil {
+		return w.gz.Write(data)
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+// decide writes the header of the response, compressed when its buffered
+// start reaches minSize, followed by its buffered start.
+func (w *compressingWriter) decide() error {
+	w.decided = true
+	header := w.Header()
+	compress := w.buf.Len() >= w.minSize && w.buf.Len() > 0 && header.Get("Content-Encoding") == "" &&
+		w.statusCode != http.StatusNoContent && w.statusCode != http.StatusNotModified
+	if compress {
+		header.Set("Content-Encoding", gzipEncoding)
+		header.Del("Content-Length")
+		// The compressed representation differs byte-wise from the
+		// uncompressed one.
+		if etag := header.Get("Etag"); etag != "" && !strings.HasPrefix(etag, "W/") {
+			header.Set("Etag", "W/"+etag)
+		}
+		w.compressed = countingWriter{w: w.ResponseWriter}
+		w.gz = gzipWriters.Get().(*gzip.Writer)
+		w.gz.Reset(&w.compressed)
+	}
+	if w.statusCode == 0 {
+		w.statusCode = http.StatusOK
+	}
+	w.ResponseWriter.WriteHeader(w.statusCode)
+	data := w.buf.Bytes()
+	w.buf = bytes.Buffer{}
+	if len(data) == 0 {
+		return nil
+	}
+	var err error
+	if w.gz != nil {
+		_, err = w.gz.Write(data)
+	} else {
+		_, err = w.ResponseWriter.Write(data)
+	}
+	return err
+}
+
+// Flush implements http.Flusher when the underlying ResponseWriter does, for
+// streamed responses, deciding whether to compress them upon their first
+// flush.
+func (w *compressingWriter) Flush() {
+	if !w.decided {
+		if err := w.decide(); err != nil {
+			return
+		}
+	}
+	if w.gz != nil {
+		if err := w.gz.Flush(); err != nil {
+			return
+		}
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// close completes the response.
+func (w *compressingWriter) close() {
+	if !w.decided && w.statusCode != 0 {
+		_ = w.decide()
+	}
+	if w.gz != nil {
+		_ = w.gz.Close()
+		w.gz.Reset(nil)
+		gzipWriters.Put(w.gz)
+	}
+}
@@ -0,0 +1,105 @@
+package compression
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcceptsGzip(t *testing.T) {
+	for header, want := range map[string]bool{
+		"":                      false,
+		"gzip":                  true,
+		"deflate, GZIP;q=0.5":   true,
+		"gzip;q=0":              false,
+		"*":                     true,
+		"*;q=0":                 false,
+		"gzip;q=0, *":           false,
+		"identity, deflate, br": false,
+	} {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		if header != "" {
+			r.Header.Set("Accept-Encoding", header)
+		}
+		require.Equal(t, want, AcceptsGzip(r), header)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	large := strings.Repeat(`{"id": "00000000-0000-0000-0000-000000000000"}`, 100)
+	handler, err := Middleware(1024, func(r *http.Request) string { return "test" }, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Etag", `"v1"`)
+		if r.URL.Path == "/small" {
+			_, _ = w.Write([]byte("small"))
+			return
+		}
+		// Written in chunks smaller than the minimum size.
+		for i := 0; i < len(large); i += 100 {
+			end := i + 100
+			if end > len(large) {
+				end = len(large)
+			}
+			_, _ = w.Write([]byte(large[i:end]))
+		}
+	}))
+	require.NoError(t, err)
+
+	serve := func(path, acceptEncoding string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, path, nil)
+		if acceptEncoding != "" {
+			r.Header.Set("Accept-Encoding", acceptEncoding)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	w := serve("/large", "gzip")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "gzip", w.Header().Get("Content-Encoding"))
+	require.Equal(t, "Accept-Encoding", w.Header().Get("Vary"))
+	require.Equal(t, `W/"v1"`, w.Header().Get("Etag"))
+	require.Less(t, w.Body.Len(), len(large))
+	reader, err := gzip.NewReader(w.Body)
+	require.NoError(t, err)
+	body, err := ioutil.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, large, string(body))
+
+	w = serve("/small", "gzip")
+	require.Equal(t, "", w.Header().Get("Content-Encoding"))
+	require.Equal(t, `"v1"`, w.Header().Get("Etag"))
+	require.Equal(t, "small", w.Body.String())
+
+	w = serve("/large", "")
+	require.Equal(t, "", w.Header().Get("Content-Encoding"))
+	require.Equal(t, large, w.Body.String())
+
+	require.Equal(t, float64(1), testutil.ToFloat64(compressedResponses.WithLabelValues("test", "gzip")))
+	require.Equal(t, float64(4600), testutil.ToFloat64(uncompressedBytes.WithLabelValues("test", "gzip")))
+	require.Positive(t, testutil.ToFloat64(savedBytes.WithLabelValues("test", "gzip")))
+
+	_, err = Middleware(-1, nil, handler)
+	require.Error(t, err)
+}
+
+func TestMiddlewareStatus(t *testing.T) {
+	large := strings.Repeat("x", 2048)
+	handler, err := Middleware(1024, func(r *http.Request) string { return "status" }, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(large))
+	}))
+	require.NoError(t, err)
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Accept-Encoding", "gzip")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	require.Equal(t, http.StatusNotFound, w.Code)
+	require.Equal(t, "gzip", w.Header().Get("Content-Encoding"))
+}
@@ -0,0 +1,3 @@
+// Package compression compresses the large responses of HTTP servers for the
+// clients accepting it.
+package compression
@@ -0,0 +1,235 @@
+package config
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/interuss/stacktrace"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// EnvPrefix prefixes the names of the environment variables overriding
+	// flags, e.g. DSS_LOG_LEVEL for --log_level.
+	EnvPrefix = "DSS_"
+
+	// FileEnvVar is the environment variable specifying the configuration
+	// file when --config is not specified.
+	FileEnvVar = EnvPrefix + "CONFIG"
+)
+
+// The sources of the values of flags, from the highest to the lowest
+// precedence.
+const (
+	SourceCommandLine = "command line"
+	SourceEnvironment = "environment"
+	SourceFile        = "file"
+	SourceDefault     = "default"
+)
+
+const (
+	fileFlag     = "config"
+	validateFlag = "validate-config"
+)
+
+var (
+	file     = flag.String(fileFlag, "", "path to a YAML file mapping the names of flags to their values, which the command line and the "+EnvPrefix+"* environment variables override; defaults to the "+FileEnvVar+" environment variable")
+	validate = flag.Bool(validateFlag, false, "check the flags and values of the config file, the environment and the command line, then print the resulting configuration, with the source of each value, and exit; invalid configurations exit with a non-zero status")
+)
+
+// EnvVar returns the name of the environment variable overriding the flag
+// named name.
+func EnvVar(name string) string {
+	return EnvPrefix + strings.ToUpper(strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return '_'
+	}, name))
+}
+
+// Load sets the flags of fs not set on its command line, which must already
+// be parsed, from the environment variables named after them, as returned by
+// lookupEnv, and from the YAML file at path, when not empty, in this order of
+// precedence.  It returns the source of the value of each flag of fs.
+func Load(fs *flag.FlagSet, path string, lookupEnv func(string) (string, bool)) (map[string]string, error) {
+	sources := map[string]string{}
+	fs.VisitAll(func(f *flag.Flag) {
+		sources[f.Name] = SourceDefault
+	})
+	fs.Visit(func(f *flag.Flag) {
+		sources[f.Name] = SourceCommandLine
+	})
+	skipped := func(name string) bool {
+		return name == fileFlag || name == validateFlag || sources[name] == SourceCommandLine
+	}
+
+	if path != "" {
+		values, err := readFile(path)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Error reading config file %s", path)
+		}
+		for _, v := range values {
+			if fs.Lookup(v.name) == nil {
+				return nil, stacktrace.NewError("Unknown flag `%s` at line %d of %s", v.name, v.line, path)
+			}
+			if skipped(v.name) {
+				continue
+			}
+			if err := fs.Set(v.name, v.value); err != nil {
+				return nil, stacktrace.Propagate(err, "Invalid value of %s at line %d of %s", v.name, v.line, path)
+			}
+			sources[v.name] = SourceFile
+		}
+	}
+
+	var err error
+	fs.VisitAll(func(f *flag.Flag) {
+		if err != nil || skipped(f.Name) {
+			return
+		}
+		value, ok := lookupEnv(EnvVar(f.Name))
+		if !ok {
+			return
+		}
+		if setErr := fs.Set(f.Name, value); setErr != nil {
+			err = stacktrace.Propagate(setErr, "Invalid value of environment variable %s", EnvVar(f.Name))
+			return
+		}
+		sources[f.Name] = SourceEnvironment
+	})
+	if err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	return sources, nil
+}
+
+type fileValue struct {
+	name  string
+	value string
+	line  int
+}
+
+// readFile returns the flag values of the YAML config file at path, in the
+// order they are specified.  Lists are joined with commas, as expected by the
+// flags taking comma-separated values.
+func readFile(path string) ([]fileValue, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error reading file")
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return nil, stacktrace.Propagate(err, "Error parsing YAML")
+	}
+	if len(doc.Content) == 0 {
+		return nil, nil
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, stacktrace.NewError("Config file must map the names of flags to their values")
+	}
+
+	var values []fileValue
+	seen := map[string]bool{}
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		key, node := root.Content[i], root.Content[i+1]
+		if seen[key.Value] {
+			return nil, stacktrace.NewError("Flag `%s` specified again at line %d", key.Value, key.Line)
+		}
+		seen[key.Value] = true
+		value, err := scalarValue(node)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Invalid value of %s at line %d", key.Value, node.Line)
+		}
+		values = append(values, fileValue{name: key.Value, value: value, line: key.Line})
+	}
+	return values, nil
+}
+
+func scalarValue(node *yaml.Node) (string, error) {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		if node.Tag == "!!null" {
+			return "", nil
+		}
+		return node.Value, nil
+	case yaml.SequenceNode:
+		items := make([]string, 0, len(node.Content))
+		for _, item := range node.Content {
+			if item.Kind != yaml.ScalarNode {
+				return "", stacktrace.NewError("Lists must only hold scalar values")
+			}
+			items = append(items, item.Value)
+		}
+		return strings.Join(items, ","), nil
+	default:
+		return "", stacktrace.NewError("Value must be a scalar or a list of scalars")
+	}
+}
+
+// Write writes the values of the flags of fs as a YAML config file, each
+// annotated with its source among sources.  The passwords of URLs are
+// redacted.
+func Write(w io.Writer, fs *flag.FlagSet, sources map[string]string) error {
+	root := &yaml.Node{Kind: yaml.MappingNode}
+	fs.VisitAll(func(f *flag.Flag) {
+		if f.Name == fileFlag || f.Name == validateFlag {
+			return
+		}
+		root.Content = append(root.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Value: f.Name},
+			&yaml.Node{Kind: yaml.ScalarNode, Value: redactURL(f.Value.String()), LineComment: sources[f.Name]})
+	})
+	enc := yaml.NewEncoder(w)
+	if err := enc.Encode(root); err != nil {
+		return stacktrace.Propagate(err, "Error encoding configuration")
+	}
+	return enc.Close()
+}
+
+func redactURL(value string) string {
+	u, err := url.Parse(value)
+	if err != nil || u.User == nil {
+		return value
+	}
+	if _, ok := u.User.Password(); !ok {
+		return value
+	}
+	u.User = url.UserPassword(u.User.Username(), "REDACTED")
+	return u.String()
+}
+
+// Parse parses the command line flags of the process, then sets the others
+// from the environment and from the --config file, exiting with status 2 upon
+// invalid flags like flag.Parse.  With --validate-config, it prints the
+// resulting configuration and exits.
+func Parse() {
+	flag.Parse()
+	path := *file
+	if path == "" {
+		path = os.Getenv(FileEnvVar)
+	}
+	sources, err := Load(flag.CommandLine, path, os.LookupEnv)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid configuration: %v\n", err)
+		os.Exit(2)
+	}
+	if err := initReload(path); err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid configuration: %v\n", err)
+		os.Exit(2)
+	}
+	if *validate {
+		if err := Write(os.Stdout, flag.CommandLine, sources); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to print configuration: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+}
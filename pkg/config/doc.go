@@ -0,0 +1,3 @@
+// Package config sets the command line flags of the DSS commands from a YAML
+// configuration file and from environment variables.
+package config
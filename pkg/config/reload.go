@@ -0,0 +1,174 @@
+package config
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	"github.com/interuss/stacktrace"
+)
+
+// Change describes the new value applied to a flag upon a reload.
+type Change struct {
+	Name string
+	Old  string
+	New  string
+}
+
+// ReloadResult describes the changes of the configuration found upon a
+// reload.
+type ReloadResult struct {
+	// Changes are the changes applied to the reloadable flags.
+	Changes []Change
+	// RestartRequired are the other flags whose values changed, which are only
+	// applied upon restart.
+	RestartRequired []string
+}
+
+// rawValue holds the unparsed value of a flag, for comparing configurations
+// without changing the flags they configure.
+type rawValue struct {
+	value  string
+	isBool bool
+}
+
+func (v *rawValue) String() string {
+	if v == nil {
+		return ""
+	}
+	return v.value
+}
+
+func (v *rawValue) Set(s string) error {
+	v.value = s
+	return nil
+}
+
+func (v *rawValue) IsBoolFlag() bool {
+	return v.isBool
+}
+
+// rawValues returns the unparsed values of the flags of fs resulting from
+// args, the environment and the YAML file at path, as Load sets them.
+func rawValues(fs *flag.FlagSet, args []string, path string, lookupEnv func(string) (string, bool)) (map[string]string, error) {
+	raw := flag.NewFlagSet(fs.Name(), flag.ContinueOnError)
+	raw.SetOutput(ioutil.Discard)
+	fs.VisitAll(func(f *flag.Flag) {
+		isBool := false
+		if b, ok := f.Value.(interface{ IsBoolFlag() bool }); ok {
+			isBool = b.IsBoolFlag()
+		}
+		raw.Var(&rawValue{value: f.DefValue, isBool: isBool}, f.Name, f.Usage)
+	})
+	if err := raw.Parse(args); err != nil {
+		return nil, stacktrace.Propagate(err, "Error parsing command line")
+	}
+	if _, err := Load(raw, path, lookupEnv); err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	values := map[string]string{}
+	raw.VisitAll(func(f *flag.Flag) {
+		values[f.Name] = f.Value.String()
+	})
+	return values, nil
+}
+
+// reloader reloads the configuration of the flags of fs.
+type reloader struct {
+	fs        *flag.FlagSet
+	args      []string
+	path      string
+	lookupEnv func(string) (string, bool)
+
+	mu sync.Mutex
+	// loaded are the unparsed values of the flags of fs as of the latest
+	// load.
+	loaded map[string]string
+}
+
+func newReloader(fs *flag.FlagSet, args []string, path string, lookupEnv func(string) (string, bool)) (*reloader, error) {
+	loaded, err := rawValues(fs, args, path, lookupEnv)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	return &reloader{fs: fs, args: args, path: path, lookupEnv: lookupEnv, loaded: loaded}, nil
+}
+
+func (r *reloader) reload(reloadable []string, apply func(changes []Change) error) (ReloadResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	values, err := rawValues(r.fs, r.args, r.path, r.lookupEnv)
+	if err != nil {
+		return ReloadResult{}, stacktrace.Propagate(err, "Error reading configuration")
+	}
+	isReloadable := map[string]bool{}
+	for _, name := range reloadable {
+		isReloadable[name] = true
+	}
+
+	var result ReloadResult
+	previous := map[string]string{}
+	restore := func() {
+		for name, value := range previous {
+			_ = r.fs.Set(name, value)
+		}
+	}
+	r.fs.VisitAll(func(f *flag.Flag) {
+		if err != nil || values[f.Name] == r.loaded[f.Name] {
+			return
+		}
+		if !isReloadable[f.Name] {
+			result.RestartRequired = append(result.RestartRequired, f.Name)
+			return
+		}
+		old := f.Value.String()
+		// Some flags are changed even by invalid values.
+		previous[f.Name] = old
+		if setErr := r.fs.Set(f.Name, values[f.Name]); setErr != nil {
+			err = stacktrace.Propagate(setErr, "Invalid value of %s", f.Name)
+			return
+		}
+		result.Changes = append(result.Changes, Change{Name: f.Name, Old: redactURL(old), New: redactURL(f.Value.String())})
+	})
+	if err != nil {
+		restore()
+		return ReloadResult{}, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	if len(result.Changes) > 0 && apply != nil {
+		if err := apply(result.Changes); err != nil {
+			restore()
+			return ReloadResult{}, stacktrace.Propagate(err, "Error applying configuration")
+		}
+	}
+	for _, c := range result.Changes {
+		r.loaded[c.Name] = values[c.Name]
+	}
+	return result, nil
+}
+
+var process *reloader
+
+// Reload reads again the config file and the environment of the process, as
+// Parse did, and sets the flags among reloadable whose values changed, then
+// calls apply with their changes for the process to apply their new values.  The flags keep
+// their previous values if their new values, or apply, fail.  Flags specified
+// on the command line are never reloaded.
+func Reload(reloadable []string, apply func(changes []Change) error) (ReloadResult, error) {
+	if process == nil {
+		return ReloadResult{}, stacktrace.NewError("Configuration was not parsed with config.Parse")
+	}
+	return process.reload(reloadable, apply)
+}
+
+// initReload records the configuration of the process parsed by Parse, for
+// Reload to compare against.
+func initReload(path string) error {
+	r, err := newReloader(flag.CommandLine, os.Args[1:], path, os.LookupEnv)
+	if err != nil {
+		return err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	process = r
+	return nil
+}
@@ -0,0 +1,56 @@
+package config
+
+import (
+	"errors"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReload(t *testing.T) {
+	f := newTestFlags()
+	args := []string{"-dump_requests"}
+	require.NoError(t, f.fs.Parse(args))
+	path := writeFile(t, "addr: \":7070\"\nserver timeout: 5s\n")
+	_, err := Load(f.fs, path, env(nil))
+	require.NoError(t, err)
+	r, err := newReloader(f.fs, args, path, env(nil))
+	require.NoError(t, err)
+	reloadable := []string{"server timeout", "dump_requests"}
+
+	// Unchanged configurations change nothing.
+	result, err := r.reload(reloadable, func([]Change) error {
+		require.Fail(t, "Nothing to apply")
+		return nil
+	})
+	require.NoError(t, err)
+	require.Empty(t, result.Changes)
+	require.Empty(t, result.RestartRequired)
+
+	// Only reloadable flags change, and failing changes are reverted.
+	require.NoError(t, ioutil.WriteFile(path, []byte("addr: \":6060\"\nserver timeout: 3s\ndump_requests: false\n"), 0600))
+	_, err = r.reload(reloadable, func([]Change) error { return errors.New("failed") })
+	require.Error(t, err)
+	require.Equal(t, 5*time.Second, *f.timeout)
+
+	var applied []Change
+	result, err = r.reload(reloadable, func(changes []Change) error {
+		applied = changes
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []Change{{Name: "server timeout", Old: "5s", New: "3s"}}, result.Changes)
+	require.Equal(t, result.Changes, applied)
+	require.Equal(t, []string{"addr"}, result.RestartRequired)
+	require.Equal(t, 3*time.Second, *f.timeout)
+	require.Equal(t, ":7070", *f.addr)
+	require.True(t, *f.dump)
+
+	// Invalid values are rejected without changing any flag.
+	require.NoError(t, ioutil.WriteFile(path, []byte("server timeout: soon\n"), 0600))
+	_, err = r.reload(reloadable, nil)
+	require.Error(t, err)
+	require.Equal(t, 3*time.Second, *f.timeout)
+}
@@ -0,0 +1,3 @@
+// Package deadline bounds the duration of incoming requests by class of
+// operation.
+package deadline
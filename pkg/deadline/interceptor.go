@@ -0,0 +1,63 @@
+package deadline
+
+import (
+	"context"
+	"time"
+
+	"github.com/interuss/dss/pkg/auth"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/dss/pkg/logging"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// ExceededReason is the reason of the errors of the requests which exceeded
+// their deadline.
+const ExceededReason = "deadline_exceeded"
+
+var exceededRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "dss_deadline_exceeded_requests_total",
+	Help: "Number of requests aborted for exceeding the deadline of their class of operation.",
+}, []string{"class"})
+
+// Deadline bounds the duration of the requests to the operations of a class.
+type Deadline struct {
+	// Class names the class of operations, e.g. search, for errors and
+	// metrics.
+	Class string
+	// Timeout is the maximum duration of each request.
+	Timeout time.Duration
+}
+
+// Interceptor returns a grpc.UnaryServerInterceptor cancelling the context of
+// the requests to the operations in deadlines once their timeout elapses.
+// Requests failing after their deadline are reported as DeadlineExceeded with
+// the ExceededReason reason, regardless of how the cancellation surfaced
+// downstream, e.g. as a datastore error.
+func Interceptor(deadlines map[auth.Operation]Deadline) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		d, ok := deadlines[auth.Operation(info.FullMethod)]
+		if !ok || d.Timeout <= 0 {
+			return handler(ctx, req)
+		}
+		boundedCtx, cancel := context.WithTimeout(ctx, d.Timeout)
+		defer cancel()
+
+		resp, err := handler(boundedCtx, req)
+		// Requests cancelled by their caller, rather than by their deadline,
+		// are reported as they failed.
+		if err == nil || boundedCtx.Err() != context.DeadlineExceeded || ctx.Err() != nil {
+			return resp, err
+		}
+		exceededRequests.WithLabelValues(d.Class).Inc()
+		logging.WithValuesFromContext(ctx, logging.Logger).Warn("Request exceeded its deadline",
+			zap.String("method", info.FullMethod),
+			zap.String("class", d.Class),
+			zap.Duration("timeout", d.Timeout),
+			zap.Error(err))
+		return nil, dsserr.NewErrorWithReason(dsserr.DeadlineExceeded, ExceededReason,
+			"Request exceeded the %s deadline of %s operations", d.Timeout, d.Class)
+	}
+}
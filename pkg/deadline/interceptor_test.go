@@ -0,0 +1,54 @@
+package deadline
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/interuss/dss/pkg/auth"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/stacktrace"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+const testMethod = "/test.Service/Search"
+
+// blockingHandler fails with the error of its context once it is done.
+func blockingHandler(ctx context.Context, req interface{}) (interface{}, error) {
+	<-ctx.Done()
+	return nil, stacktrace.Propagate(ctx.Err(), "Error querying datastore")
+}
+
+func TestInterceptorAbortsRequestsPastDeadline(t *testing.T) {
+	interceptor := Interceptor(map[auth.Operation]Deadline{
+		testMethod: {Class: "search", Timeout: 10 * time.Millisecond},
+	})
+	_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: testMethod}, blockingHandler)
+	require.Error(t, err)
+	require.Equal(t, dsserr.DeadlineExceeded, stacktrace.GetCode(err))
+}
+
+func TestInterceptorIgnoresOtherOperations(t *testing.T) {
+	interceptor := Interceptor(map[auth.Operation]Deadline{
+		testMethod: {Class: "search", Timeout: 10 * time.Millisecond},
+	})
+	_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/test.Service/Other"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		_, ok := ctx.Deadline()
+		require.False(t, ok)
+		return nil, nil
+	})
+	require.NoError(t, err)
+}
+
+func TestInterceptorKeepsCallerCancellations(t *testing.T) {
+	interceptor := Interceptor(map[auth.Operation]Deadline{
+		testMethod: {Class: "search", Timeout: time.Minute},
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: testMethod}, blockingHandler)
+	require.True(t, errors.Is(err, context.DeadlineExceeded))
+	require.NotEqual(t, dsserr.DeadlineExceeded, stacktrace.GetCode(err))
+}
@@ -0,0 +1,104 @@
+package debug
+
+import (
+	"encoding/json"
+	"expvar"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"time"
+
+	"github.com/interuss/dss/pkg/build"
+	"github.com/interuss/stacktrace"
+)
+
+var started = time.Now()
+
+// RuntimeStats describes the state of the Go runtime of the process.
+type RuntimeStats struct {
+	Build      build.Description `json:"build"`
+	GoVersion  string            `json:"go_version"`
+	Uptime     string            `json:"uptime"`
+	NumCPU     int               `json:"num_cpu"`
+	GOMAXPROCS int               `json:"gomaxprocs"`
+	Goroutines int               `json:"goroutines"`
+	CgoCalls   int64             `json:"cgo_calls"`
+
+	HeapAllocBytes  uint64 `json:"heap_alloc_bytes"`
+	HeapInuseBytes  uint64 `json:"heap_inuse_bytes"`
+	HeapObjects     uint64 `json:"heap_objects"`
+	StackInuseBytes uint64 `json:"stack_inuse_bytes"`
+	SysBytes        uint64 `json:"sys_bytes"`
+	NumGC           uint32 `json:"num_gc"`
+	GCPauseTotal    string `json:"gc_pause_total"`
+	LastGC          string `json:"last_gc,omitempty"`
+}
+
+// ReadRuntimeStats returns the current RuntimeStats of the process.  It stops
+// the world briefly to read the memory statistics.
+func ReadRuntimeStats() RuntimeStats {
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+	stats := RuntimeStats{
+		Build:      build.Describe(),
+		GoVersion:  runtime.Version(),
+		Uptime:     time.Since(started).Round(time.Second).String(),
+		NumCPU:     runtime.NumCPU(),
+		GOMAXPROCS: runtime.GOMAXPROCS(0),
+		Goroutines: runtime.NumGoroutine(),
+		CgoCalls:   runtime.NumCgoCall(),
+
+		HeapAllocBytes:  m.HeapAlloc,
+		HeapInuseBytes:  m.HeapInuse,
+		HeapObjects:     m.HeapObjects,
+		StackInuseBytes: m.StackInuse,
+		SysBytes:        m.Sys,
+		NumGC:           m.NumGC,
+		GCPauseTotal:    time.Duration(m.PauseTotalNs).String(),
+	}
+	if m.LastGC > 0 {
+		stats.LastGC = time.Unix(0, int64(m.LastGC)).UTC().Format(time.RFC3339Nano)
+	}
+	return stats
+}
+
+// Handler returns an http.Handler serving the pprof profiles of the process
+// under /debug/pprof/, its expvar variables at /debug/vars and its
+// RuntimeStats at /debug/runtime.
+func Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.Handle("/debug/vars", expvar.Handler())
+	mux.HandleFunc("/debug/runtime", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(ReadRuntimeStats()); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+	return mux
+}
+
+// CheckAddress returns an error unless address, as host:port, only listens on
+// a loopback interface, since the debug endpoints expose the internals of the
+// process without authentication.
+func CheckAddress(address string) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return stacktrace.Propagate(err, "Invalid debug address `%s`", address)
+	}
+	if host == "localhost" {
+		return nil
+	}
+	if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+		return stacktrace.NewError("Debug address `%s` must listen on localhost or a loopback IP address", address)
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package debug
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckAddress(t *testing.T) {
+	for _, address := range []string{"localhost:6060", "127.0.0.1:6060", "[::1]:6060"} {
+		require.NoError(t, CheckAddress(address), address)
+	}
+	for _, address := range []string{":6060", "0.0.0.0:6060", "10.0.0.1:6060", "example.com:6060", "localhost"} {
+		require.Error(t, CheckAddress(address), address)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	handler := Handler()
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/debug/runtime", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	var stats RuntimeStats
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &stats))
+	require.Positive(t, stats.Goroutines)
+	require.NotEmpty(t, stats.GoVersion)
+
+	for _, path := range []string{"/debug/pprof/", "/debug/pprof/goroutine?debug=1", "/debug/vars"} {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		require.Equal(t, http.StatusOK, w.Code, path)
+	}
+}
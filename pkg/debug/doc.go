@@ -0,0 +1,3 @@
+// Package debug serves the profiles and runtime statistics of the process for
+// diagnosing performance issues in production.
+package debug
@@ -0,0 +1,4 @@
+// Package encryption provides envelope encryption of selected datastore
+// columns: each value is encrypted with a data key, itself encrypted by a key
+// encryption key held in local key files or by a key management service.
+package encryption
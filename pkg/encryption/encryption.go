@@ -0,0 +1,239 @@
+package encryption
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/interuss/stacktrace"
+)
+
+const (
+	// prefix starts the encrypted values, which are formatted as
+	// prefix<key ID>:<wrapped data key>:<nonce and ciphertext>.
+	prefix = "dssenc:v1:"
+
+	dataKeySize = 32
+
+	// DefaultDataKeyLifetime is the default duration for which a data key
+	// encrypts new values before being replaced.
+	DefaultDataKeyLifetime = time.Hour
+
+	// maxCachedDataKeys bounds the number of unwrapped data keys kept to
+	// decrypt values without unwrapping their data keys again.
+	maxCachedDataKeys = 1024
+)
+
+var encoding = base64.RawURLEncoding
+
+// KeyWrapper abstracts the key encryption keys which encrypt (wrap) data keys,
+// e.g. held by a key management service.
+type KeyWrapper interface {
+	// KeyID identifies the key encryption key wrapping new data keys.
+	KeyID() string
+
+	// Wrap encrypts dataKey with the key encryption key identified by KeyID.
+	Wrap(ctx context.Context, dataKey []byte) ([]byte, error)
+
+	// Unwrap decrypts wrapped, encrypted by Wrap with the key encryption key
+	// identified by keyID.
+	Unwrap(ctx context.Context, keyID string, wrapped []byte) ([]byte, error)
+}
+
+// Encryptor encrypts the values of selected columns.  A nil *Encryptor
+// neither encrypts nor decrypts values.
+type Encryptor struct {
+	Wrapper KeyWrapper
+	// Columns are the selected columns, as table.column.
+	Columns map[string]bool
+	// DataKeyLifetime is the duration for which a data key encrypts new
+	// values, DefaultDataKeyLifetime when 0.
+	DataKeyLifetime time.Duration
+
+	mu        sync.Mutex
+	current   *dataKey
+	unwrapped map[string][]byte
+}
+
+type dataKey struct {
+	keyID     string
+	key       []byte
+	wrapped   string
+	expiresAt time.Time
+}
+
+// NewEncryptor returns an Encryptor encrypting the values of columns, as
+// table.column, with data keys wrapped by wrapper.
+func NewEncryptor(wrapper KeyWrapper, columns []string) (*Encryptor, error) {
+	if strings.Contains(wrapper.KeyID(), ":") || wrapper.KeyID() == "" {
+		return nil, stacktrace.NewError("Key encryption key ID `%s` must be non-empty and must not contain `:`", wrapper.KeyID())
+	}
+	e := &Encryptor{Wrapper: wrapper, Columns: map[string]bool{}}
+	for _, column := range columns {
+		if column = strings.TrimSpace(column); column != "" {
+			if !strings.Contains(column, ".") {
+				return nil, stacktrace.NewError("Encrypted column `%s` must be specified as table.column", column)
+			}
+			e.Columns[column] = true
+		}
+	}
+	return e, nil
+}
+
+// IsEncrypted returns whether value was encrypted by an Encryptor.
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, prefix)
+}
+
+// Encrypt returns plaintext encrypted for column, as table.column, or
+// plaintext itself when e does not encrypt column.
+func (e *Encryptor) Encrypt(ctx context.Context, column string, plaintext string) (string, error) {
+	if e == nil || !e.Columns[column] {
+		return plaintext, nil
+	}
+	key, err := e.currentDataKey(ctx)
+	if err != nil {
+		return "", err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	aead, err := newAEAD(key.key)
+	if err != nil {
+		return "", err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", stacktrace.Propagate(err, "Failed to generate nonce")
+	}
+	// The column is authenticated so that values cannot be swapped between
+	// columns.
+	sealed := aead.Seal(nonce, nonce, []byte(plaintext), []byte(column))
+	return prefix + key.keyID + ":" + key.wrapped + ":" + encoding.EncodeToString(sealed), nil
+}
+
+// Decrypt returns the plaintext of value encrypted for column by Encrypt, or
+// value itself when it is not encrypted, e.g. when written before column was
+// selected for encryption.
+func (e *Encryptor) Decrypt(ctx context.Context, column string, value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+	if e == nil {
+		return "", stacktrace.NewError("Value of %s is encrypted, but no column encryption keys are configured", column)
+	}
+	parts := strings.SplitN(strings.TrimPrefix(value, prefix), ":", 3)
+	if len(parts) != 3 {
+		return "", stacktrace.NewError("Malformed encrypted value of %s", column)
+	}
+	key, err := e.unwrap(ctx, parts[0], parts[1])
+	if err != nil {
+		return "", stacktrace.Propagate(err, "Failed to unwrap data key of %s", column)
+	}
+	sealed, err := encoding.DecodeString(parts[2])
+	if err != nil {
+		return "", stacktrace.Propagate(err, "Malformed encrypted value of %s", column)
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return "", err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", stacktrace.NewError("Malformed encrypted value of %s", column)
+	}
+	plaintext, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], []byte(column))
+	if err != nil {
+		return "", stacktrace.Propagate(err, "Failed to decrypt value of %s", column)
+	}
+	return string(plaintext), nil
+}
+
+// currentDataKey returns the data key encrypting new values, replacing it
+// once its lifetime has elapsed.
+func (e *Encryptor) currentDataKey(ctx context.Context) (*dataKey, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.current != nil && time.Now().Before(e.current.expiresAt) && e.current.keyID == e.Wrapper.KeyID() {
+		return e.current, nil
+	}
+
+	key, err := randomDataKey()
+	if err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	keyID := e.Wrapper.KeyID()
+	wrapped, err := e.Wrapper.Wrap(ctx, key)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to wrap data key with key encryption key %s", keyID)
+	}
+	lifetime := e.DataKeyLifetime
+	if lifetime <= 0 {
+		lifetime = DefaultDataKeyLifetime
+	}
+	e.current = &dataKey{
+		keyID:     keyID,
+		key:       key,
+		wrapped:   encoding.EncodeToString(wrapped),
+		expiresAt: time.Now().Add(lifetime),
+	}
+	e.cacheLocked(keyID, e.current.wrapped, key)
+	return e.current, nil
+}
+
+// unwrap returns the data key wrapped, encoded, by the key encryption key
+// identified by keyID.
+func (e *Encryptor) unwrap(ctx context.Context, keyID string, wrapped string) ([]byte, error) {
+	cacheKey := keyID + ":" + wrapped
+	e.mu.Lock()
+	key, ok := e.unwrapped[cacheKey]
+	e.mu.Unlock()
+	if ok {
+		return key, nil
+	}
+
+	decoded, err := encoding.DecodeString(wrapped)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Malformed wrapped data key")
+	}
+	key, err = e.Wrapper.Unwrap(ctx, keyID, decoded)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	if len(key) != dataKeySize {
+		return nil, stacktrace.NewError("Unwrapped data key has %d bytes instead of %d", len(key), dataKeySize)
+	}
+
+	e.mu.Lock()
+	e.cacheLocked(keyID, wrapped, key)
+	e.mu.Unlock()
+	return key, nil
+}
+
+func (e *Encryptor) cacheLocked(keyID string, wrapped string, key []byte) {
+	if e.unwrapped == nil || len(e.unwrapped) >= maxCachedDataKeys {
+		e.unwrapped = map[string][]byte{}
+	}
+	e.unwrapped[keyID+":"+wrapped] = key
+}
+
+func randomDataKey() ([]byte, error) {
+	key := make([]byte, dataKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to generate data key")
+	}
+	return key, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Invalid encryption key")
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to create AES-GCM cipher")
+	}
+	return aead, nil
+}
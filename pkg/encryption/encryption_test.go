@@ -0,0 +1,97 @@
+package encryption
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeKeyFile(t *testing.T, dir string, name string) string {
+	key := make([]byte, dataKeySize)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	path := filepath.Join(dir, name)
+	require.NoError(t, ioutil.WriteFile(path, []byte(base64.StdEncoding.EncodeToString(key)), 0600))
+	return path
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	ctx := context.Background()
+	keys, err := LoadKeyFiles([]string{"k1=" + writeKeyFile(t, t.TempDir(), "k1")})
+	require.NoError(t, err)
+	e, err := NewEncryptor(keys, []string{"scd_subscriptions.url"})
+	require.NoError(t, err)
+
+	value, err := e.Encrypt(ctx, "scd_subscriptions.url", "https://uss.example.com")
+	require.NoError(t, err)
+	require.True(t, IsEncrypted(value))
+	require.NotContains(t, value, "uss.example.com")
+
+	plaintext, err := e.Decrypt(ctx, "scd_subscriptions.url", value)
+	require.NoError(t, err)
+	require.Equal(t, "https://uss.example.com", plaintext)
+
+	// The value cannot be moved to another column.
+	_, err = e.Decrypt(ctx, "scd_operations.url", value)
+	require.Error(t, err)
+
+	// Values of unselected columns and plaintext values are passed through.
+	value, err = e.Encrypt(ctx, "scd_operations.url", "https://uss.example.com")
+	require.NoError(t, err)
+	require.Equal(t, "https://uss.example.com", value)
+	plaintext, err = e.Decrypt(ctx, "scd_subscriptions.url", "https://uss.example.com")
+	require.NoError(t, err)
+	require.Equal(t, "https://uss.example.com", plaintext)
+}
+
+func TestNilEncryptor(t *testing.T) {
+	ctx := context.Background()
+	var e *Encryptor
+
+	value, err := e.Encrypt(ctx, "scd_subscriptions.url", "https://uss.example.com")
+	require.NoError(t, err)
+	require.Equal(t, "https://uss.example.com", value)
+
+	_, err = e.Decrypt(ctx, "scd_subscriptions.url", prefix+"k1:a:b")
+	require.Error(t, err)
+}
+
+func TestKeyRotation(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	k1, k2 := writeKeyFile(t, dir, "k1"), writeKeyFile(t, dir, "k2")
+
+	keys, err := LoadKeyFiles([]string{"k1=" + k1})
+	require.NoError(t, err)
+	e, err := NewEncryptor(keys, []string{"scd_constraints.url"})
+	require.NoError(t, err)
+	old, err := e.Encrypt(ctx, "scd_constraints.url", "https://old.example.com")
+	require.NoError(t, err)
+
+	// After the rotation, new values are encrypted with k2 and old values
+	// remain readable with k1.
+	keys, err = LoadKeyFiles([]string{"k2=" + k2, "k1=" + k1})
+	require.NoError(t, err)
+	e, err = NewEncryptor(keys, []string{"scd_constraints.url"})
+	require.NoError(t, err)
+	value, err := e.Encrypt(ctx, "scd_constraints.url", "https://new.example.com")
+	require.NoError(t, err)
+	require.Contains(t, value, prefix+"k2:")
+
+	plaintext, err := e.Decrypt(ctx, "scd_constraints.url", old)
+	require.NoError(t, err)
+	require.Equal(t, "https://old.example.com", plaintext)
+
+	// Without k1, old values are not readable.
+	keys, err = LoadKeyFiles([]string{"k2=" + k2})
+	require.NoError(t, err)
+	e, err = NewEncryptor(keys, []string{"scd_constraints.url"})
+	require.NoError(t, err)
+	_, err = e.Decrypt(ctx, "scd_constraints.url", old)
+	require.Error(t, err)
+}
@@ -0,0 +1,142 @@
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"io/ioutil"
+	"os/exec"
+	"strings"
+
+	"github.com/interuss/stacktrace"
+)
+
+// LocalKeyWrapper wraps data keys with AES-256 key encryption keys held in
+// memory.
+type LocalKeyWrapper struct {
+	// Keys are the key encryption keys, by key ID.
+	Keys map[string][]byte
+	// CurrentKeyID identifies the key wrapping new data keys; the other keys
+	// only unwrap the data keys they previously wrapped, e.g. during a key
+	// rotation.
+	CurrentKeyID string
+}
+
+// LoadKeyFiles returns a LocalKeyWrapper with the key encryption keys in the
+// files of specs, each specified as id=path to a file holding a base64-encoded
+// 32-byte key.  The first key wraps new data keys.
+func LoadKeyFiles(specs []string) (*LocalKeyWrapper, error) {
+	w := &LocalKeyWrapper{Keys: map[string][]byte{}}
+	for _, spec := range specs {
+		spec = strings.TrimSpace(spec)
+		if spec == "" {
+			continue
+		}
+		parts := strings.SplitN(spec, "=", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, stacktrace.NewError("Key file `%s` is not specified as id=path", spec)
+		}
+		content, err := ioutil.ReadFile(parts[1])
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Error reading key file %s", parts[1])
+		}
+		key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(content)))
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Error decoding key file %s", parts[1])
+		}
+		if len(key) != dataKeySize {
+			return nil, stacktrace.NewError("Key in %s has %d bytes instead of %d", parts[1], len(key), dataKeySize)
+		}
+		if _, ok := w.Keys[parts[0]]; ok {
+			return nil, stacktrace.NewError("Key ID %s is specified more than once", parts[0])
+		}
+		w.Keys[parts[0]] = key
+		if w.CurrentKeyID == "" {
+			w.CurrentKeyID = parts[0]
+		}
+	}
+	if w.CurrentKeyID == "" {
+		return nil, stacktrace.NewError("No key files specified")
+	}
+	return w, nil
+}
+
+// KeyID implements KeyWrapper.KeyID.
+func (w *LocalKeyWrapper) KeyID() string {
+	return w.CurrentKeyID
+}
+
+// Wrap implements KeyWrapper.Wrap.
+func (w *LocalKeyWrapper) Wrap(ctx context.Context, dataKey []byte) ([]byte, error) {
+	aead, err := newAEAD(w.Keys[w.CurrentKeyID])
+	if err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to generate nonce")
+	}
+	return aead.Seal(nonce, nonce, dataKey, []byte(w.CurrentKeyID)), nil
+}
+
+// Unwrap implements KeyWrapper.Unwrap.
+func (w *LocalKeyWrapper) Unwrap(ctx context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	kek, ok := w.Keys[keyID]
+	if !ok {
+		return nil, stacktrace.NewError("Unknown key encryption key %s", keyID)
+	}
+	aead, err := newAEAD(kek)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	if len(wrapped) < aead.NonceSize() {
+		return nil, stacktrace.NewError("Malformed wrapped data key")
+	}
+	key, err := aead.Open(nil, wrapped[:aead.NonceSize()], wrapped[aead.NonceSize():], []byte(keyID))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to unwrap data key with key encryption key %s", keyID)
+	}
+	return key, nil
+}
+
+// CommandKeyWrapper delegates the wrapping of data keys to an external
+// command, e.g. the client of a key management service.  The command is run
+// with the arguments `wrap <key ID>` or `unwrap <key ID>`, reads the
+// base64-encoded key to wrap or unwrap from its standard input, and writes the
+// base64-encoded result to its standard output.
+type CommandKeyWrapper struct {
+	Command      string
+	CurrentKeyID string
+}
+
+// KeyID implements KeyWrapper.KeyID.
+func (w *CommandKeyWrapper) KeyID() string {
+	return w.CurrentKeyID
+}
+
+// Wrap implements KeyWrapper.Wrap.
+func (w *CommandKeyWrapper) Wrap(ctx context.Context, dataKey []byte) ([]byte, error) {
+	return w.run(ctx, "wrap", w.CurrentKeyID, dataKey)
+}
+
+// Unwrap implements KeyWrapper.Unwrap.
+func (w *CommandKeyWrapper) Unwrap(ctx context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	return w.run(ctx, "unwrap", keyID, wrapped)
+}
+
+func (w *CommandKeyWrapper) run(ctx context.Context, operation string, keyID string, input []byte) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, w.Command, operation, keyID)
+	cmd.Stdin = strings.NewReader(base64.StdEncoding.EncodeToString(input))
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, stacktrace.Propagate(err, "Key wrapping command failed to %s with key %s: %s", operation, keyID, strings.TrimSpace(stderr.String()))
+	}
+	output, err := base64.StdEncoding.DecodeString(strings.TrimSpace(stdout.String()))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Key wrapping command returned malformed output to %s with key %s", operation, keyID)
+	}
+	return output, nil
+}
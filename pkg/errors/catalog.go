@@ -0,0 +1,61 @@
+package errors
+
+import (
+	"github.com/interuss/stacktrace"
+	"google.golang.org/grpc/codes"
+)
+
+// The stable machine-readable codes of error categories, reported to clients
+// in the error_code field of error responses.  They must not change, since
+// client automation relies upon them.
+const (
+	CodeAreaTooLarge       = "AREA_TOO_LARGE"
+	CodeMissingOVN         = "MISSING_OVN"
+	CodeNotModified        = "NOT_MODIFIED"
+	CodeRequestTooLarge    = "REQUEST_TOO_LARGE"
+	CodeAlreadyExists      = "ALREADY_EXISTS"
+	CodeBadRequest         = "BAD_REQUEST"
+	CodeVersionConflict    = "VERSION_CONFLICT"
+	CodeNotFound           = "NOT_FOUND"
+	CodePermissionDenied   = "PERMISSION_DENIED"
+	CodeResourceExhausted  = "RESOURCE_EXHAUSTED"
+	CodeUnauthenticated    = "UNAUTHENTICATED"
+	CodeUnavailable        = "UNAVAILABLE"
+	CodeDeadlineExceeded   = "DEADLINE_EXCEEDED"
+	CodeCanceled           = "CANCELED"
+	CodeFailedPrecondition = "FAILED_PRECONDITION"
+	CodeOutOfRange         = "OUT_OF_RANGE"
+	CodeUnimplemented      = "UNIMPLEMENTED"
+	CodeInternal           = "INTERNAL"
+)
+
+// catalog maps the codes of errors to their machine-readable codes.
+var catalog = map[stacktrace.ErrorCode]string{
+	AreaTooLarge:     CodeAreaTooLarge,
+	MissingOVNs:      CodeMissingOVN,
+	NotModified:      CodeNotModified,
+	RequestTooLarge:  CodeRequestTooLarge,
+	AlreadyExists:    CodeAlreadyExists,
+	BadRequest:       CodeBadRequest,
+	VersionMismatch:  CodeVersionConflict,
+	NotFound:         CodeNotFound,
+	PermissionDenied: CodePermissionDenied,
+	Exhausted:        CodeResourceExhausted,
+	Unauthenticated:  CodeUnauthenticated,
+	Unavailable:      CodeUnavailable,
+	DeadlineExceeded: CodeDeadlineExceeded,
+
+	stacktrace.ErrorCode(uint16(codes.Canceled)):           CodeCanceled,
+	stacktrace.ErrorCode(uint16(codes.FailedPrecondition)): CodeFailedPrecondition,
+	stacktrace.ErrorCode(uint16(codes.OutOfRange)):         CodeOutOfRange,
+	stacktrace.ErrorCode(uint16(codes.Unimplemented)):      CodeUnimplemented,
+}
+
+// ErrorCode returns the machine-readable code of the errors with code, which
+// may also be a gRPC status code; errors of unknown codes are internal.
+func ErrorCode(code stacktrace.ErrorCode) string {
+	if name, ok := catalog[code]; ok {
+		return name
+	}
+	return CodeInternal
+}
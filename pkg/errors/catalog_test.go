@@ -0,0 +1,46 @@
+package errors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/interuss/dss/pkg/api/v1/auxpb"
+	"github.com/interuss/stacktrace"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestErrorCode(t *testing.T) {
+	require.Equal(t, "AREA_TOO_LARGE", ErrorCode(AreaTooLarge))
+	require.Equal(t, "MISSING_OVN", ErrorCode(MissingOVNs))
+	require.Equal(t, "VERSION_CONFLICT", ErrorCode(VersionMismatch))
+	require.Equal(t, "BAD_REQUEST", ErrorCode(stacktrace.ErrorCode(uint16(codes.InvalidArgument))))
+	require.Equal(t, "UNIMPLEMENTED", ErrorCode(stacktrace.ErrorCode(uint16(codes.Unimplemented))))
+	require.Equal(t, "INTERNAL", ErrorCode(stacktrace.ErrorCode(uint16(codes.Unknown))))
+	require.Equal(t, "INTERNAL", ErrorCode(stacktrace.NoCode))
+
+	// Codes must remain unique for clients to tell categories apart.
+	seen := map[string]stacktrace.ErrorCode{}
+	for code, name := range catalog {
+		other, ok := seen[name]
+		require.False(t, ok, "%s is the code of both %d and %d", name, code, other)
+		seen[name] = code
+	}
+}
+
+func TestInterceptorReportsErrorCode(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}
+	_, err := Interceptor(zap.NewNop())(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, stacktrace.NewErrorWithCode(VersionMismatch, "Version is not current")
+	})
+	s, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Len(t, s.Details(), 1)
+	response, ok := s.Details()[0].(*auxpb.StandardErrorResponse)
+	require.True(t, ok)
+	require.Equal(t, CodeVersionConflict, response.ErrorCode)
+	require.Equal(t, "Version is not current", response.Message)
+}
@@ -13,7 +13,8 @@
 // error were bubbled up to the request handler, attach the code to the error
 // with stacktrace.PropagateWithCode or stacktrace.NewErrorWithCode.  Higher-
 // level calling functions can always override this code if appropriate.  The
-// recognized codes are enumerated in errors.go of this package.
+// recognized codes are enumerated in errors.go of this package, and catalog.go
+// maps each of them to the stable machine-readable code reported to clients.
 //
 // Just before an error is ultimately returned by a request handler, the
 // interceptor in errors.go logs the full details of the error and then
@@ -28,6 +28,16 @@ const (
 	// be returned rather than the standard error response.
 	MissingOVNs stacktrace.ErrorCode = stacktrace.ErrorCode(19)
 
+	// NotModified is used when a conditional read finds the requested resource
+	// unchanged.  We want to signal to the http gateway that it should return
+	// 304 without a body to client.
+	NotModified stacktrace.ErrorCode = stacktrace.ErrorCode(20)
+
+	// RequestTooLarge is used when the body of a request exceeds the maximum
+	// size of its endpoint.  We want to signal to the http gateway that it
+	// should return 413 to client.
+	RequestTooLarge stacktrace.ErrorCode = stacktrace.ErrorCode(21)
+
 	// AlreadyExists is used when attempting to create a resource that already
 	// exists.
 	AlreadyExists stacktrace.ErrorCode = stacktrace.ErrorCode(uint16(codes.AlreadyExists))
@@ -51,14 +61,59 @@ const (
 
 	// Unauthenticated is used when an OAuth token is invalid or not supplied.
 	Unauthenticated stacktrace.ErrorCode = stacktrace.ErrorCode(uint16(codes.Unauthenticated))
+
+	// Unavailable is used when a request could not be completed due to
+	// transient contention and may succeed if retried.
+	Unavailable stacktrace.ErrorCode = stacktrace.ErrorCode(uint16(codes.Unavailable))
+
+	// DeadlineExceeded is used when a request could not be completed within
+	// the deadline of its operation.  We want to signal to the http gateway
+	// that it should return 504 to client.
+	DeadlineExceeded stacktrace.ErrorCode = stacktrace.ErrorCode(uint16(codes.DeadlineExceeded))
 )
 
+// ReasonedError is a root-cause error carrying a machine-readable reason,
+// which is reported to clients in the reason field of StandardErrorResponse.
+type ReasonedError struct {
+	Reason  string
+	Message string
+}
+
+func (e *ReasonedError) Error() string {
+	return e.Message
+}
+
+// ErrorReason returns the machine-readable reason of e.
+func (e *ReasonedError) ErrorReason() string {
+	return e.Reason
+}
+
+// reasoner is implemented by the root-cause errors carrying a machine-readable
+// reason, such as ReasonedError.
+type reasoner interface {
+	ErrorReason() string
+}
+
+// NewErrorWithReason returns a new error with code, carrying the
+// machine-readable reason.
+func NewErrorWithReason(code stacktrace.ErrorCode, reason string, format string, args ...interface{}) error {
+	return stacktrace.PropagateWithCode(&ReasonedError{Reason: reason, Message: fmt.Sprintf(format, args...)}, code, "Error %s", reason)
+}
+
 func init() {
 	if _, ok := os.LookupEnv("DSS_ERRORS_OBFUSCATE_INTERNAL_ERRORS"); ok {
 		logging.Logger.Warn("DSS_ERRORS_OBFUSCATE_INTERNAL_ERRORS has been deprecated and will be removed in a future version")
 	}
 }
 
+// reasonOf returns the machine-readable reason carried by err, if any.
+func reasonOf(err error) string {
+	if reasoned, ok := err.(reasoner); ok {
+		return reasoned.ErrorReason()
+	}
+	return ""
+}
+
 func MakeErrID() string {
 	errUUID, err := uuid.NewRandom()
 	if err == nil {
@@ -98,6 +153,7 @@ func Interceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
 		if err == nil {
 			return resp, nil
 		}
+		logger := logging.WithValuesFromContext(ctx, logger)
 
 		errID := MakeErrID()
 
@@ -118,6 +174,11 @@ func Interceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
 			return resp, rootErr
 		}
 
+		if code == NotModified {
+			// Not an actual failure; no need to log it as such.
+			return resp, status.Error(codes.Code(uint16(code)), rootErr.Error())
+		}
+
 		if code != stacktrace.NoCode {
 			logger.Error(
 				fmt.Sprintf("Error %s during unary server call", errID),
@@ -127,10 +188,12 @@ func Interceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
 				zap.Int("code", int(code)),
 				zap.Error(rootErr))
 			p, constructionErr := MakeStatusProto(codes.Code(uint16(code)), rootErr.Error(), &auxpb.StandardErrorResponse{
-				Error:   rootErr.Error(),
-				Code:    int32(code),
-				Message: rootErr.Error(),
-				ErrorId: errID,
+				Error:     rootErr.Error(),
+				Code:      int32(code),
+				Message:   rootErr.Error(),
+				ErrorId:   errID,
+				Reason:    reasonOf(rootErr),
+				ErrorCode: ErrorCode(code),
 			})
 			if constructionErr == nil {
 				err = status.ErrorProto(p)
@@ -0,0 +1,27 @@
+package errors
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/interuss/stacktrace"
+)
+
+// WriteHTTPError writes the JSON error response of an HTTP server rejecting a
+// request with statusCode before it reaches core-service, with the same
+// fields as the StandardErrorResponse of core-service errors of code.
+func WriteHTTPError(w http.ResponseWriter, statusCode int, code stacktrace.ErrorCode, errID string, reason string, message string) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	if err := json.NewEncoder(w).Encode(struct {
+		Error     string `json:"error"`
+		Code      int32  `json:"code"`
+		Message   string `json:"message"`
+		ErrorID   string `json:"error_id"`
+		Reason    string `json:"reason"`
+		ErrorCode string `json:"error_code"`
+	}{message, int32(code), message, errID, reason, ErrorCode(code)}); err != nil {
+		return stacktrace.Propagate(err, "Error writing error response")
+	}
+	return nil
+}
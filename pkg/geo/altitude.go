@@ -0,0 +1,219 @@
+package geo
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/golang/geo/s2"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/stacktrace"
+)
+
+// VerticalDatum identifies the surface relative to which a height is
+// expressed.
+type VerticalDatum string
+
+const (
+	// DatumWGS84 expresses heights above the WGS84 ellipsoid, as stored by
+	// the DSS.
+	DatumWGS84 VerticalDatum = "W84"
+	// DatumEGM96 and DatumEGM2008 express heights above mean sea level
+	// (AMSL), as modeled by the geoid of the corresponding Earth Gravitational
+	// Model.
+	DatumEGM96   VerticalDatum = "EGM96"
+	DatumEGM2008 VerticalDatum = "EGM2008"
+	// DatumPressure expresses pressure altitudes, relative to the standard
+	// pressure of 1013.25 hPa, as used by flight levels.
+	DatumPressure VerticalDatum = "STD"
+
+	metersPerFoot = 0.3048
+
+	// isaEarthRadiusMeter is the radius of the earth with which the
+	// International Standard Atmosphere relates geopotential and geometric
+	// altitudes.
+	isaEarthRadiusMeter = 6356766.0
+)
+
+var (
+	// geoids are the geoid models of the AMSL datums, set at startup.
+	geoids = map[VerticalDatum]*Geoid{}
+)
+
+// Geoid is a geoid model sampled on a regular latitude/longitude grid, giving
+// the height of mean sea level above the WGS84 ellipsoid.
+type Geoid struct {
+	// width and height are the numbers of columns, from longitude 0 eastward,
+	// and rows, from latitude 90 to -90 inclusive, of data.
+	width, height int
+	offset, scale float64
+	data          []uint16
+}
+
+// ParseGeoid parses a geoid model in the PGM format of GeographicLib, such as
+// the egm96-5.pgm and egm2008-1.pgm files it distributes, whose samples are
+// heights in meters of offset + scale * value.
+func ParseGeoid(r io.Reader) (*Geoid, error) {
+	var (
+		reader = bufio.NewReader(r)
+		g      = &Geoid{offset: math.NaN(), scale: math.NaN()}
+		fields []string
+	)
+	for len(fields) < 4 {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Error reading geoid header")
+		}
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "#") {
+			comment := strings.Fields(strings.TrimPrefix(line, "#"))
+			if len(comment) == 2 && (comment[0] == "Offset" || comment[0] == "Scale") {
+				value, err := strconv.ParseFloat(comment[1], 64)
+				if err != nil {
+					return nil, stacktrace.Propagate(err, "Invalid geoid %s", comment[0])
+				}
+				if comment[0] == "Offset" {
+					g.offset = value
+				} else {
+					g.scale = value
+				}
+			}
+			continue
+		}
+		fields = append(fields, strings.Fields(line)...)
+	}
+	if fields[0] != "P5" || len(fields) != 4 {
+		return nil, stacktrace.NewError("Geoid is not a binary PGM file")
+	}
+	if math.IsNaN(g.offset) || math.IsNaN(g.scale) {
+		return nil, stacktrace.NewError("Geoid header has no Offset or Scale")
+	}
+	var err error
+	if g.width, err = strconv.Atoi(fields[1]); err != nil || g.width < 1 {
+		return nil, stacktrace.NewError("Invalid geoid width %s", fields[1])
+	}
+	if g.height, err = strconv.Atoi(fields[2]); err != nil || g.height < 2 {
+		return nil, stacktrace.NewError("Invalid geoid height %s", fields[2])
+	}
+	if fields[3] != "65535" {
+		return nil, stacktrace.NewError("Geoid samples must be 16 bits")
+	}
+
+	g.data = make([]uint16, g.width*g.height)
+	if err := binary.Read(reader, binary.BigEndian, g.data); err != nil {
+		return nil, stacktrace.Propagate(err, "Error reading %dx%d geoid samples", g.width, g.height)
+	}
+	return g, nil
+}
+
+// LoadGeoid parses the geoid model of the PGM file at path.
+func LoadGeoid(path string) (*Geoid, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error opening geoid %s", path)
+	}
+	defer f.Close()
+	g, err := ParseGeoid(f)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Invalid geoid %s", path)
+	}
+	return g, nil
+}
+
+func (g *Geoid) sample(row, column int) float64 {
+	return g.offset + g.scale*float64(g.data[row*g.width+column%g.width])
+}
+
+// Undulation returns the height in meters of mean sea level above the WGS84
+// ellipsoid at position, bilinearly interpolated between the samples of g.
+func (g *Geoid) Undulation(position s2.LatLng) float64 {
+	lng := math.Mod(position.Lng.Degrees(), 360)
+	if lng < 0 {
+		lng += 360
+	}
+	x := lng / 360 * float64(g.width)
+	y := (90 - position.Lat.Degrees()) / 180 * float64(g.height-1)
+	column, row := int(x), int(y)
+	if row >= g.height-1 {
+		row = g.height - 2
+	}
+	dx, dy := x-float64(column), y-float64(row)
+	return (1-dy)*((1-dx)*g.sample(row, column)+dx*g.sample(row, column+1)) +
+		dy*((1-dx)*g.sample(row+1, column)+dx*g.sample(row+1, column+1))
+}
+
+// SetGeoid sets the geoid model of datum, among DatumEGM96 and DatumEGM2008,
+// without which heights relative to datum are not converted.  It must be
+// called before any height is converted.
+func SetGeoid(datum VerticalDatum, g *Geoid) error {
+	if datum != DatumEGM96 && datum != DatumEGM2008 {
+		return stacktrace.NewError("Datum %s has no geoid", datum)
+	}
+	geoids[datum] = g
+	return nil
+}
+
+// separation returns the height in meters of the surface of datum above the
+// WGS84 ellipsoid at position.
+func separation(datum VerticalDatum, position s2.LatLng) (float64, error) {
+	switch datum {
+	case DatumWGS84:
+		return 0, nil
+	case DatumEGM96, DatumEGM2008:
+		g, ok := geoids[datum]
+		if !ok {
+			return 0, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Heights relative to %s are not accepted by this DSS instance, which has no %s geoid", datum, datum)
+		}
+		return g.Undulation(position), nil
+	case DatumPressure:
+		// Under the standard atmosphere, pressure altitudes are heights above
+		// mean sea level, which ICAO relates to WGS84 with EGM96.
+		return separation(DatumEGM96, position)
+	}
+	return 0, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Unknown vertical datum %s", datum)
+}
+
+// ConvertHeight converts a height of meters relative to from into a height in
+// meters relative to to at position.  Pressure altitudes are converted
+// assuming the International Standard Atmosphere, so the actual heights of
+// aircraft flying them differ with the local pressure and temperature.
+func ConvertHeight(meters float64, from, to VerticalDatum, position s2.LatLng) (float64, error) {
+	if from == to {
+		return meters, nil
+	}
+	offset, err := separation(from, position)
+	if err != nil {
+		return 0, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	if from == DatumPressure {
+		// Pressure altitudes are geopotential.
+		meters = isaEarthRadiusMeter * meters / (isaEarthRadiusMeter - meters)
+	}
+	meters += offset
+
+	offset, err = separation(to, position)
+	if err != nil {
+		return 0, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	meters -= offset
+	if to == DatumPressure {
+		meters = isaEarthRadiusMeter * meters / (isaEarthRadiusMeter + meters)
+	}
+	return meters, nil
+}
+
+// FlightLevelToMeters returns the pressure altitude in meters of flight level
+// fl, in hundreds of feet.
+func FlightLevelToMeters(fl float64) float64 {
+	return fl * 100 * metersPerFoot
+}
+
+// MetersToFlightLevel returns the flight level, in hundreds of feet, of the
+// pressure altitude of meters.
+func MetersToFlightLevel(meters float64) float64 {
+	return meters / metersPerFoot / 100
+}
@@ -0,0 +1,87 @@
+package geo_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/golang/geo/s2"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/dss/pkg/geo"
+	"github.com/interuss/stacktrace"
+	"github.com/stretchr/testify/require"
+)
+
+// testGeoid returns a 90-degree geoid whose mean sea level is 10 m above the
+// ellipsoid at the equator along longitude 0, 30 m along longitude 90, and
+// 20 m at the poles.
+func testGeoid(t *testing.T) *geo.Geoid {
+	var b bytes.Buffer
+	b.WriteString("P5\n# Description test geoid\n# Offset -100\n# Scale 0.01\n4 3\n65535\n")
+	samples := []float64{
+		20, 20, 20, 20,
+		10, 30, 10, 10,
+		20, 20, 20, 20,
+	}
+	for _, sample := range samples {
+		require.NoError(t, binary.Write(&b, binary.BigEndian, uint16(math.Round((sample+100)/0.01))))
+	}
+	g, err := geo.ParseGeoid(&b)
+	require.NoError(t, err)
+	return g
+}
+
+func TestGeoidUndulation(t *testing.T) {
+	g := testGeoid(t)
+	require.InDelta(t, 10, g.Undulation(s2.LatLngFromDegrees(0, 0)), 1e-6)
+	require.InDelta(t, 30, g.Undulation(s2.LatLngFromDegrees(0, 90)), 1e-6)
+	require.InDelta(t, 20, g.Undulation(s2.LatLngFromDegrees(0, 45)), 1e-6)
+	require.InDelta(t, 15, g.Undulation(s2.LatLngFromDegrees(45, 0)), 1e-6)
+	require.InDelta(t, 20, g.Undulation(s2.LatLngFromDegrees(-90, 10)), 1e-6)
+	// Longitudes wrap around the antimeridian.
+	require.InDelta(t, 30, g.Undulation(s2.LatLngFromDegrees(0, -270)), 1e-6)
+	require.InDelta(t, 10, g.Undulation(s2.LatLngFromDegrees(0, -45)), 1e-6)
+}
+
+func TestParseGeoidErrors(t *testing.T) {
+	for _, header := range []string{
+		"P2\n# Offset -100\n# Scale 0.01\n4 3\n65535\n",
+		"P5\n# Scale 0.01\n4 3\n65535\n",
+		"P5\n# Offset -100\n# Scale 0.01\n4 3\n255\n",
+		"P5\n# Offset -100\n# Scale 0.01\n4 3\n65535\n\x00\x01",
+	} {
+		_, err := geo.ParseGeoid(bytes.NewBufferString(header))
+		require.Error(t, err, header)
+	}
+}
+
+func TestConvertHeight(t *testing.T) {
+	position := s2.LatLngFromDegrees(0, 0)
+
+	_, err := geo.ConvertHeight(100, geo.DatumEGM2008, geo.DatumWGS84, position)
+	require.Error(t, err)
+	require.Equal(t, dsserr.BadRequest, stacktrace.GetCode(err))
+	_, err = geo.ConvertHeight(100, "SFC", geo.DatumWGS84, position)
+	require.Error(t, err)
+	require.Equal(t, dsserr.BadRequest, stacktrace.GetCode(err))
+
+	require.NoError(t, geo.SetGeoid(geo.DatumEGM96, testGeoid(t)))
+	require.Error(t, geo.SetGeoid(geo.DatumWGS84, testGeoid(t)))
+
+	h, err := geo.ConvertHeight(100, geo.DatumEGM96, geo.DatumWGS84, position)
+	require.NoError(t, err)
+	require.InDelta(t, 110, h, 1e-6)
+	h, err = geo.ConvertHeight(h, geo.DatumWGS84, geo.DatumEGM96, position)
+	require.NoError(t, err)
+	require.InDelta(t, 100, h, 1e-6)
+
+	// FL100 is a geopotential altitude of 3048 m, about 3049.5 m above mean
+	// sea level under the standard atmosphere.
+	h, err = geo.ConvertHeight(geo.FlightLevelToMeters(100), geo.DatumPressure, geo.DatumWGS84, position)
+	require.NoError(t, err)
+	require.InDelta(t, 3049.5+10, h, 0.1)
+	h, err = geo.ConvertHeight(h, geo.DatumWGS84, geo.DatumPressure, position)
+	require.NoError(t, err)
+	require.InDelta(t, 100, geo.MetersToFlightLevel(h), 1e-6)
+}
@@ -0,0 +1,180 @@
+package geo
+
+import (
+	"container/list"
+	"encoding/binary"
+	"math"
+	"sync"
+
+	"github.com/golang/geo/s2"
+	"github.com/interuss/stacktrace"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// DefaultCoveringCacheCells is the default number of cells held by the
+// covering cache, about 8 MB of cells.
+const DefaultCoveringCacheCells = 1000000
+
+var (
+	coveringCacheLookups = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "dss_covering_cache_lookups_total",
+		Help: "Number of lookups of the coverings of areas in the covering cache, by result (hit or miss).",
+	}, []string{"result"})
+
+	// coverings caches the coverings of the areas most recently covered,
+	// which display providers poll continuously.
+	coverings = newCoveringCache(DefaultCoveringCacheCells)
+)
+
+func init() {
+	promauto.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "dss_covering_cache_entries",
+		Help: "Number of coverings held by the covering cache.",
+	}, func() float64 { return float64(coverings.len()) })
+	promauto.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "dss_covering_cache_cells",
+		Help: "Number of cells of the coverings held by the covering cache.",
+	}, func() float64 { return float64(coverings.size()) })
+}
+
+// SetCoveringCacheCells bounds the number of cells of the coverings held by
+// the covering cache, evicting the least recently used coverings beyond it;
+// zero disables the cache.
+func SetCoveringCacheCells(maxCells int) error {
+	if maxCells < 0 {
+		return stacktrace.NewError("Covering cache size %d must not be negative", maxCells)
+	}
+	coverings.resize(maxCells)
+	return nil
+}
+
+type coveringCacheEntry struct {
+	key   string
+	cells s2.CellUnion
+}
+
+// coveringCache is a least-recently-used cache of the coverings of geometries,
+// keyed by their canonical form, holding at most maxCells cells.
+type coveringCache struct {
+	mu       sync.Mutex
+	maxCells int
+	cells    int
+	lru      *list.List
+	entries  map[string]*list.Element
+}
+
+func newCoveringCache(maxCells int) *coveringCache {
+	return &coveringCache{maxCells: maxCells, lru: list.New(), entries: map[string]*list.Element{}}
+}
+
+// get returns a copy of the covering cached for key, if any.
+func (c *coveringCache) get(key string) (s2.CellUnion, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.maxCells == 0 {
+		return nil, false
+	}
+	element, ok := c.entries[key]
+	if !ok {
+		coveringCacheLookups.WithLabelValues("miss").Inc()
+		return nil, false
+	}
+	coveringCacheLookups.WithLabelValues("hit").Inc()
+	c.lru.MoveToFront(element)
+	return append(s2.CellUnion(nil), element.Value.(*coveringCacheEntry).cells...), true
+}
+
+// put caches a copy of the covering cells of key, unless it alone exceeds
+// the size of the cache.
+func (c *coveringCache) put(key string, cells s2.CellUnion) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(cells) > c.maxCells {
+		return
+	}
+	if _, ok := c.entries[key]; ok {
+		return
+	}
+	c.entries[key] = c.lru.PushFront(&coveringCacheEntry{key: key, cells: append(s2.CellUnion(nil), cells...)})
+	c.cells += len(cells)
+	c.evictLocked()
+}
+
+// resize empties the cache and sets its size to maxCells.
+func (c *coveringCache) resize(maxCells int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.maxCells = maxCells
+	c.clearLocked()
+}
+
+// clear empties the cache, whose coverings no longer match the cell level or
+// Limits of the DSS.
+func (c *coveringCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.clearLocked()
+}
+
+func (c *coveringCache) clearLocked() {
+	c.lru.Init()
+	c.entries = map[string]*list.Element{}
+	c.cells = 0
+}
+
+func (c *coveringCache) evictLocked() {
+	for c.cells > c.maxCells {
+		entry := c.lru.Remove(c.lru.Back()).(*coveringCacheEntry)
+		delete(c.entries, entry.key)
+		c.cells -= len(entry.cells)
+	}
+}
+
+func (c *coveringCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+func (c *coveringCache) size() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cells
+}
+
+// appendFloats appends the exact binary representations of values to key.
+func appendFloats(key []byte, values ...float64) []byte {
+	var b [8]byte
+	for _, v := range values {
+		binary.BigEndian.PutUint64(b[:], math.Float64bits(v))
+		key = append(key, b[:]...)
+	}
+	return key
+}
+
+// polygonCacheKey returns the canonical form of the polygon of points: its
+// vertices starting from the smallest one, so that the same polygon
+// submitted from another starting vertex or with other formatting of its
+// coordinates has the same key.
+func polygonCacheKey(points []s2.Point) string {
+	first := 0
+	for i, p := range points {
+		if p.Vector.Cmp(points[first].Vector) < 0 {
+			first = i
+		}
+	}
+	key := make([]byte, 0, 1+24*len(points))
+	key = append(key, 'p')
+	for i := range points {
+		p := points[(first+i)%len(points)]
+		key = appendFloats(key, p.X, p.Y, p.Z)
+	}
+	return string(key)
+}
+
+// circleCacheKey returns the canonical form of the circle of radiusMeter
+// around center.
+func circleCacheKey(center s2.LatLng, radiusMeter float64) string {
+	return string(appendFloats([]byte{'c'}, center.Lat.Radians(), center.Lng.Radians(), radiusMeter))
+}
@@ -0,0 +1,99 @@
+package geo_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/golang/geo/s2"
+	"github.com/interuss/dss/pkg/geo"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/stretchr/testify/require"
+)
+
+// metricValue returns the value of the exported metric series.
+func metricValue(t *testing.T, series string) float64 {
+	w := httptest.NewRecorder()
+	promhttp.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	for _, line := range strings.Split(w.Body.String(), "\n") {
+		if strings.HasPrefix(line, series+" ") {
+			value, err := strconv.ParseFloat(strings.TrimPrefix(line, series+" "), 64)
+			require.NoError(t, err)
+			return value
+		}
+	}
+	return 0
+}
+
+func TestCoveringCache(t *testing.T) {
+	defer func() { require.NoError(t, geo.SetCoveringCacheCells(geo.DefaultCoveringCacheCells)) }()
+	require.Error(t, geo.SetCoveringCacheCells(-1))
+	require.NoError(t, geo.SetCoveringCacheCells(geo.DefaultCoveringCacheCells))
+	require.Zero(t, metricValue(t, "dss_covering_cache_entries"))
+
+	hits := func() float64 { return metricValue(t, `dss_covering_cache_lookups_total{result="hit"}`) }
+	misses := func() float64 { return metricValue(t, `dss_covering_cache_lookups_total{result="miss"}`) }
+	initialHits, initialMisses := hits(), misses()
+
+	want, err := geo.AreaToCellIDs(`37.4047,-122.1474,37.4037,-122.1485,37.4035,-122.1466`)
+	require.NoError(t, err)
+	require.Equal(t, initialMisses+1, misses())
+	require.Equal(t, float64(1), metricValue(t, "dss_covering_cache_entries"))
+	require.Equal(t, float64(len(want)), metricValue(t, "dss_covering_cache_cells"))
+
+	// The same polygon, formatted otherwise and from another vertex, is
+	// covered from the cache.
+	for i, area := range []string{
+		`37.4047, -122.1474, 37.4037, -122.1485, 37.4035, -122.1466`,
+		`37.4037,-122.1485,37.4035,-122.1466,37.40470,-122.1474`,
+		`{"type": "Polygon", "coordinates": [[[-122.1466, 37.4035], [-122.1474, 37.4047], [-122.1485, 37.4037], [-122.1466, 37.4035]]]}`,
+	} {
+		got, err := geo.AreaToCellIDs(area)
+		require.NoError(t, err, area)
+		require.Equal(t, want, got, area)
+		require.Equal(t, initialHits+float64(i+1), hits(), area)
+		// Callers may modify the coverings returned.
+		got[0] = s2.CellID(0)
+	}
+	require.Equal(t, initialMisses+1, misses())
+
+	_, err = geo.AreaToCellIDs(`circle:37.4040,-122.1475,100`)
+	require.NoError(t, err)
+	_, err = geo.AreaToCellIDs(`circle:37.4040,-122.1475,100`)
+	require.NoError(t, err)
+	require.Equal(t, initialHits+4, hits())
+	require.Equal(t, float64(2), metricValue(t, "dss_covering_cache_entries"))
+
+	// Invalid polygons are not cached.
+	_, err = geo.AreaToCellIDs(`0,0,0.01,0.01,0.01,0,0,0.01`)
+	require.Error(t, err)
+	require.Equal(t, float64(2), metricValue(t, "dss_covering_cache_entries"))
+
+	// The least recently used coverings are evicted beyond the size of the
+	// cache.
+	require.NoError(t, geo.SetCoveringCacheCells(len(want)+1))
+	require.Zero(t, metricValue(t, "dss_covering_cache_entries"))
+	for _, area := range []string{
+		`37.4047,-122.1474,37.4037,-122.1485,37.4035,-122.1466`,
+		`48.8566,2.3522,48.8556,2.3512,48.8554,2.3532`,
+	} {
+		_, err := geo.AreaToCellIDs(area)
+		require.NoError(t, err)
+	}
+	require.Equal(t, float64(1), metricValue(t, "dss_covering_cache_entries"))
+	_, err = geo.AreaToCellIDs(`48.8566,2.3522,48.8556,2.3512,48.8554,2.3532`)
+	require.NoError(t, err)
+	require.Equal(t, initialHits+5, hits())
+
+	// Changing the cell level empties the cache.
+	require.NoError(t, geo.SetCellLevel(geo.DefaultMinimumCellLevel))
+	require.Zero(t, metricValue(t, "dss_covering_cache_entries"))
+
+	// A zero size disables the cache.
+	require.NoError(t, geo.SetCoveringCacheCells(0))
+	_, err = geo.AreaToCellIDs(`48.8566,2.3522,48.8556,2.3512,48.8554,2.3532`)
+	require.NoError(t, err)
+	require.Zero(t, metricValue(t, "dss_covering_cache_entries"))
+}
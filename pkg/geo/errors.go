@@ -1,6 +1,8 @@
 package geo
 
 import (
+	"fmt"
+
 	dsserr "github.com/interuss/dss/pkg/errors"
 	"github.com/interuss/stacktrace"
 )
@@ -26,8 +28,8 @@ var (
 	// was specified.
 	ErrRadiusMustBeLargerThan0 = stacktrace.NewErrorWithCode(dsserr.BadRequest, "Radius must be larger than 0")
 
-	// ErrAreaTooLarge is the error passed back when the requested Area is larger
-	// than maxAllowedAreaKm2
+	// ErrAreaTooLarge is the error passed back when the requested Area exceeds
+	// the Limits of the DSS.
 	ErrAreaTooLarge = stacktrace.NewErrorWithCode(dsserr.AreaTooLarge, "Area too large")
 
 	// ErrOddNumberOfCoordinatesInAreaString indicates that an area string that
@@ -35,3 +37,88 @@ var (
 	// coordinate pair.
 	ErrOddNumberOfCoordinatesInAreaString = stacktrace.NewErrorWithCode(dsserr.BadRequest, "Odd number of coordinates in area string")
 )
+
+// PolygonDefect identifies why a polygon is rejected.  Its value is the
+// machine-readable reason reported to clients.  The winding order of the
+// vertices is not a defect: a polygon is the smaller of the two areas its
+// vertices bound, whichever order they are submitted in, so clients may not
+// wind polygons wrongly, and polygons too large in both orders are
+// PolygonTooLarge.
+type PolygonDefect string
+
+const (
+	// PolygonTooFewVertices rejects polygons of fewer than 3 vertices.
+	PolygonTooFewVertices PolygonDefect = "polygon_too_few_vertices"
+
+	// PolygonTooManyVertices rejects polygons of more than
+	// Limits.MaxPolygonVertices vertices.
+	PolygonTooManyVertices PolygonDefect = "polygon_too_many_vertices"
+
+	// PolygonInvalidVertex rejects vertices which are not valid coordinates.
+	PolygonInvalidVertex PolygonDefect = "polygon_invalid_vertex"
+
+	// PolygonDuplicateVertex rejects polygons with the same vertex twice.
+	PolygonDuplicateVertex PolygonDefect = "polygon_duplicate_vertex"
+
+	// PolygonSelfIntersection rejects polygons whose edges cross.
+	PolygonSelfIntersection PolygonDefect = "polygon_self_intersection"
+
+	// PolygonTooLarge rejects polygons larger than Limits.MaxAreaKm2 in
+	// either winding order.
+	PolygonTooLarge PolygonDefect = "polygon_too_large"
+)
+
+// PolygonError is the root cause of the rejection of a polygon, describing
+// its defect and the indices, counted from 0 in the order submitted, of its
+// offending vertices.  It wraps the ErrNotEnoughPointsInPolygon,
+// ErrBadCoordSet or ErrAreaTooLarge error it refines.
+type PolygonError struct {
+	Defect   PolygonDefect
+	Vertices []int
+	// Limit is the limit exceeded by polygons rejected for
+	// PolygonTooManyVertices or PolygonTooLarge.
+	Limit   float64
+	Message string
+
+	cause error
+}
+
+func (e *PolygonError) Error() string {
+	return e.Message
+}
+
+// Unwrap returns the generic error refined by e.
+func (e *PolygonError) Unwrap() error {
+	return e.cause
+}
+
+// ErrorReason returns the defect of e as the reason reported to clients.
+func (e *PolygonError) ErrorReason() string {
+	return string(e.Defect)
+}
+
+// NewPolygonError returns an error, with the code of cause, rejecting a
+// polygon for defect at vertices.
+func NewPolygonError(cause error, defect PolygonDefect, vertices []int, format string, args ...interface{}) error {
+	return wrapPolygonError(&PolygonError{
+		Defect:   defect,
+		Vertices: vertices,
+		Message:  fmt.Sprintf(format, args...),
+		cause:    cause,
+	})
+}
+
+// newPolygonLimitError returns an error, with the code of cause, rejecting a
+// polygon for defect, exceeding limit.
+func newPolygonLimitError(cause error, defect PolygonDefect, limit float64, format string, args ...interface{}) error {
+	return wrapPolygonError(&PolygonError{
+		Defect:  defect,
+		Limit:   limit,
+		Message: fmt.Sprintf(format, args...),
+		cause:   cause,
+	})
+}
+
+func wrapPolygonError(e *PolygonError) error {
+	return stacktrace.PropagateWithCode(e, stacktrace.GetCode(e.cause), "Invalid polygon")
+}
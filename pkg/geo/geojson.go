@@ -0,0 +1,222 @@
+package geo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/golang/geo/s2"
+	"github.com/interuss/stacktrace"
+)
+
+// The GeoJSON (RFC 7946) object types accepted as areas.
+const (
+	GeoJSONTypeFeature      = "Feature"
+	GeoJSONTypePolygon      = "Polygon"
+	GeoJSONTypeMultiPolygon = "MultiPolygon"
+
+	// geoJSONAreaPrefix introduces an area string holding a GeoJSON object.
+	geoJSONAreaPrefix = "{"
+)
+
+// GeoJSONGeometry is a GeoJSON geometry object.
+type GeoJSONGeometry struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+}
+
+// GeoJSONFeature is a GeoJSON feature, i.e. a geometry with properties.
+type GeoJSONFeature struct {
+	Type       string           `json:"type"`
+	Geometry   *GeoJSONGeometry `json:"geometry"`
+	Properties interface{}      `json:"properties"`
+}
+
+// GeoJSONFeatureCollection is a GeoJSON collection of features.
+type GeoJSONFeatureCollection struct {
+	Type     string            `json:"type"`
+	Features []*GeoJSONFeature `json:"features"`
+}
+
+// NewGeoJSONFeatureCollection returns a collection of features.
+func NewGeoJSONFeatureCollection(features ...*GeoJSONFeature) *GeoJSONFeatureCollection {
+	return &GeoJSONFeatureCollection{Type: "FeatureCollection", Features: append([]*GeoJSONFeature{}, features...)}
+}
+
+// NewGeoJSONFeature returns a feature of geometry with properties.
+func NewGeoJSONFeature(geometry *GeoJSONGeometry, properties interface{}) *GeoJSONFeature {
+	return &GeoJSONFeature{Type: GeoJSONTypeFeature, Geometry: geometry, Properties: properties}
+}
+
+// geoJSONPosition is a GeoJSON position: longitude, latitude and optionally
+// altitude.
+type geoJSONPosition []float64
+
+// latLng returns the coordinates of p, vertex i of a polygon.
+func (p geoJSONPosition) latLng(i int) (s2.LatLng, error) {
+	if len(p) < 2 {
+		return s2.LatLng{}, NewPolygonError(ErrBadCoordSet, PolygonInvalidVertex, []int{i}, "GeoJSON position of polygon vertex %d must have a longitude and a latitude", i)
+	}
+	ll := s2.LatLngFromDegrees(p[1], p[0])
+	if !ll.IsValid() {
+		return s2.LatLng{}, NewPolygonError(ErrBadCoordSet, PolygonInvalidVertex, []int{i}, "Invalid GeoJSON position [%g, %g] of polygon vertex %d", p[0], p[1], i)
+	}
+	return ll, nil
+}
+
+// ParseGeoJSONPolygons returns the vertices of the exterior rings of the
+// polygons of data, a GeoJSON Polygon or MultiPolygon geometry or a Feature of
+// one, without their closing vertices.  Holes are ignored, so that the
+// polygons cover at least the area described.
+func ParseGeoJSONPolygons(data []byte) ([][]s2.LatLng, error) {
+	var object struct {
+		GeoJSONGeometry
+		Geometry *GeoJSONGeometry `json:"geometry"`
+	}
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, stacktrace.Propagate(ErrBadCoordSet, "Invalid GeoJSON: %s", err.Error())
+	}
+	geometry := object.GeoJSONGeometry
+	if geometry.Type == GeoJSONTypeFeature {
+		if object.Geometry == nil {
+			return nil, stacktrace.Propagate(ErrBadCoordSet, "GeoJSON Feature has no geometry")
+		}
+		geometry = *object.Geometry
+	}
+
+	var polygons [][][]geoJSONPosition
+	switch geometry.Type {
+	case GeoJSONTypePolygon:
+		var polygon [][]geoJSONPosition
+		if err := json.Unmarshal(geometry.Coordinates, &polygon); err != nil {
+			return nil, stacktrace.Propagate(ErrBadCoordSet, "Invalid GeoJSON Polygon coordinates: %s", err.Error())
+		}
+		polygons = append(polygons, polygon)
+	case GeoJSONTypeMultiPolygon:
+		if err := json.Unmarshal(geometry.Coordinates, &polygons); err != nil {
+			return nil, stacktrace.Propagate(ErrBadCoordSet, "Invalid GeoJSON MultiPolygon coordinates: %s", err.Error())
+		}
+	default:
+		return nil, stacktrace.Propagate(ErrBadCoordSet, "Unsupported GeoJSON type `%s`; expected %s, %s or a %s of one", geometry.Type, GeoJSONTypePolygon, GeoJSONTypeMultiPolygon, GeoJSONTypeFeature)
+	}
+	if len(polygons) == 0 {
+		return nil, ErrNotEnoughPointsInPolygon
+	}
+
+	result := make([][]s2.LatLng, 0, len(polygons))
+	for _, polygon := range polygons {
+		if len(polygon) == 0 {
+			return nil, ErrNotEnoughPointsInPolygon
+		}
+		ring := polygon[0]
+		if n := len(ring); n > 1 && ring[0].equal(ring[n-1]) {
+			ring = ring[:n-1]
+		}
+		if err := validateVertexCount(len(ring)); err != nil {
+			return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+		}
+		vertices := make([]s2.LatLng, 0, len(ring))
+		for i, position := range ring {
+			ll, err := position.latLng(i)
+			if err != nil {
+				return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+			}
+			vertices = append(vertices, ll)
+		}
+		result = append(result, vertices)
+	}
+	return result, nil
+}
+
+// equal reports whether p and other are the same position, including at
+// longitudes 180 and -180.
+func (p geoJSONPosition) equal(other geoJSONPosition) bool {
+	if len(p) < 2 || len(other) < 2 {
+		return false
+	}
+	return s2.PointFromLatLng(s2.LatLngFromDegrees(p[1], p[0])).ApproxEqual(s2.PointFromLatLng(s2.LatLngFromDegrees(other[1], other[0])))
+}
+
+// geoJSONAreaToCellIDs returns the covering of the polygons of the GeoJSON
+// area, whose total area and number of cells must not exceed the Limits of a
+// single polygon.
+func geoJSONAreaToCellIDs(area string) (s2.CellUnion, error) {
+	polygons, err := ParseGeoJSONPolygons([]byte(area))
+	if err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	var (
+		total  float64
+		result s2.CellUnion
+		seen   = map[s2.CellID]bool{}
+	)
+	for k, vertices := range polygons {
+		points := make([]s2.Point, len(vertices))
+		for i, ll := range vertices {
+			points[i] = s2.PointFromLatLng(ll)
+		}
+		total += PolygonAreaKm2(points)
+		if err := checkArea(total); err != nil {
+			return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+		}
+		cells, err := Covering(points)
+		if err != nil {
+			var polygonErr *PolygonError
+			if len(polygons) > 1 && errors.As(err, &polygonErr) {
+				// Identify the offending polygon of the MultiPolygon
+				return nil, wrapPolygonError(&PolygonError{
+					Defect:   polygonErr.Defect,
+					Vertices: polygonErr.Vertices,
+					Limit:    polygonErr.Limit,
+					Message:  fmt.Sprintf("GeoJSON polygon %d: %s", k, polygonErr.Message),
+					cause:    polygonErr.cause,
+				})
+			}
+			return nil, stacktrace.Propagate(err, "Error covering GeoJSON polygon %d", k)
+		}
+		for _, cell := range cells {
+			if !seen[cell] {
+				seen[cell] = true
+				result = append(result, cell)
+			}
+		}
+	}
+	// Like the coverings of single polygons, the cells are sorted for
+	// s2.CellUnion.Intersects and Contains.
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	if err := checkCells(result); err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	return result, nil
+}
+
+// geoJSONCoordinates rounds the coordinates of ll into a GeoJSON position.
+func geoJSONCoordinates(ll s2.LatLng) [2]float64 {
+	round := func(degrees float64) float64 { return math.Round(degrees*1e7) / 1e7 }
+	return [2]float64{round(ll.Lng.Degrees()), round(ll.Lat.Degrees())}
+}
+
+// CellsToGeoJSON returns the GeoJSON MultiPolygon of the outlines of cells.
+func CellsToGeoJSON(cells s2.CellUnion) *GeoJSONGeometry {
+	polygons := make([][][][2]float64, 0, len(cells))
+	for _, id := range cells {
+		cell := s2.CellFromCellID(id)
+		ring := make([][2]float64, 0, 5)
+		for k := 0; k < 4; k++ {
+			ring = append(ring, geoJSONCoordinates(s2.LatLngFromPoint(cell.Vertex(k))))
+		}
+		ring = append(ring, ring[0])
+		polygons = append(polygons, [][][2]float64{ring})
+	}
+	coordinates, _ := json.Marshal(polygons) // Arrays of float64 always marshal
+	return &GeoJSONGeometry{Type: GeoJSONTypeMultiPolygon, Coordinates: coordinates}
+}
+
+// isGeoJSONArea returns whether area holds a GeoJSON object rather than a
+// list of coordinates.
+func isGeoJSONArea(area string) bool {
+	return strings.HasPrefix(strings.TrimSpace(area), geoJSONAreaPrefix)
+}
@@ -0,0 +1,72 @@
+package geo_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/geo/s2"
+	"github.com/interuss/dss/pkg/geo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAreaToCellIDsGeoJSON(t *testing.T) {
+	want, err := geo.AreaToCellIDs(`37.4047,-122.1474,37.4037,-122.1485,37.4035,-122.1466`)
+	require.NoError(t, err)
+
+	for _, area := range []string{
+		`{"type": "Polygon", "coordinates": [[[-122.1474, 37.4047], [-122.1485, 37.4037], [-122.1466, 37.4035], [-122.1474, 37.4047]]]}`,
+		// Unclosed rings and holes are tolerated.
+		` {"type": "Polygon", "coordinates": [[[-122.1474, 37.4047], [-122.1485, 37.4037], [-122.1466, 37.4035]], [[-122.1475, 37.4040], [-122.1476, 37.4039], [-122.1474, 37.4039]]]}`,
+		`{"type": "Feature", "properties": {}, "geometry": {"type": "MultiPolygon", "coordinates": [[[[-122.1474, 37.4047, 10], [-122.1485, 37.4037, 10], [-122.1466, 37.4035, 10], [-122.1474, 37.4047, 10]]]]}}`,
+	} {
+		got, err := geo.AreaToCellIDs(area)
+		require.NoError(t, err, area)
+		require.ElementsMatch(t, want, got, area)
+	}
+
+	got, err := geo.AreaToCellIDs(`{"type": "MultiPolygon", "coordinates": [
+		[[[-122.1474, 37.4047], [-122.1485, 37.4037], [-122.1466, 37.4035]]],
+		[[[2.35, 48.85], [2.36, 48.85], [2.36, 48.86]]]]}`)
+	require.NoError(t, err)
+	require.Greater(t, len(got), len(want))
+	require.Subset(t, got, want)
+}
+
+func TestAreaToCellIDsGeoJSONErrors(t *testing.T) {
+	for _, r := range []struct {
+		area string
+		err  error
+	}{
+		{`{"type": "Point", "coordinates": [2.35, 48.85]}`, geo.ErrBadCoordSet},
+		{`{"type": "Polygon", "coordinates": [[[2.35, 48.85], [2.36, 48.85], [2.35, 48.85]]]}`, geo.ErrNotEnoughPointsInPolygon},
+		{`{"type": "Polygon", "coordinates": [[[2.35, 98.85], [2.36, 48.85], [2.36, 48.86]]]}`, geo.ErrBadCoordSet},
+		{`{"type": "Polygon", "coordinates": [[2.35, 48.85]]}`, geo.ErrBadCoordSet},
+		{`{"type": "Feature", "geometry": null}`, geo.ErrBadCoordSet},
+		{`{"type": "MultiPolygon", "coordinates": []}`, geo.ErrNotEnoughPointsInPolygon},
+		// Each polygon is small enough, but not all of them.
+		{`{"type": "MultiPolygon", "coordinates": [
+			[[[0, 0], [0.4, 0], [0.4, 0.4], [0, 0.4]]],
+			[[[1, 0], [1.4, 0], [1.4, 0.4], [1, 0.4]]]]}`, geo.ErrAreaTooLarge},
+		{`{"type": "Polygon"`, geo.ErrBadCoordSet},
+	} {
+		_, err := geo.AreaToCellIDs(r.area)
+		require.ErrorIs(t, err, r.err, r.area)
+	}
+}
+
+func TestCellsToGeoJSON(t *testing.T) {
+	cell := s2.CellIDFromLatLng(s2.LatLngFromDegrees(48.85, 2.35)).Parent(13)
+	geometry := geo.CellsToGeoJSON(s2.CellUnion{cell})
+	require.Equal(t, geo.GeoJSONTypeMultiPolygon, geometry.Type)
+
+	// The outlines parse back into polygons covered by the same cell.
+	data, err := json.Marshal(geometry)
+	require.NoError(t, err)
+	polygons, err := geo.ParseGeoJSONPolygons(data)
+	require.NoError(t, err)
+	require.Len(t, polygons, 1)
+	require.Len(t, polygons[0], 4)
+	for _, vertex := range polygons[0] {
+		require.InDelta(t, 0, s2.CellFromCellID(cell).Distance(s2.PointFromLatLng(vertex)).Angle().Degrees(), 1e-6)
+	}
+}
@@ -0,0 +1,119 @@
+package geo
+
+import (
+	"fmt"
+
+	"github.com/golang/geo/s2"
+	"github.com/interuss/stacktrace"
+)
+
+// The machine-readable reasons of LimitErrors.
+const (
+	// ReasonAreaTooLarge rejects areas larger than Limits.MaxAreaKm2.
+	ReasonAreaTooLarge = "area_too_large"
+
+	// ReasonTooManyCells rejects areas covered by more than Limits.MaxCells
+	// cells.
+	ReasonTooManyCells = "too_many_cells"
+)
+
+// Limits bound the areas accepted by the DSS, both those searched and those
+// of the entities created.
+type Limits struct {
+	// MaxAreaKm2 bounds the area, in km², of each area.
+	MaxAreaKm2 float64
+
+	// MaxPolygonVertices bounds the number of vertices of each polygon, so
+	// that validating them, quadratic in their number of vertices, stays cheap.
+	MaxPolygonVertices int
+
+	// MaxCells bounds the number of cells covering each area; zero disables
+	// the limit.
+	MaxCells int
+}
+
+// DefaultLimits are the limits of the DSS unless set otherwise with
+// SetLimits.
+var DefaultLimits = Limits{
+	MaxAreaKm2:         2500,
+	MaxPolygonVertices: 1000,
+}
+
+// limits are the limits of the DSS.
+var limits = DefaultLimits
+
+// CurrentLimits returns the limits of the DSS.
+func CurrentLimits() Limits {
+	return limits
+}
+
+// SetLimits sets the limits of the DSS.  It must be called before any area is
+// covered.
+func SetLimits(l Limits) error {
+	if !(l.MaxAreaKm2 > 0) {
+		return stacktrace.NewError("Maximum area %g km² must be positive", l.MaxAreaKm2)
+	}
+	if l.MaxPolygonVertices < 3 {
+		return stacktrace.NewError("Maximum number of polygon vertices %d must be at least 3", l.MaxPolygonVertices)
+	}
+	if l.MaxCells < 0 {
+		return stacktrace.NewError("Maximum number of cells %d must not be negative", l.MaxCells)
+	}
+	limits = l
+	coverings.clear()
+	return nil
+}
+
+// LimitError is the root cause of the rejection of an area exceeding one of
+// the Limits, stating the limit exceeded.  It wraps ErrAreaTooLarge.
+type LimitError struct {
+	// Reason is the machine-readable reason reported to clients, among
+	// ReasonAreaTooLarge and ReasonTooManyCells.
+	Reason string
+	// Value is the area or number of cells of the area, and Limit the limit
+	// it exceeds.
+	Value   float64
+	Limit   float64
+	Message string
+}
+
+func (e *LimitError) Error() string {
+	return e.Message
+}
+
+// Unwrap returns ErrAreaTooLarge.
+func (e *LimitError) Unwrap() error {
+	return ErrAreaTooLarge
+}
+
+// ErrorReason returns the reason reported to clients.
+func (e *LimitError) ErrorReason() string {
+	return e.Reason
+}
+
+func newLimitError(reason string, value float64, limit float64, format string, args ...interface{}) error {
+	return stacktrace.PropagateWithCode(&LimitError{
+		Reason:  reason,
+		Value:   value,
+		Limit:   limit,
+		Message: fmt.Sprintf(format, args...),
+	}, stacktrace.GetCode(ErrAreaTooLarge), "Area exceeds limits")
+}
+
+// checkArea returns a LimitError if areaKm2 exceeds Limits.MaxAreaKm2.
+func checkArea(areaKm2 float64) error {
+	if areaKm2 > limits.MaxAreaKm2 {
+		return newLimitError(ReasonAreaTooLarge, areaKm2, limits.MaxAreaKm2,
+			"Area is too large (%fkm² > %fkm²)", areaKm2, limits.MaxAreaKm2)
+	}
+	return nil
+}
+
+// checkCells returns a LimitError if cells exceed Limits.MaxCells.
+func checkCells(cells s2.CellUnion) error {
+	if limits.MaxCells > 0 && len(cells) > limits.MaxCells {
+		return newLimitError(ReasonTooManyCells, float64(len(cells)), float64(limits.MaxCells),
+			"Area is covered by too many cells (%d > %d)", len(cells), limits.MaxCells)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package geo_test
+
+import (
+	"errors"
+	"testing"
+
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/dss/pkg/geo"
+	"github.com/interuss/stacktrace"
+	"github.com/stretchr/testify/require"
+)
+
+func requireLimitError(t *testing.T, reason string, limit float64, err error) {
+	var limitErr *geo.LimitError
+	require.True(t, errors.As(err, &limitErr), err)
+	require.Equal(t, reason, limitErr.Reason)
+	require.Equal(t, reason, limitErr.ErrorReason())
+	require.Equal(t, limit, limitErr.Limit)
+	require.Greater(t, limitErr.Value, limit)
+	require.ErrorIs(t, err, geo.ErrAreaTooLarge)
+	require.Equal(t, dsserr.AreaTooLarge, stacktrace.GetCode(err))
+	require.Equal(t, limitErr, stacktrace.RootCause(err))
+}
+
+func TestSetLimits(t *testing.T) {
+	defer func() { require.NoError(t, geo.SetLimits(geo.DefaultLimits)) }()
+
+	for _, l := range []geo.Limits{
+		{MaxAreaKm2: 0, MaxPolygonVertices: 10},
+		{MaxAreaKm2: 10, MaxPolygonVertices: 2},
+		{MaxAreaKm2: 10, MaxPolygonVertices: 10, MaxCells: -1},
+	} {
+		require.Error(t, geo.SetLimits(l), l)
+	}
+	require.Equal(t, geo.DefaultLimits, geo.CurrentLimits())
+
+	// A square of about 1.2km², covered by a few level-13 cells.
+	const square = `0,0,0.01,0,0.01,0.01,0,0.01`
+	cells, err := geo.AreaToCellIDs(square)
+	require.NoError(t, err)
+
+	require.NoError(t, geo.SetLimits(geo.Limits{MaxAreaKm2: 1, MaxPolygonVertices: 3}))
+	require.Equal(t, geo.Limits{MaxAreaKm2: 1, MaxPolygonVertices: 3}, geo.CurrentLimits())
+	_, err = geo.AreaToCellIDs(square)
+	var polygonErr *geo.PolygonError
+	require.True(t, errors.As(err, &polygonErr))
+	require.Equal(t, geo.PolygonTooManyVertices, polygonErr.Defect)
+	require.Equal(t, float64(3), polygonErr.Limit)
+	_, err = geo.AreaToCellIDs(`0,0,0.01,0,0.01,0.01`)
+	require.NoError(t, err)
+	_, err = geo.AreaToCellIDs(`0,0,0.02,0,0.02,0.02`)
+	require.True(t, errors.As(err, &polygonErr))
+	require.Equal(t, geo.PolygonTooLarge, polygonErr.Defect)
+	require.Equal(t, float64(1), polygonErr.Limit)
+	_, err = geo.AreaToCellIDs(`circle:0,0,1000`)
+	requireLimitError(t, geo.ReasonAreaTooLarge, 1, err)
+	_, err = geo.AreaToCellIDs(`{"type": "MultiPolygon", "coordinates": [
+		[[[0, 0], [0.012, 0], [0.012, 0.012]]],
+		[[[1, 0], [1.012, 0], [1.012, 0.012]]]]}`)
+	requireLimitError(t, geo.ReasonAreaTooLarge, 1, err)
+
+	require.NoError(t, geo.SetLimits(geo.Limits{MaxAreaKm2: 2500, MaxPolygonVertices: 1000, MaxCells: len(cells)}))
+	_, err = geo.AreaToCellIDs(square)
+	require.NoError(t, err)
+	_, err = geo.AreaToCellIDs(`0,0,0.1,0,0.1,0.1,0,0.1`)
+	requireLimitError(t, geo.ReasonTooManyCells, float64(len(cells)), err)
+	_, err = geo.AreaToCellIDs(`circle:0,0,5000`)
+	requireLimitError(t, geo.ReasonTooManyCells, float64(len(cells)), err)
+}
@@ -19,10 +19,24 @@ const (
 	// DefaultMaximumCellLevel is the default minimum cell level, chosen such
 	// that the maximum cell size is ~1km^2.
 	DefaultMaximumCellLevel = 13
-	maxAllowedAreaKm2       = 2500.0
 	radiusEarthMeter        = 6371010.0
 
+	// MinimumConfigurableCellLevel and MaximumConfigurableCellLevel bound
+	// the cell levels accepted by SetCellLevel: coarser cells match most
+	// entities of dense areas, while finer cells make the coverings of the
+	// largest areas allowed too large.
+	MinimumConfigurableCellLevel = 8
+	MaximumConfigurableCellLevel = 15
+
 	earthAreaKm2 = 510072000.0 // rough area of the earth in KM².
+
+	// coplanarEpsilon is the tolerance, in radians, below which points are
+	// considered to lie on the same great circle.
+	coplanarEpsilon = 1e-12
+
+	// circleAreaPrefix introduces a circular area string in the format
+	// 'circle:lat,lng,radius' with the radius expressed in meters.
+	circleAreaPrefix = "circle:"
 )
 
 var (
@@ -34,23 +48,70 @@ var (
 	}
 	// RegionCoverer provides an overridable interface to defaultRegionCoverer
 	RegionCoverer = defaultRegionCoverer
+
+	// cellLevel is the level of the cells of the coverings of the DSS.
+	cellLevel = DefaultMinimumCellLevel
 )
 
+// CellLevel returns the level of the cells of the coverings of the DSS.
+func CellLevel() int {
+	return cellLevel
+}
+
+// SetCellLevel sets the level of the cells of the coverings of the DSS, which
+// must be the level of the cells of the entities already stored, since
+// entities only match searches covered by the same cells.  It must be called
+// before any covering is computed.
+func SetCellLevel(level int) error {
+	if level < MinimumConfigurableCellLevel || level > MaximumConfigurableCellLevel {
+		return stacktrace.NewError("Cell level %d is not between %d and %d", level, MinimumConfigurableCellLevel, MaximumConfigurableCellLevel)
+	}
+	cellLevel = level
+	defaultRegionCoverer.MinLevel = level
+	defaultRegionCoverer.MaxLevel = level
+	coverings.clear()
+	return nil
+}
+
 // Levelify takes a cell union that might have been normalized and returns to
 // the appropriate level
 func Levelify(cells *s2.CellUnion) {
 	// thirty is the number of s2 cells, we make it negative to get the number
 	// of cells we want
-	cells.Denormalize(DefaultMinimumCellLevel, 1)
+	cells.Denormalize(cellLevel, 1)
 }
 
 func ValidateCell(cell s2.CellID) error {
-	if cell.Level() < DefaultMinimumCellLevel || cell.Level() > DefaultMaximumCellLevel {
-		return stacktrace.NewError("Cells must be at level 13 at current implementation")
+	if cell.Level() != cellLevel {
+		return stacktrace.NewError("Cells must be at level %d in this DSS pool", cellLevel)
 	}
 	return nil
 }
 
+// RelevelCells returns the cells at level covering cells: their ancestors at
+// level when coarser, and their descendants at level when finer.
+func RelevelCells(cells s2.CellUnion, level int) s2.CellUnion {
+	result := s2.CellUnion{}
+	seen := map[s2.CellID]bool{}
+	for _, cell := range cells {
+		if cell.Level() >= level {
+			parent := cell.Parent(level)
+			if !seen[parent] {
+				seen[parent] = true
+				result = append(result, parent)
+			}
+			continue
+		}
+		for child := cell.ChildBeginAtLevel(level); child != cell.ChildEndAtLevel(level); child = child.Next() {
+			if !seen[child] {
+				seen[child] = true
+				result = append(result, child)
+			}
+		}
+	}
+	return result
+}
+
 func splitAtComma(data []byte, atEOF bool) (int, []byte, error) {
 	if atEOF && len(data) == 0 {
 		return 0, nil, nil
@@ -89,6 +150,12 @@ func loopAreaKm2(loop *s2.Loop) float64 {
 	return (loop.Area() * earthAreaKm2) / (4.0 * math.Pi)
 }
 
+// onArc reports whether p lies on the shortest arc from a to b of the great
+// circle containing all three points.
+func onArc(p s2.Point, a s2.Point, b s2.Point) bool {
+	return float64(a.Angle(p.Vector)+p.Angle(b.Vector)-a.Angle(b.Vector)) < coplanarEpsilon
+}
+
 // chordSegmentsIntersect determines if two chord segments (segment 1 from p1a
 // to p1b and segment 2 from p2a to p2b) on a sphere intersect.
 func chordSegmentsIntersect(p1a s2.Point, p1b s2.Point, p2a s2.Point, p2b s2.Point) bool {
@@ -98,6 +165,13 @@ func chordSegmentsIntersect(p1a s2.Point, p1b s2.Point, p2a s2.Point, p2b s2.Poi
 	// Normal of plane containing great circle connecting p1a to p1b
 	n2 := p2a.Cross(p2b.Vector)
 
+	// Segments on the same great circle, such as two edges along the equator
+	// or along the meridians on either side of a pole, have no single
+	// intersection point: they intersect when they overlap.
+	if n1.Cross(n2).Norm() < coplanarEpsilon*n1.Norm()*n2.Norm() {
+		return onArc(p2a, p1a, p1b) || onArc(p2b, p1a, p1b) || onArc(p1a, p2a, p2b) || onArc(p1b, p2a, p2b)
+	}
+
 	// Possible chord intersection point (other one is ip.Mul(-1))
 	ip := n1.Cross(n2).Normalize()
 
@@ -144,17 +218,79 @@ func validateLoop(points []s2.Point) error {
 		}
 		for j := i + 2; j < upperBound; j++ {
 			if chordSegmentsIntersect(points[i], points[i+1], points[j], points[(j+1)%n]) {
-				return stacktrace.NewError("Intersection found between polygon edge %d and %d", i, j)
+				return NewPolygonError(ErrBadCoordSet, PolygonSelfIntersection, []int{i, i + 1, j, (j + 1) % n},
+					"Polygon edge from vertex %d to %d intersects edge from vertex %d to %d", i, i+1, j, (j+1)%n)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidatePolygon returns a PolygonError if the specified points do not form
+// a polygon: fewer than 3 or more than Limits.MaxPolygonVertices vertices, duplicated
+// vertices or intersecting edges.
+func ValidatePolygon(points []s2.Point) error {
+	if err := validateVertexCount(len(points)); err != nil {
+		return err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	for i := range points {
+		for j := i + 1; j < len(points); j++ {
+			// Vertices at longitudes 180 and -180 are the same, up to rounding.
+			if points[i].ApproxEqual(points[j]) {
+				return NewPolygonError(ErrBadCoordSet, PolygonDuplicateVertex, []int{i, j}, "Polygon vertices %d and %d are identical", i, j)
 			}
 		}
 	}
+	return validateLoop(points)
+}
+
+// validateVertexCount returns a PolygonError unless a polygon of n vertices
+// has between 3 and Limits.MaxPolygonVertices vertices.
+func validateVertexCount(n int) error {
+	if n < 3 {
+		return NewPolygonError(ErrNotEnoughPointsInPolygon, PolygonTooFewVertices, nil, "Polygon has %d vertices; at least 3 are required", n)
+	}
+	if max := limits.MaxPolygonVertices; n > max {
+		return newPolygonLimitError(ErrBadCoordSet, PolygonTooManyVertices, float64(max), "Polygon has %d vertices; at most %d are allowed", n, max)
+	}
 	return nil
 }
 
+// PolygonAreaKm2 returns the area, in km², of the polygon formed by the
+// specified points in the winding order producing the smaller area.
+func PolygonAreaKm2(points []s2.Point) float64 {
+	area := loopAreaKm2(s2.LoopFromPoints(points))
+	reversed := make([]s2.Point, len(points))
+	for i, p := range points {
+		reversed[len(points)-1-i] = p
+	}
+	return math.Min(area, loopAreaKm2(s2.LoopFromPoints(reversed)))
+}
+
+// CircleAreaKm2 returns the area, in km², of a circle of radiusMeter.
+func CircleAreaKm2(radiusMeter float64) float64 {
+	circle := s2.CapFromCenterAngle(s2.PointFromLatLng(s2.LatLngFromDegrees(0, 0)), DistanceMetersToAngle(radiusMeter))
+	return (circle.Area() * earthAreaKm2) / (4.0 * math.Pi)
+}
+
 // Covering calculates the S2 covering of a set of S2 points representing a
-// polygon. Will try the loop in both clockwise and counter clockwise.
+// polygon. Will try the loop in both clockwise and counter clockwise.  The
+// coverings of polygons covered recently are cached.
 func Covering(points []s2.Point) (s2.CellUnion, error) {
-	err := validateLoop(points)
+	key := polygonCacheKey(points)
+	if cells, ok := coverings.get(key); ok {
+		return cells, nil
+	}
+	cells, err := covering(points)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	coverings.put(key, cells)
+	return cells, nil
+}
+
+func covering(points []s2.Point) (s2.CellUnion, error) {
+	err := ValidatePolygon(points)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Error validating polygon")
 	}
@@ -164,37 +300,108 @@ func Covering(points []s2.Point) (s2.CellUnion, error) {
 		return nil, stacktrace.Propagate(err, "Error validating loop")
 	}
 	area := loopAreaKm2(loop)
-	if area > maxAllowedAreaKm2 {
+	if area > limits.MaxAreaKm2 {
 		// This may have happened because the vertices were not ordered counter-clockwise.
 		// We can try reversing to see if that's the case.
-		for i, j := 0, len(points)-1; i < j; i, j = i+1, j-1 {
-			points[i], points[j] = points[j], points[i]
+		reversed := make([]s2.Point, len(points))
+		for i, p := range points {
+			reversed[len(points)-1-i] = p
 		}
-		loop = s2.LoopFromPoints(points)
-		area = loopAreaKm2(loop)
-	}
-	if area > maxAllowedAreaKm2 {
-		return nil, stacktrace.Propagate(
-			ErrAreaTooLarge, "Area is too large (%fkm² > %fkm²)",
-			area, maxAllowedAreaKm2)
+		reversedLoop := s2.LoopFromPoints(reversed)
+		reversedArea := loopAreaKm2(reversedLoop)
+		if reversedArea > limits.MaxAreaKm2 {
+			return nil, newPolygonLimitError(ErrAreaTooLarge, PolygonTooLarge, limits.MaxAreaKm2,
+				"Polygon area is too large in either winding order (%fkm² as submitted and %fkm² reversed > %fkm²)",
+				area, reversedArea, limits.MaxAreaKm2)
+		}
+		loop, area = reversedLoop, reversedArea
 	}
+	var cells s2.CellUnion
 	if area <= 0 {
 		// Since the loop has no area, try a PolyLine
 		pl := s2.Polyline(loop.Vertices())
-		return RegionCoverer.Covering(&pl), nil
+		cells = RegionCoverer.Covering(&pl)
+	} else {
+		cells = RegionCoverer.Covering(loop)
+	}
+	if err := checkCells(cells); err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	return cells, nil
+}
+
+// CircleCovering calculates the S2 covering of the circle of radiusMeter
+// around center.  The coverings of circles covered recently are cached.
+func CircleCovering(center s2.LatLng, radiusMeter float64) (s2.CellUnion, error) {
+	key := circleCacheKey(center, radiusMeter)
+	if cells, ok := coverings.get(key); ok {
+		return cells, nil
 	}
-	return RegionCoverer.Covering(loop), nil
+	cells, err := circleCovering(center, radiusMeter)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	coverings.put(key, cells)
+	return cells, nil
+}
+
+func circleCovering(center s2.LatLng, radiusMeter float64) (s2.CellUnion, error) {
+	if !center.IsValid() {
+		return nil, ErrBadCoordSet
+	}
+	if !(radiusMeter > 0) {
+		return nil, ErrRadiusMustBeLargerThan0
+	}
+	circle := s2.CapFromCenterAngle(s2.PointFromLatLng(center), DistanceMetersToAngle(radiusMeter))
+	area := (circle.Area() * earthAreaKm2) / (4.0 * math.Pi)
+	if err := checkArea(area); err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	cells := RegionCoverer.Covering(circle)
+	if err := checkCells(cells); err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	return cells, nil
+}
+
+// circleAreaToCellIDs parses "area" in the format 'lat,lng,radius' and
+// returns the covering of the resulting circle.
+func circleAreaToCellIDs(area string) (s2.CellUnion, error) {
+	values := strings.Split(area, ",")
+	if len(values) != 3 {
+		return nil, stacktrace.Propagate(ErrBadCoordSet, "Circular area must be specified as %slat,lng,radius", circleAreaPrefix)
+	}
+	var parsed [3]float64
+	for i, name := range []string{"lat", "lng", "radius"} {
+		f, err := strconv.ParseFloat(strings.TrimSpace(values[i]), 64)
+		if err != nil {
+			return nil, stacktrace.Propagate(ErrBadCoordSet, "Unable to parse %s: %s", name, err.Error())
+		}
+		parsed[i] = f
+	}
+	return CircleCovering(s2.LatLngFromDegrees(parsed[0], parsed[1]), parsed[2])
 }
 
 // AreaToCellIDs parses "area" in the format 'lat0,lon0,lat1,lon1,...'
-// and returns the resulting s2.CellUnion, or else:
+// describing a polygon, 'circle:lat,lng,radius' describing a circle with a
+// radius in meters, or a GeoJSON Polygon or MultiPolygon (see
+// ParseGeoJSONPolygons), and returns the resulting s2.CellUnion, or else:
 // * ErrOddNumberOfCoordinatesInAreaString
 // * ErrNotEnoughPointsInPolygon
 // * ErrBadCoordSet
+// * ErrRadiusMustBeLargerThan0
+// * ErrAreaTooLarge
 //
-// TODO(tvoss):
-//   * Agree and implement a maximum number of points in area
+// refined by a PolygonError for the defects of polygons, including more than
+// Limits.MaxPolygonVertices vertices, or by a LimitError for areas exceeding
+// the other Limits.
 func AreaToCellIDs(area string) (s2.CellUnion, error) {
+	if strings.HasPrefix(area, circleAreaPrefix) {
+		return circleAreaToCellIDs(strings.TrimPrefix(area, circleAreaPrefix))
+	}
+	if isGeoJSONArea(area) {
+		return geoJSONAreaToCellIDs(area)
+	}
 	var (
 		lat, lng float64
 		points   = []s2.Point{}
@@ -205,8 +412,8 @@ func AreaToCellIDs(area string) (s2.CellUnion, error) {
 	if numCoords%2 == 1 {
 		return nil, ErrOddNumberOfCoordinatesInAreaString
 	}
-	if numCoords/2 < 3 {
-		return nil, ErrNotEnoughPointsInPolygon
+	if err := validateVertexCount(numCoords / 2); err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
 	}
 	scanner.Split(splitAtComma)
 
@@ -216,15 +423,20 @@ func AreaToCellIDs(area string) (s2.CellUnion, error) {
 		case 0:
 			f, err := strconv.ParseFloat(trimmed, 64)
 			if err != nil {
-				return nil, stacktrace.Propagate(ErrBadCoordSet, "Unable to parse lat: %s", err.Error())
+				return nil, NewPolygonError(ErrBadCoordSet, PolygonInvalidVertex, []int{len(points)}, "Unable to parse latitude of polygon vertex %d: %s", len(points), err.Error())
 			}
 			lat = f
 		case 1:
 			f, err := strconv.ParseFloat(trimmed, 64)
 			if err != nil {
-				return nil, stacktrace.Propagate(ErrBadCoordSet, "Unable to parse lng: %s", err.Error())
+				return nil, NewPolygonError(ErrBadCoordSet, PolygonInvalidVertex, []int{len(points)}, "Unable to parse longitude of polygon vertex %d: %s", len(points), err.Error())
 			}
 			lng = f
+			// Latitudes beyond the poles would wrap onto the other side of the
+			// pole, while longitudes beyond the antimeridian wrap correctly.
+			if lat < -90 || lat > 90 {
+				return nil, NewPolygonError(ErrBadCoordSet, PolygonInvalidVertex, []int{len(points)}, "Polygon vertex %d has latitude %g, which is not between -90 and 90", len(points), lat)
+			}
 			points = append(points, s2.PointFromLatLng(s2.LatLngFromDegrees(lat, lng)))
 		}
 
@@ -1,10 +1,15 @@
 package geo_test
 
 import (
+	"errors"
+	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/golang/geo/s2"
 	"github.com/interuss/dss/pkg/geo"
 	"github.com/interuss/dss/pkg/geo/testdata"
+	"github.com/interuss/stacktrace"
 
 	"github.com/stretchr/testify/require"
 )
@@ -33,6 +38,30 @@ func TestParseAreaSucceedsForValidLoop(t *testing.T) {
 	require.NotNil(t, cells)
 }
 
+func TestParseAreaSucceedsForCircle(t *testing.T) {
+	cells, err := geo.AreaToCellIDs(`circle:37.4047,-122.1474,500`)
+	require.NoError(t, err)
+	require.NotEmpty(t, cells)
+}
+
+func TestParseAreaFailsForCircleWithoutRadius(t *testing.T) {
+	cells, err := geo.AreaToCellIDs(`circle:37.4047,-122.1474`)
+	require.Error(t, err)
+	require.Nil(t, cells)
+}
+
+func TestParseAreaFailsForCircleWithNonPositiveRadius(t *testing.T) {
+	cells, err := geo.AreaToCellIDs(`circle:37.4047,-122.1474,0`)
+	require.Error(t, err)
+	require.Nil(t, cells)
+}
+
+func TestParseAreaFailsForTooLargeCircle(t *testing.T) {
+	cells, err := geo.AreaToCellIDs(`circle:37.4047,-122.1474,100000`)
+	require.ErrorIs(t, err, geo.ErrAreaTooLarge)
+	require.Nil(t, cells)
+}
+
 func TestParseAreaFailsForEmptyString(t *testing.T) {
 	cells, err := geo.AreaToCellIDs("")
 	require.Error(t, err)
@@ -50,3 +79,187 @@ func TestParseAreaFailsForLoopWithOddNumberOfCoordinates(t *testing.T) {
 	require.Error(t, err)
 	require.Nil(t, cells)
 }
+
+func TestSetCellLevel(t *testing.T) {
+	defer func() { require.NoError(t, geo.SetCellLevel(geo.DefaultMinimumCellLevel)) }()
+
+	require.Error(t, geo.SetCellLevel(geo.MaximumConfigurableCellLevel+1))
+	require.Equal(t, geo.DefaultMinimumCellLevel, geo.CellLevel())
+
+	require.NoError(t, geo.SetCellLevel(11))
+	cells, err := geo.AreaToCellIDs(`circle:37.4047,-122.1474,500`)
+	require.NoError(t, err)
+	require.NotEmpty(t, cells)
+	for _, cell := range cells {
+		require.Equal(t, 11, cell.Level())
+		require.NoError(t, geo.ValidateCell(cell))
+	}
+	require.Error(t, geo.ValidateCell(cells[0].ChildBegin()))
+}
+
+func TestRelevelCells(t *testing.T) {
+	cells, err := geo.AreaToCellIDs(testdata.Loop)
+	require.NoError(t, err)
+	region := s2.CellUnion(append([]s2.CellID{}, cells...))
+	region.Normalize()
+
+	for _, level := range []int{geo.MinimumConfigurableCellLevel, 14} {
+		releveled := geo.RelevelCells(cells, level)
+		require.NotEmpty(t, releveled)
+		for _, cell := range releveled {
+			require.Equal(t, level, cell.Level())
+		}
+		// The releveled cells still cover the original cells.
+		covering := s2.CellUnion(append([]s2.CellID{}, releveled...))
+		covering.Normalize()
+		require.True(t, covering.Contains(region))
+	}
+	require.Len(t, geo.RelevelCells(cells, 14), 4*len(cells))
+}
+
+// coversPoint reports whether cells, as returned by geo.AreaToCellIDs,
+// contain the point at lat, lng.
+func coversPoint(cells s2.CellUnion, lat, lng float64) bool {
+	return cells.ContainsCellID(s2.CellIDFromLatLng(s2.LatLngFromDegrees(lat, lng)).Parent(geo.CellLevel()))
+}
+
+func TestParseAreaAcrossAntimeridian(t *testing.T) {
+	for _, area := range []string{
+		`-16.1,179.9,-16.1,-179.9,-15.9,-179.9,-15.9,179.9`,
+		// Clockwise
+		`-15.9,179.9,-15.9,-179.9,-16.1,-179.9,-16.1,179.9`,
+		// Longitudes beyond the antimeridian wrap around it.
+		`-16.1,179.9,-16.1,180.1,-15.9,180.1,-15.9,179.9`,
+		`{"type": "Polygon", "coordinates": [[[179.9, -16.1], [-179.9, -16.1], [-179.9, -15.9], [179.9, -15.9], [179.9, -16.1]]]}`,
+		`circle:-16,180,10000`,
+	} {
+		cells, err := geo.AreaToCellIDs(area)
+		require.NoError(t, err, area)
+		require.True(t, coversPoint(cells, -16, 179.95), area)
+		require.True(t, coversPoint(cells, -16, -179.95), area)
+		require.True(t, coversPoint(cells, -16, 180), area)
+		require.False(t, coversPoint(cells, -16, 179.5), area)
+		require.False(t, coversPoint(cells, -16, -179.5), area)
+		require.False(t, coversPoint(cells, -16, 0), area)
+		require.Less(t, len(cells), 1000, area)
+	}
+}
+
+func TestParseAreaNearPoles(t *testing.T) {
+	for _, r := range []struct {
+		area     string
+		pole     float64
+		pointLat float64
+	}{
+		// Polygons around the poles, in both winding orders.
+		{`89.8,0,89.8,90,89.8,180,89.8,-90`, 90, 89.9},
+		{`89.8,0,89.8,-90,89.8,180,89.8,90`, 90, 89.9},
+		{`-89.8,0,-89.8,90,-89.8,180,-89.8,-90`, -90, -89.9},
+		{`circle:90,0,5000`, 90, 89.98},
+		{`{"type": "Polygon", "coordinates": [[[0, -89.8], [90, -89.8], [180, -89.8], [-90, -89.8]]]}`, -90, -89.9},
+	} {
+		cells, err := geo.AreaToCellIDs(r.area)
+		require.NoError(t, err, r.area)
+		require.True(t, coversPoint(cells, r.pole, 0), r.area)
+		for _, lng := range []float64{-135, -45, 45, 135, 180} {
+			require.True(t, coversPoint(cells, r.pointLat, lng), r.area)
+		}
+		require.False(t, coversPoint(cells, -r.pole, 0), r.area)
+		require.False(t, coversPoint(cells, r.pointLat-r.pole/90, 0), r.area)
+	}
+
+	// A polygon next to the pole, whose edge passes close to it.
+	cells, err := geo.AreaToCellIDs(`89.9,10,89.9,-170,89.8,-80`)
+	require.NoError(t, err)
+	require.True(t, coversPoint(cells, 89.85, -80))
+	require.False(t, coversPoint(cells, 89.85, 100))
+
+	// Latitudes beyond the poles are not silently wrapped onto the other side
+	// of the pole.
+	_, err = geo.AreaToCellIDs(`89.9,0,90.1,90,89.9,180`)
+	require.ErrorIs(t, err, geo.ErrBadCoordSet)
+}
+
+func TestParseAreaWithEdgesOnTheSameGreatCircle(t *testing.T) {
+	// The edges from (0, 179.5) to (0, 179.7) and from (0, 179.9) to
+	// (0, -179.9) both lie on the equator without intersecting.
+	cells, err := geo.AreaToCellIDs(`0,179.5,0,179.7,0.1,179.8,0,179.9,0,-179.9,0.2,179.7`)
+	require.NoError(t, err)
+	require.True(t, coversPoint(cells, 0.05, 179.95))
+
+	// Overlapping edges on the same great circle intersect.
+	_, err = geo.AreaToCellIDs(`0,179.5,0,179.9,0.1,179.8,0,179.7,0,-179.9,0.2,179.7`)
+	require.Error(t, err)
+}
+
+func TestValidatePolygonAcrossAntimeridian(t *testing.T) {
+	points := []s2.Point{
+		s2.PointFromLatLng(s2.LatLngFromDegrees(0, 180)),
+		s2.PointFromLatLng(s2.LatLngFromDegrees(0.1, 179.9)),
+		s2.PointFromLatLng(s2.LatLngFromDegrees(0, -180)),
+		s2.PointFromLatLng(s2.LatLngFromDegrees(-0.1, 179.9)),
+	}
+	// Longitudes 180 and -180 are the same vertex.
+	require.Error(t, geo.ValidatePolygon(points))
+	require.NoError(t, geo.ValidatePolygon(append(points[:2:2], s2.PointFromLatLng(s2.LatLngFromDegrees(0, -179.9)))))
+}
+
+func TestParseAreaPolygonDiagnostics(t *testing.T) {
+	tooMany := make([]string, 0, 2*(geo.DefaultLimits.MaxPolygonVertices+1))
+	for i := 0; i <= geo.DefaultLimits.MaxPolygonVertices; i++ {
+		tooMany = append(tooMany, "0", fmt.Sprint(float64(i)*1e-5))
+	}
+	for _, r := range []struct {
+		area     string
+		defect   geo.PolygonDefect
+		vertices []int
+		err      error
+	}{
+		// Bow tie: the edge from vertex 0 to 1 crosses the edge from vertex 2
+		// to 3.
+		{`0,0,0.01,0.01,0.01,0,0,0.01`, geo.PolygonSelfIntersection, []int{0, 1, 2, 3}, geo.ErrBadCoordSet},
+		{`0,0,0.01,0,0.01,0.01,0.01,0`, geo.PolygonDuplicateVertex, []int{1, 3}, geo.ErrBadCoordSet},
+		{`0,0,0.01,0,0,0.01,0,0`, geo.PolygonDuplicateVertex, []int{0, 3}, geo.ErrBadCoordSet},
+		{`0,0,0.01,0`, geo.PolygonTooFewVertices, nil, geo.ErrNotEnoughPointsInPolygon},
+		{strings.Join(tooMany, ","), geo.PolygonTooManyVertices, nil, geo.ErrBadCoordSet},
+		{`0,0,0.01,0,91,0.01`, geo.PolygonInvalidVertex, []int{2}, geo.ErrBadCoordSet},
+		{`0,0,0.01,x,0,0.01`, geo.PolygonInvalidVertex, []int{1}, geo.ErrBadCoordSet},
+		{`0,0,1,0,1,1,0,1`, geo.PolygonTooLarge, nil, geo.ErrAreaTooLarge},
+		// Vertex 3 lies on the edge from vertex 4 back to 0.
+		{`{"type": "Polygon", "coordinates": [[[0, 0], [0.01, 0], [0.01, 0.01], [0, 0.01], [0, 0.02], [0, 0]]]}`, geo.PolygonSelfIntersection, []int{2, 3, 4, 0}, geo.ErrBadCoordSet},
+		{`{"type": "Polygon", "coordinates": [[[0, 0], [0.01, 0], [200, 0.01]]]}`, geo.PolygonInvalidVertex, []int{2}, geo.ErrBadCoordSet},
+	} {
+		_, err := geo.AreaToCellIDs(r.area)
+		require.ErrorIs(t, err, r.err, r.area)
+		var polygonErr *geo.PolygonError
+		require.True(t, errors.As(err, &polygonErr), r.area)
+		require.Equal(t, r.defect, polygonErr.Defect, r.area)
+		require.Equal(t, r.vertices, polygonErr.Vertices, r.area)
+		require.Equal(t, string(r.defect), polygonErr.ErrorReason())
+		require.Equal(t, polygonErr, stacktrace.RootCause(err), r.area)
+		require.Equal(t, stacktrace.GetCode(r.err), stacktrace.GetCode(err), r.area)
+	}
+
+	_, err := geo.AreaToCellIDs(`{"type": "MultiPolygon", "coordinates": [
+		[[[0, 0], [0.01, 0], [0.01, 0.01]]],
+		[[[1, 0], [1.01, 0.01], [1.01, 0], [1, 0.01]]]]}`)
+	var polygonErr *geo.PolygonError
+	require.True(t, errors.As(err, &polygonErr))
+	require.Equal(t, geo.PolygonSelfIntersection, polygonErr.Defect)
+	require.Contains(t, polygonErr.Error(), "GeoJSON polygon 1:")
+}
+
+func TestPolygonWindingIsNotADefect(t *testing.T) {
+	counterClockwise, err := geo.AreaToCellIDs(`0,0,0,0.01,0.01,0.01,0.01,0`)
+	require.NoError(t, err)
+	for _, area := range []string{
+		`0,0,0.01,0,0.01,0.01,0,0.01`,
+		`{"type": "Polygon", "coordinates": [[[0, 0], [0.01, 0], [0.01, 0.01], [0, 0.01], [0, 0]]]}`,
+		`{"type": "Polygon", "coordinates": [[[0, 0], [0, 0.01], [0.01, 0.01], [0.01, 0], [0, 0]]]}`,
+	} {
+		// Either winding order covers the smaller of the two areas bounded.
+		cells, err := geo.AreaToCellIDs(area)
+		require.NoError(t, err, area)
+		require.Equal(t, counterClockwise, cells, area)
+	}
+}
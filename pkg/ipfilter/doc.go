@@ -0,0 +1,3 @@
+// Package ipfilter restricts the clients of HTTP servers to network ranges,
+// identifying clients behind trusted proxies by their forwarded addresses.
+package ipfilter
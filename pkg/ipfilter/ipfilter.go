@@ -0,0 +1,168 @@
+package ipfilter
+
+import (
+	"net"
+	"net/http"
+	"strings"
+
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/stacktrace"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+)
+
+// ForwardedForHeader is the header listing the addresses of the client of a
+// request and of the proxies it went through, appended by each proxy.
+const ForwardedForHeader = "X-Forwarded-For"
+
+// The reasons for which requests are denied.
+const (
+	ReasonDenylisted     = "denylisted"
+	ReasonNotAllowlisted = "not_allowlisted"
+	ReasonUnknownClient  = "unknown_client"
+)
+
+var deniedRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "dss_ip_filter_denied_requests_total",
+	Help: "Number of HTTP requests denied because of the network address of their client, by reason.",
+}, []string{"reason"})
+
+// ParseCIDRs parses a comma-separated list of CIDR network ranges, where a
+// bare IP address stands for itself alone.
+func ParseCIDRs(s string) ([]*net.IPNet, error) {
+	var networks []*net.IPNet
+	for _, cidr := range strings.Split(s, ",") {
+		cidr = strings.TrimSpace(cidr)
+		if cidr == "" {
+			continue
+		}
+		if !strings.Contains(cidr, "/") {
+			ip := net.ParseIP(cidr)
+			if ip == nil {
+				return nil, stacktrace.NewError("Invalid IP address `%s`", cidr)
+			}
+			bits := 8 * net.IPv6len
+			if ip4 := ip.To4(); ip4 != nil {
+				ip, bits = ip4, 8*net.IPv4len
+			}
+			networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Invalid CIDR network range `%s`", cidr)
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
+
+func contains(networks []*net.IPNet, ip net.IP) bool {
+	for _, network := range networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// Filter decides which clients may make requests.
+type Filter struct {
+	// Allow are the network ranges of the clients allowed; all clients not
+	// denied are allowed when empty.
+	Allow []*net.IPNet
+	// Deny are the network ranges of the clients denied, even when allowed.
+	Deny []*net.IPNet
+	// TrustedProxies are the network ranges of the proxies, such as load
+	// balancers, whose ForwardedForHeader identifies the clients of the
+	// requests they forward.
+	TrustedProxies []*net.IPNet
+}
+
+// Enabled returns whether f actually restricts clients.
+func (f *Filter) Enabled() bool {
+	return len(f.Allow) > 0 || len(f.Deny) > 0
+}
+
+// ClientIP returns the address of the client of r: the peer of the request,
+// or, when the peer is a trusted proxy, the last address of the
+// ForwardedForHeader which is not a trusted proxy, since the addresses
+// preceding it may be forged by the client.  It returns nil when the address
+// cannot be determined.
+func (f *Filter) ClientIP(r *http.Request) net.IP {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !contains(f.TrustedProxies, ip) {
+		return ip
+	}
+	var forwarded []string
+	for _, header := range r.Header.Values(ForwardedForHeader) {
+		forwarded = append(forwarded, strings.Split(header, ",")...)
+	}
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		ip = net.ParseIP(strings.TrimSpace(forwarded[i]))
+		if ip == nil {
+			return nil
+		}
+		if !contains(f.TrustedProxies, ip) {
+			return ip
+		}
+	}
+	// Every address is a trusted proxy, so the first one is the client.
+	return ip
+}
+
+// Check returns the reason for which the client at ip is denied, or an empty
+// string if it is allowed.
+func (f *Filter) Check(ip net.IP) string {
+	switch {
+	case ip == nil:
+		return ReasonUnknownClient
+	case contains(f.Deny, ip):
+		return ReasonDenylisted
+	case len(f.Allow) > 0 && !contains(f.Allow, ip):
+		return ReasonNotAllowlisted
+	}
+	return ""
+}
+
+// Middleware serves the requests of the clients allowed by f with handler,
+// and responds 403 to the others, logging an audit entry of each denied
+// request to logger.
+func (f *Filter) Middleware(logger *zap.Logger, handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip := f.ClientIP(r)
+		reason := f.Check(ip)
+		if reason == "" {
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		deniedRequests.WithLabelValues(reason).Inc()
+		errID := dsserr.MakeErrID()
+		client := ""
+		if ip != nil {
+			client = ip.String()
+		}
+		logger.Warn("audit",
+			zap.String("event", "ip_filter_denied"),
+			zap.String("reason", reason),
+			zap.String("client_ip", client),
+			zap.String("remote_addr", r.RemoteAddr),
+			zap.Strings("forwarded_for", r.Header.Values(ForwardedForHeader)),
+			zap.String("method", r.Method),
+			zap.String("path", r.URL.Path),
+			zap.String("user_agent", r.UserAgent()),
+			zap.String("error_id", errID),
+		)
+
+		if err := dsserr.WriteHTTPError(w, http.StatusForbidden, dsserr.PermissionDenied, errID, reason,
+			"Requests from this network address are not allowed"); err != nil {
+			logger.Error("Error writing denied response", zap.Error(err))
+		}
+	})
+}
@@ -0,0 +1,99 @@
+package ipfilter
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func mustParseCIDRs(t *testing.T, s string) []*net.IPNet {
+	networks, err := ParseCIDRs(s)
+	require.NoError(t, err)
+	return networks
+}
+
+func TestParseCIDRs(t *testing.T) {
+	networks := mustParseCIDRs(t, " 10.0.0.0/8, 192.0.2.1,2001:db8::/32,, 2001:db8::1")
+	require.Len(t, networks, 4)
+	require.Equal(t, "192.0.2.1/32", networks[1].String())
+	require.Equal(t, "2001:db8::1/128", networks[3].String())
+
+	for _, s := range []string{"10.0.0.0/33", "10.0.0", "example.com"} {
+		_, err := ParseCIDRs(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	f := &Filter{TrustedProxies: mustParseCIDRs(t, "10.0.0.0/8")}
+	for _, c := range []struct {
+		remote    string
+		forwarded []string
+		want      string
+	}{
+		{remote: "192.0.2.1:1234", want: "192.0.2.1"},
+		// Untrusted peers cannot forge their address.
+		{remote: "192.0.2.1:1234", forwarded: []string{"198.51.100.1"}, want: "192.0.2.1"},
+		{remote: "10.0.0.1:1234", want: "10.0.0.1"},
+		{remote: "10.0.0.1:1234", forwarded: []string{"198.51.100.1"}, want: "198.51.100.1"},
+		// Only the addresses appended by trusted proxies are trusted.
+		{remote: "10.0.0.1:1234", forwarded: []string{"203.0.113.1, 198.51.100.1, 10.0.0.2"}, want: "198.51.100.1"},
+		{remote: "10.0.0.1:1234", forwarded: []string{"203.0.113.1", "198.51.100.1"}, want: "198.51.100.1"},
+		{remote: "10.0.0.1:1234", forwarded: []string{"10.0.0.3, 10.0.0.2"}, want: "10.0.0.3"},
+		{remote: "10.0.0.1:1234", forwarded: []string{"garbage"}, want: "<nil>"},
+	} {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.RemoteAddr = c.remote
+		for _, header := range c.forwarded {
+			r.Header.Add(ForwardedForHeader, header)
+		}
+		require.Equal(t, c.want, f.ClientIP(r).String(), "%+v", c)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	f := &Filter{
+		Allow: mustParseCIDRs(t, "192.0.2.0/24,2001:db8::/32"),
+		Deny:  mustParseCIDRs(t, "192.0.2.128/25"),
+	}
+	require.True(t, f.Enabled())
+	require.Equal(t, "", f.Check(net.ParseIP("192.0.2.1")))
+	require.Equal(t, "", f.Check(net.ParseIP("2001:db8::1")))
+	require.Equal(t, ReasonDenylisted, f.Check(net.ParseIP("192.0.2.129")))
+	require.Equal(t, ReasonNotAllowlisted, f.Check(net.ParseIP("198.51.100.1")))
+	require.Equal(t, ReasonUnknownClient, f.Check(nil))
+
+	f = &Filter{Deny: mustParseCIDRs(t, "192.0.2.128/25")}
+	require.Equal(t, "", f.Check(net.ParseIP("198.51.100.1")))
+	require.Equal(t, ReasonDenylisted, f.Check(net.ParseIP("192.0.2.129")))
+
+	require.False(t, (&Filter{}).Enabled())
+}
+
+func TestMiddleware(t *testing.T) {
+	f := &Filter{Deny: mustParseCIDRs(t, "192.0.2.0/24")}
+	handler := f.Middleware(zap.NewNop(), http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("served"))
+	}))
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.RemoteAddr = "198.51.100.1:1234"
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "served", w.Body.String())
+
+	r.RemoteAddr = "192.0.2.1:1234"
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	require.Equal(t, http.StatusForbidden, w.Code)
+	require.Contains(t, w.Body.String(), `"code":7`)
+	require.Contains(t, w.Body.String(), `"error_code":"PERMISSION_DENIED"`)
+
+	require.Equal(t, float64(1), testutil.ToFloat64(deniedRequests.WithLabelValues("denylisted")))
+}
@@ -0,0 +1,5 @@
+// Package jobs schedules the periodic maintenance jobs of a DSS pool, e.g.
+// garbage collection, within the core-service, electing through leases held in
+// the datastore a single DSS instance to run each job, so that they need not be
+// triggered by an external cron against administrative endpoints.
+package jobs
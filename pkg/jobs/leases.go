@@ -0,0 +1,45 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"github.com/interuss/dss/pkg/cockroach"
+	"github.com/interuss/stacktrace"
+	"github.com/jackc/pgx/v4"
+)
+
+// LeaseLocker implements Locker with leases held in the job_leases table of
+// the database DB is connected to.  CockroachDB not implementing advisory
+// locks, each lease is a row expiring unless renewed by its holder.
+type LeaseLocker struct {
+	DB *cockroach.DB
+}
+
+// TryLock implements Locker.TryLock.
+func (l *LeaseLocker) TryLock(ctx context.Context, name string, holder string, ttl time.Duration) (bool, error) {
+	const query = `
+		INSERT INTO job_leases (name, holder, expires_at)
+		VALUES ($1, $2, now() + $3::INT8 * INTERVAL '1 microsecond')
+		ON CONFLICT (name) DO UPDATE
+			SET holder = excluded.holder, expires_at = excluded.expires_at
+			WHERE job_leases.holder = excluded.holder OR job_leases.expires_at < now()
+		RETURNING holder`
+	var current string
+	err := l.DB.Pool.QueryRow(ctx, query, name, holder, ttl.Microseconds()).Scan(&current)
+	if err == pgx.ErrNoRows {
+		return false, nil
+	} else if err != nil {
+		return false, stacktrace.Propagate(err, "Error in query: %s", query)
+	}
+	return current == holder, nil
+}
+
+// Unlock implements Locker.Unlock.
+func (l *LeaseLocker) Unlock(ctx context.Context, name string, holder string) error {
+	const query = `DELETE FROM job_leases WHERE name = $1 AND holder = $2`
+	if _, err := l.DB.Pool.Exec(ctx, query, name, holder); err != nil {
+		return stacktrace.Propagate(err, "Error in query: %s", query)
+	}
+	return nil
+}
@@ -0,0 +1,246 @@
+package jobs
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/interuss/stacktrace"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/robfig/cron/v3"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultLeaseDuration is the default duration for which a DSS instance
+	// is elected to run a job unless it renews its lease.
+	DefaultLeaseDuration = time.Minute
+
+	resultSuccess = "success"
+	resultFailure = "failure"
+	resultSkipped = "skipped"
+)
+
+var (
+	jobRuns = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "dss_job_runs_total",
+		Help: "Number of scheduled runs of the maintenance jobs on this DSS instance, by job and result (success, failure, or skipped when another instance is elected to run the job or its previous run is still in progress).",
+	}, []string{"job", "result"})
+	jobDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "dss_job_duration_seconds",
+		Help:    "Duration of the runs of the maintenance jobs on this DSS instance, by job.",
+		Buckets: []float64{.1, .5, 1, 5, 10, 30, 60, 300, 900, 3600},
+	}, []string{"job"})
+	jobLastSuccess = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dss_job_last_success_timestamp_seconds",
+		Help: "Unix time of the latest successful run of each maintenance job on this DSS instance.",
+	}, []string{"job"})
+	jobLeader = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dss_job_leader",
+		Help: "Whether this DSS instance is elected to run each maintenance job (1) or not (0), as of its latest election.",
+	}, []string{"job"})
+)
+
+// Job is a maintenance job run periodically.
+type Job struct {
+	// Name identifies the job across the DSS instances of a pool, which elect
+	// a single instance to run each job.
+	Name string
+	// Spec is the schedule of the job, in robfig/cron format.
+	Spec string
+	// Run performs the job.
+	Run func(ctx context.Context) error
+}
+
+// Locker elects the holder of the lease of each job.
+type Locker interface {
+	// TryLock acquires or renews the lease of job name for holder for ttl,
+	// and returns whether holder holds the lease, i.e. whether the lease was
+	// free, expired or already held by holder.
+	TryLock(ctx context.Context, name string, holder string, ttl time.Duration) (bool, error)
+
+	// Unlock releases the lease of job name if held by holder.
+	Unlock(ctx context.Context, name string, holder string) error
+}
+
+type scheduledJob struct {
+	Job
+	running int32
+	leader  int32
+}
+
+// Scheduler runs Jobs on their schedules, on the DSS instance holding their
+// leases.  Leases are renewed while held, so that a job keeps being run by the
+// same instance until it stops, and another instance takes over once the lease
+// expires.
+type Scheduler struct {
+	// Locker elects the instance running each job; every instance runs all
+	// jobs when nil, e.g. for a single instance.
+	Locker Locker
+	// Holder identifies this instance in the leases of Locker.
+	Holder string
+	// LeaseDuration is the duration of the leases, DefaultLeaseDuration when
+	// 0.  Leases are renewed every third of it.
+	LeaseDuration time.Duration
+	Logger        *zap.Logger
+
+	mu     sync.Mutex
+	jobs   []*scheduledJob
+	cron   *cron.Cron
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// Add schedules job, which starts being run once s is started.
+func (s *Scheduler) Add(job Job) error {
+	if job.Name == "" || job.Run == nil {
+		return stacktrace.NewError("Jobs must have a name and a function to run")
+	}
+	if _, err := cron.ParseStandard(job.Spec); err != nil {
+		return stacktrace.Propagate(err, "Invalid schedule `%s` of job %s", job.Spec, job.Name)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cron != nil {
+		return stacktrace.NewError("Job %s cannot be added to a started scheduler", job.Name)
+	}
+	for _, j := range s.jobs {
+		if j.Name == job.Name {
+			return stacktrace.NewError("Job %s is scheduled more than once", job.Name)
+		}
+	}
+	s.jobs = append(s.jobs, &scheduledJob{Job: job})
+	return nil
+}
+
+// Start runs the jobs of s on their schedules until ctx is done or s is
+// stopped.
+func (s *Scheduler) Start(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cron != nil {
+		return stacktrace.NewError("Scheduler is already started")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	c := cron.New()
+	for _, j := range s.jobs {
+		j := j
+		if _, err := c.AddFunc(j.Spec, func() { s.run(ctx, j) }); err != nil {
+			cancel()
+			return stacktrace.Propagate(err, "Failed to schedule job %s", j.Name)
+		}
+	}
+	s.cron, s.cancel, s.done = c, cancel, make(chan struct{})
+	go s.renew(ctx)
+	c.Start()
+	return nil
+}
+
+// Stop stops running the jobs of s, waits for the runs in progress to end, and
+// releases the leases of s.
+func (s *Scheduler) Stop() {
+	s.mu.Lock()
+	c, cancel, done := s.cron, s.cancel, s.done
+	s.cron = nil
+	s.mu.Unlock()
+	if c == nil {
+		return
+	}
+	cancel()
+	<-c.Stop().Done()
+	<-done
+
+	if s.Locker == nil {
+		return
+	}
+	ctx, cancelUnlock := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancelUnlock()
+	for _, j := range s.jobs {
+		if atomic.SwapInt32(&j.leader, 0) == 0 {
+			continue
+		}
+		jobLeader.WithLabelValues(j.Name).Set(0)
+		if err := s.Locker.Unlock(ctx, j.Name, s.Holder); err != nil {
+			s.Logger.Warn("Failed to release job lease", zap.String("job", j.Name), zap.Error(err))
+		}
+	}
+}
+
+func (s *Scheduler) leaseDuration() time.Duration {
+	if s.LeaseDuration <= 0 {
+		return DefaultLeaseDuration
+	}
+	return s.LeaseDuration
+}
+
+// renew campaigns for the leases of all jobs every third of the lease
+// duration, so that the leases held by s do not expire, including while their
+// jobs run.
+func (s *Scheduler) renew(ctx context.Context) {
+	defer close(s.done)
+	if s.Locker == nil {
+		return
+	}
+	ticker := time.NewTicker(s.leaseDuration() / 3)
+	defer ticker.Stop()
+	for {
+		for _, j := range s.jobs {
+			s.elect(ctx, j)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// elect returns whether s is elected to run j.
+func (s *Scheduler) elect(ctx context.Context, j *scheduledJob) bool {
+	if s.Locker == nil {
+		return true
+	}
+	leader, err := s.Locker.TryLock(ctx, j.Name, s.Holder, s.leaseDuration())
+	if err != nil {
+		if ctx.Err() == nil {
+			s.Logger.Warn("Failed to acquire job lease", zap.String("job", j.Name), zap.Error(err))
+		}
+		leader = false
+	}
+	value := 0.0
+	if leader {
+		atomic.StoreInt32(&j.leader, 1)
+		value = 1
+	} else {
+		atomic.StoreInt32(&j.leader, 0)
+	}
+	jobLeader.WithLabelValues(j.Name).Set(value)
+	return leader
+}
+
+// run runs j if s is elected to and its previous run ended.
+func (s *Scheduler) run(ctx context.Context, j *scheduledJob) {
+	if !atomic.CompareAndSwapInt32(&j.running, 0, 1) {
+		s.Logger.Info("Skipping job whose previous run is still in progress", zap.String("job", j.Name))
+		jobRuns.WithLabelValues(j.Name, resultSkipped).Inc()
+		return
+	}
+	defer atomic.StoreInt32(&j.running, 0)
+	if !s.elect(ctx, j) {
+		jobRuns.WithLabelValues(j.Name, resultSkipped).Inc()
+		return
+	}
+
+	start := time.Now()
+	err := j.Run(ctx)
+	jobDuration.WithLabelValues(j.Name).Observe(time.Since(start).Seconds())
+	if err != nil {
+		s.Logger.Warn("Job failed", zap.String("job", j.Name), zap.Error(err))
+		jobRuns.WithLabelValues(j.Name, resultFailure).Inc()
+		return
+	}
+	jobRuns.WithLabelValues(j.Name, resultSuccess).Inc()
+	jobLastSuccess.WithLabelValues(j.Name).Set(float64(time.Now().Unix()))
+}
@@ -0,0 +1,110 @@
+package jobs
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// memoryLocker holds leases in memory, for the Schedulers of a test to share.
+type memoryLocker struct {
+	mu     sync.Mutex
+	leases map[string]string
+}
+
+func (l *memoryLocker) TryLock(ctx context.Context, name string, holder string, ttl time.Duration) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.leases == nil {
+		l.leases = map[string]string{}
+	}
+	if current, ok := l.leases[name]; ok && current != holder {
+		return false, nil
+	}
+	l.leases[name] = holder
+	return true, nil
+}
+
+func (l *memoryLocker) Unlock(ctx context.Context, name string, holder string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.leases[name] == holder {
+		delete(l.leases, name)
+	}
+	return nil
+}
+
+func countingJob(name string, runs *int) *scheduledJob {
+	return &scheduledJob{Job: Job{Name: name, Spec: "@every 1m", Run: func(ctx context.Context) error {
+		*runs++
+		return nil
+	}}}
+}
+
+func TestSingleLeader(t *testing.T) {
+	ctx := context.Background()
+	locker := &memoryLocker{}
+	a := &Scheduler{Locker: locker, Holder: "a", Logger: zap.NewNop()}
+	b := &Scheduler{Locker: locker, Holder: "b", Logger: zap.NewNop()}
+
+	var runsA, runsB int
+	jobA, jobB := countingJob("gc", &runsA), countingJob("gc", &runsB)
+	for i := 0; i < 3; i++ {
+		a.run(ctx, jobA)
+		b.run(ctx, jobB)
+	}
+	require.Equal(t, 3, runsA)
+	require.Equal(t, 0, runsB)
+
+	// Once a releases the lease, b takes over.
+	require.NoError(t, locker.Unlock(ctx, "gc", "a"))
+	b.run(ctx, jobB)
+	a.run(ctx, jobA)
+	require.Equal(t, 3, runsA)
+	require.Equal(t, 1, runsB)
+}
+
+func TestWithoutLocker(t *testing.T) {
+	ctx := context.Background()
+	a := &Scheduler{Holder: "a", Logger: zap.NewNop()}
+	b := &Scheduler{Holder: "b", Logger: zap.NewNop()}
+
+	var runsA, runsB int
+	a.run(ctx, countingJob("gc", &runsA))
+	b.run(ctx, countingJob("gc", &runsB))
+	require.Equal(t, 1, runsA)
+	require.Equal(t, 1, runsB)
+}
+
+func TestSkipIfStillRunning(t *testing.T) {
+	ctx := context.Background()
+	s := &Scheduler{Logger: zap.NewNop()}
+
+	var runs int
+	j := countingJob("gc", &runs)
+	j.running = 1
+	s.run(ctx, j)
+	require.Equal(t, 0, runs)
+
+	j.running = 0
+	s.run(ctx, j)
+	require.Equal(t, 1, runs)
+}
+
+func TestAdd(t *testing.T) {
+	s := &Scheduler{Logger: zap.NewNop()}
+	run := func(ctx context.Context) error { return nil }
+
+	require.NoError(t, s.Add(Job{Name: "gc", Spec: "@every 30m", Run: run}))
+	require.Error(t, s.Add(Job{Name: "gc", Spec: "@every 1h", Run: run}))
+	require.Error(t, s.Add(Job{Name: "purge", Spec: "whenever", Run: run}))
+	require.Error(t, s.Add(Job{Name: "purge", Spec: "@every 1h"}))
+
+	require.NoError(t, s.Start(context.Background()))
+	require.Error(t, s.Add(Job{Name: "purge", Spec: "@every 1h", Run: run}))
+	s.Stop()
+}
@@ -0,0 +1,275 @@
+package logging
+
+import (
+	"context"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"github.com/interuss/stacktrace"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// RequestIDHeader is the metadata key of the ID identifying each request
+	// in the logs, taken from the request when specified and returned in the
+	// response.
+	RequestIDHeader = "x-request-id"
+
+	// maxRequestIDLength bounds the length of the request IDs accepted from
+	// clients.
+	maxRequestIDLength = 128
+
+	// redacted replaces the values of redacted access log fields.
+	redacted = "[REDACTED]"
+)
+
+// The fields of the access log entry of each request.
+const (
+	AccessFieldRequestID = "request_id"
+	AccessFieldMethod    = "method"
+	AccessFieldSubject   = "subject"
+	AccessFieldCode      = "code"
+	AccessFieldLatency   = "latency"
+	AccessFieldEntityIDs = "entity_ids"
+	AccessFieldPeer      = "peer"
+	AccessFieldUserAgent = "user_agent"
+	AccessFieldTraceID   = "trace_id"
+)
+
+// AccessFields lists the fields of the access log entries, in the order they
+// are logged.
+var AccessFields = []string{
+	AccessFieldRequestID,
+	AccessFieldMethod,
+	AccessFieldSubject,
+	AccessFieldCode,
+	AccessFieldLatency,
+	AccessFieldEntityIDs,
+	AccessFieldPeer,
+	AccessFieldUserAgent,
+	AccessFieldTraceID,
+}
+
+// AccessLogConfig describes the access log entries of requests.
+type AccessLogConfig struct {
+	// Fields are the fields logged, among AccessFields; all of them when
+	// empty.
+	Fields []string
+	// Redact are the fields whose values are replaced by a placeholder, e.g.
+	// to keep the identities of clients out of the logs.
+	Redact []string
+	// SampleRatio is the fraction of the successful requests logged; failed
+	// requests are always logged.
+	SampleRatio float64
+}
+
+type requestIDKey struct{}
+
+// accessRecord collects the values of the access log entry of a request known
+// only to the handlers of inner interceptors, such as the subject.
+type accessRecord struct {
+	mu      sync.Mutex
+	subject string
+}
+
+type accessRecordKey struct{}
+
+// RequestIDFromContext returns the ID of the request of ctx, if any.
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIDKey{}).(string)
+	return id, ok
+}
+
+// SetAccessSubject records subject as the authenticated client of the request
+// of ctx in its access log entry.
+func SetAccessSubject(ctx context.Context, subject string) {
+	if record, ok := ctx.Value(accessRecordKey{}).(*accessRecord); ok {
+		record.mu.Lock()
+		record.subject = subject
+		record.mu.Unlock()
+	}
+}
+
+// requestID returns the valid request ID of the incoming metadata of ctx, or
+// a new one.
+func requestID(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(RequestIDHeader); len(values) > 0 && validRequestID(values[0]) {
+			return values[0]
+		}
+	}
+	return uuid.New().String()
+}
+
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range id {
+		if r > unicode.MaxASCII || !unicode.IsPrint(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// entityIDs returns the IDs of the entities identified by the fields of req.
+func entityIDs(req interface{}) []string {
+	var ids []string
+	if r, ok := req.(interface{ GetId() string }); ok && r.GetId() != "" {
+		ids = append(ids, r.GetId())
+	}
+	if r, ok := req.(interface{ GetEntityid() string }); ok && r.GetEntityid() != "" {
+		ids = append(ids, r.GetEntityid())
+	}
+	if r, ok := req.(interface{ GetSubscriptionid() string }); ok && r.GetSubscriptionid() != "" {
+		ids = append(ids, r.GetSubscriptionid())
+	}
+	if r, ok := req.(interface{ GetSubscriptionId() string }); ok && r.GetSubscriptionId() != "" {
+		ids = append(ids, r.GetSubscriptionId())
+	}
+	return ids
+}
+
+// firstMetadata returns the first value of the first of keys in the incoming
+// metadata of ctx.
+func firstMetadata(ctx context.Context, keys ...string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, key := range keys {
+		if values := md.Get(key); len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
+
+// peerAddress returns the address of the client of ctx, as forwarded by the
+// http-gateway when it proxies the request.
+func peerAddress(ctx context.Context) string {
+	if forwarded := firstMetadata(ctx, "x-forwarded-for"); forwarded != "" {
+		return strings.TrimSpace(strings.Split(forwarded, ",")[0])
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return ""
+}
+
+// ParseAccessFields parses a comma-separated list of access log fields.
+func ParseAccessFields(s string) ([]string, error) {
+	known := map[string]bool{}
+	for _, field := range AccessFields {
+		known[field] = true
+	}
+	var fields []string
+	for _, field := range strings.Split(s, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		if !known[field] {
+			return nil, stacktrace.NewError("Unknown access log field `%s`; must be among %s", field, strings.Join(AccessFields, ", "))
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// AccessLogInterceptor returns a grpc.UnaryServerInterceptor that identifies
+// each request with an ID, returned in the RequestIDHeader response header,
+// and logs an access log entry to "logger" once it completes.  It must
+// precede the interceptors converting errors to gRPC statuses, so that it
+// observes their status codes.
+func AccessLogInterceptor(logger *zap.Logger, config AccessLogConfig) (grpc.UnaryServerInterceptor, error) {
+	if config.SampleRatio < 0 || config.SampleRatio > 1 {
+		return nil, stacktrace.NewError("Access log sample ratio %g is not between 0 and 1", config.SampleRatio)
+	}
+	fields := map[string]bool{}
+	if len(config.Fields) == 0 {
+		config.Fields = AccessFields
+	}
+	for _, field := range config.Fields {
+		fields[field] = true
+	}
+	redact := map[string]bool{}
+	for _, field := range config.Redact {
+		redact[field] = true
+	}
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		id := requestID(ctx)
+		// Outside of an actual call, as in tests, there is no header to set.
+		_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, id))
+		record := &accessRecord{}
+		ctx = context.WithValue(ctx, requestIDKey{}, id)
+		ctx = context.WithValue(ctx, accessRecordKey{}, record)
+
+		resp, err := handler(ctx, req)
+
+		code := status.Code(err)
+		if err == nil && config.SampleRatio < 1 && rand.Float64() >= config.SampleRatio {
+			return resp, err
+		}
+
+		record.mu.Lock()
+		subject := record.subject
+		record.mu.Unlock()
+		entry := make([]zap.Field, 0, len(config.Fields))
+		value := func(field string, v string) zap.Field {
+			if redact[field] {
+				v = redacted
+			}
+			return zap.String(field, v)
+		}
+		for _, field := range AccessFields {
+			if !fields[field] {
+				continue
+			}
+			switch field {
+			case AccessFieldRequestID:
+				entry = append(entry, value(field, id))
+			case AccessFieldMethod:
+				entry = append(entry, value(field, info.FullMethod))
+			case AccessFieldSubject:
+				entry = append(entry, value(field, subject))
+			case AccessFieldCode:
+				entry = append(entry, value(field, code.String()))
+			case AccessFieldLatency:
+				entry = append(entry, zap.Duration(field, time.Since(start)))
+			case AccessFieldEntityIDs:
+				ids := entityIDs(req)
+				if redact[field] {
+					for i := range ids {
+						ids[i] = redacted
+					}
+				}
+				entry = append(entry, zap.Strings(field, ids))
+			case AccessFieldPeer:
+				entry = append(entry, value(field, peerAddress(ctx)))
+			case AccessFieldUserAgent:
+				entry = append(entry, value(field, firstMetadata(ctx, "grpcgateway-user-agent", "user-agent")))
+			case AccessFieldTraceID:
+				traceID := ""
+				if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+					traceID = sc.TraceID().String()
+				}
+				entry = append(entry, value(field, traceID))
+			}
+		}
+		logger.Info("access", entry...)
+		return resp, err
+	}, nil
+}
@@ -0,0 +1,83 @@
+package logging
+
+import (
+	"context"
+	"testing"
+
+	"github.com/interuss/stacktrace"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type getRequest struct{ id string }
+
+func (r *getRequest) GetId() string { return r.id }
+
+func TestAccessLogInterceptor(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	interceptor, err := AccessLogInterceptor(zap.New(core), AccessLogConfig{
+		Fields:      []string{AccessFieldRequestID, AccessFieldSubject, AccessFieldCode, AccessFieldEntityIDs},
+		Redact:      []string{AccessFieldSubject},
+		SampleRatio: 1,
+	})
+	require.NoError(t, err)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDHeader, "abc"))
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Get"}
+	_, err = interceptor(ctx, &getRequest{id: "isa1"}, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		id, ok := RequestIDFromContext(ctx)
+		require.True(t, ok)
+		require.Equal(t, "abc", id)
+		SetAccessSubject(ctx, "uss1")
+		return nil, status.Error(codes.NotFound, "not found")
+	})
+	require.Error(t, err)
+
+	entries := logs.All()
+	require.Len(t, entries, 1)
+	fields := entries[0].ContextMap()
+	require.Equal(t, map[string]interface{}{
+		AccessFieldRequestID: "abc",
+		AccessFieldSubject:   redacted,
+		AccessFieldCode:      "NotFound",
+		AccessFieldEntityIDs: []interface{}{"isa1"},
+	}, fields)
+}
+
+func TestAccessLogSampling(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	interceptor, err := AccessLogInterceptor(zap.New(core), AccessLogConfig{SampleRatio: 0})
+	require.NoError(t, err)
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Get"}
+	_, err = interceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	require.NoError(t, err)
+	require.Zero(t, logs.Len())
+
+	// Failures are logged regardless of sampling.
+	_, err = interceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, stacktrace.NewError("failure")
+	})
+	require.Error(t, err)
+	require.Equal(t, 1, logs.Len())
+	require.NotEmpty(t, logs.All()[0].ContextMap()[AccessFieldRequestID])
+
+	_, err = AccessLogInterceptor(zap.NewNop(), AccessLogConfig{SampleRatio: 2})
+	require.Error(t, err)
+}
+
+func TestParseAccessFields(t *testing.T) {
+	fields, err := ParseAccessFields(" subject,code ,")
+	require.NoError(t, err)
+	require.Equal(t, []string{AccessFieldSubject, AccessFieldCode}, fields)
+
+	_, err = ParseAccessFields("subject,password")
+	require.Error(t, err)
+}
@@ -82,6 +82,12 @@ func Configure(level string, format string) error {
 	return setUpLogger(level, format)
 }
 
+// SetLevel changes the log "level" of the loggers configured with Configure,
+// without reconfiguring them.
+func SetLevel(level string) error {
+	return DefaultLevel.UnmarshalText([]byte(level))
+}
+
 // Interceptor returns a grpc.UnaryServerInterceptor that logs incoming requests
 // and associated tags to "logger".
 func Interceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
@@ -97,7 +103,9 @@ func Interceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
 // WithValuesFromContext augments logger with relevant fields from ctx and returns
 // the the resulting logger.
 func WithValuesFromContext(ctx context.Context, logger *zap.Logger) *zap.Logger {
-	// Naive implementation for now, meant to evolve over time.
+	if id, ok := RequestIDFromContext(ctx); ok {
+		return logger.With(zap.String(AccessFieldRequestID, id))
+	}
 	return logger
 }
 
@@ -0,0 +1,3 @@
+// Package maintenance rejects the requests to the DSS during the maintenance
+// windows of its pool, e.g. while it is upgraded.
+package maintenance
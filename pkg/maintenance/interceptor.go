@@ -0,0 +1,62 @@
+package maintenance
+
+import (
+	"context"
+	"math"
+	"strconv"
+
+	"github.com/interuss/dss/pkg/auth"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/dss/pkg/ratelimit"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Reason is the reason of the errors of the requests rejected during
+// maintenance windows.
+const Reason = "maintenance"
+
+var rejectedRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "dss_maintenance_rejected_requests_total",
+	Help: "Number of requests rejected during maintenance windows, by method.",
+}, []string{"method"})
+
+// Interceptor returns a grpc.UnaryServerInterceptor rejecting the requests to
+// mutations, and to reads unless the window allows them, while the
+// maintenance window of m is active, asking clients to retry once it ends.
+func Interceptor(m *Mode, mutations []auth.Operation, reads []auth.Operation) grpc.UnaryServerInterceptor {
+	kinds := map[auth.Operation]bool{}
+	for _, op := range reads {
+		kinds[op] = false
+	}
+	for _, op := range mutations {
+		kinds[op] = true
+	}
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		mutation, ok := kinds[auth.Operation(info.FullMethod)]
+		if !ok {
+			return handler(ctx, req)
+		}
+		now := m.Clock.Now()
+		w := m.Window()
+		if !w.Active(now) || (!mutation && w.ReadsAvailable) {
+			return handler(ctx, req)
+		}
+
+		rejectedRequests.WithLabelValues(info.FullMethod).Inc()
+		retryAfter := int(math.Ceil(w.RetryAfter(now).Seconds()))
+		_ = grpc.SetHeader(ctx, metadata.Pairs(ratelimit.RetryAfterHeader, strconv.Itoa(retryAfter)))
+		until := "until further notice"
+		if !w.End.IsZero() {
+			until = "until " + w.End.UTC().Format("2006-01-02T15:04:05Z")
+		}
+		message := w.Message
+		if message == "" {
+			message = "scheduled maintenance"
+		}
+		return nil, dsserr.NewErrorWithReason(dsserr.Unavailable, Reason,
+			"DSS pool under maintenance %s (%s); retry after %d s", until, message, retryAfter)
+	}
+}
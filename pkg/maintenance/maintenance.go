@@ -0,0 +1,234 @@
+package maintenance
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/interuss/dss/pkg/cockroach"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/stacktrace"
+	"github.com/jackc/pgx/v4"
+	"github.com/jonboulle/clockwork"
+)
+
+const (
+	// DefaultRetryAfter is how long clients are asked to wait before retrying
+	// the requests rejected during maintenance windows without end.
+	DefaultRetryAfter = time.Minute
+
+	// RefreshInterval is the interval at which each DSS instance reads the
+	// maintenance window of its pool.
+	RefreshInterval = 10 * time.Second
+
+	// windowID identifies the single maintenance window of the pool.
+	windowID = "pool"
+)
+
+// Window is a maintenance window, during which the DSS rejects mutations,
+// and reads unless ReadsAvailable.
+type Window struct {
+	Start time.Time
+	// End is when the window ends, or zero if it lasts until cancelled.
+	End time.Time
+	// ReadsAvailable is whether the DSS keeps serving reads during the
+	// window.
+	ReadsAvailable bool
+	// Message explains the maintenance to clients.
+	Message string
+	// ScheduledBy identifies the administrator who scheduled the window.
+	ScheduledBy string
+	ScheduledAt time.Time
+}
+
+// Active returns whether w is in progress at now.
+func (w *Window) Active(now time.Time) bool {
+	return w != nil && !now.Before(w.Start) && (w.End.IsZero() || now.Before(w.End))
+}
+
+// Over returns whether w ended by now.
+func (w *Window) Over(now time.Time) bool {
+	return w == nil || (!w.End.IsZero() && !now.Before(w.End))
+}
+
+// RetryAfter returns how long clients should wait at now before retrying the
+// requests rejected during w.
+func (w *Window) RetryAfter(now time.Time) time.Duration {
+	if w.End.IsZero() {
+		return DefaultRetryAfter
+	}
+	return w.End.Sub(now)
+}
+
+// Store holds the maintenance window of a pool.
+type Store interface {
+	// Get returns the maintenance window, or nil if there is none.
+	Get(ctx context.Context) (*Window, error)
+	// Put replaces the maintenance window with w.
+	Put(ctx context.Context, w Window) error
+	// Delete deletes the maintenance window, if any.
+	Delete(ctx context.Context) error
+}
+
+// MemoryStore holds the maintenance window of a single DSS instance in
+// memory.
+type MemoryStore struct {
+	mu     sync.Mutex
+	window *Window
+}
+
+// Get implements Store.
+func (s *MemoryStore) Get(context.Context) (*Window, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.window == nil {
+		return nil, nil
+	}
+	w := *s.window
+	return &w, nil
+}
+
+// Put implements Store.
+func (s *MemoryStore) Put(_ context.Context, w Window) error {
+	s.mu.Lock()
+	s.window = &w
+	s.mu.Unlock()
+	return nil
+}
+
+// Delete implements Store.
+func (s *MemoryStore) Delete(context.Context) error {
+	s.mu.Lock()
+	s.window = nil
+	s.mu.Unlock()
+	return nil
+}
+
+// DatastoreStore holds the maintenance window of a pool in the
+// maintenance_windows table of the database DB is connected to, shared by
+// the DSS instances of the pool.
+type DatastoreStore struct {
+	DB *cockroach.DB
+}
+
+// Get implements Store.
+func (s *DatastoreStore) Get(ctx context.Context) (*Window, error) {
+	const query = `
+		SELECT starts_at, ends_at, reads_available, message, scheduled_by, scheduled_at
+		FROM maintenance_windows
+		WHERE id = $1`
+	var (
+		w   Window
+		end *time.Time
+	)
+	err := s.DB.Pool.QueryRow(ctx, query, windowID).Scan(&w.Start, &end, &w.ReadsAvailable, &w.Message, &w.ScheduledBy, &w.ScheduledAt)
+	switch {
+	case err == pgx.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, stacktrace.Propagate(err, "Error in query: %s", query)
+	}
+	if end != nil {
+		w.End = *end
+	}
+	return &w, nil
+}
+
+// Put implements Store.
+func (s *DatastoreStore) Put(ctx context.Context, w Window) error {
+	const query = `
+		INSERT INTO maintenance_windows (id, starts_at, ends_at, reads_available, message, scheduled_by, scheduled_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (id) DO UPDATE SET
+			starts_at = excluded.starts_at,
+			ends_at = excluded.ends_at,
+			reads_available = excluded.reads_available,
+			message = excluded.message,
+			scheduled_by = excluded.scheduled_by,
+			scheduled_at = excluded.scheduled_at`
+	var end *time.Time
+	if !w.End.IsZero() {
+		end = &w.End
+	}
+	if _, err := s.DB.Pool.Exec(ctx, query, windowID, w.Start, end, w.ReadsAvailable, w.Message, w.ScheduledBy, w.ScheduledAt); err != nil {
+		return stacktrace.Propagate(err, "Error in query: %s", query)
+	}
+	return nil
+}
+
+// Delete implements Store.
+func (s *DatastoreStore) Delete(ctx context.Context) error {
+	const query = `DELETE FROM maintenance_windows WHERE id = $1`
+	if _, err := s.DB.Pool.Exec(ctx, query, windowID); err != nil {
+		return stacktrace.Propagate(err, "Error in query: %s", query)
+	}
+	return nil
+}
+
+// Mode tracks the maintenance window of the pool held in Store, as of its
+// latest Refresh.
+type Mode struct {
+	Store Store
+	Clock clockwork.Clock
+
+	mu     sync.RWMutex
+	window *Window
+}
+
+// Refresh reads the maintenance window of the pool from m.Store.
+func (m *Mode) Refresh(ctx context.Context) error {
+	w, err := m.Store.Get(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "Could not read maintenance window")
+	}
+	m.mu.Lock()
+	m.window = w
+	m.mu.Unlock()
+	return nil
+}
+
+// Window returns the maintenance window of the pool which has not ended yet,
+// if any.
+func (m *Mode) Window() *Window {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.window.Over(m.Clock.Now()) {
+		return nil
+	}
+	w := *m.window
+	return &w
+}
+
+// Schedule replaces the maintenance window of the pool with w, starting
+// immediately when w.Start is zero, and returns it.
+func (m *Mode) Schedule(ctx context.Context, w Window) (*Window, error) {
+	now := m.Clock.Now()
+	if w.Start.IsZero() {
+		w.Start = now
+	}
+	if !w.End.IsZero() && !w.End.After(w.Start) {
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Maintenance window must end after it starts")
+	}
+	if w.Over(now) {
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Maintenance window must end in the future")
+	}
+	w.ScheduledAt = now
+	if err := m.Store.Put(ctx, w); err != nil {
+		return nil, stacktrace.Propagate(err, "Could not schedule maintenance window")
+	}
+	m.mu.Lock()
+	m.window = &w
+	m.mu.Unlock()
+	return &w, nil
+}
+
+// Cancel ends the maintenance window of the pool, if any.
+func (m *Mode) Cancel(ctx context.Context) error {
+	if err := m.Store.Delete(ctx); err != nil {
+		return stacktrace.Propagate(err, "Could not cancel maintenance window")
+	}
+	m.mu.Lock()
+	m.window = nil
+	m.mu.Unlock()
+	return nil
+}
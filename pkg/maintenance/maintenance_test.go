@@ -0,0 +1,100 @@
+package maintenance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/interuss/dss/pkg/auth"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/stacktrace"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestWindow(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	w := &Window{Start: start, End: start.Add(time.Hour)}
+	require.False(t, w.Active(start.Add(-time.Second)))
+	require.True(t, w.Active(start))
+	require.Equal(t, 30*time.Minute, w.RetryAfter(start.Add(30*time.Minute)))
+	require.False(t, w.Active(start.Add(time.Hour)))
+	require.True(t, w.Over(start.Add(time.Hour)))
+
+	w = &Window{Start: start}
+	require.True(t, w.Active(start.Add(1000*time.Hour)))
+	require.False(t, w.Over(start.Add(1000*time.Hour)))
+	require.Equal(t, DefaultRetryAfter, w.RetryAfter(start))
+
+	var none *Window
+	require.False(t, none.Active(start))
+}
+
+func TestMode(t *testing.T) {
+	ctx := context.Background()
+	clock := clockwork.NewFakeClock()
+	store := &MemoryStore{}
+	m := &Mode{Store: store, Clock: clock}
+	require.Nil(t, m.Window())
+
+	_, err := m.Schedule(ctx, Window{Start: clock.Now().Add(time.Hour), End: clock.Now().Add(time.Minute)})
+	require.Error(t, err)
+	require.Equal(t, dsserr.BadRequest, stacktrace.GetCode(err))
+	_, err = m.Schedule(ctx, Window{Start: clock.Now().Add(-time.Hour), End: clock.Now().Add(-time.Minute)})
+	require.Error(t, err)
+
+	w, err := m.Schedule(ctx, Window{End: clock.Now().Add(time.Hour), ScheduledBy: "admin"})
+	require.NoError(t, err)
+	require.Equal(t, clock.Now(), w.Start)
+	require.True(t, m.Window().Active(clock.Now()))
+
+	// Other instances sharing the store see the window once refreshed.
+	other := &Mode{Store: store, Clock: clock}
+	require.Nil(t, other.Window())
+	require.NoError(t, other.Refresh(ctx))
+	require.Equal(t, "admin", other.Window().ScheduledBy)
+
+	clock.Advance(time.Hour)
+	require.Nil(t, m.Window())
+
+	_, err = m.Schedule(ctx, Window{})
+	require.NoError(t, err)
+	require.NoError(t, m.Cancel(ctx))
+	require.Nil(t, m.Window())
+	require.NoError(t, other.Refresh(ctx))
+	require.Nil(t, other.Window())
+}
+
+func TestInterceptor(t *testing.T) {
+	ctx := context.Background()
+	clock := clockwork.NewFakeClock()
+	m := &Mode{Store: &MemoryStore{}, Clock: clock}
+	interceptor := Interceptor(m, []auth.Operation{"/test.Service/Put"}, []auth.Operation{"/test.Service/Get"})
+	call := func(method string) error {
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, nil
+		})
+		return err
+	}
+
+	_, err := m.Schedule(ctx, Window{Start: clock.Now().Add(time.Minute), End: clock.Now().Add(time.Hour), ReadsAvailable: true})
+	require.NoError(t, err)
+	require.NoError(t, call("/test.Service/Put"))
+
+	clock.Advance(time.Minute)
+	err = call("/test.Service/Put")
+	require.Error(t, err)
+	require.Equal(t, dsserr.Unavailable, stacktrace.GetCode(err))
+	require.Contains(t, err.Error(), "retry after 3540 s")
+	require.NoError(t, call("/test.Service/Get"))
+	require.NoError(t, call("/test.Service/Other"))
+
+	_, err = m.Schedule(ctx, Window{})
+	require.NoError(t, err)
+	require.Error(t, call("/test.Service/Get"))
+	require.NoError(t, call("/test.Service/Other"))
+
+	require.NoError(t, m.Cancel(ctx))
+	require.NoError(t, call("/test.Service/Put"))
+}
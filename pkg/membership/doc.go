@@ -0,0 +1,4 @@
+// Package membership registers the DSS instances of a pool in their shared
+// remote ID database, for operators to find the instances which stopped
+// participating or run another version.
+package membership
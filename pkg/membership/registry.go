@@ -0,0 +1,172 @@
+package membership
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/interuss/dss/pkg/cockroach"
+	"github.com/interuss/stacktrace"
+	"github.com/jackc/pgx/v4"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultHeartbeatInterval is the default interval between the heartbeats
+	// of each instance.
+	DefaultHeartbeatInterval = 30 * time.Second
+
+	// staleHeartbeats is the number of heartbeats an instance may miss before
+	// it is reported stale.
+	staleHeartbeats = 3
+
+	// retention is the duration after which instances which stopped sending
+	// heartbeats are forgotten.
+	retention = 7 * 24 * time.Hour
+)
+
+// Instance describes a DSS instance of the pool.
+type Instance struct {
+	// ID identifies the instance in the pool, and should remain the same
+	// across restarts.
+	ID string
+	// Process identifies the process running as the instance, to detect
+	// several processes using the same ID.
+	Process  string
+	Hostname string
+	Locality string
+	Version  string
+	Commit   string
+	// StartedAt is when the process started, per its clock.
+	StartedAt time.Time
+	// ReportedAt is when the instance sent its latest heartbeat, per its
+	// clock.
+	ReportedAt time.Time
+	// LastSeenAt is when the latest heartbeat of the instance was received,
+	// per the clock of the datastore.
+	LastSeenAt time.Time
+}
+
+// ClockSkew returns by how much the clock of the instance was ahead of the
+// clock of the datastore upon its latest heartbeat, including the latency of
+// the heartbeat.
+func (i Instance) ClockSkew() time.Duration {
+	return i.ReportedAt.Sub(i.LastSeenAt)
+}
+
+// Member is an Instance as listed by a Registry.
+type Member struct {
+	Instance
+	// SinceSeen is the duration since the latest heartbeat of the instance,
+	// per the clock of the datastore.
+	SinceSeen time.Duration
+	// Stale is whether the instance missed several heartbeats.
+	Stale bool
+}
+
+// Registry registers the instances of the pool in the dss_instances table of
+// the database DB is connected to.
+type Registry struct {
+	DB *cockroach.DB
+	// Self is the instance running this process.
+	Self Instance
+	// HeartbeatInterval is the interval between the heartbeats of each
+	// instance, DefaultHeartbeatInterval when 0.
+	HeartbeatInterval time.Duration
+	Logger            *zap.Logger
+}
+
+// NewProcess returns a new ID of the process running an Instance.
+func NewProcess() string {
+	return uuid.New().String()
+}
+
+func (r *Registry) heartbeatInterval() time.Duration {
+	if r.HeartbeatInterval <= 0 {
+		return DefaultHeartbeatInterval
+	}
+	return r.HeartbeatInterval
+}
+
+// Heartbeat records that the instance of this process is alive, and forgets
+// the instances which stopped sending heartbeats long ago.  It warns when
+// another live process registers with the same instance ID.
+func (r *Registry) Heartbeat(ctx context.Context) error {
+	const selectQuery = `SELECT process, hostname, (now() - last_seen_at) < $2::INT8 * INTERVAL '1 microsecond' FROM dss_instances WHERE id = $1`
+	var (
+		process, hostname string
+		live              bool
+	)
+	staleAfter := staleHeartbeats * r.heartbeatInterval()
+	err := r.DB.Pool.QueryRow(ctx, selectQuery, r.Self.ID, staleAfter.Microseconds()).Scan(&process, &hostname, &live)
+	switch {
+	case err == pgx.ErrNoRows:
+	case err != nil:
+		return stacktrace.Propagate(err, "Error in query: %s", selectQuery)
+	case process != r.Self.Process && live && r.Logger != nil:
+		r.Logger.Warn("Another live DSS process registered with the same instance ID; instance IDs must be unique in the pool",
+			zap.String("instance_id", r.Self.ID), zap.String("other_hostname", hostname))
+	}
+
+	const upsertQuery = `
+		INSERT INTO dss_instances (id, process, hostname, locality, version, commit_hash, started_at, reported_at, last_seen_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, now())
+		ON CONFLICT (id) DO UPDATE SET
+			process = excluded.process,
+			hostname = excluded.hostname,
+			locality = excluded.locality,
+			version = excluded.version,
+			commit_hash = excluded.commit_hash,
+			started_at = excluded.started_at,
+			reported_at = excluded.reported_at,
+			last_seen_at = excluded.last_seen_at`
+	i := r.Self
+	if _, err := r.DB.Pool.Exec(ctx, upsertQuery, i.ID, i.Process, i.Hostname, i.Locality, i.Version, i.Commit, i.StartedAt, time.Now()); err != nil {
+		return stacktrace.Propagate(err, "Error in query: %s", upsertQuery)
+	}
+
+	const deleteQuery = `DELETE FROM dss_instances WHERE last_seen_at < now() - $1::INT8 * INTERVAL '1 microsecond'`
+	if _, err := r.DB.Pool.Exec(ctx, deleteQuery, retention.Microseconds()); err != nil {
+		return stacktrace.Propagate(err, "Error in query: %s", deleteQuery)
+	}
+	return nil
+}
+
+// List returns the instances of the pool, ordered by ID, and the current
+// time per the clock of the datastore.
+func (r *Registry) List(ctx context.Context) ([]Member, time.Time, error) {
+	const query = `
+		SELECT id, process, hostname, locality, version, commit_hash, started_at, reported_at, last_seen_at, now()
+		FROM dss_instances
+		ORDER BY id`
+	rows, err := r.DB.Pool.Query(ctx, query)
+	if err != nil {
+		return nil, time.Time{}, stacktrace.Propagate(err, "Error in query: %s", query)
+	}
+	defer rows.Close()
+
+	var (
+		members []Member
+		now     time.Time
+	)
+	for rows.Next() {
+		var i Instance
+		if err := rows.Scan(&i.ID, &i.Process, &i.Hostname, &i.Locality, &i.Version, &i.Commit, &i.StartedAt, &i.ReportedAt, &i.LastSeenAt, &now); err != nil {
+			return nil, time.Time{}, stacktrace.Propagate(err, "Error scanning instance row")
+		}
+		members = append(members, r.member(i, now))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, time.Time{}, stacktrace.Propagate(err, "Error in rows query result")
+	}
+	return members, now, nil
+}
+
+func (r *Registry) member(i Instance, now time.Time) Member {
+	sinceSeen := now.Sub(i.LastSeenAt)
+	return Member{
+		Instance:  i,
+		SinceSeen: sinceSeen,
+		Stale:     sinceSeen > staleHeartbeats*r.heartbeatInterval(),
+	}
+}
@@ -0,0 +1,26 @@
+package membership
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMember(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	r := &Registry{HeartbeatInterval: 10 * time.Second}
+
+	live := r.member(Instance{ID: "a", LastSeenAt: now.Add(-25 * time.Second), ReportedAt: now.Add(-24 * time.Second)}, now)
+	require.False(t, live.Stale)
+	require.Equal(t, 25*time.Second, live.SinceSeen)
+	require.Equal(t, time.Second, live.ClockSkew())
+
+	stale := r.member(Instance{ID: "b", LastSeenAt: now.Add(-31 * time.Second)}, now)
+	require.True(t, stale.Stale)
+
+	// Instances are stale after missing 3 default heartbeats when the
+	// interval is not specified.
+	r.HeartbeatInterval = 0
+	require.False(t, r.member(Instance{ID: "b", LastSeenAt: now.Add(-31 * time.Second)}, now).Stale)
+}
@@ -0,0 +1,3 @@
+// Package metrics measures the gRPC and HTTP requests served by the DSS as
+// Prometheus metrics of the default registry.
+package metrics
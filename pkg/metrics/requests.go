@@ -0,0 +1,89 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	grpcRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "dss_grpc_requests_total",
+		Help: "Number of gRPC requests served, by method and status code.",
+	}, []string{"method", "code"})
+	grpcRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "dss_grpc_request_duration_seconds",
+		Help: "Duration of the gRPC requests served, by method.",
+	}, []string{"method"})
+
+	httpRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "dss_http_requests_total",
+		Help: "Number of HTTP requests served, by route and status code.",
+	}, []string{"route", "code"})
+	httpRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "dss_http_request_duration_seconds",
+		Help: "Duration of the HTTP requests served, by route.",
+	}, []string{"route"})
+)
+
+// Interceptor returns a grpc.UnaryServerInterceptor counting requests and
+// measuring their durations.  It must precede the interceptors converting
+// errors to gRPC statuses, so that it observes their status codes.
+func Interceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		grpcRequestDuration.WithLabelValues(info.FullMethod).Observe(time.Since(start).Seconds())
+		grpcRequests.WithLabelValues(info.FullMethod, status.Code(err).String()).Inc()
+		return resp, err
+	}
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+func (w *statusRecorder) WriteHeader(statusCode int) {
+	if w.statusCode == 0 {
+		w.statusCode = statusCode
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *statusRecorder) Write(data []byte) (int, error) {
+	if w.statusCode == 0 {
+		w.statusCode = http.StatusOK
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+// Flush implements http.Flusher when the underlying ResponseWriter does, for
+// streamed responses.
+func (w *statusRecorder) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// HTTPMiddleware counts the requests served by handler and measures their
+// durations, labeled by route(r), which must take a small number of values.
+func HTTPMiddleware(route func(r *http.Request) string, handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		recorder := &statusRecorder{ResponseWriter: w}
+		handler.ServeHTTP(recorder, r)
+		if recorder.statusCode == 0 {
+			recorder.statusCode = http.StatusOK
+		}
+		label := route(r)
+		httpRequestDuration.WithLabelValues(label).Observe(time.Since(start).Seconds())
+		httpRequests.WithLabelValues(label, strconv.Itoa(recorder.statusCode)).Inc()
+	})
+}
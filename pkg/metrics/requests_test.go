@@ -0,0 +1,40 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Fail"}
+	_, err := Interceptor()(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "not found")
+	})
+	require.Error(t, err)
+
+	require.Equal(t, float64(1), testutil.ToFloat64(grpcRequests.WithLabelValues(info.FullMethod, "NotFound")))
+	require.Equal(t, 1, testutil.CollectAndCount(grpcRequestDuration))
+}
+
+func TestHTTPMiddleware(t *testing.T) {
+	handler := HTTPMiddleware(func(r *http.Request) string { return "test" }, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+		_, _ = w.Write([]byte("body"))
+	}))
+	for _, path := range []string{"/found", "/missing", "/found"} {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	require.Equal(t, float64(2), testutil.ToFloat64(httpRequests.WithLabelValues("test", "200")))
+	require.Equal(t, float64(1), testutil.ToFloat64(httpRequests.WithLabelValues("test", "404")))
+}
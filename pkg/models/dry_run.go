@@ -0,0 +1,40 @@
+package models
+
+import (
+	"context"
+	"strconv"
+
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/stacktrace"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// DryRunHeader is the metadata key requesting, with value "true", that a
+	// mutation be validated and evaluated without being committed (set by the
+	// http gateway from the dry_run query parameter), and confirming in the
+	// response that nothing was committed.
+	DryRunHeader = "dss-dry-run"
+
+	// DryRunParameter is the query parameter from which the http gateway sets
+	// DryRunHeader.
+	DryRunParameter = "dry_run"
+)
+
+// DryRunFromContext returns whether the request in ctx asks for a dry run
+// through DryRunHeader.
+func DryRunFromContext(ctx context.Context) (bool, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false, nil
+	}
+	values := md.Get(DryRunHeader)
+	if len(values) == 0 || values[0] == "" {
+		return false, nil
+	}
+	dryRun, err := strconv.ParseBool(values[0])
+	if err != nil {
+		return false, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid %s: `%s`", DryRunParameter, values[0])
+	}
+	return dryRun, nil
+}
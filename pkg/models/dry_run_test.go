@@ -0,0 +1,41 @@
+package models
+
+import (
+	"context"
+	"testing"
+
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/stacktrace"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestDryRunFromContext(t *testing.T) {
+	for _, r := range []struct {
+		name    string
+		value   string
+		want    bool
+		wantErr bool
+	}{
+		{"no header", "", false, false},
+		{"true", "true", true, false},
+		{"false", "false", false, false},
+		{"numeric", "1", true, false},
+		{"invalid", "maybe", false, true},
+	} {
+		t.Run(r.name, func(t *testing.T) {
+			ctx := context.Background()
+			if r.value != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(DryRunHeader, r.value))
+			}
+			dryRun, err := DryRunFromContext(ctx)
+			if r.wantErr {
+				require.Error(t, err)
+				require.Equal(t, dsserr.BadRequest, stacktrace.GetCode(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, r.want, dryRun)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"context"
+	"strings"
+
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/stacktrace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// ETagHeader is the response metadata key carrying the entity tag of the
+	// returned entity, derived from its version.
+	ETagHeader = "etag"
+
+	// ifNoneMatchHeaders are the request metadata keys under which the
+	// If-None-Match header may be received, directly or as forwarded by the
+	// http gateway.
+	ifNoneMatchHeader        = "if-none-match"
+	gatewayIfNoneMatchHeader = "grpcgateway-if-none-match"
+)
+
+// ETag returns the entity tag of an entity at version, further distinguished
+// by the optional qualifiers for state that changes without the version
+// changing.
+func ETag(version *Version, qualifiers ...string) string {
+	return `"` + strings.Join(append([]string{version.String()}, qualifiers...), "-") + `"`
+}
+
+// CheckNotModified sets etag in the response metadata of ctx, and returns a
+// dsserr.NotModified error if the request declared, through If-None-Match,
+// that it already holds the entity with that tag.
+func CheckNotModified(ctx context.Context, etag string) error {
+	_ = grpc.SetHeader(ctx, metadata.Pairs(ETagHeader, etag))
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+	for _, key := range []string{ifNoneMatchHeader, gatewayIfNoneMatchHeader} {
+		for _, value := range md.Get(key) {
+			for _, candidate := range strings.Split(value, ",") {
+				candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+				if candidate == "*" || candidate == etag {
+					return stacktrace.NewErrorWithCode(dsserr.NotModified, "Entity unchanged since %s", etag)
+				}
+			}
+		}
+	}
+	return nil
+}
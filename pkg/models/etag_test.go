@@ -0,0 +1,45 @@
+package models
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/stacktrace"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestCheckNotModified(t *testing.T) {
+	version := VersionFromTime(time.Unix(1600000000, 0))
+	other := VersionFromTime(time.Unix(1600000001, 0))
+
+	for _, r := range []struct {
+		name        string
+		ifNoneMatch []string
+		wantErr     bool
+	}{
+		{"no header", nil, false},
+		{"matching", []string{ETag(version)}, true},
+		{"weak matching", []string{"W/" + ETag(version)}, true},
+		{"matching in list", []string{ETag(other) + ", " + ETag(version)}, true},
+		{"wildcard", []string{"*"}, true},
+		{"stale", []string{ETag(other)}, false},
+		{"stale qualifier", []string{ETag(version, "1")}, false},
+	} {
+		t.Run(r.name, func(t *testing.T) {
+			ctx := context.Background()
+			if r.ifNoneMatch != nil {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(gatewayIfNoneMatchHeader, r.ifNoneMatch[0]))
+			}
+			err := CheckNotModified(ctx, ETag(version))
+			if r.wantErr {
+				require.Error(t, err)
+				require.Equal(t, dsserr.NotModified, stacktrace.GetCode(err))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
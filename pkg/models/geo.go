@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/golang/geo/s2"
+	dsserr "github.com/interuss/dss/pkg/errors"
 	"github.com/interuss/dss/pkg/geo"
 	"github.com/interuss/stacktrace"
 )
@@ -16,16 +17,44 @@ const (
 	minLng            = -180.0
 	maxLng            = 180.0
 	UnitsM            = "M"
+	UnitsFT           = "FT"
+	UnitsFL           = "FL"
 	ReferenceW84      = "W84"
+	ReferenceWGS84    = "WGS84"
+	ReferenceEGM96    = "EGM96"
+	ReferenceEGM2008  = "EGM2008"
+	ReferenceSTD      = "STD"
+
+	// MinAltitude and MaxAltitude bound (in meters above the WGS84 ellipsoid)
+	// the altitudes accepted in volumes.
+	MinAltitude = -8000
+	MaxAltitude = 100000
 )
 
 var (
 	unitToMeterMultiplicativeFactors = map[unit]float32{
 		unitMeter: 1,
+		unitFeet:  0.3048,
 	}
 
-	altitudeReferenceWGS84 altitudeReference = "W84"
-	unitMeter              unit              = "M"
+	// altitudeReferenceDatums are the vertical datums of the supported
+	// altitude references.
+	altitudeReferenceDatums = map[altitudeReference]geo.VerticalDatum{
+		altitudeReferenceWGS84:      geo.DatumWGS84,
+		altitudeReferenceWGS84Alias: geo.DatumWGS84,
+		altitudeReferenceEGM96:      geo.DatumEGM96,
+		altitudeReferenceEGM2008:    geo.DatumEGM2008,
+		altitudeReferenceSTD:        geo.DatumPressure,
+	}
+
+	altitudeReferenceWGS84      altitudeReference = "W84"
+	altitudeReferenceWGS84Alias altitudeReference = "WGS84"
+	altitudeReferenceEGM96      altitudeReference = "EGM96"
+	altitudeReferenceEGM2008    altitudeReference = "EGM2008"
+	altitudeReferenceSTD        altitudeReference = "STD"
+	unitMeter                   unit              = "M"
+	unitFeet                    unit              = "FT"
+	unitFlightLevel             unit              = "FL"
 )
 
 type (
@@ -72,6 +101,94 @@ type Volume3D struct {
 	Footprint Geometry
 }
 
+// AltitudeToWGS84Meters converts an altitude of value units relative to
+// reference at position into meters above the WGS84 ellipsoid, so that
+// volumes submitted relative to different references overlap consistently.
+// Flight levels, in units of UnitsFL, are only relative to ReferenceSTD, and
+// only altitudes relative to the WGS84 ellipsoid convert without a position.
+func AltitudeToWGS84Meters(value float64, reference string, units string, position *s2.LatLng) (float32, error) {
+	datum, ok := altitudeReferenceDatums[altitudeReference(reference)]
+	if !ok {
+		return 0, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Invalid altitude reference '%s'; expected one of '%s', '%s', '%s', '%s' or '%s'",
+			reference, ReferenceWGS84, ReferenceW84, ReferenceEGM96, ReferenceEGM2008, ReferenceSTD)
+	}
+	var meters float64
+	switch {
+	case unit(units) == unitFlightLevel && datum == geo.DatumPressure:
+		meters = geo.FlightLevelToMeters(value)
+	case unit(units) == unitFlightLevel:
+		return 0, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Flight levels must be relative to '%s'", ReferenceSTD)
+	default:
+		factor, ok := unitToMeterMultiplicativeFactors[unit(units)]
+		if !ok {
+			return 0, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Invalid altitude units '%s'; expected '%s', '%s' or '%s'", units, UnitsM, UnitsFT, UnitsFL)
+		}
+		meters = value * float64(factor)
+	}
+	if datum == geo.DatumWGS84 {
+		return float32(meters), nil
+	}
+	if position == nil {
+		return 0, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Altitudes relative to '%s' require a footprint", reference)
+	}
+	meters, err := geo.ConvertHeight(meters, datum, geo.DatumWGS84, *position)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "Error converting altitude relative to '%s'", reference)
+	}
+	return float32(meters), nil
+}
+
+// FootprintPosition returns the position of footprint at which its altitudes
+// are converted to the WGS84 ellipsoid: the center of a GeoCircle or the mean
+// of the vertices of a GeoPolygon, as the separation between the datums
+// varies little across the largest areas accepted.  It returns nil for other
+// or empty footprints.
+func FootprintPosition(footprint Geometry) *s2.LatLng {
+	switch f := footprint.(type) {
+	case *GeoCircle:
+		if f == nil {
+			return nil
+		}
+		position := s2.LatLngFromDegrees(f.Center.Lat, f.Center.Lng)
+		return &position
+	case *GeoPolygon:
+		if f == nil {
+			return nil
+		}
+		var sum s2.Point
+		for _, v := range f.Vertices {
+			sum = s2.Point{Vector: sum.Add(s2.PointFromLatLng(s2.LatLngFromDegrees(v.Lat, v.Lng)).Vector)}
+		}
+		if len(f.Vertices) > 0 && sum.Norm() > 0 {
+			position := s2.LatLngFromPoint(sum)
+			return &position
+		}
+	}
+	return nil
+}
+
+// ValidateAltitudes ensures the altitudes bounding v, when specified, are
+// within [MinAltitude, MaxAltitude] and consistently ordered.
+func (v *Volume3D) ValidateAltitudes() error {
+	if v == nil {
+		return nil
+	}
+	for _, alt := range []struct {
+		name  string
+		value *float32
+	}{{"lower", v.AltitudeLo}, {"upper", v.AltitudeHi}} {
+		if alt.value != nil && (*alt.value < MinAltitude || *alt.value > MaxAltitude) {
+			return stacktrace.NewErrorWithCode(dsserr.BadRequest,
+				"Invalid %s altitude %g m; must be between %d m and %d m above the WGS84 ellipsoid", alt.name, *alt.value, MinAltitude, MaxAltitude)
+		}
+	}
+	if v.AltitudeLo != nil && v.AltitudeHi != nil && *v.AltitudeLo > *v.AltitudeHi {
+		return stacktrace.NewErrorWithCode(dsserr.BadRequest,
+			"Lower altitude %g m must not be above upper altitude %g m", *v.AltitudeLo, *v.AltitudeHi)
+	}
+	return nil
+}
+
 // Geometry models a geometry.
 type Geometry interface {
 	// CalculateCovering returns an s2 cell covering for a geometry.
@@ -225,16 +342,7 @@ func (gc *GeoCircle) CalculateCovering() (s2.CellUnion, error) {
 		return nil, geo.ErrBadCoordSet
 	}
 
-	if !(gc.RadiusMeter > 0) {
-		return nil, geo.ErrRadiusMustBeLargerThan0
-	}
-
-	// TODO: Use an S2 Cap as an inscribed polygon does not fully cover the defined circle
-	return geo.RegionCoverer.Covering(s2.RegularLoop(
-		s2.PointFromLatLng(s2.LatLngFromDegrees(gc.Center.Lat, gc.Center.Lng)),
-		geo.DistanceMetersToAngle(float64(gc.RadiusMeter)),
-		20,
-	)), nil
+	return geo.CircleCovering(s2.LatLngFromDegrees(gc.Center.Lat, gc.Center.Lng), float64(gc.RadiusMeter))
 }
 
 // GeoPolygon models an enclosed area on the earth.
@@ -253,16 +361,14 @@ func (gp *GeoPolygon) CalculateCovering() (s2.CellUnion, error) {
 	if gp == nil {
 		return nil, geo.ErrBadCoordSet
 	}
-	for _, v := range gp.Vertices {
+	for i, v := range gp.Vertices {
 		// ensure that coordinates passed are actually on earth
-		if (v.Lat > maxLat) || (v.Lat < minLat) || (v.Lng > maxLng) || (v.Lng < minLng) {
-			return nil, geo.ErrBadCoordSet
+		if v == nil || (v.Lat > maxLat) || (v.Lat < minLat) || (v.Lng > maxLng) || (v.Lng < minLng) {
+			return nil, geo.NewPolygonError(geo.ErrBadCoordSet, geo.PolygonInvalidVertex, []int{i}, "Polygon vertex %d is not a valid coordinate", i)
 		}
 		points = append(points, s2.PointFromLatLng(s2.LatLngFromDegrees(v.Lat, v.Lng)))
 	}
-	if len(points) < 3 {
-		return nil, geo.ErrNotEnoughPointsInPolygon
-	}
+	// Covering rejects polygons of fewer than 3 vertices.
 	return geo.Covering(points)
 }
 
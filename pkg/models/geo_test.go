@@ -1,9 +1,12 @@
 package models
 
 import (
+	"math"
 	"testing"
 
 	"github.com/golang/geo/s2"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/stacktrace"
 	"github.com/stretchr/testify/require"
 )
 
@@ -53,3 +56,76 @@ func TestPolygonCovering(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, want, got)
 }
+
+func TestAltitudeToWGS84Meters(t *testing.T) {
+	got, err := AltitudeToWGS84Meters(100, ReferenceWGS84, UnitsM, nil)
+	require.NoError(t, err)
+	require.Equal(t, float32(100), got)
+
+	got, err = AltitudeToWGS84Meters(1000, ReferenceW84, UnitsFT, nil)
+	require.NoError(t, err)
+	require.InDelta(t, 304.8, got, 1e-3)
+
+	_, err = AltitudeToWGS84Meters(100, "SFC", UnitsM, nil)
+	require.Error(t, err)
+	require.Equal(t, dsserr.BadRequest, stacktrace.GetCode(err))
+
+	_, err = AltitudeToWGS84Meters(100, ReferenceWGS84, "NM", nil)
+	require.Error(t, err)
+	require.Equal(t, dsserr.BadRequest, stacktrace.GetCode(err))
+
+	_, err = AltitudeToWGS84Meters(0, ReferenceW84, UnitsFL, nil)
+	require.Error(t, err)
+	require.Equal(t, dsserr.BadRequest, stacktrace.GetCode(err))
+
+	// No geoid is loaded, so AMSL altitudes are rejected rather than
+	// misinterpreted.
+	_, err = AltitudeToWGS84Meters(100, ReferenceEGM2008, UnitsM, &s2.LatLng{})
+	require.Error(t, err)
+	require.Equal(t, dsserr.BadRequest, stacktrace.GetCode(err))
+	_, err = AltitudeToWGS84Meters(100, ReferenceEGM2008, UnitsM, nil)
+	require.Error(t, err)
+	require.Equal(t, dsserr.BadRequest, stacktrace.GetCode(err))
+}
+
+func TestFootprintPosition(t *testing.T) {
+	position := FootprintPosition(&GeoCircle{Center: LatLngPoint{Lat: 10, Lng: 20}, RadiusMeter: 100})
+	require.NotNil(t, position)
+	require.InDelta(t, 10, position.Lat.Degrees(), 1e-9)
+	require.InDelta(t, 20, position.Lng.Degrees(), 1e-9)
+
+	position = FootprintPosition(&GeoPolygon{Vertices: []*LatLngPoint{
+		{Lat: -1, Lng: 179}, {Lat: 1, Lng: 179}, {Lat: 1, Lng: -179}, {Lat: -1, Lng: -179},
+	}})
+	require.NotNil(t, position)
+	require.InDelta(t, 0, position.Lat.Degrees(), 1e-9)
+	require.InDelta(t, 180, math.Abs(position.Lng.Degrees()), 1e-9)
+
+	require.Nil(t, FootprintPosition(&GeoPolygon{}))
+	require.Nil(t, FootprintPosition(nil))
+}
+
+func TestValidateAltitudes(t *testing.T) {
+	for _, r := range []struct {
+		name    string
+		lo, hi  *float32
+		wantErr bool
+	}{
+		{"unbounded", nil, nil, false},
+		{"ordered", float32p(10), float32p(100), false},
+		{"equal", float32p(10), float32p(10), false},
+		{"inverted", float32p(100), float32p(10), true},
+		{"too low", float32p(MinAltitude - 1), nil, true},
+		{"too high", nil, float32p(MaxAltitude + 1), true},
+	} {
+		t.Run(r.name, func(t *testing.T) {
+			err := (&Volume3D{AltitudeLo: r.lo, AltitudeHi: r.hi}).ValidateAltitudes()
+			if r.wantErr {
+				require.Error(t, err)
+				require.Equal(t, dsserr.BadRequest, stacktrace.GetCode(err))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
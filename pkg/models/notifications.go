@@ -0,0 +1,59 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// MaxNotificationIndex is the largest notification index a subscription
+	// may reach.  Incrementing a notification index at MaxNotificationIndex
+	// wraps it around to 0, which is otherwise only the index of a
+	// subscription that has never been notified.
+	MaxNotificationIndex = math.MaxInt32
+
+	// NotificationIndexWrappedHeader is the response metadata key listing the
+	// IDs of the subscriptions whose notification index wrapped around to 0
+	// as a result of the request.
+	NotificationIndexWrappedHeader = "dss-notification-index-wrapped"
+)
+
+var notificationIndexWraparounds = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "dss_notification_index_wraparounds_total",
+	Help: "Number of subscription notification indices that wrapped around to 0.",
+}, []string{"service"})
+
+// NextNotificationIndexSQL returns the SQL expression computing the
+// notification index following the one in column, wrapping around to 0 past
+// MaxNotificationIndex.
+func NextNotificationIndexSQL(column string) string {
+	return fmt.Sprintf("CASE WHEN %s >= %d THEN 0 ELSE %s + 1 END", column, MaxNotificationIndex, column)
+}
+
+// NotificationIndexWrapped returns whether a freshly incremented notification
+// index indicates the index wrapped around.
+func NotificationIndexWrapped(index int) bool {
+	return index == 0
+}
+
+// SignalNotificationIndexWraparounds reports, in the response metadata of ctx
+// and the metrics of service, the subscriptions whose notification index
+// wrapped around.  It does nothing if subscriptionIDs is empty.
+func SignalNotificationIndexWraparounds(ctx context.Context, service string, subscriptionIDs []ID) {
+	if len(subscriptionIDs) == 0 {
+		return
+	}
+	notificationIndexWraparounds.WithLabelValues(service).Add(float64(len(subscriptionIDs)))
+	ids := make([]string, len(subscriptionIDs))
+	for i, id := range subscriptionIDs {
+		ids[i] = id.String()
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(NotificationIndexWrappedHeader, strings.Join(ids, ",")))
+}
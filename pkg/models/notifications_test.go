@@ -0,0 +1,19 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextNotificationIndexSQL(t *testing.T) {
+	require.Equal(t,
+		"CASE WHEN notification_index >= 2147483647 THEN 0 ELSE notification_index + 1 END",
+		NextNotificationIndexSQL("notification_index"))
+}
+
+func TestNotificationIndexWrapped(t *testing.T) {
+	require.True(t, NotificationIndexWrapped(0))
+	require.False(t, NotificationIndexWrapped(1))
+	require.False(t, NotificationIndexWrapped(MaxNotificationIndex))
+}
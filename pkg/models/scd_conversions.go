@@ -46,48 +46,39 @@ func Volume3DFromSCDProto(vol3 *scdpb.Volume3D) (*Volume3D, error) {
 		return nil, nil
 	}
 
+	var footprint Geometry
+	switch {
+	case vol3.GetOutlineCircle() != nil && vol3.GetOutlinePolygon() != nil:
+		return nil, stacktrace.NewError("Both circle and polygon specified in outline geometry")
+	case vol3.GetOutlinePolygon() != nil:
+		footprint = GeoPolygonFromSCDProto(vol3.GetOutlinePolygon())
+	case vol3.GetOutlineCircle() != nil:
+		footprint = GeoCircleFromSCDProto(vol3.GetOutlineCircle())
+	}
+
+	position := FootprintPosition(footprint)
 	altitudeLower := vol3.GetAltitudeLower()
 	var altLo *float32
 	if altitudeLower != nil {
-		if altitudeLower.Units != UnitsM {
-			return nil, stacktrace.NewError("Invalid lower altitude unit")
-		}
-		if altitudeLower.Reference != ReferenceW84 {
-			return nil, stacktrace.NewError("Invalid lower altitude reference")
+		value, err := AltitudeToWGS84Meters(altitudeLower.GetValue(), altitudeLower.GetReference(), altitudeLower.GetUnits(), position)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Invalid lower altitude")
 		}
-		altLo = float32p(float32(altitudeLower.GetValue()))
+		altLo = &value
 	}
 
 	altitudeUpper := vol3.GetAltitudeUpper()
 	var altHi *float32
 	if altitudeUpper != nil {
-		if altitudeUpper.Units != UnitsM {
-			return nil, stacktrace.NewError("Invalid upper altitude unit")
-		}
-		if altitudeUpper.Reference != ReferenceW84 {
-			return nil, stacktrace.NewError("Invalid upper altitude reference")
+		value, err := AltitudeToWGS84Meters(altitudeUpper.GetValue(), altitudeUpper.GetReference(), altitudeUpper.GetUnits(), position)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Invalid upper altitude")
 		}
-		altHi = float32p(float32(altitudeUpper.GetValue()))
-	}
-
-	switch {
-	case vol3.GetOutlineCircle() != nil && vol3.GetOutlinePolygon() != nil:
-		return nil, stacktrace.NewError("Both circle and polygon specified in outline geometry")
-	case vol3.GetOutlinePolygon() != nil:
-		return &Volume3D{
-			Footprint:  GeoPolygonFromSCDProto(vol3.GetOutlinePolygon()),
-			AltitudeLo: altLo,
-			AltitudeHi: altHi,
-		}, nil
-	case vol3.GetOutlineCircle() != nil:
-		return &Volume3D{
-			Footprint:  GeoCircleFromSCDProto(vol3.GetOutlineCircle()),
-			AltitudeLo: altLo,
-			AltitudeHi: altHi,
-		}, nil
+		altHi = &value
 	}
 
 	return &Volume3D{
+		Footprint:  footprint,
 		AltitudeLo: altLo,
 		AltitudeHi: altHi,
 	}, nil
@@ -0,0 +1,72 @@
+package models
+
+import (
+	"time"
+
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/stacktrace"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// SubscriptionExpiryHeader is the response metadata key carrying the end
+	// time the DSS applied to a created or updated subscription.
+	SubscriptionExpiryHeader = "dss-subscription-expiry"
+
+	// SubscriptionExpiryTruncatedHeader is the response metadata key present
+	// (with value "true") when the DSS truncated the requested end time of a
+	// subscription to the maximum allowed duration.
+	SubscriptionExpiryTruncatedHeader = "dss-subscription-expiry-truncated"
+)
+
+// SubscriptionLifetime bounds the interval between the start and end times of
+// subscriptions.
+type SubscriptionLifetime struct {
+	// MaxDuration is the largest allowed interval between StartTime and
+	// EndTime.
+	MaxDuration time.Duration
+
+	// Truncate causes subscriptions requesting a longer interval than
+	// MaxDuration to have their EndTime truncated rather than being rejected.
+	Truncate bool
+}
+
+// DefaultSubscriptionLifetime is the 24-hour maximum subscription duration
+// prescribed by the ASTM standards.
+var DefaultSubscriptionLifetime = SubscriptionLifetime{
+	MaxDuration: time.Hour * 24,
+}
+
+// Enforce returns the EndTime for a subscription starting at start and
+// requesting end (or, if end is nil, the longest allowed interval), and
+// whether the requested end was truncated.
+func (l SubscriptionLifetime) Enforce(start time.Time, end *time.Time) (*time.Time, bool, error) {
+	maxEnd := start.Add(l.MaxDuration)
+	if end == nil {
+		return &maxEnd, false, nil
+	}
+
+	// EndTime cannot be before StartTime.
+	if end.Before(start) {
+		return nil, false, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Subscription time_end must be after time_start")
+	}
+
+	if end.After(maxEnd) {
+		if !l.Truncate {
+			return nil, false, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Subscription window exceeds %s", l.MaxDuration)
+		}
+		return &maxEnd, true, nil
+	}
+
+	return end, false, nil
+}
+
+// SubscriptionExpiryMetadata returns the response metadata signaling the
+// applied EndTime of a subscription, and whether it was truncated.
+func SubscriptionExpiryMetadata(endTime time.Time, truncated bool) metadata.MD {
+	md := metadata.Pairs(SubscriptionExpiryHeader, endTime.UTC().Format(time.RFC3339Nano))
+	if truncated {
+		md.Set(SubscriptionExpiryTruncatedHeader, "true")
+	}
+	return md
+}
@@ -0,0 +1,189 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang/geo/s2"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/dss/pkg/geo"
+)
+
+// VolumeRule identifies a rule checked on submitted volumes.  Its value is the
+// machine-readable reason reported when a volume breaks the rule.
+type VolumeRule string
+
+const (
+	// VolumeRuleTimeRequired requires volumes to specify their start and end
+	// times.
+	VolumeRuleTimeRequired VolumeRule = "volume_time_required"
+
+	// VolumeRuleTimeOrder requires volumes not to end before they start.
+	VolumeRuleTimeOrder VolumeRule = "volume_time_order"
+
+	// VolumeRuleEndInPast requires submitted volumes not to all end in the
+	// past.
+	VolumeRuleEndInPast VolumeRule = "volume_end_in_past"
+
+	// VolumeRuleMaxDuration bounds the duration of volumes.
+	VolumeRuleMaxDuration VolumeRule = "volume_max_duration"
+
+	// VolumeRuleAltitudeOrder requires the lower altitude of volumes not to be
+	// above their upper altitude.
+	VolumeRuleAltitudeOrder VolumeRule = "volume_altitude_order"
+
+	// VolumeRuleAltitudeRange bounds the altitudes of volumes.
+	VolumeRuleAltitudeRange VolumeRule = "volume_altitude_range"
+
+	// VolumeRuleMaxArea bounds the area of the footprint of volumes.
+	VolumeRuleMaxArea VolumeRule = "volume_max_area"
+
+	// VolumeRuleFootprint requires the footprint of volumes to be a
+	// well-formed circle or polygon.
+	VolumeRuleFootprint VolumeRule = "volume_footprint"
+)
+
+// VolumeValidator checks submitted volumes against configurable limits.  The
+// zero value only checks the consistency of each volume.
+type VolumeValidator struct {
+	// MaxDuration bounds the interval between the start and end times of each
+	// volume; zero disables the limit.
+	MaxDuration time.Duration
+
+	// MinAltitude and MaxAltitude bound (in meters above the WGS84 ellipsoid)
+	// the altitudes of each volume; nil disables the bound.
+	MinAltitude *float32
+	MaxAltitude *float32
+
+	// MaxAreaKm2 bounds the area of the footprint of each volume; zero
+	// disables the limit.
+	MaxAreaKm2 float64
+}
+
+// DefaultVolumeValidator bounds altitudes to [MinAltitude, MaxAltitude].
+var DefaultVolumeValidator = VolumeValidator{
+	MinAltitude: float32p(MinAltitude),
+	MaxAltitude: float32p(MaxAltitude),
+}
+
+func volumeError(rule VolumeRule, format string, args ...interface{}) error {
+	code := dsserr.BadRequest
+	if rule == VolumeRuleMaxArea {
+		code = dsserr.AreaTooLarge
+	}
+	return dsserr.NewErrorWithReason(code, string(rule), format, args...)
+}
+
+// ValidateSpace checks the spatial extent of a volume.
+func (v VolumeValidator) ValidateSpace(vol3 *Volume3D) error {
+	return v.validateSpace(vol3, "Volume")
+}
+
+func (v VolumeValidator) validateSpace(vol3 *Volume3D, label string) error {
+	if vol3 == nil {
+		return volumeError(VolumeRuleFootprint, "%s is missing its spatial volume", label)
+	}
+
+	for _, alt := range []struct {
+		name  string
+		value *float32
+	}{{"lower", vol3.AltitudeLo}, {"upper", vol3.AltitudeHi}} {
+		if alt.value == nil {
+			continue
+		}
+		if v.MinAltitude != nil && *alt.value < *v.MinAltitude {
+			return volumeError(VolumeRuleAltitudeRange, "%s has invalid %s altitude %g m; must not be below %g m above the WGS84 ellipsoid", label, alt.name, *alt.value, *v.MinAltitude)
+		}
+		if v.MaxAltitude != nil && *alt.value > *v.MaxAltitude {
+			return volumeError(VolumeRuleAltitudeRange, "%s has invalid %s altitude %g m; must not be above %g m above the WGS84 ellipsoid", label, alt.name, *alt.value, *v.MaxAltitude)
+		}
+	}
+	if vol3.AltitudeLo != nil && vol3.AltitudeHi != nil && *vol3.AltitudeLo > *vol3.AltitudeHi {
+		return volumeError(VolumeRuleAltitudeOrder, "%s lower altitude %g m must not be above upper altitude %g m", label, *vol3.AltitudeLo, *vol3.AltitudeHi)
+	}
+
+	var area float64
+	switch footprint := vol3.Footprint.(type) {
+	case nil:
+		return volumeError(VolumeRuleFootprint, "%s is missing its footprint", label)
+	case *GeoCircle:
+		c := footprint.Center
+		if c.Lat > maxLat || c.Lat < minLat || c.Lng > maxLng || c.Lng < minLng {
+			return volumeError(VolumeRuleFootprint, "%s circle center (%g, %g) is not a valid coordinate", label, c.Lat, c.Lng)
+		}
+		if !(footprint.RadiusMeter > 0) {
+			return volumeError(VolumeRuleFootprint, "%s circle radius must be larger than 0", label)
+		}
+		area = geo.CircleAreaKm2(float64(footprint.RadiusMeter))
+	case *GeoPolygon:
+		points := make([]s2.Point, len(footprint.Vertices))
+		for i, vertex := range footprint.Vertices {
+			if vertex == nil || vertex.Lat > maxLat || vertex.Lat < minLat || vertex.Lng > maxLng || vertex.Lng < minLng {
+				return volumeError(VolumeRuleFootprint, "%s polygon vertex %d is not a valid coordinate", label, i)
+			}
+			points[i] = s2.PointFromLatLng(s2.LatLngFromDegrees(vertex.Lat, vertex.Lng))
+		}
+		if err := geo.ValidatePolygon(points); err != nil {
+			var polygonErr *geo.PolygonError
+			if errors.As(err, &polygonErr) {
+				return volumeError(VolumeRuleFootprint, "%s polygon is invalid (%s): %s", label, polygonErr.Defect, polygonErr.Message)
+			}
+			return volumeError(VolumeRuleFootprint, "%s polygon is invalid: %s", label, err.Error())
+		}
+		area = geo.PolygonAreaKm2(points)
+	}
+	if v.MaxAreaKm2 > 0 && area > v.MaxAreaKm2 {
+		return volumeError(VolumeRuleMaxArea, "%s area is too large (%fkm² > %fkm²)", label, area, v.MaxAreaKm2)
+	}
+
+	return nil
+}
+
+// Validate checks a volume, whose start and end times may be omitted.
+func (v VolumeValidator) Validate(vol4 *Volume4D) error {
+	return v.validate(vol4, "Volume")
+}
+
+func (v VolumeValidator) validate(vol4 *Volume4D, label string) error {
+	if err := v.validateSpace(vol4.SpatialVolume, label); err != nil {
+		return err // No need to Propagate this error as this stack layer does not add useful information
+	}
+	if vol4.StartTime != nil && vol4.EndTime != nil {
+		if vol4.EndTime.Before(*vol4.StartTime) {
+			return volumeError(VolumeRuleTimeOrder, "%s end time %s is before its start time %s", label, vol4.EndTime.Format(time.RFC3339), vol4.StartTime.Format(time.RFC3339))
+		}
+		if d := vol4.EndTime.Sub(*vol4.StartTime); v.MaxDuration > 0 && d > v.MaxDuration {
+			return volumeError(VolumeRuleMaxDuration, "%s duration %s exceeds %s", label, d, v.MaxDuration)
+		}
+	}
+	return nil
+}
+
+// ValidateExtents checks the extents of an entity submitted at now: each
+// extent must be valid and specify its start and end times, and the extents
+// may not all end before now.
+func (v VolumeValidator) ValidateExtents(extents []*Volume4D, now time.Time) error {
+	if len(extents) == 0 {
+		return volumeError(VolumeRuleFootprint, "Missing extents")
+	}
+	var end time.Time
+	for idx, extent := range extents {
+		if extent.StartTime == nil {
+			return volumeError(VolumeRuleTimeRequired, "Extent %d is missing time_start", idx)
+		}
+		if extent.EndTime == nil {
+			return volumeError(VolumeRuleTimeRequired, "Extent %d is missing time_end", idx)
+		}
+		if err := v.validate(extent, fmt.Sprintf("Extent %d", idx)); err != nil {
+			return err // No need to Propagate this error as this stack layer does not add useful information
+		}
+		if extent.EndTime.After(end) {
+			end = *extent.EndTime
+		}
+	}
+	if now.After(end) {
+		return volumeError(VolumeRuleEndInPast, "Extents may not end in the past")
+	}
+	return nil
+}
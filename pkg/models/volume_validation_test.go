@@ -0,0 +1,83 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/stacktrace"
+	"github.com/stretchr/testify/require"
+)
+
+func requireVolumeRule(t *testing.T, rule VolumeRule, err error) {
+	require.Error(t, err)
+	reasoned, ok := stacktrace.RootCause(err).(*dsserr.ReasonedError)
+	require.True(t, ok, "Root cause of %v is not a ReasonedError", err)
+	require.Equal(t, string(rule), reasoned.Reason)
+}
+
+func TestVolumeValidator(t *testing.T) {
+	var (
+		now      = time.Now()
+		start    = now.Add(time.Minute)
+		end      = now.Add(time.Hour)
+		lo, hi   = float32(10), float32(100)
+		triangle = &GeoPolygon{Vertices: []*LatLngPoint{
+			{Lat: 37.427636, Lng: -122.170502},
+			{Lat: 37.408799, Lng: -122.064069},
+			{Lat: 37.421265, Lng: -122.086504},
+		}}
+		volume = func() *Volume4D {
+			s, e, l, h := start, end, lo, hi
+			return &Volume4D{
+				StartTime:     &s,
+				EndTime:       &e,
+				SpatialVolume: &Volume3D{Footprint: triangle, AltitudeLo: &l, AltitudeHi: &h},
+			}
+		}
+		v = DefaultVolumeValidator
+	)
+
+	require.NoError(t, v.ValidateExtents([]*Volume4D{volume()}, now))
+
+	missingEnd := volume()
+	missingEnd.EndTime = nil
+	requireVolumeRule(t, VolumeRuleTimeRequired, v.ValidateExtents([]*Volume4D{missingEnd}, now))
+	require.NoError(t, v.Validate(missingEnd))
+
+	reversed := volume()
+	reversed.StartTime, reversed.EndTime = reversed.EndTime, reversed.StartTime
+	requireVolumeRule(t, VolumeRuleTimeOrder, v.ValidateExtents([]*Volume4D{reversed}, now))
+
+	requireVolumeRule(t, VolumeRuleEndInPast, v.ValidateExtents([]*Volume4D{volume()}, end.Add(time.Second)))
+
+	short := v
+	short.MaxDuration = 10 * time.Minute
+	requireVolumeRule(t, VolumeRuleMaxDuration, short.ValidateExtents([]*Volume4D{volume()}, now))
+
+	inverted := volume()
+	inverted.SpatialVolume.AltitudeLo, inverted.SpatialVolume.AltitudeHi = inverted.SpatialVolume.AltitudeHi, inverted.SpatialVolume.AltitudeLo
+	requireVolumeRule(t, VolumeRuleAltitudeOrder, v.Validate(inverted))
+
+	high := volume()
+	*high.SpatialVolume.AltitudeHi = MaxAltitude + 1
+	requireVolumeRule(t, VolumeRuleAltitudeRange, v.Validate(high))
+
+	small := v
+	small.MaxAreaKm2 = 1
+	err := small.Validate(volume())
+	requireVolumeRule(t, VolumeRuleMaxArea, err)
+	require.Equal(t, dsserr.AreaTooLarge, stacktrace.GetCode(err))
+
+	line := volume()
+	line.SpatialVolume.Footprint = &GeoPolygon{Vertices: triangle.Vertices[:2]}
+	requireVolumeRule(t, VolumeRuleFootprint, v.Validate(line))
+
+	duplicated := volume()
+	duplicated.SpatialVolume.Footprint = &GeoPolygon{Vertices: append(triangle.Vertices[:3:3], triangle.Vertices[0])}
+	requireVolumeRule(t, VolumeRuleFootprint, v.Validate(duplicated))
+
+	circle := volume()
+	circle.SpatialVolume.Footprint = &GeoCircle{Center: LatLngPoint{Lat: 37.4, Lng: -122.1}}
+	requireVolumeRule(t, VolumeRuleFootprint, v.Validate(circle))
+}
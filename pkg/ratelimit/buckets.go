@@ -0,0 +1,90 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/interuss/dss/pkg/cockroach"
+	"github.com/interuss/stacktrace"
+	"github.com/jonboulle/clockwork"
+)
+
+// Buckets holds token buckets identified by keys.
+type Buckets interface {
+	// Take consumes a request from the bucket of key, holding the budget of
+	// limit, and returns whether the request may proceed.  If it may not,
+	// Take also returns how long to wait before the next request may
+	// proceed.
+	Take(ctx context.Context, key string, limit Limit) (bool, time.Duration, error)
+}
+
+// LocalBuckets holds token buckets in memory, as a Limiter per budget.
+type LocalBuckets struct {
+	clock clockwork.Clock
+
+	mu       sync.Mutex
+	limiters map[Limit]*Limiter
+}
+
+// NewLocalBuckets returns empty LocalBuckets.
+func NewLocalBuckets(clock clockwork.Clock) *LocalBuckets {
+	return &LocalBuckets{clock: clock, limiters: make(map[Limit]*Limiter)}
+}
+
+// Take implements Buckets.
+func (b *LocalBuckets) Take(_ context.Context, key string, limit Limit) (bool, time.Duration, error) {
+	b.mu.Lock()
+	l, ok := b.limiters[limit]
+	if !ok {
+		l = NewLimiter(limit, b.clock)
+		b.limiters[limit] = l
+	}
+	b.mu.Unlock()
+	allowed, wait := l.Allow(key)
+	return allowed, wait, nil
+}
+
+// DatastoreBuckets holds token buckets in the rate_limit_buckets table of the
+// database DB is connected to, shared by the processes connected to it.
+type DatastoreBuckets struct {
+	DB *cockroach.DB
+}
+
+// Take implements Buckets, replenishing and consuming the bucket of key in a
+// single statement, per the clock of the datastore.
+func (b *DatastoreBuckets) Take(ctx context.Context, key string, limit Limit) (bool, time.Duration, error) {
+	if !limit.Enabled() {
+		return true, 0, nil
+	}
+	const query = `
+		INSERT INTO rate_limit_buckets AS b (id, tokens, taken, updated_at)
+		VALUES ($1, $2::FLOAT8 - 1, true, now())
+		ON CONFLICT (id) DO UPDATE SET
+			tokens = least($2::FLOAT8, b.tokens + $3::FLOAT8 * extract(epoch FROM now() - b.updated_at)::FLOAT8)
+				- CASE WHEN least($2::FLOAT8, b.tokens + $3::FLOAT8 * extract(epoch FROM now() - b.updated_at)::FLOAT8) >= 1 THEN 1 ELSE 0 END,
+			taken = least($2::FLOAT8, b.tokens + $3::FLOAT8 * extract(epoch FROM now() - b.updated_at)::FLOAT8) >= 1,
+			updated_at = now()
+		RETURNING tokens, taken`
+	var (
+		tokens float64
+		taken  bool
+	)
+	if err := b.DB.Pool.QueryRow(ctx, query, key, float64(limit.Burst), limit.Rate).Scan(&tokens, &taken); err != nil {
+		return false, 0, stacktrace.Propagate(err, "Error in query: %s", query)
+	}
+	if taken {
+		return true, 0, nil
+	}
+	return false, time.Duration((1 - tokens) / limit.Rate * float64(time.Second)), nil
+}
+
+// Prune deletes the buckets not used for olderThan, which have fully
+// replenished unless their budget is tiny.
+func (b *DatastoreBuckets) Prune(ctx context.Context, olderThan time.Duration) error {
+	const query = `DELETE FROM rate_limit_buckets WHERE updated_at < now() - $1::INT8 * INTERVAL '1 microsecond'`
+	if _, err := b.DB.Pool.Exec(ctx, query, olderThan.Microseconds()); err != nil {
+		return stacktrace.Propagate(err, "Error in query: %s", query)
+	}
+	return nil
+}
@@ -0,0 +1,3 @@
+// Package ratelimit provides per-subject token bucket rate limiting of
+// incoming requests.
+package ratelimit
@@ -0,0 +1,116 @@
+package ratelimit
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/interuss/dss/pkg/auth"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+)
+
+// The budgets of a Policy exceeded by rate-limited requests.
+const (
+	LimitGlobal  = "global"
+	LimitSubject = "subject"
+)
+
+var (
+	httpRateLimitedRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "dss_http_rate_limited_requests_total",
+		Help: "Number of HTTP requests rejected for exceeding a budget of the rate limit policy, by budget.",
+	}, []string{"limit"})
+	httpRateLimitFallbacks = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "dss_http_rate_limit_fallbacks_total",
+		Help: "Number of HTTP requests whose budgets were checked against the local fallback token buckets because the shared token buckets failed.",
+	}, []string{"limit"})
+)
+
+// RequestToken returns the bearer access token of r, or an empty string
+// without token.
+func RequestToken(r *http.Request) string {
+	return strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+}
+
+// HTTPLimiter rejects the HTTP requests exceeding the budgets of Policy.
+type HTTPLimiter struct {
+	Policy Policy
+	// Buckets hold the budgets of Policy.
+	Buckets Buckets
+	// Fallback, when not nil, holds the budgets of Policy when Buckets fail,
+	// e.g. when shared Buckets are unavailable; requests are allowed when
+	// Buckets fail otherwise.
+	Fallback Buckets
+	// Authorizer, when not nil, verifies the access tokens whose subjects are
+	// checked against their budgets; requests are only checked against the
+	// global budget otherwise, since clients may forge the subjects of
+	// unverified tokens.
+	Authorizer *auth.Authorizer
+	Logger     *zap.Logger
+}
+
+// subject returns the subject of the verified access token of r, or an empty
+// string when r has no access token or when it cannot be verified.
+func (l *HTTPLimiter) subject(r *http.Request) string {
+	token := RequestToken(r)
+	if token == "" || l.Authorizer == nil {
+		return ""
+	}
+	subject, err := l.Authorizer.TokenSubject(token)
+	if err != nil {
+		return ""
+	}
+	return subject
+}
+
+// take consumes a request from the bucket of key.
+func (l *HTTPLimiter) take(r *http.Request, name, key string, limit Limit) (bool, float64) {
+	if !limit.Enabled() {
+		return true, 0
+	}
+	allowed, wait, err := l.Buckets.Take(r.Context(), key, limit)
+	if err != nil {
+		l.Logger.Warn("Failed to check rate limit", zap.String("limit", name), zap.Error(err))
+		if l.Fallback == nil {
+			return true, 0
+		}
+		httpRateLimitFallbacks.WithLabelValues(name).Inc()
+		allowed, wait, _ = l.Fallback.Take(r.Context(), key, limit)
+	}
+	return allowed, wait.Seconds()
+}
+
+// Middleware serves the requests within the budgets of l.Policy with handler,
+// and responds 429 to the others, with a Retry-After header.  Each request with
+// a verified access token is first checked against the budget of its subject,
+// so that subjects exceeding their own budgets do not consume the global
+// budget.
+func (l *HTTPLimiter) Middleware(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name, allowed, wait := LimitGlobal, true, 0.0
+		if subject := l.subject(r); subject != "" {
+			name = LimitSubject
+			allowed, wait = l.take(r, name, "subject:"+subject, l.Policy.SubjectLimit(subject))
+		}
+		if allowed {
+			name = LimitGlobal
+			allowed, wait = l.take(r, name, "global", l.Policy.Global)
+		}
+		if allowed {
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		httpRateLimitedRequests.WithLabelValues(name).Inc()
+		retryAfter := int(math.Ceil(wait))
+		message := "Rate limit exceeded; retry after " + strconv.Itoa(retryAfter) + " s"
+		w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+		if err := dsserr.WriteHTTPError(w, http.StatusTooManyRequests, dsserr.Exhausted, dsserr.MakeErrID(), "rate_limited_"+name, message); err != nil {
+			l.Logger.Error("Error writing rate limited response", zap.Error(err))
+		}
+	})
+}
@@ -0,0 +1,170 @@
+package ratelimit
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/interuss/dss/pkg/auth"
+	"github.com/interuss/stacktrace"
+	"github.com/jonboulle/clockwork"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type failingBuckets struct{}
+
+func (failingBuckets) Take(context.Context, string, Limit) (bool, time.Duration, error) {
+	return false, 0, stacktrace.NewError("unavailable")
+}
+
+type keyResolver struct {
+	key *rsa.PublicKey
+}
+
+func (r keyResolver) ResolveKeys(context.Context) ([]interface{}, error) {
+	return []interface{}{r.key}, nil
+}
+
+// newAuthorizer returns an auth.Authorizer verifying the access tokens signed
+// with the returned key.
+func newAuthorizer(t *testing.T) (*auth.Authorizer, *rsa.PrivateKey) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	authorizer, err := auth.NewRSAAuthorizer(ctx, auth.Configuration{
+		KeyResolver:       keyResolver{&key.PublicKey},
+		KeyRefreshTimeout: time.Hour,
+	})
+	require.NoError(t, err)
+	return authorizer, key
+}
+
+func request(t *testing.T, key *rsa.PrivateKey, subject string) *http.Request {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	if subject != "" {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.StandardClaims{
+			Subject:   subject,
+			Issuer:    "issuer",
+			ExpiresAt: time.Now().Add(time.Minute).Unix(),
+		}).SignedString(key)
+		require.NoError(t, err)
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+	return r
+}
+
+func TestRequestToken(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	require.Equal(t, "", RequestToken(r))
+	r.Header.Set("Authorization", "Bearer token")
+	require.Equal(t, "token", RequestToken(r))
+}
+
+func TestHTTPLimiter(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	authorizer, key := newAuthorizer(t)
+	l := &HTTPLimiter{
+		Policy: Policy{
+			Global:   Limit{Rate: 1, Burst: 4},
+			Subject:  Limit{Rate: 1, Burst: 2},
+			Subjects: map[string]Limit{"uss2": {Rate: 1, Burst: 3}},
+		},
+		Buckets:    NewLocalBuckets(clock),
+		Authorizer: authorizer,
+		Logger:     zap.NewNop(),
+	}
+	handler := l.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	serve := func(subject string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, request(t, key, subject))
+		return w
+	}
+
+	require.Equal(t, http.StatusOK, serve("uss1").Code)
+	require.Equal(t, http.StatusOK, serve("uss1").Code)
+	w := serve("uss1")
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.Equal(t, "1", w.Header().Get("Retry-After"))
+
+	// Rejected subjects do not consume the global budget.
+	require.Equal(t, http.StatusOK, serve("uss2").Code)
+	require.Equal(t, http.StatusOK, serve("uss2").Code)
+	require.Equal(t, http.StatusTooManyRequests, serve("uss2").Code)
+
+	clock.Advance(time.Second)
+	require.Equal(t, http.StatusOK, serve("").Code)
+	require.Equal(t, http.StatusTooManyRequests, serve("").Code)
+
+	require.Equal(t, float64(1), testutil.ToFloat64(httpRateLimitedRequests.WithLabelValues("subject")))
+	require.Equal(t, float64(2), testutil.ToFloat64(httpRateLimitedRequests.WithLabelValues("global")))
+}
+
+func TestHTTPLimiterForgedSubjects(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	authorizer, key := newAuthorizer(t)
+	forger, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	l := &HTTPLimiter{
+		Policy: Policy{
+			Global:  Limit{Rate: 1, Burst: 3},
+			Subject: Limit{Rate: 1, Burst: 1},
+		},
+		Buckets:    NewLocalBuckets(clock),
+		Authorizer: authorizer,
+		Logger:     zap.NewNop(),
+	}
+	handler := l.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	serve := func(key *rsa.PrivateKey, subject string) int {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, request(t, key, subject))
+		return w.Code
+	}
+
+	// Tokens forging the subject of uss1 do not consume its budget.
+	require.Equal(t, http.StatusOK, serve(forger, "uss1"))
+	require.Equal(t, http.StatusOK, serve(key, "uss1"))
+	require.Equal(t, http.StatusTooManyRequests, serve(key, "uss1"))
+
+	// Tokens forging new subjects remain bounded by the global budget.
+	require.Equal(t, http.StatusOK, serve(forger, "uss2"))
+	require.Equal(t, http.StatusTooManyRequests, serve(forger, "uss3"))
+	clock.Advance(time.Second)
+	require.Equal(t, http.StatusOK, serve(forger, "uss4"))
+	require.Equal(t, http.StatusTooManyRequests, serve(forger, "uss5"))
+
+	// Without authorizer, subjects are not trusted.
+	l.Authorizer = nil
+	clock.Advance(time.Second)
+	require.Equal(t, http.StatusOK, serve(key, "uss6"))
+	require.Equal(t, http.StatusTooManyRequests, serve(key, "uss6"))
+}
+
+func TestHTTPLimiterFallback(t *testing.T) {
+	l := &HTTPLimiter{
+		Policy:  Policy{Global: Limit{Rate: 1, Burst: 1}},
+		Buckets: failingBuckets{},
+		Logger:  zap.NewNop(),
+	}
+	handler := l.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	for i := 0; i < 3; i++ {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, request(t, nil, ""))
+		require.Equal(t, http.StatusOK, w.Code)
+	}
+
+	l.Fallback = NewLocalBuckets(clockwork.NewFakeClock())
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, request(t, nil, ""))
+	require.Equal(t, http.StatusOK, w.Code)
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, request(t, nil, ""))
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+}
@@ -0,0 +1,50 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"strconv"
+
+	"github.com/interuss/dss/pkg/auth"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/stacktrace"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// RetryAfterHeader is the response metadata key carrying the number of
+// seconds a rate-limited client should wait before retrying.
+const RetryAfterHeader = "retry-after"
+
+var rateLimitedRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "dss_rate_limited_requests_total",
+	Help: "Number of requests rejected for exceeding their subject's rate limit.",
+}, []string{"method"})
+
+// Interceptor returns a grpc.UnaryServerInterceptor rejecting the requests to
+// the operations in limiters that exceed the budget of their subject.
+// Operations sharing a Limiter share the budget of each subject.  The
+// interceptor must run after authentication populated the owner in the
+// request context.
+func Interceptor(limiters map[auth.Operation]*Limiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		limiter, ok := limiters[auth.Operation(info.FullMethod)]
+		if !ok {
+			return handler(ctx, req)
+		}
+		owner, ok := auth.OwnerFromContext(ctx)
+		if !ok {
+			return handler(ctx, req)
+		}
+		if allowed, wait := limiter.Allow(owner.String()); !allowed {
+			rateLimitedRequests.WithLabelValues(info.FullMethod).Inc()
+			retryAfter := int(math.Ceil(wait.Seconds()))
+			_ = grpc.SetHeader(ctx, metadata.Pairs(RetryAfterHeader, strconv.Itoa(retryAfter)))
+			return nil, stacktrace.NewErrorWithCode(dsserr.Exhausted,
+				"Rate limit exceeded for %s; retry after %d s", owner, retryAfter)
+		}
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,92 @@
+package ratelimit
+
+import (
+	"bytes"
+	"io/ioutil"
+
+	"github.com/interuss/stacktrace"
+	"gopkg.in/yaml.v3"
+)
+
+// Policy describes the budgets of the requests to a server, as a whole and
+// per subject.
+type Policy struct {
+	// Global is the budget of all the requests, regardless of their subject.
+	Global Limit `yaml:"global"`
+	// Subject is the budget of the requests of each subject not listed in
+	// Subjects.
+	Subject Limit `yaml:"subject"`
+	// Subjects are the budgets of the requests of specific subjects.
+	Subjects map[string]Limit `yaml:"subjects"`
+}
+
+// SubjectLimit returns the budget of the requests of subject.
+func (p Policy) SubjectLimit(subject string) Limit {
+	if limit, ok := p.Subjects[subject]; ok {
+		return limit
+	}
+	return p.Subject
+}
+
+// Enabled returns whether p actually limits requests.
+func (p Policy) Enabled() bool {
+	if p.Global.Enabled() || p.Subject.Enabled() {
+		return true
+	}
+	for _, limit := range p.Subjects {
+		if limit.Enabled() {
+			return true
+		}
+	}
+	return false
+}
+
+func validate(name string, limit Limit) error {
+	if limit.Rate < 0 || limit.Burst < 0 {
+		return stacktrace.NewError("Rate limit of %s must not be negative", name)
+	}
+	if (limit.Rate > 0) != (limit.Burst > 0) {
+		return stacktrace.NewError("Rate limit of %s must specify both a rate and a burst, or neither", name)
+	}
+	return nil
+}
+
+// ParsePolicy parses a Policy from YAML, e.g.
+//
+//	global: {rate: 500, burst: 1000}
+//	subject: {rate: 20, burst: 40}
+//	subjects:
+//	  uss1: {rate: 50, burst: 100}
+func ParsePolicy(data []byte) (Policy, error) {
+	var p Policy
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&p); err != nil {
+		return Policy{}, stacktrace.Propagate(err, "Error parsing rate limit policy")
+	}
+	if err := validate("global", p.Global); err != nil {
+		return Policy{}, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	if err := validate("subject", p.Subject); err != nil {
+		return Policy{}, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	for subject, limit := range p.Subjects {
+		if err := validate("subject "+subject, limit); err != nil {
+			return Policy{}, err // No need to Propagate this error as this is not a useful stacktrace line
+		}
+	}
+	return p, nil
+}
+
+// LoadPolicy parses the Policy of the YAML file at path.
+func LoadPolicy(path string) (Policy, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Policy{}, stacktrace.Propagate(err, "Error reading rate limit policy %s", path)
+	}
+	p, err := ParsePolicy(data)
+	if err != nil {
+		return Policy{}, stacktrace.Propagate(err, "Invalid rate limit policy %s", path)
+	}
+	return p, nil
+}
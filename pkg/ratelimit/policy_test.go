@@ -0,0 +1,38 @@
+package ratelimit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePolicy(t *testing.T) {
+	p, err := ParsePolicy([]byte(`
+global: {rate: 500, burst: 1000}
+subject: {rate: 20, burst: 40}
+subjects:
+  uss1: {rate: 50, burst: 100}
+  uss2: {}
+`))
+	require.NoError(t, err)
+	require.True(t, p.Enabled())
+	require.Equal(t, Limit{Rate: 500, Burst: 1000}, p.Global)
+	require.Equal(t, Limit{Rate: 50, Burst: 100}, p.SubjectLimit("uss1"))
+	require.Equal(t, Limit{}, p.SubjectLimit("uss2"))
+	require.Equal(t, Limit{Rate: 20, Burst: 40}, p.SubjectLimit("uss3"))
+
+	for _, invalid := range []string{
+		"global: {rate: 1}",
+		"subject: {rate: -1, burst: 1}",
+		"subjects: {uss1: {burst: 1}}",
+		"global: {rate: 1, burst: 1, period: 1}",
+		"unknown: {}",
+	} {
+		_, err := ParsePolicy([]byte(invalid))
+		require.Error(t, err, invalid)
+	}
+
+	p, err = ParsePolicy([]byte("subjects: {uss1: {}}"))
+	require.NoError(t, err)
+	require.False(t, p.Enabled())
+}
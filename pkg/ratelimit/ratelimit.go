@@ -0,0 +1,103 @@
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+)
+
+// sweepInterval is the minimum interval between evictions of idle buckets.
+const sweepInterval = time.Minute
+
+// Limit describes the budget of each subject of a Limiter.
+type Limit struct {
+	// Rate is the number of requests per second replenishing the budget.
+	Rate float64
+
+	// Burst is the maximum number of requests that may be made at once.
+	Burst int
+}
+
+// Enabled returns whether l actually limits requests.
+func (l Limit) Enabled() bool {
+	return l.Rate > 0 && l.Burst > 0
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// Limiter tracks a token bucket per subject.
+type Limiter struct {
+	limit Limit
+	clock clockwork.Clock
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+// NewLimiter returns a Limiter granting each subject the budget of limit.
+func NewLimiter(limit Limit, clock clockwork.Clock) *Limiter {
+	return &Limiter{
+		limit:     limit,
+		clock:     clock,
+		buckets:   make(map[string]*bucket),
+		lastSweep: clock.Now(),
+	}
+}
+
+// SetLimit replaces the budget of each subject with limit.  The requests
+// already made by each subject still count against its new budget.
+func (l *Limiter) SetLimit(limit Limit) {
+	l.mu.Lock()
+	l.limit = limit
+	l.mu.Unlock()
+}
+
+// Allow consumes a request from the budget of subject and returns whether the
+// request may proceed.  If it may not, Allow also returns how long subject
+// should wait before its next request may proceed.
+func (l *Limiter) Allow(subject string) (bool, time.Duration) {
+	now := l.clock.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if !l.limit.Enabled() {
+		return true, 0
+	}
+	l.sweep(now)
+
+	b, ok := l.buckets[subject]
+	if !ok {
+		b = &bucket{tokens: float64(l.limit.Burst), last: now}
+		l.buckets[subject] = b
+	}
+	b.tokens = math.Min(float64(l.limit.Burst), b.tokens+now.Sub(b.last).Seconds()*l.limit.Rate)
+	b.last = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+	wait := time.Duration((1 - b.tokens) / l.limit.Rate * float64(time.Second))
+	return false, wait
+}
+
+// sweep evicts the buckets that have fully replenished, which are
+// indistinguishable from absent buckets.
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+	l.lastSweep = now
+	refill := time.Duration(float64(l.limit.Burst) / l.limit.Rate * float64(time.Second))
+	for subject, b := range l.buckets {
+		if now.Sub(b.last) >= refill {
+			delete(l.buckets, subject)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiterAllowsBurstThenRefills(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	l := NewLimiter(Limit{Rate: 2, Burst: 3}, clock)
+
+	for i := 0; i < 3; i++ {
+		allowed, _ := l.Allow("uss1")
+		require.True(t, allowed)
+	}
+	allowed, wait := l.Allow("uss1")
+	require.False(t, allowed)
+	require.Equal(t, 500*time.Millisecond, wait)
+
+	// Other subjects have their own budget.
+	allowed, _ = l.Allow("uss2")
+	require.True(t, allowed)
+
+	clock.Advance(500 * time.Millisecond)
+	allowed, _ = l.Allow("uss1")
+	require.True(t, allowed)
+	allowed, _ = l.Allow("uss1")
+	require.False(t, allowed)
+}
+
+func TestDisabledLimiterAllowsEverything(t *testing.T) {
+	l := NewLimiter(Limit{}, clockwork.NewFakeClock())
+	for i := 0; i < 100; i++ {
+		allowed, _ := l.Allow("uss1")
+		require.True(t, allowed)
+	}
+}
+
+func TestLimiterEvictsIdleBuckets(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	l := NewLimiter(Limit{Rate: 1, Burst: 1}, clock)
+	l.Allow("uss1")
+	clock.Advance(sweepInterval)
+	l.Allow("uss2")
+	require.NotContains(t, l.buckets, "uss1")
+	require.Contains(t, l.buckets, "uss2")
+}
+
+func TestLimiterSetLimit(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	l := NewLimiter(Limit{}, clock)
+	for i := 0; i < 10; i++ {
+		allowed, _ := l.Allow("uss1")
+		require.True(t, allowed)
+	}
+
+	l.SetLimit(Limit{Rate: 1, Burst: 1})
+	allowed, _ := l.Allow("uss1")
+	require.True(t, allowed)
+	allowed, _ = l.Allow("uss1")
+	require.False(t, allowed)
+
+	l.SetLimit(Limit{})
+	allowed, _ = l.Allow("uss1")
+	require.True(t, allowed)
+}
@@ -1,6 +1,9 @@
 package application
 
 import (
+	"time"
+
+	dssmodels "github.com/interuss/dss/pkg/models"
 	"github.com/interuss/dss/pkg/rid/store"
 	"github.com/jonboulle/clockwork"
 	"go.uber.org/zap"
@@ -17,19 +20,29 @@ type app struct {
 	store.Store
 	clock  clockwork.Clock
 	logger *zap.Logger
+
+	subscriptionLifetime dssmodels.SubscriptionLifetime
+
+	// isaRecoveryWindow is how long deleted ISAs remain restorable; zero
+	// disables tombstoning so that ISAs are deleted immediately.
+	isaRecoveryWindow time.Duration
 }
 
 type App interface {
 	ISAApp
 	SubscriptionApp
+	ResetApp
 }
 
 // NewFromTransactor is a convenience function for creating an App
-// with the given store.
-func NewFromTransactor(store store.Store, logger *zap.Logger) App {
+// with the given store, bounding Subscriptions to the given lifetime and
+// keeping deleted ISAs restorable for isaRecoveryWindow.
+func NewFromTransactor(store store.Store, logger *zap.Logger, subscriptionLifetime dssmodels.SubscriptionLifetime, isaRecoveryWindow time.Duration) App {
 	return &app{
-		Store:  store,
-		clock:  DefaultClock,
-		logger: logger,
+		Store:                store,
+		clock:                DefaultClock,
+		logger:               logger,
+		subscriptionLifetime: subscriptionLifetime,
+		isaRecoveryWindow:    isaRecoveryWindow,
 	}
 }
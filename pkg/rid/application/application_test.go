@@ -51,7 +51,8 @@ func setUpStore(ctx context.Context, t *testing.T, logger *zap.Logger) (store.St
 		logger.Info("using the stubbed in memory store.")
 		return &mockRepo{
 			isaStore: &isaStore{
-				isas: make(map[dssmodels.ID]*ridmodels.IdentificationServiceArea),
+				isas:       make(map[dssmodels.ID]*ridmodels.IdentificationServiceArea),
+				tombstoned: make(map[dssmodels.ID]isaTombstone),
 			},
 			subscriptionStore: &subscriptionStore{
 				subs: make(map[dssmodels.ID]*ridmodels.Subscription),
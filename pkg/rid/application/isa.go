@@ -23,6 +23,12 @@ type ISAApp interface {
 	// Returns the delete IdentificationServiceArea and all Subscriptions affected by the delete.
 	DeleteISA(ctx context.Context, id dssmodels.ID, owner dssmodels.Owner, version *dssmodels.Version) (*ridmodels.IdentificationServiceArea, []*ridmodels.Subscription, error)
 
+	// RestoreISA restores the IdentificationServiceArea identified by "id" and
+	// owned by "owner" that was deleted within the recovery window.
+	// Returns the restored IdentificationServiceArea and all Subscriptions
+	// affected by the restoration.
+	RestoreISA(ctx context.Context, id dssmodels.ID, owner dssmodels.Owner) (*ridmodels.IdentificationServiceArea, []*ridmodels.Subscription, error)
+
 	// InsertISA inserts or updates an ISA.
 	InsertISA(ctx context.Context, isa *ridmodels.IdentificationServiceArea) (*ridmodels.IdentificationServiceArea, []*ridmodels.Subscription, error)
 
@@ -78,7 +84,11 @@ func (a *app) DeleteISA(ctx context.Context, id dssmodels.ID, owner dssmodels.Ow
 				"ISA owned by %s, but %s attempted to delete", old.Owner, owner)
 		}
 
-		ret, err = repo.DeleteISA(ctx, old)
+		if a.isaRecoveryWindow > 0 {
+			ret, err = repo.TombstoneISA(ctx, old)
+		} else {
+			ret, err = repo.DeleteISA(ctx, old)
+		}
 		if err != nil {
 			return stacktrace.Propagate(err, "Error deleting ISA")
 		}
@@ -89,6 +99,49 @@ func (a *app) DeleteISA(ctx context.Context, id dssmodels.ID, owner dssmodels.Ow
 		}
 		return nil
 	})
+	if err == nil {
+		signalNotificationIndexWraparounds(ctx, subs)
+	}
+	return ret, subs, err // No need to Propagate this error as this stack layer does not add useful information
+}
+
+// RestoreISA implements the AppInterface RestoreISA method
+func (a *app) RestoreISA(ctx context.Context, id dssmodels.ID, owner dssmodels.Owner) (*ridmodels.IdentificationServiceArea, []*ridmodels.Subscription, error) {
+	if a.isaRecoveryWindow <= 0 {
+		return nil, nil, stacktrace.NewErrorWithCode(dsserr.NotFound, "ISA recovery is not enabled on this DSS instance")
+	}
+	var (
+		ret  *ridmodels.IdentificationServiceArea
+		subs []*ridmodels.Subscription
+	)
+	deletedAfter := a.clock.Now().Add(-a.isaRecoveryWindow)
+	// The following will automatically retry TXN retry errors.
+	err := a.Store.Transact(ctx, func(repo repos.Repository) error {
+		old, err := repo.GetTombstonedISA(ctx, id, deletedAfter)
+		switch {
+		case err != nil:
+			return stacktrace.Propagate(err, "Error getting deleted ISA")
+		case old == nil:
+			return stacktrace.NewErrorWithCode(dsserr.NotFound, "No ISA %s deleted within the recovery window", id.String())
+		case old.Owner != owner:
+			return stacktrace.NewErrorWithCode(dsserr.PermissionDenied,
+				"ISA owned by %s, but %s attempted to restore", old.Owner, owner)
+		}
+
+		ret, err = repo.RestoreISA(ctx, old)
+		if err != nil {
+			return stacktrace.Propagate(err, "Error restoring ISA")
+		}
+
+		subs, err = repo.UpdateNotificationIdxsInCells(ctx, ret.Cells)
+		if err != nil {
+			return stacktrace.Propagate(err, "Error updating notification indices")
+		}
+		return nil
+	})
+	if err == nil {
+		signalNotificationIndexWraparounds(ctx, subs)
+	}
 	return ret, subs, err // No need to Propagate this error as this stack layer does not add useful information
 }
 
@@ -127,6 +180,9 @@ func (a *app) InsertISA(ctx context.Context, isa *ridmodels.IdentificationServic
 		}
 		return nil
 	})
+	if err == nil {
+		signalNotificationIndexWraparounds(ctx, subs)
+	}
 	return ret, subs, err // No need to Propagate this error as this stack layer does not add useful information
 }
 
@@ -178,5 +234,20 @@ func (a *app) UpdateISA(ctx context.Context, isa *ridmodels.IdentificationServic
 		return nil
 	})
 
+	if err == nil {
+		signalNotificationIndexWraparounds(ctx, subs)
+	}
 	return ret, subs, err // No need to Propagate this error as this stack layer does not add useful information
 }
+
+// signalNotificationIndexWraparounds reports the Subscriptions in subs whose
+// notification index wrapped around when incremented.
+func signalNotificationIndexWraparounds(ctx context.Context, subs []*ridmodels.Subscription) {
+	var wrapped []dssmodels.ID
+	for _, sub := range subs {
+		if dssmodels.NotificationIndexWrapped(sub.NotificationIndex) {
+			wrapped = append(wrapped, sub.ID)
+		}
+	}
+	dssmodels.SignalNotificationIndexWraparounds(ctx, "rid", wrapped)
+}
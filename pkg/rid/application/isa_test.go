@@ -2,6 +2,7 @@ package application
 
 import (
 	"context"
+	"sort"
 	"testing"
 	"time"
 
@@ -21,14 +22,24 @@ var (
 )
 
 func setUpISAApp(ctx context.Context, t *testing.T) (*app, func()) {
+	return setUpISAAppWithRecoveryWindow(ctx, t, 0)
+}
+
+func setUpISAAppWithRecoveryWindow(ctx context.Context, t *testing.T, isaRecoveryWindow time.Duration) (*app, func()) {
 	l := zap.L()
 	transactor, cleanup := setUpStore(ctx, t, l)
-	return NewFromTransactor(transactor, l).(*app), cleanup
+	return NewFromTransactor(transactor, l, dssmodels.DefaultSubscriptionLifetime, isaRecoveryWindow).(*app), cleanup
+}
+
+type isaTombstone struct {
+	isa       *ridmodels.IdentificationServiceArea
+	deletedAt time.Time
 }
 
 // TODO:steeling add owner logic.
 type isaStore struct {
-	isas map[dssmodels.ID]*ridmodels.IdentificationServiceArea
+	isas       map[dssmodels.ID]*ridmodels.IdentificationServiceArea
+	tombstoned map[dssmodels.ID]isaTombstone
 }
 
 func (store *isaStore) GetISA(ctx context.Context, id dssmodels.ID) (*ridmodels.IdentificationServiceArea, error) {
@@ -49,6 +60,51 @@ func (store *isaStore) DeleteISA(ctx context.Context, isa *ridmodels.Identificat
 	return isa, nil
 }
 
+// Implements repos.ISA.TombstoneISA
+func (store *isaStore) TombstoneISA(ctx context.Context, isa *ridmodels.IdentificationServiceArea) (*ridmodels.IdentificationServiceArea, error) {
+	isa, ok := store.isas[isa.ID]
+	if !ok {
+		return nil, nil
+	}
+	delete(store.isas, isa.ID)
+	store.tombstoned[isa.ID] = isaTombstone{isa: isa, deletedAt: fakeClock.Now()}
+
+	return isa, nil
+}
+
+// Implements repos.ISA.GetTombstonedISA
+func (store *isaStore) GetTombstonedISA(ctx context.Context, id dssmodels.ID, deletedAfter time.Time) (*ridmodels.IdentificationServiceArea, error) {
+	if t, ok := store.tombstoned[id]; ok && t.deletedAt.After(deletedAfter) {
+		return t.isa, nil
+	}
+	return nil, nil
+}
+
+// Implements repos.ISA.RestoreISA
+func (store *isaStore) RestoreISA(ctx context.Context, isa *ridmodels.IdentificationServiceArea) (*ridmodels.IdentificationServiceArea, error) {
+	t, ok := store.tombstoned[isa.ID]
+	if !ok {
+		return nil, nil
+	}
+	delete(store.tombstoned, isa.ID)
+	restored := *t.isa
+	restored.Version = dssmodels.VersionFromTime(time.Now())
+	store.isas[isa.ID] = &restored
+
+	return &restored, nil
+}
+
+// Implements repos.ISA.ListTombstonedISAs
+func (store *isaStore) ListTombstonedISAs(ctx context.Context, writer string, deletedBefore time.Time) ([]*ridmodels.IdentificationServiceArea, error) {
+	isas := make([]*ridmodels.IdentificationServiceArea, 0)
+	for _, t := range store.tombstoned {
+		if !t.deletedAt.After(deletedBefore) {
+			isas = append(isas, t.isa)
+		}
+	}
+	return isas, nil
+}
+
 // Implements repos.ISA.InsertISA
 func (store *isaStore) InsertISA(ctx context.Context, isa *ridmodels.IdentificationServiceArea) (*ridmodels.IdentificationServiceArea, error) {
 	storedCopy := *isa
@@ -89,6 +145,20 @@ func (store *isaStore) ListExpiredISAs(ctx context.Context, writer string) ([]*r
 	return make([]*ridmodels.IdentificationServiceArea, 0), nil
 }
 
+func (store *isaStore) ListISAsByOwner(ctx context.Context, owner dssmodels.Owner, after dssmodels.ID, limit int) ([]*ridmodels.IdentificationServiceArea, error) {
+	var isas []*ridmodels.IdentificationServiceArea
+	for _, isa := range store.isas {
+		if isa.Owner == owner && isa.ID > after {
+			isas = append(isas, isa)
+		}
+	}
+	sort.Slice(isas, func(i, j int) bool { return isas[i].ID < isas[j].ID })
+	if len(isas) > limit {
+		isas = isas[:limit]
+	}
+	return isas, nil
+}
+
 func TestISAUpdateIdxCells(t *testing.T) {
 	ctx := context.Background()
 	app, cleanup := setUpISAApp(ctx, t)
@@ -114,7 +184,7 @@ func TestISAUpdateIdxCells(t *testing.T) {
 	// with the soon to be new version of the isa. both should increase their
 	// notification index.
 
-	_, err = app.InsertSubscription(ctx, &ridmodels.Subscription{
+	_, _, err = app.InsertSubscription(ctx, &ridmodels.Subscription{
 		ID:        dssmodels.ID(uuid.New().String()),
 		Owner:     "owner",
 		StartTime: &startTime,
@@ -123,7 +193,7 @@ func TestISAUpdateIdxCells(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	_, err = app.InsertSubscription(ctx, &ridmodels.Subscription{
+	_, _, err = app.InsertSubscription(ctx, &ridmodels.Subscription{
 		ID:        dssmodels.ID(uuid.New().String()),
 		Owner:     "owner",
 		StartTime: &startTime,
@@ -329,7 +399,7 @@ func TestAppDeleteISAs(t *testing.T) {
 	insertedSubscriptions := []*ridmodels.Subscription{}
 	for _, r := range subscriptionsPool {
 		copy := *r.input
-		s1, err := app.InsertSubscription(ctx, &copy)
+		s1, _, err := app.InsertSubscription(ctx, &copy)
 		require.NoError(t, err)
 		require.NotNil(t, s1)
 		require.Equal(t, 42, s1.NotificationIndex)
@@ -378,3 +448,52 @@ func TestAppDeleteISAs(t *testing.T) {
 		require.Equal(t, 44, subscriptionsOut[i].NotificationIndex)
 	}
 }
+
+func TestAppRestoreISA(t *testing.T) {
+	var (
+		ctx          = context.Background()
+		app, cleanup = setUpISAAppWithRecoveryWindow(ctx, t, time.Hour)
+	)
+	defer cleanup()
+
+	serviceArea := &ridmodels.IdentificationServiceArea{
+		ID:        dssmodels.ID(uuid.New().String()),
+		Owner:     dssmodels.Owner(uuid.New().String()),
+		URL:       "https://no/place/like/home/for/flights",
+		StartTime: &startTime,
+		EndTime:   &endTime,
+		Cells: s2.CellUnion{
+			s2.CellID(12494535935418957824),
+		},
+	}
+
+	copy := *serviceArea
+	isa, _, err := app.InsertISA(ctx, &copy)
+	require.NoError(t, err)
+
+	// Can't restore an ISA that is not deleted.
+	_, _, err = app.RestoreISA(ctx, isa.ID, isa.Owner)
+	require.Error(t, err)
+	require.Equal(t, dsserr.NotFound, stacktrace.GetCode(err))
+
+	_, _, err = app.DeleteISA(ctx, isa.ID, isa.Owner, isa.Version)
+	require.NoError(t, err)
+
+	deleted, err := app.GetISA(ctx, isa.ID)
+	require.NoError(t, err)
+	require.Nil(t, deleted)
+
+	// Can't restore with different owner.
+	_, _, err = app.RestoreISA(ctx, isa.ID, "bad-owner")
+	require.Error(t, err)
+	require.Equal(t, dsserr.PermissionDenied, stacktrace.GetCode(err))
+
+	restored, _, err := app.RestoreISA(ctx, isa.ID, isa.Owner)
+	require.NoError(t, err)
+	require.Equal(t, isa.ID, restored.ID)
+	require.Equal(t, isa.URL, restored.URL)
+
+	got, err := app.GetISA(ctx, isa.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+}
@@ -0,0 +1,96 @@
+package application
+
+import (
+	"context"
+
+	"github.com/golang/geo/s2"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	ridmodels "github.com/interuss/dss/pkg/rid/models"
+	"github.com/interuss/dss/pkg/rid/repos"
+	"github.com/interuss/stacktrace"
+)
+
+// ResetApp provides the interface to the removal of all the entities of an
+// owner, for resetting test environments.
+type ResetApp interface {
+	// DeleteOwnerEntities removes all the IdentificationServiceAreas and
+	// Subscriptions owned by "owner", or only those intersecting "area" if it
+	// is non-nil.  No notifications are issued for the removed entities.
+	DeleteOwnerEntities(ctx context.Context, owner dssmodels.Owner, area s2.CellUnion) (*DeletedEntities, error)
+}
+
+// DeletedEntities lists the IDs of the entities removed by
+// DeleteOwnerEntities.
+type DeletedEntities struct {
+	ISAs          []dssmodels.ID
+	Subscriptions []dssmodels.ID
+}
+
+// inArea returns whether cells intersect area, where a nil area covers
+// everything.
+func inArea(area s2.CellUnion, cells s2.CellUnion) bool {
+	return area == nil || area.Intersects(cells)
+}
+
+// DeleteOwnerEntities implements ResetApp.DeleteOwnerEntities.
+func (a *app) DeleteOwnerEntities(ctx context.Context, owner dssmodels.Owner, area s2.CellUnion) (*DeletedEntities, error) {
+	if owner == "" {
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Missing owner")
+	}
+
+	var deleted *DeletedEntities
+	err := a.Store.Transact(ctx, func(repo repos.Repository) error {
+		deleted = &DeletedEntities{}
+
+		var isas []*ridmodels.IdentificationServiceArea
+		for after := dssmodels.ID(""); ; {
+			page, err := repo.ListISAsByOwner(ctx, owner, after, dssmodels.MaxResultLimit)
+			if err != nil {
+				return stacktrace.Propagate(err, "Error listing ISAs")
+			}
+			isas = append(isas, page...)
+			if len(page) < dssmodels.MaxResultLimit {
+				break
+			}
+			after = page[len(page)-1].ID
+		}
+		for _, isa := range isas {
+			if !inArea(area, isa.Cells) {
+				continue
+			}
+			if _, err := repo.DeleteISA(ctx, isa); err != nil {
+				return stacktrace.Propagate(err, "Error deleting ISA %s", isa.ID)
+			}
+			deleted.ISAs = append(deleted.ISAs, isa.ID)
+		}
+
+		var subs []*ridmodels.Subscription
+		for after := dssmodels.ID(""); ; {
+			page, err := repo.ListSubscriptionsByOwner(ctx, owner, after, dssmodels.MaxResultLimit)
+			if err != nil {
+				return stacktrace.Propagate(err, "Error listing Subscriptions")
+			}
+			subs = append(subs, page...)
+			if len(page) < dssmodels.MaxResultLimit {
+				break
+			}
+			after = page[len(page)-1].ID
+		}
+		for _, sub := range subs {
+			if !inArea(area, sub.Cells) {
+				continue
+			}
+			if _, err := repo.DeleteSubscription(ctx, sub); err != nil {
+				return stacktrace.Propagate(err, "Error deleting Subscription %s", sub.ID)
+			}
+			deleted.Subscriptions = append(deleted.Subscriptions, sub.ID)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+	return deleted, nil
+}
@@ -0,0 +1,80 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/geo/s2"
+	"github.com/google/uuid"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	ridmodels "github.com/interuss/dss/pkg/rid/models"
+	"github.com/interuss/stacktrace"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteOwnerEntities(t *testing.T) {
+	ctx := context.Background()
+	app, cleanup := setUpISAApp(ctx, t)
+	defer cleanup()
+
+	var (
+		inside  = s2.CellUnion{17106221850767130624}
+		outside = s2.CellUnion{12494535935418957824}
+	)
+	insertISA := func(owner dssmodels.Owner, cells s2.CellUnion) dssmodels.ID {
+		isa, _, err := app.InsertISA(ctx, &ridmodels.IdentificationServiceArea{
+			ID:        dssmodels.ID(uuid.New().String()),
+			Owner:     owner,
+			StartTime: &startTime,
+			EndTime:   &endTime,
+			Cells:     cells,
+		})
+		require.NoError(t, err)
+		return isa.ID
+	}
+	insertSubscription := func(owner dssmodels.Owner, cells s2.CellUnion) dssmodels.ID {
+		sub, _, err := app.InsertSubscription(ctx, &ridmodels.Subscription{
+			ID:        dssmodels.ID(uuid.New().String()),
+			Owner:     owner,
+			StartTime: &startTime,
+			EndTime:   &endTime,
+			Cells:     cells,
+		})
+		require.NoError(t, err)
+		return sub.ID
+	}
+
+	isaInside := insertISA("owner", inside)
+	isaOutside := insertISA("owner", outside)
+	otherISA := insertISA("other", inside)
+	subInside := insertSubscription("owner", inside)
+	subOutside := insertSubscription("owner", outside)
+	otherSub := insertSubscription("other", inside)
+
+	// Only the entities of the owner in the area are removed
+	deleted, err := app.DeleteOwnerEntities(ctx, "owner", inside)
+	require.NoError(t, err)
+	require.Equal(t, []dssmodels.ID{isaInside}, deleted.ISAs)
+	require.Equal(t, []dssmodels.ID{subInside}, deleted.Subscriptions)
+
+	for _, id := range []dssmodels.ID{isaOutside, otherISA} {
+		isa, err := app.GetISA(ctx, id)
+		require.NoError(t, err)
+		require.NotNil(t, isa)
+	}
+	for _, id := range []dssmodels.ID{subOutside, otherSub} {
+		sub, err := app.GetSubscription(ctx, id)
+		require.NoError(t, err)
+		require.NotNil(t, sub)
+	}
+
+	// Without an area, all the remaining entities of the owner are removed
+	deleted, err = app.DeleteOwnerEntities(ctx, "owner", nil)
+	require.NoError(t, err)
+	require.Equal(t, []dssmodels.ID{isaOutside}, deleted.ISAs)
+	require.Equal(t, []dssmodels.ID{subOutside}, deleted.Subscriptions)
+
+	_, err = app.DeleteOwnerEntities(ctx, "", nil)
+	require.Equal(t, dsserr.BadRequest, stacktrace.GetCode(err))
+}
@@ -28,14 +28,19 @@ type SubscriptionApp interface {
 	// Returns the delete Subscription and all IdentificationServiceAreas affected by the delete.
 	DeleteSubscription(ctx context.Context, id dssmodels.ID, owner dssmodels.Owner, version *dssmodels.Version) (*ridmodels.Subscription, error)
 
-	// InsertSubscription inserts or updates an Subscription.
-	InsertSubscription(ctx context.Context, s *ridmodels.Subscription) (*ridmodels.Subscription, error)
+	// InsertSubscription inserts or updates an Subscription, returning whether
+	// its EndTime was truncated to the maximum Subscription lifetime.
+	InsertSubscription(ctx context.Context, s *ridmodels.Subscription) (*ridmodels.Subscription, bool, error)
 
-	// UpdateSubscription
-	UpdateSubscription(ctx context.Context, s *ridmodels.Subscription) (*ridmodels.Subscription, error)
+	// UpdateSubscription updates a Subscription, returning whether its EndTime
+	// was truncated to the maximum Subscription lifetime.
+	UpdateSubscription(ctx context.Context, s *ridmodels.Subscription) (*ridmodels.Subscription, bool, error)
 
 	// SearchSubscriptionsByOwner returns all IdentificationServiceAreas ownded by "owner" in "cells".
 	SearchSubscriptionsByOwner(ctx context.Context, cells s2.CellUnion, owner dssmodels.Owner) ([]*ridmodels.Subscription, error)
+
+	// SearchSubscriptions returns all unexpired Subscriptions in "cells", regardless of owner.
+	SearchSubscriptions(ctx context.Context, cells s2.CellUnion) ([]*ridmodels.Subscription, error)
 }
 
 func (a *app) GetSubscription(ctx context.Context, id dssmodels.ID) (*ridmodels.Subscription, error) {
@@ -54,13 +59,22 @@ func (a *app) SearchSubscriptionsByOwner(ctx context.Context, cells s2.CellUnion
 	return repo.SearchSubscriptionsByOwner(ctx, cells, owner)
 }
 
-func (a *app) InsertSubscription(ctx context.Context, s *ridmodels.Subscription) (*ridmodels.Subscription, error) {
+func (a *app) SearchSubscriptions(ctx context.Context, cells s2.CellUnion) ([]*ridmodels.Subscription, error) {
+	repo, err := a.Store.Interact(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Unable to interact with store")
+	}
+	return repo.SearchSubscriptions(ctx, cells)
+}
+
+func (a *app) InsertSubscription(ctx context.Context, s *ridmodels.Subscription) (*ridmodels.Subscription, bool, error) {
 	// Validate and perhaps correct StartTime and EndTime.
-	if err := s.AdjustTimeRange(a.clock.Now(), nil); err != nil {
-		return nil, stacktrace.Propagate(err, "Unable to adjust time range")
+	truncated, err := s.AdjustTimeRange(a.clock.Now(), nil, a.subscriptionLifetime)
+	if err != nil {
+		return nil, false, stacktrace.Propagate(err, "Unable to adjust time range")
 	}
 	var sub *ridmodels.Subscription
-	err := a.Store.Transact(ctx, func(repo repos.Repository) error {
+	err = a.Store.Transact(ctx, func(repo repos.Repository) error {
 
 		// ensure it doesn't exist yet
 		old, err := repo.GetSubscription(ctx, s.ID)
@@ -91,12 +105,15 @@ func (a *app) InsertSubscription(ctx context.Context, s *ridmodels.Subscription)
 
 		return nil
 	})
-	return sub, err
+	return sub, truncated, err
 }
 
 // InsertSubscription implements the App InsertSubscription method
-func (a *app) UpdateSubscription(ctx context.Context, s *ridmodels.Subscription) (*ridmodels.Subscription, error) {
-	var sub *ridmodels.Subscription
+func (a *app) UpdateSubscription(ctx context.Context, s *ridmodels.Subscription) (*ridmodels.Subscription, bool, error) {
+	var (
+		sub       *ridmodels.Subscription
+		truncated bool
+	)
 
 	err := a.Store.Transact(ctx, func(repo repos.Repository) error {
 		old, err := repo.GetSubscription(ctx, s.ID)
@@ -117,7 +134,8 @@ func (a *app) UpdateSubscription(ctx context.Context, s *ridmodels.Subscription)
 				"Subscription owned by %s, but %s attempted to update", old.Owner, s.Owner)
 		}
 		// Validate and perhaps correct StartTime and EndTime.
-		if err := s.AdjustTimeRange(a.clock.Now(), old); err != nil {
+		truncated, err = s.AdjustTimeRange(a.clock.Now(), old, a.subscriptionLifetime)
+		if err != nil {
 			return stacktrace.Propagate(err, "Error adjusting time range")
 		}
 
@@ -139,7 +157,7 @@ func (a *app) UpdateSubscription(ctx context.Context, s *ridmodels.Subscription)
 		}
 		return nil
 	})
-	return sub, err
+	return sub, truncated, err
 }
 
 // DeleteSubscription deletes the Subscription identified by "id" and owned by "owner".
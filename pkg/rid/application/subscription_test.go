@@ -2,6 +2,7 @@ package application
 
 import (
 	"context"
+	"sort"
 	"testing"
 	"time"
 
@@ -55,7 +56,7 @@ var (
 func setUpSubApp(ctx context.Context, t *testing.T) (*app, func()) {
 	l := zap.L()
 	transactor, cleanup := setUpStore(ctx, t, l)
-	return NewFromTransactor(transactor, l).(*app), cleanup
+	return NewFromTransactor(transactor, l, dssmodels.DefaultSubscriptionLifetime, 0).(*app), cleanup
 }
 
 type subscriptionStore struct {
@@ -109,6 +110,20 @@ func (store *subscriptionStore) SearchSubscriptionsByOwner(ctx context.Context,
 	return subs, nil
 }
 
+func (store *subscriptionStore) ListSubscriptionsByOwner(ctx context.Context, owner dssmodels.Owner, after dssmodels.ID, limit int) ([]*ridmodels.Subscription, error) {
+	var subs []*ridmodels.Subscription
+	for _, s := range store.subs {
+		if s.Owner == owner && s.ID > after {
+			subs = append(subs, s)
+		}
+	}
+	sort.Slice(subs, func(i, j int) bool { return subs[i].ID < subs[j].ID })
+	if len(subs) > limit {
+		subs = subs[:limit]
+	}
+	return subs, nil
+}
+
 func (store *subscriptionStore) UpdateNotificationIdxsInCells(ctx context.Context, cells s2.CellUnion) ([]*ridmodels.Subscription, error) {
 	subs, _ := store.SearchSubscriptions(ctx, cells)
 	for i := range subs {
@@ -173,11 +188,11 @@ func TestBadOwner(t *testing.T) {
 		Cells: s2.CellUnion{s2.CellID(17106221850767130624)},
 	}
 
-	sub, err := app.InsertSubscription(ctx, sub)
+	sub, _, err := app.InsertSubscription(ctx, sub)
 	require.NoError(t, err)
 	// Test changing owner fails
 	sub.Owner = "new bad owner"
-	_, err = app.UpdateSubscription(ctx, sub)
+	_, _, err = app.UpdateSubscription(ctx, sub)
 	require.Equal(t, stacktrace.GetCode(err), dsserr.PermissionDenied)
 }
 
@@ -194,7 +209,7 @@ func TestSubscriptionUpdateCells(t *testing.T) {
 	// library might try to Normalize (this is the name of the function) the Union
 	// into a single cell. We don't support this currently, so let's make sure
 	// this doesn't happen.
-	sub, err := app.InsertSubscription(ctx, &ridmodels.Subscription{
+	sub, _, err := app.InsertSubscription(ctx, &ridmodels.Subscription{
 		ID:        dssmodels.ID(uuid.New().String()),
 		Owner:     owner,
 		StartTime: &startTime,
@@ -207,7 +222,7 @@ func TestSubscriptionUpdateCells(t *testing.T) {
 
 	sub.Cells = s2.CellUnion{17106221953846345728}
 
-	sub, err = app.UpdateSubscription(ctx, sub)
+	sub, _, err = app.UpdateSubscription(ctx, sub)
 	require.NoError(t, err)
 	require.NotNil(t, sub)
 
@@ -279,7 +294,7 @@ func TestInsertSubscriptionsWithTimes(t *testing.T) {
 			if !r.endTime.IsZero() {
 				s.EndTime = &r.endTime
 			}
-			sub, err := app.InsertSubscription(ctx, s)
+			sub, _, err := app.InsertSubscription(ctx, s)
 
 			if r.wantErr == stacktrace.ErrorCode(0) {
 				require.NoError(t, err)
@@ -299,6 +314,34 @@ func TestInsertSubscriptionsWithTimes(t *testing.T) {
 	}
 }
 
+func TestSubscriptionExpiryTruncation(t *testing.T) {
+	ctx := context.Background()
+	l := zap.L()
+	transactor, cleanup := setUpStore(ctx, t, l)
+	defer cleanup()
+	app := NewFromTransactor(transactor, l, dssmodels.SubscriptionLifetime{MaxDuration: time.Hour, Truncate: true}, 0)
+
+	start := fakeClock.Now()
+	end := start.Add(time.Hour)
+	sub, truncated, err := app.InsertSubscription(ctx, &ridmodels.Subscription{
+		ID:        dssmodels.ID(uuid.New().String()),
+		Owner:     dssmodels.Owner("owner"),
+		StartTime: &start,
+		EndTime:   &end,
+		Cells:     s2.CellUnion{s2.CellID(17106221850767130624)},
+	})
+	require.NoError(t, err)
+	require.False(t, truncated)
+
+	end = start.Add(2 * time.Hour)
+	sub.StartTime = &start
+	sub.EndTime = &end
+	sub, truncated, err = app.UpdateSubscription(ctx, sub)
+	require.NoError(t, err)
+	require.True(t, truncated)
+	require.Equal(t, start.Add(time.Hour), (*sub.EndTime).UTC())
+}
+
 func TestUpdateSubscriptionsWithTimes(t *testing.T) {
 	ctx := context.Background()
 	app, cleanup := setUpSubApp(ctx, t)
@@ -385,7 +428,7 @@ func TestUpdateSubscriptionsWithTimes(t *testing.T) {
 			if !r.endTime.IsZero() {
 				s.EndTime = &r.endTime
 			}
-			sub, err := app.UpdateSubscription(ctx, s)
+			sub, _, err := app.UpdateSubscription(ctx, s)
 
 			if r.wantErr == stacktrace.ErrorCode(0) {
 				require.NoError(t, err)
@@ -431,23 +474,23 @@ func TestInsertTooManySubscription(t *testing.T) {
 
 	// We should be able to insert 10 subscriptions without error.
 	for i := 0; i < 10; i++ {
-		ret, err := app.InsertSubscription(ctx, makeSubscription([]uint64{12494535901059219456, 12494535866699481088}))
+		ret, _, err := app.InsertSubscription(ctx, makeSubscription([]uint64{12494535901059219456, 12494535866699481088}))
 		require.NoError(t, err)
 		require.NotNil(t, &ret)
 	}
 
 	// Inserting the 11th subscription will fail.
-	ret, err := app.InsertSubscription(ctx, makeSubscription([]uint64{12494535901059219456, 12494535866699481088}))
+	ret, _, err := app.InsertSubscription(ctx, makeSubscription([]uint64{12494535901059219456, 12494535866699481088}))
 	require.Equal(t, stacktrace.GetCode(err), dsserr.Exhausted)
 	require.Nil(t, ret)
 
 	// Inserting a subscription in a different cell will succeed.
-	ret, err = app.InsertSubscription(ctx, makeSubscription([]uint64{12494535832339742720}))
+	ret, _, err = app.InsertSubscription(ctx, makeSubscription([]uint64{12494535832339742720}))
 	require.NoError(t, err)
 	require.NotNil(t, &ret)
 
 	// Inserting a subscription that overlaps fail.
-	ret, err = app.InsertSubscription(ctx, makeSubscription([]uint64{12494535935418957824, 12494535866699481088}))
+	ret, _, err = app.InsertSubscription(ctx, makeSubscription([]uint64{12494535935418957824, 12494535866699481088}))
 	require.Equal(t, stacktrace.GetCode(err), dsserr.Exhausted)
 	require.Nil(t, ret)
 }
@@ -3,6 +3,7 @@ package apiv2
 import (
 	"time"
 
+	"github.com/golang/geo/s2"
 	ridpb "github.com/interuss/dss/pkg/api/v2/ridpbv2"
 	dssmodels "github.com/interuss/dss/pkg/models"
 	ridmodels "github.com/interuss/dss/pkg/rid/models"
@@ -33,20 +34,16 @@ func FromTime(t *ridpb.Time) (*time.Time, error) {
 	return &ts, nil
 }
 
-// FromAltitude converts proto to float
-func FromAltitude(alt *ridpb.Altitude) (*float32, error) {
+// FromAltitude converts proto to float, relative to the WGS84 ellipsoid at
+// position
+func FromAltitude(alt *ridpb.Altitude, position *s2.LatLng) (*float32, error) {
 	if alt == nil {
 		return nil, nil
 	}
-	ref := alt.GetReference()
-	if ref != "WGS84" {
-		return nil, stacktrace.NewError("Invalid altitude reference '" + ref + "'; expected 'WGS84'")
-	}
-	units := alt.GetUnits()
-	if units != "M" {
-		return nil, stacktrace.NewError("Invalid units '" + units + "'; expected 'M'")
+	value, err := dssmodels.AltitudeToWGS84Meters(alt.GetValue(), alt.GetReference(), alt.GetUnits(), position)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error converting altitude")
 	}
-	value := float32(alt.GetValue())
 	return &value, nil
 }
 
@@ -75,48 +72,39 @@ func FromVolume4D(vol4 *ridpb.Volume4D) (*dssmodels.Volume4D, error) {
 
 // FromVolume3D converts proto to business object
 func FromVolume3D(vol3 *ridpb.Volume3D) (*dssmodels.Volume3D, error) {
-	altitudeLo, err := FromAltitude(vol3.GetAltitudeLower())
-	if err != nil {
-		return nil, stacktrace.Propagate(err, "Error parsing lower altitude of Volume3D")
-	}
-	altitudeHi, err := FromAltitude(vol3.GetAltitudeUpper())
-	if err != nil {
-		return nil, stacktrace.Propagate(err, "Error parsing upper altitude of Volume3D")
-	}
-
+	var footprint dssmodels.Geometry
 	polygon := vol3.GetOutlinePolygon()
-	if polygon != nil {
-		if vol3.GetOutlineCircle() != nil {
-			return nil, stacktrace.NewError("Only one of outline_circle or outline_polygon may be specified")
-		}
-		footprint := FromPolygon(polygon)
-
-		result := &dssmodels.Volume3D{
-			Footprint:  footprint,
-			AltitudeLo: altitudeLo,
-			AltitudeHi: altitudeHi,
-		}
-
-		return result, nil
-	}
-
 	circle := vol3.GetOutlineCircle()
-	if circle != nil {
-		footprint, err := FromCircle(circle)
+	switch {
+	case polygon != nil && circle != nil:
+		return nil, stacktrace.NewError("Only one of outline_circle or outline_polygon may be specified")
+	case polygon != nil:
+		footprint = FromPolygon(polygon)
+	case circle != nil:
+		var err error
+		footprint, err = FromCircle(circle)
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "Error parsing outline_circle for Volume3D")
 		}
+	default:
+		return nil, stacktrace.NewError("Neither outline_polygon nor outline_circle were specified in volume")
+	}
 
-		result := &dssmodels.Volume3D{
-			Footprint:  footprint,
-			AltitudeLo: altitudeLo,
-			AltitudeHi: altitudeHi,
-		}
-
-		return result, nil
+	position := dssmodels.FootprintPosition(footprint)
+	altitudeLo, err := FromAltitude(vol3.GetAltitudeLower(), position)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error parsing lower altitude of Volume3D")
+	}
+	altitudeHi, err := FromAltitude(vol3.GetAltitudeUpper(), position)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error parsing upper altitude of Volume3D")
 	}
 
-	return nil, stacktrace.NewError("Neither outline_polygon nor outline_circle were specified in volume")
+	return &dssmodels.Volume3D{
+		Footprint:  footprint,
+		AltitudeLo: altitudeLo,
+		AltitudeHi: altitudeHi,
+	}, nil
 }
 
 // FromPolygon converts proto to business object
@@ -190,7 +178,7 @@ func ToIdentificationServiceArea(i *ridmodels.IdentificationServiceArea) *ridpb.
 	result := &ridpb.IdentificationServiceArea{
 		Id:         i.ID.String(),
 		Owner:      i.Owner.String(),
-		UssBaseUrl: i.URL,
+		UssBaseUrl: ridmodels.USSBaseURLFromFlightsURL(i.URL),
 		Version:    i.Version.String(),
 		TimeStart:  ToTime(i.StartTime),
 		TimeEnd:    ToTime(i.EndTime),
@@ -203,7 +191,7 @@ func ToIdentificationServiceArea(i *ridmodels.IdentificationServiceArea) *ridpb.
 // for API consumption.
 func ToSubscriberToNotify(s *ridmodels.Subscription) *ridpb.SubscriberToNotify {
 	return &ridpb.SubscriberToNotify{
-		Url: s.URL,
+		Url: ridmodels.USSBaseURLFromISANotificationURL(s.URL),
 		Subscriptions: []*ridpb.SubscriptionState{
 			{
 				NotificationIndex: int32(s.NotificationIndex),
@@ -219,7 +207,7 @@ func ToSubscription(s *ridmodels.Subscription) *ridpb.Subscription {
 	result := &ridpb.Subscription{
 		Id:                s.ID.String(),
 		Owner:             s.Owner.String(),
-		UssBaseUrl:        s.URL,
+		UssBaseUrl:        ridmodels.USSBaseURLFromISANotificationURL(s.URL),
 		NotificationIndex: int32(s.NotificationIndex),
 		Version:           s.Version.String(),
 		TimeStart:         ToTime(s.StartTime),
@@ -40,6 +40,9 @@ func (i *IdentificationServiceArea) SetExtents(extents *dssmodels.Volume4D) erro
 	if extents == nil {
 		return nil
 	}
+	if err := extents.SpatialVolume.ValidateAltitudes(); err != nil {
+		return stacktrace.Propagate(err, "Invalid ISA altitudes")
+	}
 	i.StartTime = extents.StartTime
 	i.EndTime = extents.EndTime
 	i.AltitudeHi = extents.SpatialVolume.AltitudeHi
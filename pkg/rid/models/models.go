@@ -1,10 +1,53 @@
 package models
 
 import (
-	"github.com/interuss/stacktrace"
 	"net/url"
+	"strings"
+
+	"github.com/interuss/stacktrace"
 )
 
+// The remote ID APIs differ in how they express the USS endpoints of
+// entities: v1 carries the full URLs of the endpoints while v2 carries the
+// base URL of the USS, from which the endpoints are located at the standard
+// paths below.  The DSS stores the v1 form, so entities written through either
+// version can be translated for readers of the other.
+const (
+	// flightsPath locates the flights endpoint of an ISA relative to its
+	// USS base URL.
+	flightsPath = "/uss/flights"
+
+	// isaNotificationPath locates the ISA notification endpoint of a
+	// subscription relative to its USS base URL.
+	isaNotificationPath = "/uss/identification_service_areas"
+)
+
+// FlightsURLFromUSSBaseURL converts the v2 USS base URL of an ISA to its v1
+// flights URL.
+func FlightsURLFromUSSBaseURL(baseURL string) string {
+	return strings.TrimSuffix(baseURL, "/") + flightsPath
+}
+
+// USSBaseURLFromFlightsURL converts the v1 flights URL of an ISA to its v2 USS
+// base URL.  A flights URL that does not follow the standard path is returned
+// unchanged.
+func USSBaseURLFromFlightsURL(flightsURL string) string {
+	return strings.TrimSuffix(flightsURL, flightsPath)
+}
+
+// ISANotificationURLFromUSSBaseURL converts the v2 USS base URL of a
+// subscription to its v1 ISA notification URL.
+func ISANotificationURLFromUSSBaseURL(baseURL string) string {
+	return strings.TrimSuffix(baseURL, "/") + isaNotificationPath
+}
+
+// USSBaseURLFromISANotificationURL converts the v1 ISA notification URL of a
+// subscription to its v2 USS base URL.  A notification URL that does not
+// follow the standard path is returned unchanged.
+func USSBaseURLFromISANotificationURL(notificationURL string) string {
+	return strings.TrimSuffix(notificationURL, isaNotificationPath)
+}
+
 // ValidateURL ensures https
 func ValidateURL(s string) error {
 	u, err := url.Parse(s)
@@ -0,0 +1,76 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	ridmodels "github.com/interuss/dss/pkg/rid/models"
+	apiv1 "github.com/interuss/dss/pkg/rid/models/api/v1"
+	apiv2 "github.com/interuss/dss/pkg/rid/models/api/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestISAURLRoundTrips(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+
+	for _, r := range []struct {
+		name       string
+		storedURL  string
+		flightsURL string
+		baseURL    string
+	}{
+		{
+			name:       "written through v2",
+			storedURL:  ridmodels.FlightsURLFromUSSBaseURL("https://uss.example.com/rid/"),
+			flightsURL: "https://uss.example.com/rid/uss/flights",
+			baseURL:    "https://uss.example.com/rid",
+		},
+		{
+			name:       "written through v1",
+			storedURL:  "https://uss.example.com/rid/uss/flights",
+			flightsURL: "https://uss.example.com/rid/uss/flights",
+			baseURL:    "https://uss.example.com/rid",
+		},
+		{
+			name:       "non-standard v1 path",
+			storedURL:  "https://uss.example.com/flights",
+			flightsURL: "https://uss.example.com/flights",
+			baseURL:    "https://uss.example.com/flights",
+		},
+	} {
+		t.Run(r.name, func(t *testing.T) {
+			isa := &ridmodels.IdentificationServiceArea{
+				ID:        "4348c8e5-0b1c-43cf-9114-2e67a4532765",
+				URL:       r.storedURL,
+				StartTime: &start,
+				EndTime:   &end,
+			}
+
+			v1 := apiv1.ToIdentificationServiceArea(isa)
+			require.Equal(t, r.flightsURL, v1.FlightsUrl)
+			require.Equal(t, start, v1.TimeStart.AsTime())
+			require.Equal(t, end, v1.TimeEnd.AsTime())
+
+			v2 := apiv2.ToIdentificationServiceArea(isa)
+			require.Equal(t, r.baseURL, v2.UssBaseUrl)
+			require.Equal(t, start, v2.TimeStart.Value.AsTime())
+			require.Equal(t, end, v2.TimeEnd.Value.AsTime())
+		})
+	}
+}
+
+func TestSubscriptionURLRoundTrips(t *testing.T) {
+	sub := &ridmodels.Subscription{
+		ID:  "4348c8e5-0b1c-43cf-9114-2e67a4532765",
+		URL: ridmodels.ISANotificationURLFromUSSBaseURL("https://uss.example.com"),
+	}
+
+	v1 := apiv1.ToSubscription(sub)
+	require.Equal(t, "https://uss.example.com/uss/identification_service_areas", v1.Callbacks.IdentificationServiceAreaUrl)
+	require.Equal(t, "https://uss.example.com/uss/identification_service_areas", apiv1.ToSubscriberToNotify(sub).Url)
+
+	v2 := apiv2.ToSubscription(sub)
+	require.Equal(t, "https://uss.example.com", v2.UssBaseUrl)
+	require.Equal(t, "https://uss.example.com", apiv2.ToSubscriberToNotify(sub).Url)
+}
@@ -11,10 +11,6 @@ import (
 )
 
 var (
-	// maxSubscriptionDuration is the largest allowed interval between StartTime
-	// and EndTime.
-	maxSubscriptionDuration = time.Hour * 24
-
 	// maxClockSkew is the largest allowed interval between the StartTime of a new
 	// subscription and the server's idea of the current time.
 	maxClockSkew = time.Minute * 5
@@ -67,8 +63,9 @@ func (s *Subscription) SetExtents(extents *dssmodels.Volume4D) error {
 }
 
 // AdjustTimeRange adjusts the time range to the max allowed ranges on a
-// subscription.
-func (s *Subscription) AdjustTimeRange(now time.Time, old *Subscription) error {
+// subscription, returning whether the requested EndTime was truncated to fit
+// within lifetime.
+func (s *Subscription) AdjustTimeRange(now time.Time, old *Subscription, lifetime dssmodels.SubscriptionLifetime) (bool, error) {
 	if s.StartTime == nil {
 		// If StartTime was omitted, default to Now() for new subscriptions or re-
 		// use the existing time of existing subscriptions.
@@ -80,7 +77,7 @@ func (s *Subscription) AdjustTimeRange(now time.Time, old *Subscription) error {
 	} else {
 		// If setting the StartTime explicitly ensure it is not too far in the past.
 		if now.Sub(*s.StartTime) > maxClockSkew {
-			return stacktrace.NewErrorWithCode(dsserr.BadRequest, "Subscription time_start must not be in the past")
+			return false, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Subscription time_start must not be in the past")
 		}
 	}
 
@@ -89,21 +86,13 @@ func (s *Subscription) AdjustTimeRange(now time.Time, old *Subscription) error {
 		s.EndTime = old.EndTime
 	}
 
-	// Or if this is a new subscription default to StartTime + 1 day.
-	if s.EndTime == nil {
-		truncatedEndTime := s.StartTime.Add(maxSubscriptionDuration)
-		s.EndTime = &truncatedEndTime
-	}
-
-	// EndTime cannot be before StartTime.
-	if s.EndTime.Sub(*s.StartTime) < 0 {
-		return stacktrace.NewErrorWithCode(dsserr.BadRequest, "Subscription time_end must be after time_start")
-	}
-
-	// EndTime cannot be 24 hrs after StartTime
-	if s.EndTime.Sub(*s.StartTime) > maxSubscriptionDuration {
-		return stacktrace.NewErrorWithCode(dsserr.BadRequest, "Subscription window exceeds 24 hours")
+	// Or if this is a new subscription default to the longest allowed lifetime,
+	// and in any case make sure the lifetime is within bounds.
+	endTime, truncated, err := lifetime.Enforce(*s.StartTime, s.EndTime)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "Invalid Subscription time range")
 	}
+	s.EndTime = endTime
 
-	return nil
+	return truncated, nil
 }
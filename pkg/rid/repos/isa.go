@@ -31,4 +31,30 @@ type ISA interface {
 
 	// ListExpiredISAs lists all expired ISAs based on writer
 	ListExpiredISAs(ctx context.Context, writer string) ([]*ridmodels.IdentificationServiceArea, error)
+
+	// ListISAsByOwner returns up to "limit" IdentificationServiceAreas owned
+	// by "owner" with an ID greater than "after" (or any ID if empty), in ID
+	// order.
+	ListISAsByOwner(ctx context.Context, owner dssmodels.Owner, after dssmodels.ID, limit int) ([]*ridmodels.IdentificationServiceArea, error)
+
+	// TombstoneISA marks the IdentificationServiceArea identified by "id" as
+	// deleted such that it may later be restored with RestoreISA.  Tombstoned
+	// ISAs are excluded from all other queries except GetTombstonedISA and
+	// ListTombstonedISAs.
+	// Returns nil, nil if ID, version not found
+	TombstoneISA(ctx context.Context, isa *ridmodels.IdentificationServiceArea) (*ridmodels.IdentificationServiceArea, error)
+
+	// GetTombstonedISA returns the IdentificationServiceArea identified by "id"
+	// if it was tombstoned after "deletedAfter".
+	// Returns nil, nil if not found
+	GetTombstonedISA(ctx context.Context, id dssmodels.ID, deletedAfter time.Time) (*ridmodels.IdentificationServiceArea, error)
+
+	// RestoreISA clears the tombstone of the IdentificationServiceArea
+	// identified by "id".
+	// Returns nil, nil if ID, version not found
+	RestoreISA(ctx context.Context, isa *ridmodels.IdentificationServiceArea) (*ridmodels.IdentificationServiceArea, error)
+
+	// ListTombstonedISAs lists all ISAs based on writer which were tombstoned
+	// before "deletedBefore".
+	ListTombstonedISAs(ctx context.Context, writer string, deletedBefore time.Time) ([]*ridmodels.IdentificationServiceArea, error)
 }
@@ -40,4 +40,9 @@ type Subscription interface {
 
 	// ListExpiredSubscriptions lists all expired Subscriptions based on writer.
 	ListExpiredSubscriptions(ctx context.Context, writer string) ([]*ridmodels.Subscription, error)
+
+	// ListSubscriptionsByOwner returns up to "limit" Subscriptions owned by
+	// "owner" with an ID greater than "after" (or any ID if empty), in ID
+	// order.
+	ListSubscriptionsByOwner(ctx context.Context, owner dssmodels.Owner, after dssmodels.ID, limit int) ([]*ridmodels.Subscription, error)
 }
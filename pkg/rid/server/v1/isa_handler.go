@@ -35,6 +35,9 @@ func (s *Server) GetIdentificationServiceArea(
 	if isa == nil {
 		return nil, stacktrace.NewErrorWithCode(dsserr.NotFound, "ISA %s not found", req.GetId())
 	}
+	if err := dssmodels.CheckNotModified(ctx, dssmodels.ETag(isa.Version)); err != nil {
+		return nil, err // No need to Propagate this error as it is a signal rather than a failure
+	}
 	return &ridpb.GetIdentificationServiceAreaResponse{
 		ServiceArea: apiv1.ToIdentificationServiceArea(isa),
 	}, nil
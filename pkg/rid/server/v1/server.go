@@ -51,3 +51,24 @@ func (s *Server) AuthScopes() map[auth.Operation]auth.KeyClaimedScopesValidator
 		"/ridpbv1.DiscoveryAndSynchronizationService/UpdateSubscription":               auth.RequireAllScopes(Scopes.ISA.Read),
 	}
 }
+
+// MutationOperations returns the endpoints creating, updating or deleting
+// entities.
+func (s *Server) MutationOperations() []auth.Operation {
+	return []auth.Operation{
+		"/ridpbv1.DiscoveryAndSynchronizationService/CreateIdentificationServiceArea",
+		"/ridpbv1.DiscoveryAndSynchronizationService/CreateSubscription",
+		"/ridpbv1.DiscoveryAndSynchronizationService/DeleteIdentificationServiceArea",
+		"/ridpbv1.DiscoveryAndSynchronizationService/DeleteSubscription",
+		"/ridpbv1.DiscoveryAndSynchronizationService/UpdateIdentificationServiceArea",
+		"/ridpbv1.DiscoveryAndSynchronizationService/UpdateSubscription",
+	}
+}
+
+// SearchOperations returns the endpoints searching for entities in an area.
+func (s *Server) SearchOperations() []auth.Operation {
+	return []auth.Operation{
+		"/ridpbv1.DiscoveryAndSynchronizationService/SearchIdentificationServiceAreas",
+		"/ridpbv1.DiscoveryAndSynchronizationService/SearchSubscriptions",
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/interuss/dss/pkg/geo"
 	"github.com/interuss/dss/pkg/geo/testdata"
 	dssmodels "github.com/interuss/dss/pkg/models"
+	"github.com/interuss/dss/pkg/rid/application"
 	ridmodels "github.com/interuss/dss/pkg/rid/models"
 	apiv1 "github.com/interuss/dss/pkg/rid/models/api/v1"
 
@@ -45,18 +46,18 @@ type mockApp struct {
 	mock.Mock
 }
 
-func (ma *mockApp) InsertSubscription(ctx context.Context, s *ridmodels.Subscription) (*ridmodels.Subscription, error) {
+func (ma *mockApp) InsertSubscription(ctx context.Context, s *ridmodels.Subscription) (*ridmodels.Subscription, bool, error) {
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 	args := ma.Called(ctx, s)
-	return args.Get(0).(*ridmodels.Subscription), args.Error(1)
+	return args.Get(0).(*ridmodels.Subscription), args.Bool(1), args.Error(2)
 }
 
-func (ma *mockApp) UpdateSubscription(ctx context.Context, s *ridmodels.Subscription) (*ridmodels.Subscription, error) {
+func (ma *mockApp) UpdateSubscription(ctx context.Context, s *ridmodels.Subscription) (*ridmodels.Subscription, bool, error) {
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 	args := ma.Called(ctx, s)
-	return args.Get(0).(*ridmodels.Subscription), args.Error(1)
+	return args.Get(0).(*ridmodels.Subscription), args.Bool(1), args.Error(2)
 }
 
 func (ma *mockApp) GetSubscription(ctx context.Context, id dssmodels.ID) (*ridmodels.Subscription, error) {
@@ -80,6 +81,13 @@ func (ma *mockApp) SearchSubscriptionsByOwner(ctx context.Context, cells s2.Cell
 	return args.Get(0).([]*ridmodels.Subscription), args.Error(1)
 }
 
+func (ma *mockApp) SearchSubscriptions(ctx context.Context, cells s2.CellUnion) ([]*ridmodels.Subscription, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	args := ma.Called(ctx, cells)
+	return args.Get(0).([]*ridmodels.Subscription), args.Error(1)
+}
+
 func (ma *mockApp) GetISA(ctx context.Context, id dssmodels.ID) (*ridmodels.IdentificationServiceArea, error) {
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
@@ -94,6 +102,13 @@ func (ma *mockApp) DeleteISA(ctx context.Context, id dssmodels.ID, owner dssmode
 	return args.Get(0).(*ridmodels.IdentificationServiceArea), args.Get(1).([]*ridmodels.Subscription), args.Error(2)
 }
 
+func (ma *mockApp) RestoreISA(ctx context.Context, id dssmodels.ID, owner dssmodels.Owner) (*ridmodels.IdentificationServiceArea, []*ridmodels.Subscription, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	args := ma.Called(ctx, id, owner)
+	return args.Get(0).(*ridmodels.IdentificationServiceArea), args.Get(1).([]*ridmodels.Subscription), args.Error(2)
+}
+
 func (ma *mockApp) InsertISA(ctx context.Context, isa *ridmodels.IdentificationServiceArea) (*ridmodels.IdentificationServiceArea, []*ridmodels.Subscription, error) {
 	args := ma.Called(ctx, isa)
 	return args.Get(0).(*ridmodels.IdentificationServiceArea), args.Get(1).([]*ridmodels.Subscription), args.Error(2)
@@ -111,6 +126,11 @@ func (ma *mockApp) SearchISAs(ctx context.Context, cells s2.CellUnion, earliest
 	return args.Get(0).([]*ridmodels.IdentificationServiceArea), args.Error(1)
 }
 
+func (ma *mockApp) DeleteOwnerEntities(ctx context.Context, owner dssmodels.Owner, area s2.CellUnion) (*application.DeletedEntities, error) {
+	args := ma.Called(ctx, owner, area)
+	return args.Get(0).(*application.DeletedEntities), args.Error(1)
+}
+
 func TestDeleteSubscription(t *testing.T) {
 	ctx := auth.ContextWithOwner(context.Background(), "foo")
 	version, _ := dssmodels.VersionFromString("bar")
@@ -238,7 +258,7 @@ func TestCreateSubscription(t *testing.T) {
 				ma.On("SearchISAs", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(
 					[]*ridmodels.IdentificationServiceArea(nil), nil)
 				ma.On("InsertSubscription", mock.Anything, r.wantSubscription).Return(
-					r.wantSubscription, nil,
+					r.wantSubscription, false, nil,
 				)
 			}
 			s := &Server{App: ma}
@@ -284,7 +304,7 @@ func TestCreateSubscriptionResponseIncludesISAs(t *testing.T) {
 	ma := &mockApp{}
 
 	ma.On("SearchISAs", mock.Anything, cells, mock.Anything, mock.Anything).Return(isas, nil)
-	ma.On("InsertSubscription", mock.Anything, sub).Return(sub, nil)
+	ma.On("InsertSubscription", mock.Anything, sub).Return(sub, true, nil)
 	s := &Server{
 		App: ma,
 	}
@@ -308,6 +328,10 @@ func TestCreateSubscriptionResponseIncludesISAs(t *testing.T) {
 			Owner:      "me-myself-and-i",
 		},
 	}, resp.ServiceAreas)
+
+	// The applied expiry is reported along with its truncation.
+	require.True(t, resp.ExpiryTruncated)
+	require.Equal(t, resp.Subscription.TimeEnd.AsTime(), resp.Expiry.AsTime())
 }
 
 func TestGetSubscription(t *testing.T) {
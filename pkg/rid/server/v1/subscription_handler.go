@@ -2,6 +2,7 @@ package v1
 
 import (
 	"context"
+	"strconv"
 
 	ridpb "github.com/interuss/dss/pkg/api/v1/ridpbv1"
 	"github.com/interuss/dss/pkg/auth"
@@ -13,6 +14,7 @@ import (
 	apiv1 "github.com/interuss/dss/pkg/rid/models/api/v1"
 	"github.com/interuss/stacktrace"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc"
 )
 
 // DeleteSubscription deletes an existing subscription.
@@ -98,6 +100,11 @@ func (s *Server) GetSubscription(
 	if subscription == nil {
 		return nil, stacktrace.NewErrorWithCode(dsserr.NotFound, "Subscription %s not found", req.GetId())
 	}
+	// The notification index changes without the version changing.
+	etag := dssmodels.ETag(subscription.Version, strconv.Itoa(subscription.NotificationIndex))
+	if err := dssmodels.CheckNotModified(ctx, etag); err != nil {
+		return nil, err // No need to Propagate this error as it is a signal rather than a failure
+	}
 	return &ridpb.GetSubscriptionResponse{
 		Subscription: apiv1.ToSubscription(subscription),
 	}, nil
@@ -153,11 +160,15 @@ func (s *Server) CreateSubscription(
 		return nil, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid extents")
 	}
 
-	insertedSub, err := s.App.InsertSubscription(ctx, sub)
+	insertedSub, truncated, err := s.App.InsertSubscription(ctx, sub)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Could not insert Subscription")
 	}
 
+	// Report the lifetime applied to the Subscription; failing to do so does not
+	// invalidate the mutation.
+	_ = grpc.SetHeader(ctx, dssmodels.SubscriptionExpiryMetadata(*insertedSub.EndTime, truncated))
+
 	p := apiv1.ToSubscription(insertedSub)
 
 	// Find ISAs that were in this subscription's area.
@@ -173,8 +184,10 @@ func (s *Server) CreateSubscription(
 	}
 
 	return &ridpb.PutSubscriptionResponse{
-		Subscription: p,
-		ServiceAreas: isaProtos,
+		Subscription:    p,
+		ServiceAreas:    isaProtos,
+		Expiry:          p.TimeEnd,
+		ExpiryTruncated: truncated,
 	}, nil
 }
 
@@ -227,11 +240,15 @@ func (s *Server) UpdateSubscription(
 		return nil, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid extents")
 	}
 
-	insertedSub, err := s.App.UpdateSubscription(ctx, sub)
+	insertedSub, truncated, err := s.App.UpdateSubscription(ctx, sub)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Could not update Subscription")
 	}
 
+	// Report the lifetime applied to the Subscription; failing to do so does not
+	// invalidate the mutation.
+	_ = grpc.SetHeader(ctx, dssmodels.SubscriptionExpiryMetadata(*insertedSub.EndTime, truncated))
+
 	p := apiv1.ToSubscription(insertedSub)
 
 	// Find ISAs that were in this subscription's area.
@@ -247,7 +264,9 @@ func (s *Server) UpdateSubscription(
 	}
 
 	return &ridpb.PutSubscriptionResponse{
-		Subscription: p,
-		ServiceAreas: isaProtos,
+		Subscription:    p,
+		ServiceAreas:    isaProtos,
+		Expiry:          p.TimeEnd,
+		ExpiryTruncated: truncated,
 	}, nil
 }
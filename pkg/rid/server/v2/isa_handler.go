@@ -35,6 +35,9 @@ func (s *Server) GetIdentificationServiceArea(
 	if isa == nil {
 		return nil, stacktrace.NewErrorWithCode(dsserr.NotFound, "ISA %s not found", req.GetId())
 	}
+	if err := dssmodels.CheckNotModified(ctx, dssmodels.ETag(isa.Version)); err != nil {
+		return nil, err // No need to Propagate this error as it is a signal rather than a failure
+	}
 	return &ridpb.GetIdentificationServiceAreaResponse{
 		ServiceArea: apiv2.ToIdentificationServiceArea(isa),
 	}, nil
@@ -81,7 +84,7 @@ func (s *Server) CreateIdentificationServiceArea(
 
 	isa := &ridmodels.IdentificationServiceArea{
 		ID:     id,
-		URL:    params.GetUssBaseUrl(),
+		URL:    ridmodels.FlightsURLFromUSSBaseURL(params.GetUssBaseUrl()),
 		Owner:  owner,
 		Writer: s.Locality,
 	}
@@ -147,7 +150,7 @@ func (s *Server) UpdateIdentificationServiceArea(
 
 	isa := &ridmodels.IdentificationServiceArea{
 		ID:      dssmodels.ID(id),
-		URL:     params.UssBaseUrl,
+		URL:     ridmodels.FlightsURLFromUSSBaseURL(params.UssBaseUrl),
 		Owner:   owner,
 		Version: version,
 		Writer:  s.Locality,
@@ -44,3 +44,24 @@ func (s *Server) AuthScopes() map[auth.Operation]auth.KeyClaimedScopesValidator
 		"/ridpbv2.StandardRemoteIDAPIInterfacesService/UpdateSubscription":               auth.RequireAllScopes(Scopes.DisplayProvider),
 	}
 }
+
+// MutationOperations returns the endpoints creating, updating or deleting
+// entities.
+func (s *Server) MutationOperations() []auth.Operation {
+	return []auth.Operation{
+		"/ridpbv2.StandardRemoteIDAPIInterfacesService/CreateIdentificationServiceArea",
+		"/ridpbv2.StandardRemoteIDAPIInterfacesService/CreateSubscription",
+		"/ridpbv2.StandardRemoteIDAPIInterfacesService/DeleteIdentificationServiceArea",
+		"/ridpbv2.StandardRemoteIDAPIInterfacesService/DeleteSubscription",
+		"/ridpbv2.StandardRemoteIDAPIInterfacesService/UpdateIdentificationServiceArea",
+		"/ridpbv2.StandardRemoteIDAPIInterfacesService/UpdateSubscription",
+	}
+}
+
+// SearchOperations returns the endpoints searching for entities in an area.
+func (s *Server) SearchOperations() []auth.Operation {
+	return []auth.Operation{
+		"/ridpbv2.StandardRemoteIDAPIInterfacesService/SearchIdentificationServiceAreas",
+		"/ridpbv2.StandardRemoteIDAPIInterfacesService/SearchSubscriptions",
+	}
+}
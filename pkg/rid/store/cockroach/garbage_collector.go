@@ -2,20 +2,33 @@ package cockroach
 
 import (
 	"context"
+	"time"
 
 	"github.com/interuss/dss/pkg/rid/repos"
 	"github.com/interuss/stacktrace"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 )
 
+var expiredEntityPurges = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "dss_rid_expired_entity_purges_total",
+	Help: "Number of remote ID entities deleted by the garbage collector of this DSS instance once expired, by type.",
+}, []string{"type"})
+
 type GarbageCollector struct {
 	repos  repos.Repository
 	writer string
+
+	// isaRecoveryWindow is how long tombstoned ISAs are retained before being
+	// purged.
+	isaRecoveryWindow time.Duration
 }
 
-func NewGarbageCollector(repos repos.Repository, writer string) *GarbageCollector {
+func NewGarbageCollector(repos repos.Repository, writer string, isaRecoveryWindow time.Duration) *GarbageCollector {
 	return &GarbageCollector{
-		repos:  repos,
-		writer: writer,
+		repos:             repos,
+		writer:            writer,
+		isaRecoveryWindow: isaRecoveryWindow,
 	}
 }
 
@@ -25,6 +38,11 @@ func (gc *GarbageCollector) DeleteRIDExpiredRecords(ctx context.Context) error {
 		return stacktrace.Propagate(err,
 			"Failed to delete RID expired records")
 	}
+	err = gc.PurgeTombstonedISAs(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err,
+			"Failed to delete RID expired records")
+	}
 	err = gc.DeleteExpiredSubscriptions(ctx)
 	if err != nil {
 		return stacktrace.Propagate(err,
@@ -42,15 +60,31 @@ func (gc *GarbageCollector) DeleteExpiredISAs(ctx context.Context) error {
 	}
 
 	for _, isa := range expiredISAs {
-		isaOut, err := gc.repos.DeleteISA(ctx, isa)
-		if isaOut != nil {
+		if _, err := gc.repos.DeleteISA(ctx, isa); err != nil {
 			return stacktrace.Propagate(err,
-				"Deleted ISA")
+				"Failed to delete ISAs")
 		}
-		if err != nil {
+		expiredEntityPurges.WithLabelValues("isa").Inc()
+	}
+
+	return nil
+}
+
+// PurgeTombstonedISAs permanently deletes the ISAs that were tombstoned
+// longer ago than the recovery window.
+func (gc *GarbageCollector) PurgeTombstonedISAs(ctx context.Context) error {
+	tombstonedISAs, err := gc.repos.ListTombstonedISAs(ctx, gc.writer, time.Now().Add(-gc.isaRecoveryWindow))
+	if err != nil {
+		return stacktrace.Propagate(err,
+			"Failed to list tombstoned ISAs")
+	}
+
+	for _, isa := range tombstonedISAs {
+		if _, err := gc.repos.DeleteISA(ctx, isa); err != nil {
 			return stacktrace.Propagate(err,
-				"Failed to delete ISAs")
+				"Failed to purge ISA")
 		}
+		expiredEntityPurges.WithLabelValues("tombstoned_isa").Inc()
 	}
 
 	return nil
@@ -64,15 +98,11 @@ func (gc *GarbageCollector) DeleteExpiredSubscriptions(ctx context.Context) erro
 	}
 
 	for _, sub := range expiredSubscriptions {
-		subOut, err := gc.repos.DeleteSubscription(ctx, sub)
-		if subOut != nil {
-			return stacktrace.Propagate(err,
-				"Deleted Subscription")
-		}
-		if err != nil {
+		if _, err := gc.repos.DeleteSubscription(ctx, sub); err != nil {
 			return stacktrace.Propagate(err,
 				"Failed to delete Subscription")
 		}
+		expiredEntityPurges.WithLabelValues("subscription").Inc()
 	}
 	return nil
 }
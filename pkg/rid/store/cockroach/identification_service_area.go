@@ -28,8 +28,9 @@ const (
 func NewISARepo(ctx context.Context, db dssql.Queryable, dbVersion semver.Version, logger *zap.Logger) repos.ISA {
 	if dbVersion.Compare(v400) >= 0 {
 		return &isaRepo{
-			Queryable: db,
-			logger:    logger,
+			Queryable:  db,
+			logger:     logger,
+			tombstones: dbVersion.Compare(v410) >= 0,
 		}
 	}
 	return &isaRepoV3{
@@ -43,6 +44,32 @@ type isaRepo struct {
 	dssql.Queryable
 
 	logger *zap.Logger
+
+	// tombstones indicates whether the schema supports tombstoning ISAs rather
+	// than deleting them outright.
+	tombstones bool
+
+	// asOfSystemTime, when not empty, is the AS OF SYSTEM TIME clause of
+	// searches, which may then be served by follower replicas.
+	asOfSystemTime string
+
+	// shardCells, when positive, is the number of cells beyond which searches
+	// are split into concurrent queries of at most shardCells cells each, at
+	// most shardConcurrency at a time.  Only set outside of transactions,
+	// which cannot run concurrent queries.
+	shardCells       int
+	shardConcurrency int
+}
+
+// liveFilter returns the condition (to be appended to a WHERE clause)
+// excluding tombstoned ISAs from a query.
+func (c *isaRepo) liveFilter() string {
+	if !c.tombstones {
+		return ""
+	}
+	return `
+			AND
+				deleted_at IS NULL`
 }
 
 func (c *isaRepo) process(ctx context.Context, query string, args ...interface{}) ([]*ridmodels.IdentificationServiceArea, error) {
@@ -111,7 +138,7 @@ func (c *isaRepo) GetISA(ctx context.Context, id dssmodels.ID) (*ridmodels.Ident
 		SELECT %s FROM
 			identification_service_areas
 		WHERE
-			id = $1`, isaFields)
+			id = $1%s`, isaFields, c.liveFilter())
 	uid, err := id.PgUUID()
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to convert id to PgUUID")
@@ -133,9 +160,9 @@ func (c *isaRepo) InsertISA(ctx context.Context, isa *ridmodels.IdentificationSe
 				identification_service_areas
 				(%s)
 			VALUES
-				($1, $2, $3, $4, $5, $6, $7, transaction_timestamp())
+				($1, $2, $3, $4, $5, $6, $7, transaction_timestamp())%s
 			RETURNING
-				%s`, isaFields, isaFields)
+				%s`, isaFields, c.replaceTombstoneClause(), isaFields)
 	)
 
 	cids := make([]int64, len(isa.Cells))
@@ -171,9 +198,9 @@ func (c *isaRepo) UpdateISA(ctx context.Context, isa *ridmodels.IdentificationSe
 			UPDATE
 				identification_service_areas
 			SET	(%s) = ($1, $2, $3, $4, $5, $7, transaction_timestamp())
-			WHERE id = $1 AND updated_at = $6
+			WHERE id = $1 AND updated_at = $6%s
 			RETURNING
-				%s`, updateISAFields, isaFields)
+				%s`, updateISAFields, c.liveFilter(), isaFields)
 	)
 
 	cids := make([]int64, len(isa.Cells))
@@ -222,23 +249,6 @@ func (c *isaRepo) DeleteISA(ctx context.Context, isa *ridmodels.IdentificationSe
 // instances that intersect with "cells" and, if set, the temporal volume
 // defined by "earliest" and "latest".
 func (c *isaRepo) SearchISAs(ctx context.Context, cells s2.CellUnion, earliest *time.Time, latest *time.Time) ([]*ridmodels.IdentificationServiceArea, error) {
-	var (
-		// TODO: make earliest and latest required (NOT NULL) and remove coalesce.
-		// Make them real values (not pointers), on the model layer.
-		isasInCellsQuery = fmt.Sprintf(`
-			SELECT
-				%s
-			FROM
-				identification_service_areas
-			WHERE
-				ends_at >= $1
-			AND
-				COALESCE(starts_at <= $2, true)
-			AND
-				cells && $3
-			LIMIT $4`, isaFields)
-	)
-
 	if len(cells) == 0 {
 		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Missing cell IDs for query")
 	}
@@ -247,18 +257,63 @@ func (c *isaRepo) SearchISAs(ctx context.Context, cells s2.CellUnion, earliest *
 		return nil, stacktrace.NewError("Earliest start time is missing")
 	}
 
+	if c.shardCells > 0 && len(cells) > c.shardCells {
+		return c.searchISAShards(ctx, cells, *earliest, latest)
+	}
+
+	pgCids, err := cellsArray(cells)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+
+	isasInCellsQuery, args := searchISAsQuery(isaFields, c.asOfSystemTime, *earliest, latest, pgCids, c.liveFilter())
+	return c.process(ctx, isasInCellsQuery, args...)
+}
+
+// cellsArray converts cells to a query argument.
+func cellsArray(cells s2.CellUnion) (pgtype.Int8Array, error) {
 	cids := make([]int64, len(cells))
 	for i, cid := range cells {
 		cids[i] = int64(cid)
 	}
 
 	var pgCids pgtype.Int8Array
-	err := pgCids.Set(cids)
-	if err != nil {
-		return nil, stacktrace.Propagate(err, "Failed to convert array to jackc/pgtype")
+	if err := pgCids.Set(cids); err != nil {
+		return pgCids, stacktrace.Propagate(err, "Failed to convert array to jackc/pgtype")
 	}
+	return pgCids, nil
+}
 
-	return c.process(ctx, isasInCellsQuery, earliest, latest, pgCids, dssmodels.MaxResultLimit)
+// searchISAsQuery builds the query (and its arguments) selecting "fields" of
+// the ISAs intersecting "cells" and the time window starting at "earliest" and
+// ending at "latest" (open-ended if nil), further restricted by "filter", as of
+// the "asOf" clause of the table if not empty.  The cells are matched with
+// the array overlap operator so that the datastore serves the query from the
+// inverted index on cells, which is selective where the time filter, matching
+// every ISA that has not ended yet, is not.  The time filter applies to the
+// ISAs matched by that index within the same query; a composite index would
+// not serve it either, since multi-column inverted indexes only serve equality
+// filters on the columns preceding the inverted one.
+func searchISAsQuery(fields string, asOf string, earliest time.Time, latest *time.Time, cells pgtype.Int8Array, filter string) (string, []interface{}) {
+	args := []interface{}{earliest, cells, dssmodels.MaxResultLimit}
+	startsAtFilter := ""
+	if latest != nil {
+		args = append(args, *latest)
+		startsAtFilter = fmt.Sprintf(`
+			AND
+				(starts_at IS NULL OR starts_at <= $%d)`, len(args))
+	}
+	query := fmt.Sprintf(`
+			SELECT
+				%s
+			FROM
+				identification_service_areas%s
+			WHERE
+				ends_at >= $1
+			AND
+				cells && $2%s%s
+			LIMIT $3`, fields, asOfClause(asOf), startsAtFilter, filter)
+	return query, args
 }
 
 // ListExpiredISAs lists all expired ISAs based on writer.
@@ -285,3 +340,130 @@ func (c *isaRepo) ListExpiredISAs(ctx context.Context, writer string) ([]*ridmod
 
 	return c.process(ctx, isasInCellsQuery, dssmodels.MaxResultLimit)
 }
+
+// ListISAsByOwner implements repos.ISA.ListISAsByOwner.
+func (c *isaRepo) ListISAsByOwner(ctx context.Context, owner dssmodels.Owner, after dssmodels.ID, limit int) ([]*ridmodels.IdentificationServiceArea, error) {
+	var query = fmt.Sprintf(`
+		SELECT
+			%s
+		FROM
+			identification_service_areas
+		WHERE
+			owner = $1
+		AND
+			id > $2%s
+		ORDER BY id
+		LIMIT $3`, isaFields, c.liveFilter())
+
+	uid, err := pageCursor(after)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+	return c.process(ctx, query, owner, uid, limit)
+}
+
+// replaceTombstoneClause returns the clause (to be appended to an INSERT)
+// allowing a new ISA to replace a tombstoned ISA with the same ID.
+func (c *isaRepo) replaceTombstoneClause() string {
+	if !c.tombstones {
+		return ""
+	}
+	return `
+			ON CONFLICT (id) DO UPDATE SET
+				(owner, url, cells, starts_at, ends_at, writer, updated_at, deleted_at) =
+				(excluded.owner, excluded.url, excluded.cells, excluded.starts_at, excluded.ends_at, excluded.writer, excluded.updated_at, NULL)
+			WHERE
+				identification_service_areas.deleted_at IS NOT NULL`
+}
+
+// TombstoneISA implements repos.ISA.TombstoneISA.
+func (c *isaRepo) TombstoneISA(ctx context.Context, isa *ridmodels.IdentificationServiceArea) (*ridmodels.IdentificationServiceArea, error) {
+	if !c.tombstones {
+		return nil, stacktrace.NewError("Tombstoning ISAs is not supported by the current remote ID schema version")
+	}
+	var (
+		tombstoneQuery = fmt.Sprintf(`
+			UPDATE
+				identification_service_areas
+			SET
+				(updated_at, deleted_at) = (transaction_timestamp(), transaction_timestamp())
+			WHERE
+				id = $1
+			AND
+				updated_at = $2
+			AND
+				deleted_at IS NULL
+			RETURNING %s`, isaFields)
+	)
+	id, err := isa.ID.PgUUID()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to convert id to PgUUID")
+	}
+	return c.processOne(ctx, tombstoneQuery, id, isa.Version.ToTimestamp())
+}
+
+// GetTombstonedISA implements repos.ISA.GetTombstonedISA.
+func (c *isaRepo) GetTombstonedISA(ctx context.Context, id dssmodels.ID, deletedAfter time.Time) (*ridmodels.IdentificationServiceArea, error) {
+	if !c.tombstones {
+		return nil, nil
+	}
+	var query = fmt.Sprintf(`
+		SELECT %s FROM
+			identification_service_areas
+		WHERE
+			id = $1
+		AND
+			deleted_at > $2`, isaFields)
+	uid, err := id.PgUUID()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to convert id to PgUUID")
+	}
+	return c.processOne(ctx, query, uid, deletedAfter)
+}
+
+// RestoreISA implements repos.ISA.RestoreISA.
+func (c *isaRepo) RestoreISA(ctx context.Context, isa *ridmodels.IdentificationServiceArea) (*ridmodels.IdentificationServiceArea, error) {
+	if !c.tombstones {
+		return nil, stacktrace.NewError("Restoring ISAs is not supported by the current remote ID schema version")
+	}
+	var (
+		restoreQuery = fmt.Sprintf(`
+			UPDATE
+				identification_service_areas
+			SET
+				(updated_at, deleted_at) = (transaction_timestamp(), NULL)
+			WHERE
+				id = $1
+			AND
+				updated_at = $2
+			AND
+				deleted_at IS NOT NULL
+			RETURNING %s`, isaFields)
+	)
+	id, err := isa.ID.PgUUID()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to convert id to PgUUID")
+	}
+	return c.processOne(ctx, restoreQuery, id, isa.Version.ToTimestamp())
+}
+
+// ListTombstonedISAs implements repos.ISA.ListTombstonedISAs.
+// The function queries both empty writer and null writer when passing empty string as a writer.
+func (c *isaRepo) ListTombstonedISAs(ctx context.Context, writer string, deletedBefore time.Time) ([]*ridmodels.IdentificationServiceArea, error) {
+	if !c.tombstones {
+		return make([]*ridmodels.IdentificationServiceArea, 0), nil
+	}
+	var (
+		query = fmt.Sprintf(`
+			SELECT
+				%s
+			FROM
+				identification_service_areas
+			WHERE
+				deleted_at <= $1
+			AND
+				(writer = $2 OR ($2 = '' AND writer IS NULL))
+			LIMIT $3`, isaFields)
+	)
+	return c.process(ctx, query, deletedBefore, writer, dssmodels.MaxResultLimit)
+}
@@ -2,6 +2,9 @@ package cockroach
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -10,6 +13,7 @@ import (
 	dssmodels "github.com/interuss/dss/pkg/models"
 	ridmodels "github.com/interuss/dss/pkg/rid/models"
 	"github.com/interuss/dss/pkg/rid/repos"
+	"github.com/jackc/pgtype"
 	"github.com/jonboulle/clockwork"
 	"github.com/stretchr/testify/require"
 )
@@ -321,3 +325,111 @@ func TestListExpiredISAsWithEmptyWriter(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, serviceAreas, 1)
 }
+
+func TestSearchISAsQueryAsOfSystemTime(t *testing.T) {
+	var cells pgtype.Int8Array
+	require.NoError(t, cells.Set([]int64{1, 2}))
+
+	query, _ := searchISAsQuery(isaFields, "", startTime, nil, cells, "")
+	require.NotContains(t, query, "AS OF SYSTEM TIME")
+
+	query, _ = searchISAsQuery(isaFields, followerReadTime(10*time.Second), startTime, nil, cells, "")
+	require.Contains(t, query, "identification_service_areas AS OF SYSTEM TIME '-10000ms'")
+}
+
+func TestSearchISAsQueryUsesCellIndex(t *testing.T) {
+	var (
+		ctx                  = context.Background()
+		store, tearDownStore = setUpStore(ctx, t)
+	)
+	defer tearDownStore()
+
+	conn, err := store.db.Pool.Acquire(ctx)
+	require.NoError(t, err)
+	defer conn.Release()
+	if !store.db.Dialect.IsCockroachDB() {
+		// PostgreSQL scans small tables sequentially regardless of their
+		// indices.
+		_, err := conn.Exec(ctx, "SET enable_seqscan = off")
+		require.NoError(t, err)
+	}
+
+	pgCids, err := cellsArray(serviceArea.Cells)
+	require.NoError(t, err)
+	latest := endTime
+	query, args := searchISAsQuery(isaFields, "", startTime, &latest, pgCids, "")
+	rows, err := conn.Query(ctx, "EXPLAIN "+query, args...)
+	require.NoError(t, err)
+	defer rows.Close()
+	var plan []string
+	for rows.Next() {
+		values, err := rows.Values()
+		require.NoError(t, err)
+		for _, value := range values {
+			plan = append(plan, fmt.Sprint(value))
+		}
+	}
+	require.NoError(t, rows.Err())
+	require.Contains(t, strings.Join(plan, "\n"), "cell_idx")
+}
+
+func TestSearchISAsMatchesCellsAndTimes(t *testing.T) {
+	var (
+		ctx                  = context.Background()
+		store, tearDownStore = setUpStore(ctx, t)
+		now                  = fakeClock.Now()
+		inside               = s2.CellID(17106221850767130624)
+		outside              = s2.CellID(17106221953846345728)
+	)
+	defer tearDownStore()
+
+	repo, err := store.Interact(ctx)
+	require.NoError(t, err)
+
+	// ISAs of every combination of cells and time window relative to the
+	// searched ones.
+	var isas []*ridmodels.IdentificationServiceArea
+	for _, cells := range []s2.CellUnion{{inside}, {outside}, {inside, outside}} {
+		for _, window := range [][2]time.Duration{
+			{-2 * time.Hour, -time.Hour},
+			{-time.Hour, time.Hour},
+			{time.Hour, 2 * time.Hour},
+			{3 * time.Hour, 4 * time.Hour},
+		} {
+			start, end := now.Add(window[0]), now.Add(window[1])
+			isa, err := repo.InsertISA(ctx, &ridmodels.IdentificationServiceArea{
+				ID:        dssmodels.ID(uuid.New().String()),
+				Owner:     dssmodels.Owner("owner"),
+				URL:       "https://no/place/like/home/for/flights",
+				StartTime: &start,
+				EndTime:   &end,
+				Writer:    writer,
+				Cells:     cells,
+			})
+			require.NoError(t, err)
+			isas = append(isas, isa)
+		}
+	}
+
+	ids := func(isas []*ridmodels.IdentificationServiceArea) []string {
+		result := []string{}
+		for _, isa := range isas {
+			result = append(result, isa.ID.String())
+		}
+		sort.Strings(result)
+		return result
+	}
+	latest := now.Add(150 * time.Minute)
+	for _, latest := range []*time.Time{nil, &latest} {
+		var expected []*ridmodels.IdentificationServiceArea
+		for _, isa := range isas {
+			if isa.Cells.ContainsCellID(inside) && !isa.EndTime.Before(now) && (latest == nil || !isa.StartTime.After(*latest)) {
+				expected = append(expected, isa)
+			}
+		}
+
+		found, err := repo.SearchISAs(ctx, s2.CellUnion{inside}, &now, latest)
+		require.NoError(t, err)
+		require.Equal(t, ids(expected), ids(found))
+	}
+}
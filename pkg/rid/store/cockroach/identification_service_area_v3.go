@@ -207,23 +207,6 @@ func (c *isaRepoV3) DeleteISA(ctx context.Context, isa *ridmodels.Identification
 // instances that intersect with "cells" and, if set, the temporal volume
 // defined by "earliest" and "latest".
 func (c *isaRepoV3) SearchISAs(ctx context.Context, cells s2.CellUnion, earliest *time.Time, latest *time.Time) ([]*ridmodels.IdentificationServiceArea, error) {
-	var (
-		// TODO: make earliest and latest required (NOT NULL) and remove coalesce.
-		// Make them real values (not pointers), on the model layer.
-		isasInCellsQuery = fmt.Sprintf(`
-			SELECT
-				%s
-			FROM
-				identification_service_areas
-			WHERE
-				ends_at >= $1
-			AND
-				COALESCE(starts_at <= $2, true)
-			AND
-				cells && $3
-			LIMIT $4`, isaFieldsV3)
-	)
-
 	if len(cells) == 0 {
 		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Missing cell IDs for query")
 	}
@@ -243,10 +226,52 @@ func (c *isaRepoV3) SearchISAs(ctx context.Context, cells s2.CellUnion, earliest
 		return nil, stacktrace.Propagate(err, "Failed to convert array to jackc/pgtype")
 	}
 
-	return c.process(ctx, isasInCellsQuery, earliest, latest, pgCids, dssmodels.MaxResultLimit)
+	isasInCellsQuery, args := searchISAsQuery(isaFieldsV3, "", *earliest, latest, pgCids, "")
+	return c.process(ctx, isasInCellsQuery, args...)
 }
 
 // ListExpiredISAs returns empty. We don't support thi function in store v3.0 because db doesn't have 'writer' field.
 func (c *isaRepoV3) ListExpiredISAs(ctx context.Context, writer string) ([]*ridmodels.IdentificationServiceArea, error) {
 	return make([]*ridmodels.IdentificationServiceArea, 0), nil
 }
+
+// ListISAsByOwner implements repos.ISA.ListISAsByOwner.
+func (c *isaRepoV3) ListISAsByOwner(ctx context.Context, owner dssmodels.Owner, after dssmodels.ID, limit int) ([]*ridmodels.IdentificationServiceArea, error) {
+	var query = fmt.Sprintf(`
+		SELECT
+			%s
+		FROM
+			identification_service_areas
+		WHERE
+			owner = $1
+		AND
+			id > $2
+		ORDER BY id
+		LIMIT $3`, isaFieldsV3)
+
+	uid, err := pageCursor(after)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+	return c.process(ctx, query, owner, uid, limit)
+}
+
+// TombstoneISA is not supported in store v3.0 because db doesn't have 'deleted_at' field.
+func (c *isaRepoV3) TombstoneISA(ctx context.Context, isa *ridmodels.IdentificationServiceArea) (*ridmodels.IdentificationServiceArea, error) {
+	return nil, stacktrace.NewError("Tombstoning ISAs is not supported by remote ID schema version 3")
+}
+
+// GetTombstonedISA returns nil, nil as store v3.0 never tombstones ISAs.
+func (c *isaRepoV3) GetTombstonedISA(ctx context.Context, id dssmodels.ID, deletedAfter time.Time) (*ridmodels.IdentificationServiceArea, error) {
+	return nil, nil
+}
+
+// RestoreISA is not supported in store v3.0 because db doesn't have 'deleted_at' field.
+func (c *isaRepoV3) RestoreISA(ctx context.Context, isa *ridmodels.IdentificationServiceArea) (*ridmodels.IdentificationServiceArea, error) {
+	return nil, stacktrace.NewError("Restoring ISAs is not supported by remote ID schema version 3")
+}
+
+// ListTombstonedISAs returns empty as store v3.0 never tombstones ISAs.
+func (c *isaRepoV3) ListTombstonedISAs(ctx context.Context, writer string, deletedBefore time.Time) ([]*ridmodels.IdentificationServiceArea, error) {
+	return make([]*ridmodels.IdentificationServiceArea, 0), nil
+}
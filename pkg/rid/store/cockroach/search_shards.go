@@ -0,0 +1,109 @@
+package cockroach
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/golang/geo/s2"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	ridmodels "github.com/interuss/dss/pkg/rid/models"
+	"github.com/interuss/stacktrace"
+)
+
+// DefaultSearchShardConcurrency is the number of shards of a search queried
+// concurrently when Store.SearchShardConcurrency is not positive.
+const DefaultSearchShardConcurrency = 4
+
+// splitCells splits cells into consecutive shards of at most size cells each.
+// The cells of a normalized CellUnion are sorted, so that each shard covers a
+// compact part of the searched area.
+func splitCells(cells s2.CellUnion, size int) []s2.CellUnion {
+	var shards []s2.CellUnion
+	for len(cells) > size {
+		shards = append(shards, cells[:size])
+		cells = cells[size:]
+	}
+	return append(shards, cells)
+}
+
+// mergeISAs merges the ISAs found by each shard of a search, dropping the ISAs
+// found by several shards, up to dssmodels.MaxResultLimit ISAs like unsharded
+// searches.
+func mergeISAs(shards [][]*ridmodels.IdentificationServiceArea) []*ridmodels.IdentificationServiceArea {
+	var (
+		merged []*ridmodels.IdentificationServiceArea
+		seen   = map[dssmodels.ID]bool{}
+	)
+	for _, isas := range shards {
+		for _, isa := range isas {
+			if seen[isa.ID] {
+				continue
+			}
+			if len(merged) == dssmodels.MaxResultLimit {
+				return merged
+			}
+			seen[isa.ID] = true
+			merged = append(merged, isa)
+		}
+	}
+	return merged
+}
+
+// searchISAShards searches the ISAs in cells with one query per shard of
+// c.shardCells cells, running at most c.shardConcurrency queries at a time, so
+// that the latency of searches of very large areas is bounded by that of their
+// slowest shard rather than by that of a single query over all their cells.
+func (c *isaRepo) searchISAShards(ctx context.Context, cells s2.CellUnion, earliest time.Time, latest *time.Time) ([]*ridmodels.IdentificationServiceArea, error) {
+	parent := ctx
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	concurrency := c.shardConcurrency
+	if concurrency <= 0 {
+		concurrency = DefaultSearchShardConcurrency
+	}
+	var (
+		shards    = splitCells(cells, c.shardCells)
+		results   = make([][]*ridmodels.IdentificationServiceArea, len(shards))
+		errs      = make([]error, len(shards))
+		semaphore = make(chan struct{}, concurrency)
+		wg        sync.WaitGroup
+	)
+	for i, shard := range shards {
+		i, shard := i, shard
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case semaphore <- struct{}{}:
+				defer func() { <-semaphore }()
+			case <-ctx.Done():
+				// Another shard failed, or the search was abandoned
+				return
+			}
+			pgCids, err := cellsArray(shard)
+			if err != nil {
+				errs[i] = err
+				cancel()
+				return
+			}
+			query, args := searchISAsQuery(isaFields, c.asOfSystemTime, earliest, latest, pgCids, c.liveFilter())
+			results[i], errs[i] = c.process(ctx, query, args...)
+			if errs[i] != nil {
+				cancel()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if err := parent.Err(); err != nil {
+		return nil, stacktrace.Propagate(err, "ISA search abandoned")
+	}
+	for i, err := range errs {
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Error searching ISAs in shard %d of %d", i+1, len(shards))
+		}
+	}
+	return mergeISAs(results), nil
+}
@@ -0,0 +1,26 @@
+package cockroach
+
+import (
+	"testing"
+
+	"github.com/golang/geo/s2"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	ridmodels "github.com/interuss/dss/pkg/rid/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitCells(t *testing.T) {
+	cells := s2.CellUnion{1, 2, 3, 4, 5}
+	require.Equal(t, []s2.CellUnion{{1, 2}, {3, 4}, {5}}, splitCells(cells, 2))
+	require.Equal(t, []s2.CellUnion{{1, 2, 3, 4, 5}}, splitCells(cells, 5))
+}
+
+func TestMergeISAs(t *testing.T) {
+	a := &ridmodels.IdentificationServiceArea{ID: dssmodels.ID("a")}
+	b := &ridmodels.IdentificationServiceArea{ID: dssmodels.ID("b")}
+	c := &ridmodels.IdentificationServiceArea{ID: dssmodels.ID("c")}
+
+	// ISAs spanning several shards are only returned once.
+	merged := mergeISAs([][]*ridmodels.IdentificationServiceArea{{a, b}, {b, c}, nil, {a}})
+	require.Equal(t, []*ridmodels.IdentificationServiceArea{a, b, c}, merged)
+}
@@ -2,16 +2,18 @@ package cockroach
 
 import (
 	"context"
-	"github.com/cockroachdb/cockroach-go/v2/crdb"
+	"fmt"
 	"github.com/interuss/dss/pkg/cockroach/flags"
 	"time"
 
-	"github.com/cockroachdb/cockroach-go/v2/crdb/crdbpgx"
 	"github.com/coreos/go-semver/semver"
+	"github.com/google/uuid"
 	"github.com/interuss/dss/pkg/cockroach"
 	"github.com/interuss/dss/pkg/logging"
+	dssmodels "github.com/interuss/dss/pkg/models"
 	"github.com/interuss/dss/pkg/rid/repos"
 	"github.com/interuss/stacktrace"
+	"github.com/jackc/pgtype"
 	"github.com/jackc/pgx/v4"
 	"github.com/jonboulle/clockwork"
 	"go.uber.org/zap"
@@ -37,6 +39,37 @@ var (
 	DefaultTimeout = 10 * time.Second
 
 	v400 = *semver.New("4.0.0")
+	v410 = *semver.New("4.1.0")
+	v420 = *semver.New("4.2.0")
+	v430 = *semver.New("4.3.0")
+	v440 = *semver.New("4.4.0")
+	v450 = *semver.New("4.5.0")
+
+	// MinimumSchemaVersion is the oldest remote ID schema version this Store
+	// understands.
+	MinimumSchemaVersion = v400
+
+	// LatestSchemaVersion is the latest remote ID schema version this Store
+	// understands; the Store refuses newer schemas, whose data it could
+	// corrupt.
+	LatestSchemaVersion = v450
+
+	// PoolTables lists, by the schema version creating them, the tables of the
+	// remote ID schema which are shared by all the DSS instances of a pool,
+	// including those only serving strategic conflict detection.
+	PoolTables = map[semver.Version][]string{
+		v420: {"job_leases"},
+		v430: {"dss_instances"},
+		v440: {"rate_limit_buckets"},
+		v450: {"maintenance_windows"},
+	}
+
+	// EntityTables maps the remote ID entity types to the tables storing them,
+	// for cockroach.DB.RecordEntityCounts.
+	EntityTables = map[string]string{
+		"identification_service_area": "identification_service_areas",
+		"subscription":                "subscriptions",
+	}
 )
 
 type repo struct {
@@ -57,6 +90,23 @@ type Store struct {
 
 	// DatabaseName is the name of database storing remote ID data.
 	DatabaseName string
+
+	// SearchStaleness, when positive, is how long ago the searches performed
+	// outside of transactions read the data as of, so that CockroachDB may
+	// serve them from the nearest (follower) replicas rather than from the
+	// leaseholders.  It must exceed the closed timestamp target duration of
+	// the cluster for follower reads to occur.  Mutations are not affected.
+	SearchStaleness time.Duration
+
+	// SearchShardCells, when positive, is the number of cells beyond which
+	// the ISA searches performed outside of transactions are split into
+	// concurrent queries of at most SearchShardCells cells each, whose results
+	// are merged, to reduce the latency of searches of very large areas.
+	SearchShardCells int
+
+	// SearchShardConcurrency is the maximum number of concurrent queries of a
+	// sharded search, DefaultSearchShardConcurrency when not positive.
+	SearchShardConcurrency int
 }
 
 // NewStore returns a Store instance connected to a cockroach instance via db.
@@ -81,6 +131,30 @@ func NewStore(ctx context.Context, db *cockroach.DB, dbName string, logger *zap.
 	return store, nil
 }
 
+// SupportsJobLeases returns whether the schema of s holds the leases electing
+// the DSS instance running each maintenance job.
+func (s *Store) SupportsJobLeases() bool {
+	return s.version != nil && s.version.Compare(v420) >= 0
+}
+
+// SupportsInstanceRegistry returns whether the schema of s holds the registry
+// of the DSS instances of the pool.
+func (s *Store) SupportsInstanceRegistry() bool {
+	return s.version != nil && s.version.Compare(v430) >= 0
+}
+
+// SupportsRateLimitBuckets returns whether the schema of s holds the token
+// buckets shared by the http-gateway instances of the pool.
+func (s *Store) SupportsRateLimitBuckets() bool {
+	return s.version != nil && s.version.Compare(v440) >= 0
+}
+
+// SupportsMaintenanceWindows returns whether the schema of s holds the
+// maintenance window of the pool.
+func (s *Store) SupportsMaintenanceWindows() bool {
+	return s.version != nil && s.version.Compare(v450) >= 0
+}
+
 // CheckCurrentMajorSchemaVersion checks that store supports the current major schema version.
 func (s *Store) CheckCurrentMajorSchemaVersion(ctx context.Context) error {
 	vs, err := s.GetVersion(ctx)
@@ -95,6 +169,14 @@ func (s *Store) CheckCurrentMajorSchemaVersion(ctx context.Context) error {
 		return stacktrace.NewError("Unsupported schema version for remote ID! Got %s, requires major version of %d. Please check https://github.com/interuss/dss/tree/master/build#updgrading-database-schemas", vs, currentMajorSchemaVersion)
 	}
 
+	if vs.LessThan(MinimumSchemaVersion) {
+		return stacktrace.NewError("Unsupported schema version for remote ID! Got %s, which is older than the minimum version %s supported by this DSS. Please check https://github.com/interuss/dss/tree/master/build#updgrading-database-schemas", vs, MinimumSchemaVersion)
+	}
+
+	if LatestSchemaVersion.LessThan(*vs) {
+		return stacktrace.NewError("Unsupported schema version for remote ID! Got %s, which is newer than the latest version %s supported by this DSS. Please upgrade the DSS before migrating its schema", vs, LatestSchemaVersion)
+	}
+
 	return nil
 }
 
@@ -106,12 +188,44 @@ func (s *Store) Interact(ctx context.Context) (repos.Repository, error) {
 		return nil, stacktrace.Propagate(err, "Error determining database RID schema version")
 	}
 
+	q := s.db.Instrument(s.db.Pool)
+	isas := NewISARepo(ctx, q, *storeVersion, logger)
+	subscriptions := NewISASubscriptionRepo(ctx, q, *storeVersion, logger, s.clock)
+	if r, ok := isas.(*isaRepo); ok {
+		r.shardCells = s.SearchShardCells
+		r.shardConcurrency = s.SearchShardConcurrency
+	}
+	if s.SearchStaleness > 0 && s.db.Dialect.IsCockroachDB() {
+		asOf := followerReadTime(s.SearchStaleness)
+		if r, ok := isas.(*isaRepo); ok {
+			r.asOfSystemTime = asOf
+		}
+		if r, ok := subscriptions.(*subscriptionRepo); ok {
+			r.asOfSystemTime = asOf
+		}
+	}
+
 	return &repo{
-		ISA:          NewISARepo(ctx, s.db.Pool, *storeVersion, logger),
-		Subscription: NewISASubscriptionRepo(ctx, s.db.Pool, *storeVersion, logger, s.clock),
+		ISA:          isas,
+		Subscription: subscriptions,
 	}, nil
 }
 
+// followerReadTime returns the AS OF SYSTEM TIME expression of reads
+// staleness in the past.
+func followerReadTime(staleness time.Duration) string {
+	return fmt.Sprintf("'-%dms'", staleness.Milliseconds())
+}
+
+// asOfClause returns the AS OF SYSTEM TIME clause following the table of a
+// SELECT statement reading as of asOf, or nothing when asOf is empty.
+func asOfClause(asOf string) string {
+	if asOf == "" {
+		return ""
+	}
+	return " AS OF SYSTEM TIME " + asOf
+}
+
 // Transact supplies a new repo, that will perform all of the DB accesses
 // in a Txn, and will retry any Txn's that fail due to retry-able errors
 // (typically contention).
@@ -123,18 +237,17 @@ func (s *Store) Transact(ctx context.Context, f func(repo repos.Repository) erro
 	ctx, cancel := context.WithTimeout(ctx, DefaultTimeout)
 	defer cancel()
 
-	ctx = crdb.WithMaxRetries(ctx, flags.ConnectParameters().MaxRetries)
-
 	storeVersion, err := s.GetVersion(ctx)
 	if err != nil {
 		return stacktrace.Propagate(err, "Error determining database RID schema version")
 	}
-	return crdbpgx.ExecuteTx(ctx, s.db.Pool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+	return s.db.ExecuteTx(ctx, flags.ConnectParameters().MaxRetries, func(tx pgx.Tx) error {
 		// Is this recover still necessary?
 		defer recoverRollbackRepanic(ctx, tx)
+		q := s.db.Instrument(tx)
 		return f(&repo{
-			ISA:          NewISARepo(ctx, tx, *storeVersion, logger),
-			Subscription: NewISASubscriptionRepo(ctx, tx, *storeVersion, logger, s.clock),
+			ISA:          NewISARepo(ctx, q, *storeVersion, logger),
+			Subscription: NewISASubscriptionRepo(ctx, q, *storeVersion, logger, s.clock),
 		})
 	})
 }
@@ -177,3 +290,16 @@ func (s *Store) GetVersion(ctx context.Context) (*semver.Version, error) {
 	}
 	return s.version, nil
 }
+
+// pageCursor converts the ID after which a page of entities starts, where
+// empty starts from the first entity, to a query argument.
+func pageCursor(after dssmodels.ID) (*pgtype.UUID, error) {
+	if after.Empty() {
+		after = dssmodels.ID(uuid.Nil.String())
+	}
+	uid, err := after.PgUUID()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to convert page cursor to PgUUID")
+	}
+	return uid, nil
+}
@@ -253,11 +253,11 @@ func (c *subscriptionRepoV3) DeleteSubscription(ctx context.Context, s *ridmodel
 func (c *subscriptionRepoV3) UpdateNotificationIdxsInCells(ctx context.Context, cells s2.CellUnion) ([]*ridmodels.Subscription, error) {
 	var updateQuery = fmt.Sprintf(`
 			UPDATE subscriptions
-			SET notification_index = notification_index + 1
+			SET notification_index = %s
 			WHERE
 				cells && $1
 				AND ends_at >= $2
-			RETURNING %s`, subscriptionFieldsV3)
+			RETURNING %s`, dssmodels.NextNotificationIndexSQL("notification_index"), subscriptionFieldsV3)
 
 	cids := make([]int64, len(cells))
 	for i, cell := range cells {
@@ -346,3 +346,25 @@ func (c *subscriptionRepoV3) SearchSubscriptionsByOwner(ctx context.Context, cel
 func (c *subscriptionRepoV3) ListExpiredSubscriptions(ctx context.Context, writer string) ([]*ridmodels.Subscription, error) {
 	return make([]*ridmodels.Subscription, 0), nil
 }
+
+// ListSubscriptionsByOwner implements
+// repos.Subscription.ListSubscriptionsByOwner.
+func (c *subscriptionRepoV3) ListSubscriptionsByOwner(ctx context.Context, owner dssmodels.Owner, after dssmodels.ID, limit int) ([]*ridmodels.Subscription, error) {
+	var query = fmt.Sprintf(`
+		SELECT
+			%s
+		FROM
+			subscriptions
+		WHERE
+			owner = $1
+		AND
+			id > $2
+		ORDER BY id
+		LIMIT $3`, subscriptionFieldsV3)
+
+	uid, err := pageCursor(after)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+	return c.process(ctx, query, owner, uid, limit)
+}
@@ -46,6 +46,10 @@ type subscriptionRepo struct {
 
 	clock  clockwork.Clock
 	logger *zap.Logger
+
+	// asOfSystemTime, when not empty, is the AS OF SYSTEM TIME clause of
+	// searches, which may then be served by follower replicas.
+	asOfSystemTime string
 }
 
 // process a query that should return one or many subscriptions.
@@ -275,11 +279,11 @@ func (c *subscriptionRepo) DeleteSubscription(ctx context.Context, s *ridmodels.
 func (c *subscriptionRepo) UpdateNotificationIdxsInCells(ctx context.Context, cells s2.CellUnion) ([]*ridmodels.Subscription, error) {
 	var updateQuery = fmt.Sprintf(`
 			UPDATE subscriptions
-			SET notification_index = notification_index + 1
+			SET notification_index = %s
 			WHERE
 				cells && $1
 				AND ends_at >= $2
-			RETURNING %s`, subscriptionFields)
+			RETURNING %s`, dssmodels.NextNotificationIndexSQL("notification_index"), subscriptionFields)
 
 	cids := make([]int64, len(cells))
 	for i, cell := range cells {
@@ -303,12 +307,12 @@ func (c *subscriptionRepo) SearchSubscriptions(ctx context.Context, cells s2.Cel
 			SELECT
 				%s
 			FROM
-				subscriptions
+				subscriptions%s
 			WHERE
 				cells && $1
 			AND
 				ends_at >= $2
-			LIMIT $3`, subscriptionFields)
+			LIMIT $3`, subscriptionFields, asOfClause(c.asOfSystemTime))
 	)
 
 	if len(cells) == 0 {
@@ -336,14 +340,14 @@ func (c *subscriptionRepo) SearchSubscriptionsByOwner(ctx context.Context, cells
 			SELECT
 				%s
 			FROM
-				subscriptions
+				subscriptions%s
 			WHERE
 				cells && $1
 			AND
 				subscriptions.owner = $2
 			AND
 				ends_at >= $3
-			LIMIT $4`, subscriptionFields)
+			LIMIT $4`, subscriptionFields, asOfClause(c.asOfSystemTime))
 	)
 
 	if len(cells) == 0 {
@@ -387,3 +391,25 @@ func (c *subscriptionRepo) ListExpiredSubscriptions(ctx context.Context, writer
 
 	return c.process(ctx, query)
 }
+
+// ListSubscriptionsByOwner implements
+// repos.Subscription.ListSubscriptionsByOwner.
+func (c *subscriptionRepo) ListSubscriptionsByOwner(ctx context.Context, owner dssmodels.Owner, after dssmodels.ID, limit int) ([]*ridmodels.Subscription, error) {
+	var query = fmt.Sprintf(`
+		SELECT
+			%s
+		FROM
+			subscriptions
+		WHERE
+			owner = $1
+		AND
+			id > $2
+		ORDER BY id
+		LIMIT $3`, subscriptionFields)
+
+	uid, err := pageCursor(after)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+	return c.process(ctx, query, owner, uid, limit)
+}
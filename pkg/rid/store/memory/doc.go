@@ -0,0 +1,3 @@
+// Package memory provides an implementation of a dss.Store holding remote ID
+// data in memory, for tests and mock deployments without a database.
+package memory
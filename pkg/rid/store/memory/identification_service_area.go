@@ -0,0 +1,283 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/golang/geo/s2"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/dss/pkg/geo"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	ridmodels "github.com/interuss/dss/pkg/rid/models"
+	"github.com/interuss/stacktrace"
+)
+
+const (
+	//  Records expire if current time is <expiredDurationInMin> minutes more than records' endTime.
+	expiredDurationInMin = 30
+)
+
+// isaRecord is a stored IdentificationServiceArea.
+type isaRecord struct {
+	isa       ridmodels.IdentificationServiceArea
+	updatedAt time.Time
+	// deletedAt is set once the ISA is tombstoned.
+	deletedAt *time.Time
+}
+
+// toModel returns a copy of the ISA of r, as it would be read from the
+// database.
+func (r *isaRecord) toModel() *ridmodels.IdentificationServiceArea {
+	isa := r.isa
+	isa.Cells = append(s2.CellUnion{}, r.isa.Cells...)
+	isa.StartTime = copyTime(r.isa.StartTime)
+	isa.EndTime = copyTime(r.isa.EndTime)
+	isa.Version = dssmodels.VersionFromTime(r.updatedAt)
+	return &isa
+}
+
+// newISARecord returns the record storing the fields of isa which are
+// persisted by the database.
+func newISARecord(isa *ridmodels.IdentificationServiceArea, updatedAt time.Time) (*isaRecord, error) {
+	for _, cell := range isa.Cells {
+		if err := geo.ValidateCell(cell); err != nil {
+			return nil, stacktrace.Propagate(err, "Error validating cell")
+		}
+	}
+	if err := validateExtents(isa.Cells, isa.StartTime, isa.EndTime); err != nil {
+		return nil, stacktrace.Propagate(err, "Invalid ISA")
+	}
+	return &isaRecord{
+		isa: ridmodels.IdentificationServiceArea{
+			ID:        isa.ID,
+			URL:       isa.URL,
+			Owner:     isa.Owner,
+			Cells:     append(s2.CellUnion{}, isa.Cells...),
+			StartTime: copyTime(isa.StartTime),
+			EndTime:   copyTime(isa.EndTime),
+			Writer:    isa.Writer,
+		},
+		updatedAt: updatedAt,
+	}, nil
+}
+
+// GetISA implements repos.ISA.GetISA.
+func (r *repo) GetISA(ctx context.Context, id dssmodels.ID) (*ridmodels.IdentificationServiceArea, error) {
+	var result *ridmodels.IdentificationServiceArea
+	err := r.read(func(s *state) error {
+		if rec, ok := s.isas[id.String()]; ok && rec.deletedAt == nil {
+			result = rec.toModel()
+		}
+		return nil
+	})
+	return result, err
+}
+
+// InsertISA implements repos.ISA.InsertISA.  A new ISA may replace a
+// tombstoned ISA with the same ID.
+func (r *repo) InsertISA(ctx context.Context, isa *ridmodels.IdentificationServiceArea) (*ridmodels.IdentificationServiceArea, error) {
+	var result *ridmodels.IdentificationServiceArea
+	err := r.write(func(s *state, now time.Time) error {
+		if rec, ok := s.isas[isa.ID.String()]; ok && rec.deletedAt == nil {
+			return stacktrace.NewError("ISA %s already exists", isa.ID)
+		}
+		rec, err := newISARecord(isa, now)
+		if err != nil {
+			return err // No need to Propagate this error as this is not a useful stacktrace line
+		}
+		s.isas[isa.ID.String()] = rec
+		result = rec.toModel()
+		return nil
+	})
+	return result, err
+}
+
+// UpdateISA implements repos.ISA.UpdateISA.
+func (r *repo) UpdateISA(ctx context.Context, isa *ridmodels.IdentificationServiceArea) (*ridmodels.IdentificationServiceArea, error) {
+	var result *ridmodels.IdentificationServiceArea
+	err := r.write(func(s *state, now time.Time) error {
+		old, ok := s.isas[isa.ID.String()]
+		if !ok || old.deletedAt != nil || !old.updatedAt.Equal(*isa.Version.ToTimestamp()) {
+			return nil
+		}
+		rec, err := newISARecord(isa, now)
+		if err != nil {
+			return err // No need to Propagate this error as this is not a useful stacktrace line
+		}
+		rec.isa.Owner = old.isa.Owner
+		s.isas[isa.ID.String()] = rec
+		result = rec.toModel()
+		return nil
+	})
+	return result, err
+}
+
+// DeleteISA implements repos.ISA.DeleteISA.
+func (r *repo) DeleteISA(ctx context.Context, isa *ridmodels.IdentificationServiceArea) (*ridmodels.IdentificationServiceArea, error) {
+	var result *ridmodels.IdentificationServiceArea
+	err := r.write(func(s *state, now time.Time) error {
+		old, ok := s.isas[isa.ID.String()]
+		if !ok || !old.updatedAt.Equal(*isa.Version.ToTimestamp()) {
+			return nil
+		}
+		delete(s.isas, isa.ID.String())
+		result = old.toModel()
+		return nil
+	})
+	return result, err
+}
+
+// SearchISAs implements repos.ISA.SearchISAs.
+func (r *repo) SearchISAs(ctx context.Context, cells s2.CellUnion, earliest *time.Time, latest *time.Time) ([]*ridmodels.IdentificationServiceArea, error) {
+	if len(cells) == 0 {
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Missing cell IDs for query")
+	}
+	if earliest == nil {
+		return nil, stacktrace.NewError("Earliest start time is missing")
+	}
+	return r.listISAs(func(rec *isaRecord) bool {
+		return rec.deletedAt == nil &&
+			endsAtOrAfter(rec.isa.EndTime, *earliest) &&
+			(latest == nil || rec.isa.StartTime == nil || !rec.isa.StartTime.After(*latest)) &&
+			intersects(rec.isa.Cells, cells)
+	})
+}
+
+// ListExpiredISAs implements repos.ISA.ListExpiredISAs.
+func (r *repo) ListExpiredISAs(ctx context.Context, writer string) ([]*ridmodels.IdentificationServiceArea, error) {
+	now := r.now()
+	return r.listISAs(func(rec *isaRecord) bool {
+		return rec.isa.Writer == writer && expired(rec.isa.EndTime, now)
+	})
+}
+
+// ListISAsByOwner implements repos.ISA.ListISAsByOwner.
+func (r *repo) ListISAsByOwner(ctx context.Context, owner dssmodels.Owner, after dssmodels.ID, limit int) ([]*ridmodels.IdentificationServiceArea, error) {
+	result, err := r.listISAs(func(rec *isaRecord) bool {
+		return rec.deletedAt == nil && rec.isa.Owner == owner && rec.isa.ID > after
+	})
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result, err
+}
+
+// TombstoneISA implements repos.ISA.TombstoneISA.
+func (r *repo) TombstoneISA(ctx context.Context, isa *ridmodels.IdentificationServiceArea) (*ridmodels.IdentificationServiceArea, error) {
+	var result *ridmodels.IdentificationServiceArea
+	err := r.write(func(s *state, now time.Time) error {
+		old, ok := s.isas[isa.ID.String()]
+		if !ok || old.deletedAt != nil || !old.updatedAt.Equal(*isa.Version.ToTimestamp()) {
+			return nil
+		}
+		rec := *old
+		rec.updatedAt = now
+		rec.deletedAt = &now
+		s.isas[isa.ID.String()] = &rec
+		result = rec.toModel()
+		return nil
+	})
+	return result, err
+}
+
+// GetTombstonedISA implements repos.ISA.GetTombstonedISA.
+func (r *repo) GetTombstonedISA(ctx context.Context, id dssmodels.ID, deletedAfter time.Time) (*ridmodels.IdentificationServiceArea, error) {
+	var result *ridmodels.IdentificationServiceArea
+	err := r.read(func(s *state) error {
+		if rec, ok := s.isas[id.String()]; ok && rec.deletedAt != nil && rec.deletedAt.After(deletedAfter) {
+			result = rec.toModel()
+		}
+		return nil
+	})
+	return result, err
+}
+
+// RestoreISA implements repos.ISA.RestoreISA.
+func (r *repo) RestoreISA(ctx context.Context, isa *ridmodels.IdentificationServiceArea) (*ridmodels.IdentificationServiceArea, error) {
+	var result *ridmodels.IdentificationServiceArea
+	err := r.write(func(s *state, now time.Time) error {
+		old, ok := s.isas[isa.ID.String()]
+		if !ok || old.deletedAt == nil || !old.updatedAt.Equal(*isa.Version.ToTimestamp()) {
+			return nil
+		}
+		rec := *old
+		rec.updatedAt = now
+		rec.deletedAt = nil
+		s.isas[isa.ID.String()] = &rec
+		result = rec.toModel()
+		return nil
+	})
+	return result, err
+}
+
+// ListTombstonedISAs implements repos.ISA.ListTombstonedISAs.
+func (r *repo) ListTombstonedISAs(ctx context.Context, writer string, deletedBefore time.Time) ([]*ridmodels.IdentificationServiceArea, error) {
+	return r.listISAs(func(rec *isaRecord) bool {
+		return rec.isa.Writer == writer && rec.deletedAt != nil && !rec.deletedAt.After(deletedBefore)
+	})
+}
+
+// listISAs returns up to dssmodels.MaxResultLimit ISAs matching filter, in ID
+// order.
+func (r *repo) listISAs(filter func(rec *isaRecord) bool) ([]*ridmodels.IdentificationServiceArea, error) {
+	var result []*ridmodels.IdentificationServiceArea
+	err := r.read(func(s *state) error {
+		for _, rec := range s.isas {
+			if filter(rec) {
+				result = append(result, rec.toModel())
+			}
+		}
+		return nil
+	})
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	if len(result) > dssmodels.MaxResultLimit {
+		result = result[:dssmodels.MaxResultLimit]
+	}
+	return result, err
+}
+
+// validateExtents enforces the constraints of the database on the extents of
+// ISAs and Subscriptions.
+func validateExtents(cells s2.CellUnion, startTime *time.Time, endTime *time.Time) error {
+	if len(cells) == 0 {
+		return stacktrace.NewError("Cells must not be empty")
+	}
+	if startTime != nil && endTime != nil && !startTime.Before(*endTime) {
+		return stacktrace.NewError("Start time must be before end time")
+	}
+	return nil
+}
+
+// endsAtOrAfter returns whether endTime is set and not before t, like the
+// database comparison of a nullable end time.
+func endsAtOrAfter(endTime *time.Time, t time.Time) bool {
+	return endTime != nil && !endTime.Before(t)
+}
+
+// expired returns whether a record ending at endTime expired at now.
+func expired(endTime *time.Time, now time.Time) bool {
+	return endTime != nil && !endTime.Add(expiredDurationInMin*time.Minute).After(now)
+}
+
+// intersects returns whether a and b have a cell in common.
+func intersects(a s2.CellUnion, b s2.CellUnion) bool {
+	cells := make(map[s2.CellID]bool, len(b))
+	for _, cell := range b {
+		cells[cell] = true
+	}
+	for _, cell := range a {
+		if cells[cell] {
+			return true
+		}
+	}
+	return false
+}
+
+func copyTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	c := *t
+	return &c
+}
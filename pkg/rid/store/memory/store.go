@@ -0,0 +1,217 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-semver/semver"
+	"github.com/interuss/dss/pkg/rid/repos"
+	"github.com/interuss/stacktrace"
+	"github.com/jonboulle/clockwork"
+	"go.uber.org/zap"
+)
+
+var (
+	// DefaultClock is what is used as the Store's clock, returned from NewStore.
+	DefaultClock = clockwork.NewRealClock()
+
+	// DefaultMaxRetries is the number of times a transaction is retried after
+	// conflicting with another transaction.
+	DefaultMaxRetries = 100
+
+	// SchemaVersion is the remote ID schema version whose behavior the Store
+	// provides.
+	SchemaVersion = *semver.New("4.1.0")
+)
+
+// state holds all the remote ID data of a Store.  Records are never modified
+// once stored, so states may share records and copying a state only copies
+// its maps.
+type state struct {
+	isas          map[string]*isaRecord
+	subscriptions map[string]*subscriptionRecord
+}
+
+func (s *state) clone() *state {
+	c := &state{
+		isas:          make(map[string]*isaRecord, len(s.isas)),
+		subscriptions: make(map[string]*subscriptionRecord, len(s.subscriptions)),
+	}
+	for id, isa := range s.isas {
+		c.isas[id] = isa
+	}
+	for id, sub := range s.subscriptions {
+		c.subscriptions[id] = sub
+	}
+	return c
+}
+
+// Store is an implementation of store.Store holding its data in memory.
+// Transactions are serializable: each transaction works on a snapshot of the
+// data and is retried if another transaction committed changes meanwhile.
+type Store struct {
+	logger     *zap.Logger
+	clock      clockwork.Clock
+	maxRetries int
+
+	mu sync.Mutex
+	// current is the committed state, replaced with a new state on each
+	// commit.
+	current *state
+	// commits counts the changes committed to current.
+	commits uint64
+	// lastTimestamp is the latest timestamp given to a change, so that
+	// successive changes have increasing timestamps like database commits.
+	lastTimestamp time.Time
+}
+
+// NewStore returns an empty Store.
+func NewStore(logger *zap.Logger) *Store {
+	return &Store{
+		logger:     logger,
+		clock:      DefaultClock,
+		maxRetries: DefaultMaxRetries,
+		current: &state{
+			isas:          map[string]*isaRecord{},
+			subscriptions: map[string]*subscriptionRecord{},
+		},
+	}
+}
+
+// timestamp returns the time of a change made now, later than any previous
+// change.  s.mu must be held.
+func (s *Store) timestamp() time.Time {
+	t := s.clock.Now().UTC().Truncate(time.Microsecond)
+	if !t.After(s.lastTimestamp) {
+		t = s.lastTimestamp.Add(time.Microsecond)
+	}
+	s.lastTimestamp = t
+	return t
+}
+
+// Interact implements store.Interactor interface.  Each operation of the
+// returned repo is applied to the data on its own.
+func (s *Store) Interact(_ context.Context) (repos.Repository, error) {
+	return &repo{store: s}, nil
+}
+
+// Transact implements store.Transactor interface.
+func (s *Store) Transact(ctx context.Context, f func(repo repos.Repository) error) error {
+	for attempt := 0; ; attempt++ {
+		s.mu.Lock()
+		tx := &transaction{
+			state:     s.current.clone(),
+			commits:   s.commits,
+			timestamp: s.timestamp(),
+		}
+		s.mu.Unlock()
+
+		err := f(&repo{store: s, tx: tx})
+
+		s.mu.Lock()
+		conflicted := s.commits != tx.commits
+		if !conflicted && err == nil && tx.dirty {
+			s.current = tx.state
+			s.commits++
+		}
+		s.mu.Unlock()
+
+		// Like a database reporting a serialization failure, a transaction
+		// which read data changed meanwhile is retried even if it failed,
+		// since it may have failed because of the outdated data.
+		if !conflicted || (err == nil && !tx.dirty) {
+			return err // No need to Propagate this error as this is not a useful stacktrace line
+		}
+
+		if attempt >= s.maxRetries {
+			return stacktrace.NewError("Transaction conflicted with other transactions after %d retries", s.maxRetries)
+		}
+		if err := ctx.Err(); err != nil {
+			return stacktrace.Propagate(err, "Transaction conflicted with other transactions")
+		}
+	}
+}
+
+// Close implements store.Store interface.
+func (s *Store) Close() error {
+	return nil
+}
+
+// GetVersion implements store.Store interface.
+func (s *Store) GetVersion(_ context.Context) (*semver.Version, error) {
+	v := SchemaVersion
+	return &v, nil
+}
+
+// CleanUp removes all the data of s.
+func (s *Store) CleanUp(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.current = &state{
+		isas:          map[string]*isaRecord{},
+		subscriptions: map[string]*subscriptionRecord{},
+	}
+	s.commits++
+	return nil
+}
+
+// transaction is the working copy of the data of a Store during a
+// transaction.
+type transaction struct {
+	state *state
+	// commits is the number of changes committed to the Store when the
+	// transaction started.
+	commits uint64
+	// timestamp is the time at which the transaction started, given to all
+	// its changes.
+	timestamp time.Time
+	// dirty is true once the transaction made changes.
+	dirty bool
+}
+
+// repo is an implementation of repos.Repository on the data of a Store,
+// within a transaction if tx is set.
+type repo struct {
+	store *Store
+	tx    *transaction
+}
+
+// read calls f with the data visible to r.
+func (r *repo) read(f func(s *state) error) error {
+	if r.tx != nil {
+		return f(r.tx.state)
+	}
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	return f(r.store.current)
+}
+
+// write calls f with the data visible to r, to be changed at time now, and
+// keeps the changes unless f returns an error.  f must not change the data
+// before it may fail.
+func (r *repo) write(f func(s *state, now time.Time) error) error {
+	if r.tx != nil {
+		if err := f(r.tx.state, r.tx.timestamp); err != nil {
+			return err // No need to Propagate this error as this is not a useful stacktrace line
+		}
+		r.tx.dirty = true
+		return nil
+	}
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	// Changes are made to a copy so that transactions in progress keep their
+	// snapshot of the current state.
+	next := r.store.current.clone()
+	if err := f(next, r.store.timestamp()); err != nil {
+		return err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	r.store.current = next
+	r.store.commits++
+	return nil
+}
+
+// now returns the current time of the Store of r.
+func (r *repo) now() time.Time {
+	return r.store.clock.Now()
+}
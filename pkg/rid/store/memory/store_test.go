@@ -0,0 +1,208 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/geo/s2"
+	"github.com/interuss/dss/pkg/logging"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	ridmodels "github.com/interuss/dss/pkg/rid/models"
+	"github.com/interuss/dss/pkg/rid/repos"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	fakeClock = clockwork.NewFakeClock()
+	cells     = s2.CellUnion{s2.CellID(uint64(8768904281496485888))}
+)
+
+func setUpStore() *Store {
+	fakeClock = clockwork.NewFakeClock()
+	s := NewStore(logging.Logger)
+	s.clock = fakeClock
+	return s
+}
+
+func newISA() *ridmodels.IdentificationServiceArea {
+	startTime := fakeClock.Now().Add(-time.Minute)
+	endTime := fakeClock.Now().Add(time.Hour)
+	return &ridmodels.IdentificationServiceArea{
+		ID:        dssmodels.ID("a3cg3b2e-0980-47b1-8d2b-92d8e6cf8d58"),
+		Owner:     dssmodels.Owner("me"),
+		URL:       "https://no/place/like/home",
+		Cells:     cells,
+		StartTime: &startTime,
+		EndTime:   &endTime,
+		Writer:    "writer",
+	}
+}
+
+func TestTransactionRollsBackOnError(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		store = setUpStore()
+		fail  = errors.New("intended failure")
+	)
+
+	err := store.Transact(ctx, func(repo repos.Repository) error {
+		_, err := repo.InsertISA(ctx, newISA())
+		require.NoError(t, err)
+		return fail
+	})
+	require.Equal(t, fail, err)
+
+	repo, err := store.Interact(ctx)
+	require.NoError(t, err)
+	isa, err := repo.GetISA(ctx, newISA().ID)
+	require.NoError(t, err)
+	require.Nil(t, isa)
+}
+
+func TestTransactionRetriesOnConflict(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		store    = setUpStore()
+		attempts = 0
+	)
+
+	err := store.Transact(ctx, func(repo repos.Repository) error {
+		attempts++
+		isa, err := repo.GetISA(ctx, newISA().ID)
+		require.NoError(t, err)
+		if isa == nil {
+			// A concurrent transaction inserts the ISA, so that this
+			// transaction conflicts with it.
+			require.NoError(t, store.Transact(ctx, func(repo repos.Repository) error {
+				_, err := repo.InsertISA(ctx, newISA())
+				return err
+			}))
+			_, err = repo.InsertISA(ctx, newISA())
+			return err
+		}
+		_, err = repo.UpdateISA(ctx, isa)
+		return err
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, attempts)
+}
+
+func TestUpdateISARequiresCurrentVersion(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		store = setUpStore()
+	)
+	repo, err := store.Interact(ctx)
+	require.NoError(t, err)
+
+	inserted, err := repo.InsertISA(ctx, newISA())
+	require.NoError(t, err)
+	require.NotNil(t, inserted)
+
+	updated, err := repo.UpdateISA(ctx, inserted)
+	require.NoError(t, err)
+	require.NotNil(t, updated)
+	require.NotEqual(t, inserted.Version.String(), updated.Version.String())
+
+	stale, err := repo.UpdateISA(ctx, inserted)
+	require.NoError(t, err)
+	require.Nil(t, stale)
+}
+
+func TestTombstoneAndRestoreISA(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		store = setUpStore()
+	)
+	repo, err := store.Interact(ctx)
+	require.NoError(t, err)
+
+	inserted, err := repo.InsertISA(ctx, newISA())
+	require.NoError(t, err)
+
+	tombstoned, err := repo.TombstoneISA(ctx, inserted)
+	require.NoError(t, err)
+	require.NotNil(t, tombstoned)
+
+	isa, err := repo.GetISA(ctx, inserted.ID)
+	require.NoError(t, err)
+	require.Nil(t, isa)
+
+	isa, err = repo.GetTombstonedISA(ctx, inserted.ID, fakeClock.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	require.NotNil(t, isa)
+
+	restored, err := repo.RestoreISA(ctx, tombstoned)
+	require.NoError(t, err)
+	require.NotNil(t, restored)
+
+	isa, err = repo.GetISA(ctx, inserted.ID)
+	require.NoError(t, err)
+	require.Equal(t, restored.Version.String(), isa.Version.String())
+}
+
+func TestUpdateNotificationIdxsInCells(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		store = setUpStore()
+		isa   = newISA()
+	)
+	repo, err := store.Interact(ctx)
+	require.NoError(t, err)
+
+	inserted, err := repo.InsertSubscription(ctx, &ridmodels.Subscription{
+		ID:                dssmodels.ID("c6b3e2a1-5b8f-4a3c-9d2e-1f0a9b8c7d6e"),
+		Owner:             dssmodels.Owner("me"),
+		URL:               "https://no/place/like/home",
+		NotificationIndex: dssmodels.MaxNotificationIndex,
+		Cells:             isa.Cells,
+		StartTime:         isa.StartTime,
+		EndTime:           isa.EndTime,
+	})
+	require.NoError(t, err)
+
+	subs, err := repo.UpdateNotificationIdxsInCells(ctx, cells)
+	require.NoError(t, err)
+	require.Len(t, subs, 1)
+	require.Equal(t, 0, subs[0].NotificationIndex)
+	require.Equal(t, inserted.Version.String(), subs[0].Version.String())
+}
+
+func TestListISAsByOwner(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		store = setUpStore()
+	)
+	repo, err := store.Interact(ctx)
+	require.NoError(t, err)
+
+	for _, id := range []dssmodels.ID{
+		"b3cg3b2e-0980-47b1-8d2b-92d8e6cf8d58",
+		"a3cg3b2e-0980-47b1-8d2b-92d8e6cf8d58",
+		"c3cg3b2e-0980-47b1-8d2b-92d8e6cf8d58",
+	} {
+		isa := newISA()
+		isa.ID = id
+		_, err := repo.InsertISA(ctx, isa)
+		require.NoError(t, err)
+	}
+	other := newISA()
+	other.ID = "d3cg3b2e-0980-47b1-8d2b-92d8e6cf8d58"
+	other.Owner = "other"
+	_, err = repo.InsertISA(ctx, other)
+	require.NoError(t, err)
+
+	page, err := repo.ListISAsByOwner(ctx, "me", "", 2)
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	require.Equal(t, dssmodels.ID("a3cg3b2e-0980-47b1-8d2b-92d8e6cf8d58"), page[0].ID)
+	require.Equal(t, dssmodels.ID("b3cg3b2e-0980-47b1-8d2b-92d8e6cf8d58"), page[1].ID)
+
+	page, err = repo.ListISAsByOwner(ctx, "me", page[1].ID, 2)
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	require.Equal(t, dssmodels.ID("c3cg3b2e-0980-47b1-8d2b-92d8e6cf8d58"), page[0].ID)
+}
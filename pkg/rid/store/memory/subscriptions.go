@@ -0,0 +1,239 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/golang/geo/s2"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/dss/pkg/geo"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	ridmodels "github.com/interuss/dss/pkg/rid/models"
+	"github.com/interuss/stacktrace"
+)
+
+// subscriptionRecord is a stored Subscription.
+type subscriptionRecord struct {
+	sub       ridmodels.Subscription
+	updatedAt time.Time
+}
+
+// toModel returns a copy of the Subscription of r, as it would be read from
+// the database.
+func (r *subscriptionRecord) toModel() *ridmodels.Subscription {
+	sub := r.sub
+	sub.Cells = append(s2.CellUnion{}, r.sub.Cells...)
+	sub.StartTime = copyTime(r.sub.StartTime)
+	sub.EndTime = copyTime(r.sub.EndTime)
+	sub.Version = dssmodels.VersionFromTime(r.updatedAt)
+	return &sub
+}
+
+// newSubscriptionRecord returns the record storing the fields of sub which
+// are persisted by the database.
+func newSubscriptionRecord(sub *ridmodels.Subscription, updatedAt time.Time) (*subscriptionRecord, error) {
+	for _, cell := range sub.Cells {
+		if err := geo.ValidateCell(cell); err != nil {
+			return nil, stacktrace.Propagate(err, "Error validating cell")
+		}
+	}
+	if err := validateExtents(sub.Cells, sub.StartTime, sub.EndTime); err != nil {
+		return nil, stacktrace.Propagate(err, "Invalid Subscription")
+	}
+	return &subscriptionRecord{
+		sub: ridmodels.Subscription{
+			ID:                sub.ID,
+			URL:               sub.URL,
+			NotificationIndex: sub.NotificationIndex,
+			Owner:             sub.Owner,
+			Cells:             append(s2.CellUnion{}, sub.Cells...),
+			StartTime:         copyTime(sub.StartTime),
+			EndTime:           copyTime(sub.EndTime),
+			Writer:            sub.Writer,
+		},
+		updatedAt: updatedAt,
+	}, nil
+}
+
+// GetSubscription implements repos.Subscription.GetSubscription.
+func (r *repo) GetSubscription(ctx context.Context, id dssmodels.ID) (*ridmodels.Subscription, error) {
+	var result *ridmodels.Subscription
+	err := r.read(func(s *state) error {
+		if rec, ok := s.subscriptions[id.String()]; ok {
+			result = rec.toModel()
+		}
+		return nil
+	})
+	return result, err
+}
+
+// InsertSubscription implements repos.Subscription.InsertSubscription.
+func (r *repo) InsertSubscription(ctx context.Context, sub *ridmodels.Subscription) (*ridmodels.Subscription, error) {
+	var result *ridmodels.Subscription
+	err := r.write(func(s *state, now time.Time) error {
+		if _, ok := s.subscriptions[sub.ID.String()]; ok {
+			return stacktrace.NewError("Subscription %s already exists", sub.ID)
+		}
+		rec, err := newSubscriptionRecord(sub, now)
+		if err != nil {
+			return err // No need to Propagate this error as this is not a useful stacktrace line
+		}
+		s.subscriptions[sub.ID.String()] = rec
+		result = rec.toModel()
+		return nil
+	})
+	return result, err
+}
+
+// UpdateSubscription implements repos.Subscription.UpdateSubscription.
+func (r *repo) UpdateSubscription(ctx context.Context, sub *ridmodels.Subscription) (*ridmodels.Subscription, error) {
+	var result *ridmodels.Subscription
+	err := r.write(func(s *state, now time.Time) error {
+		old, ok := s.subscriptions[sub.ID.String()]
+		if !ok || !old.updatedAt.Equal(*sub.Version.ToTimestamp()) {
+			return nil
+		}
+		rec, err := newSubscriptionRecord(sub, now)
+		if err != nil {
+			return err // No need to Propagate this error as this is not a useful stacktrace line
+		}
+		rec.sub.Owner = old.sub.Owner
+		s.subscriptions[sub.ID.String()] = rec
+		result = rec.toModel()
+		return nil
+	})
+	return result, err
+}
+
+// DeleteSubscription implements repos.Subscription.DeleteSubscription.
+func (r *repo) DeleteSubscription(ctx context.Context, sub *ridmodels.Subscription) (*ridmodels.Subscription, error) {
+	var result *ridmodels.Subscription
+	err := r.write(func(s *state, now time.Time) error {
+		old, ok := s.subscriptions[sub.ID.String()]
+		if !ok || !old.updatedAt.Equal(*sub.Version.ToTimestamp()) {
+			return nil
+		}
+		delete(s.subscriptions, sub.ID.String())
+		result = old.toModel()
+		return nil
+	})
+	return result, err
+}
+
+// UpdateNotificationIdxsInCells implements
+// repos.Subscription.UpdateNotificationIdxsInCells.
+func (r *repo) UpdateNotificationIdxsInCells(ctx context.Context, cells s2.CellUnion) ([]*ridmodels.Subscription, error) {
+	var result []*ridmodels.Subscription
+	now := r.now()
+	err := r.write(func(s *state, _ time.Time) error {
+		for id, old := range s.subscriptions {
+			if !endsAtOrAfter(old.sub.EndTime, now) || !intersects(old.sub.Cells, cells) {
+				continue
+			}
+			// The version of a Subscription is unchanged by notifications
+			rec := *old
+			if rec.sub.NotificationIndex >= dssmodels.MaxNotificationIndex {
+				rec.sub.NotificationIndex = 0
+			} else {
+				rec.sub.NotificationIndex++
+			}
+			s.subscriptions[id] = &rec
+			result = append(result, rec.toModel())
+		}
+		return nil
+	})
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result, err
+}
+
+// SearchSubscriptions implements repos.Subscription.SearchSubscriptions.
+func (r *repo) SearchSubscriptions(ctx context.Context, cells s2.CellUnion) ([]*ridmodels.Subscription, error) {
+	if len(cells) == 0 {
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "no location provided")
+	}
+	now := r.now()
+	return r.listSubscriptions(func(rec *subscriptionRecord) bool {
+		return endsAtOrAfter(rec.sub.EndTime, now) && intersects(rec.sub.Cells, cells)
+	})
+}
+
+// SearchSubscriptionsByOwner implements
+// repos.Subscription.SearchSubscriptionsByOwner.
+func (r *repo) SearchSubscriptionsByOwner(ctx context.Context, cells s2.CellUnion, owner dssmodels.Owner) ([]*ridmodels.Subscription, error) {
+	if len(cells) == 0 {
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "no location provided")
+	}
+	now := r.now()
+	return r.listSubscriptions(func(rec *subscriptionRecord) bool {
+		return rec.sub.Owner == owner && endsAtOrAfter(rec.sub.EndTime, now) && intersects(rec.sub.Cells, cells)
+	})
+}
+
+// ListSubscriptionsByOwner implements
+// repos.Subscription.ListSubscriptionsByOwner.
+func (r *repo) ListSubscriptionsByOwner(ctx context.Context, owner dssmodels.Owner, after dssmodels.ID, limit int) ([]*ridmodels.Subscription, error) {
+	result, err := r.listSubscriptions(func(rec *subscriptionRecord) bool {
+		return rec.sub.Owner == owner && rec.sub.ID > after
+	})
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result, err
+}
+
+// MaxSubscriptionCountInCellsByOwner implements
+// repos.Subscription.MaxSubscriptionCountInCellsByOwner.
+func (r *repo) MaxSubscriptionCountInCellsByOwner(ctx context.Context, cells s2.CellUnion, owner dssmodels.Owner) (int, error) {
+	now := r.now()
+	counts := make(map[s2.CellID]int, len(cells))
+	for _, cell := range cells {
+		counts[cell] = 0
+	}
+	max := 0
+	err := r.read(func(s *state) error {
+		for _, rec := range s.subscriptions {
+			if rec.sub.Owner != owner || !endsAtOrAfter(rec.sub.EndTime, now) {
+				continue
+			}
+			for _, cell := range rec.sub.Cells {
+				if count, ok := counts[cell]; ok {
+					counts[cell] = count + 1
+					if count+1 > max {
+						max = count + 1
+					}
+				}
+			}
+		}
+		return nil
+	})
+	return max, err
+}
+
+// ListExpiredSubscriptions implements
+// repos.Subscription.ListExpiredSubscriptions.
+func (r *repo) ListExpiredSubscriptions(ctx context.Context, writer string) ([]*ridmodels.Subscription, error) {
+	now := r.now()
+	return r.listSubscriptions(func(rec *subscriptionRecord) bool {
+		return rec.sub.Writer == writer && expired(rec.sub.EndTime, now)
+	})
+}
+
+// listSubscriptions returns up to dssmodels.MaxResultLimit Subscriptions
+// matching filter, in ID order.
+func (r *repo) listSubscriptions(filter func(rec *subscriptionRecord) bool) ([]*ridmodels.Subscription, error) {
+	var result []*ridmodels.Subscription
+	err := r.read(func(s *state) error {
+		for _, rec := range s.subscriptions {
+			if filter(rec) {
+				result = append(result, rec.toModel())
+			}
+		}
+		return nil
+	})
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	if len(result) > dssmodels.MaxResultLimit {
+		result = result[:dssmodels.MaxResultLimit]
+	}
+	return result, err
+}
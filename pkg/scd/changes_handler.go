@@ -0,0 +1,84 @@
+package scd
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/geo/s2"
+	"github.com/interuss/dss/pkg/auth"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	scdmodels "github.com/interuss/dss/pkg/scd/models"
+	"github.com/interuss/stacktrace"
+)
+
+const (
+	// MaxChangeWait is the longest a client may wait for entity changes.
+	MaxChangeWait = time.Minute
+
+	// changePollInterval is the interval between two searches for entity
+	// changes while a client waits for them.
+	changePollInterval = time.Second
+
+	// maxChangesPerPoll is the largest number of entity changes returned at
+	// once.
+	maxChangesPerPoll = 1000
+)
+
+// ChangeReadScopes validates the scopes required to watch changes of entities.
+var ChangeReadScopes = auth.RequireAnyScope(strategicCoordinationScope, constraintManagementScope, constraintProcessingScope, conformanceMonitoringSAScope)
+
+// WatchChanges returns the changes of OperationalIntent and Constraint
+// references intersecting area recorded after the change with cursor after.
+// If there are none, it waits up to wait for changes to be recorded before
+// returning an empty result.  Changes committed concurrently may be recorded
+// slightly out of cursor order, so clients should tolerate rare misses by
+// periodically refreshing their view of the area.
+func (a *Server) WatchChanges(ctx context.Context, area s2.CellUnion, after int64, wait time.Duration) ([]*scdmodels.EntityChange, error) {
+	if len(area) == 0 {
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Missing area")
+	}
+	switch {
+	case wait < 0:
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Wait may not be negative")
+	case wait > MaxChangeWait:
+		wait = MaxChangeWait
+	}
+
+	deadline := time.Now().Add(wait)
+	for {
+		r, err := a.Store.Interact(ctx)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Unable to interact with store")
+		}
+		changes, err := r.SearchEntityChanges(ctx, area, after, maxChangesPerPoll)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Unable to search entity changes in repo")
+		}
+		remaining := time.Until(deadline)
+		if len(changes) > 0 || remaining <= 0 {
+			return changes, nil
+		}
+		if remaining > changePollInterval {
+			remaining = changePollInterval
+		}
+		select {
+		case <-ctx.Done():
+			return nil, stacktrace.Propagate(ctx.Err(), "Stopped waiting for entity changes")
+		case <-time.After(remaining):
+		}
+	}
+}
+
+// PurgeEntityChanges deletes the records of the entity changes which occurred
+// before t and returns how many were deleted.
+func (a *Server) PurgeEntityChanges(ctx context.Context, t time.Time) (int64, error) {
+	r, err := a.Store.Interact(ctx)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "Unable to interact with store")
+	}
+	purged, err := r.DeleteEntityChangesBefore(ctx, t)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "Unable to delete entity changes from repo")
+	}
+	return purged, nil
+}
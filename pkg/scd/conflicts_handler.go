@@ -0,0 +1,171 @@
+package scd
+
+import (
+	"context"
+
+	"github.com/interuss/dss/pkg/api/v1/scdpb"
+	"github.com/interuss/dss/pkg/auth"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	scdmodels "github.com/interuss/dss/pkg/scd/models"
+	"github.com/interuss/dss/pkg/scd/repos"
+	"github.com/interuss/stacktrace"
+	"github.com/jackc/pgx/v4"
+)
+
+// ConflictCheckScopes validates the scopes required to check planned
+// operational intents for conflicts.
+var ConflictCheckScopes = auth.RequireAnyScope(strategicCoordinationScope, conformanceMonitoringSAScope)
+
+// EntityOVN is an entity reference and the OVN a client believes is current.
+type EntityOVN struct {
+	ID  dssmodels.ID
+	OVN scdmodels.OVN
+}
+
+// StaleOVN is an EntityOVN whose OVN is not current.
+type StaleOVN struct {
+	EntityOVN
+
+	// EntityType is the type of the referenced entity, or empty if the entity
+	// does not exist.
+	EntityType string
+}
+
+// CheckConflicts returns the OperationalIntent and Constraint references
+// overlapping any of extents, and the priorities of those OperationalIntents
+// by ID, without changing the state of the DSS.
+func (a *Server) CheckConflicts(ctx context.Context, extents []*scdpb.Volume4D) ([]*scdpb.OperationalIntentReference, []*scdpb.ConstraintReference, map[string]int32, error) {
+	if len(extents) == 0 {
+		return nil, nil, nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Missing extents")
+	}
+
+	vol4s := make([]*dssmodels.Volume4D, len(extents))
+	for idx, extent := range extents {
+		vol4, err := dssmodels.Volume4DFromSCDProto(extent)
+		if err != nil {
+			return nil, nil, nil, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Failed to parse extent %d", idx)
+		}
+		vol4s[idx] = vol4
+	}
+
+	// Retrieve ID of client making call
+	manager, ok := auth.ManagerFromContext(ctx)
+	if !ok {
+		return nil, nil, nil, stacktrace.NewErrorWithCode(dsserr.PermissionDenied, "Missing manager from context")
+	}
+
+	var (
+		opProtos         []*scdpb.OperationalIntentReference
+		constraintProtos []*scdpb.ConstraintReference
+		priorities       map[string]int32
+	)
+	action := func(ctx context.Context, r repos.Repository) (err error) {
+		// Collect the distinct references overlapping any extent, in the order
+		// they are found
+		var (
+			ops         []*scdmodels.OperationalIntent
+			constraints []*scdmodels.Constraint
+			seen        = map[dssmodels.ID]bool{}
+		)
+		for _, vol4 := range vol4s {
+			found, err := r.SearchOperationalIntents(ctx, vol4)
+			if err != nil {
+				return stacktrace.Propagate(err, "Unable to query for OperationalIntents in repo")
+			}
+			for _, op := range found {
+				if !seen[op.ID] {
+					seen[op.ID] = true
+					ops = append(ops, op)
+				}
+			}
+
+			foundConstraints, err := r.SearchConstraints(ctx, vol4)
+			if err != nil {
+				return stacktrace.Propagate(err, "Unable to query for Constraints in repo")
+			}
+			for _, constraint := range foundConstraints {
+				if !seen[constraint.ID] {
+					seen[constraint.ID] = true
+					constraints = append(constraints, constraint)
+				}
+			}
+		}
+
+		if err := attachUssAvailabilities(ctx, r, ops, constraints); err != nil {
+			return stacktrace.Propagate(err, "Unable to attach USS availabilities")
+		}
+
+		// Convert the references found, hiding OVNs not known to the client
+		opProtos, constraintProtos, priorities = nil, nil, map[string]int32{}
+		for _, op := range ops {
+			priorities[op.ID.String()] = op.Priority
+			p, err := op.ToProto()
+			if err != nil {
+				return stacktrace.Propagate(err, "Could not convert OperationalIntent model to proto")
+			}
+			if op.Manager != manager {
+				p.Ovn = scdmodels.NoOvnPhrase
+			}
+			opProtos = append(opProtos, p)
+		}
+		for _, constraint := range constraints {
+			p, err := constraint.ToProto()
+			if err != nil {
+				return stacktrace.Propagate(err, "Could not convert Constraint model to proto")
+			}
+			if constraint.Manager != manager {
+				p.Ovn = scdmodels.NoOvnPhrase
+			}
+			constraintProtos = append(constraintProtos, p)
+		}
+
+		return nil
+	}
+
+	err := a.Store.Transact(ctx, action)
+	if err != nil {
+		return nil, nil, nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+
+	return opProtos, constraintProtos, priorities, nil
+}
+
+// CheckOVNs returns the entries of entities whose OVN is not the current OVN of
+// the referenced OperationalIntent or Constraint, in the order of entities.
+func (a *Server) CheckOVNs(ctx context.Context, entities []EntityOVN) ([]StaleOVN, error) {
+	var stale []StaleOVN
+	action := func(ctx context.Context, r repos.Repository) (err error) {
+		stale = nil
+		for _, entity := range entities {
+			op, err := r.GetOperationalIntent(ctx, entity.ID)
+			if err != nil {
+				return stacktrace.Propagate(err, "Unable to get OperationalIntent from repo")
+			}
+			if op != nil {
+				if op.OVN != entity.OVN {
+					stale = append(stale, StaleOVN{EntityOVN: entity, EntityType: scdmodels.EntityTypeOperationalIntent})
+				}
+				continue
+			}
+
+			constraint, err := r.GetConstraint(ctx, entity.ID)
+			switch {
+			case err == pgx.ErrNoRows:
+				stale = append(stale, StaleOVN{EntityOVN: entity})
+			case err != nil:
+				return stacktrace.Propagate(err, "Unable to get Constraint from repo")
+			case constraint.OVN != entity.OVN:
+				stale = append(stale, StaleOVN{EntityOVN: entity, EntityType: scdmodels.EntityTypeConstraint})
+			}
+		}
+		return nil
+	}
+
+	err := a.Store.Transact(ctx, action)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+
+	return stale, nil
+}
@@ -0,0 +1,164 @@
+package scd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/geo/s2"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	scdmodels "github.com/interuss/dss/pkg/scd/models"
+	"github.com/interuss/dss/pkg/scd/repos"
+	"github.com/interuss/stacktrace"
+	"github.com/jackc/pgx/v4"
+)
+
+// Kinds of inconsistencies reported by CheckConsistency.
+const (
+	// InconsistencyMissingEntity reports an entity found in an area but not
+	// by its ID.
+	InconsistencyMissingEntity = "missing_entity"
+
+	// InconsistencyStaleOVN reports an entity whose OVN found in an area
+	// differs from its OVN found by its ID.
+	InconsistencyStaleOVN = "stale_ovn"
+
+	// InconsistencyMissingOVN reports an entity without an OVN.
+	InconsistencyMissingOVN = "missing_ovn"
+
+	// InconsistencyDuplicateKey reports an ID or OVN shared by several
+	// entities, which makes the key of a new plan ambiguous.
+	InconsistencyDuplicateKey = "duplicate_key"
+
+	// InconsistencyMissingSubscription reports an OperationalIntent whose
+	// Subscription does not exist.
+	InconsistencyMissingSubscription = "missing_subscription"
+
+	// InconsistencyInvalidTimeRange reports an entity ending before it starts.
+	InconsistencyInvalidTimeRange = "invalid_time_range"
+)
+
+// Inconsistency is an internal inconsistency of the stored entities.
+type Inconsistency struct {
+	Kind       string
+	EntityType string
+	EntityID   dssmodels.ID
+	Detail     string
+}
+
+// KeyEntity is an entity whose OVN a new plan in an area would need to
+// present.
+type KeyEntity struct {
+	EntityOVN
+	EntityType string
+	Manager    dssmodels.Manager
+}
+
+// CheckConsistency recomputes the key a new plan in area would need, over all
+// times and altitudes, and cross-checks the entities of that key against the
+// stored entities, without changing the state of the DSS.
+func (a *Server) CheckConsistency(ctx context.Context, area s2.CellUnion) ([]KeyEntity, []Inconsistency, error) {
+	if len(area) == 0 {
+		return nil, nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Missing area")
+	}
+	vol4 := &dssmodels.Volume4D{
+		SpatialVolume: &dssmodels.Volume3D{
+			Footprint: dssmodels.GeometryFunc(func() (s2.CellUnion, error) {
+				return area, nil
+			}),
+		},
+	}
+
+	var (
+		key    []KeyEntity
+		issues []Inconsistency
+	)
+	action := func(ctx context.Context, r repos.Repository) error {
+		key, issues = nil, nil
+		report := func(kind, entityType string, id dssmodels.ID, format string, args ...interface{}) {
+			issues = append(issues, Inconsistency{Kind: kind, EntityType: entityType, EntityID: id, Detail: fmt.Sprintf(format, args...)})
+		}
+
+		ops, err := r.SearchOperationalIntents(ctx, vol4)
+		if err != nil {
+			return stacktrace.Propagate(err, "Unable to query for OperationalIntents in repo")
+		}
+		constraints, err := r.SearchConstraints(ctx, vol4)
+		if err != nil {
+			return stacktrace.Propagate(err, "Unable to query for Constraints in repo")
+		}
+
+		var (
+			typeByID  = map[dssmodels.ID]string{}
+			idByOVN   = map[scdmodels.OVN]dssmodels.ID{}
+			checkKeys = func(entityType string, id dssmodels.ID, ovn scdmodels.OVN) {
+				if other, ok := typeByID[id]; ok {
+					report(InconsistencyDuplicateKey, entityType, id, "ID is also used by a %s", other)
+				}
+				typeByID[id] = entityType
+				if ovn == "" {
+					report(InconsistencyMissingOVN, entityType, id, "Entity has no OVN")
+					return
+				}
+				if other, ok := idByOVN[ovn]; ok {
+					report(InconsistencyDuplicateKey, entityType, id, "OVN %s is also the OVN of %s", ovn, other)
+				}
+				idByOVN[ovn] = id
+			}
+		)
+
+		for _, op := range ops {
+			key = append(key, KeyEntity{EntityOVN: EntityOVN{ID: op.ID, OVN: op.OVN}, EntityType: scdmodels.EntityTypeOperationalIntent, Manager: op.Manager})
+			checkKeys(scdmodels.EntityTypeOperationalIntent, op.ID, op.OVN)
+			if op.StartTime != nil && op.EndTime != nil && op.EndTime.Before(*op.StartTime) {
+				report(InconsistencyInvalidTimeRange, scdmodels.EntityTypeOperationalIntent, op.ID, "Entity ends at %s before it starts at %s", op.EndTime, op.StartTime)
+			}
+
+			stored, err := r.GetOperationalIntent(ctx, op.ID)
+			if err != nil {
+				return stacktrace.Propagate(err, "Unable to get OperationalIntent from repo")
+			}
+			switch {
+			case stored == nil:
+				report(InconsistencyMissingEntity, scdmodels.EntityTypeOperationalIntent, op.ID, "Entity found in area but not by ID")
+			case stored.OVN != op.OVN:
+				report(InconsistencyStaleOVN, scdmodels.EntityTypeOperationalIntent, op.ID, "OVN %s found in area but %s found by ID", op.OVN, stored.OVN)
+			}
+
+			sub, err := r.GetSubscription(ctx, op.SubscriptionID)
+			if err != nil {
+				return stacktrace.Propagate(err, "Unable to get Subscription from repo")
+			}
+			if sub == nil {
+				report(InconsistencyMissingSubscription, scdmodels.EntityTypeOperationalIntent, op.ID, "Subscription %s does not exist", op.SubscriptionID)
+			}
+		}
+
+		for _, constraint := range constraints {
+			key = append(key, KeyEntity{EntityOVN: EntityOVN{ID: constraint.ID, OVN: constraint.OVN}, EntityType: scdmodels.EntityTypeConstraint, Manager: constraint.Manager})
+			checkKeys(scdmodels.EntityTypeConstraint, constraint.ID, constraint.OVN)
+			if constraint.StartTime != nil && constraint.EndTime != nil && constraint.EndTime.Before(*constraint.StartTime) {
+				report(InconsistencyInvalidTimeRange, scdmodels.EntityTypeConstraint, constraint.ID, "Entity ends at %s before it starts at %s", constraint.EndTime, constraint.StartTime)
+			}
+
+			stored, err := r.GetConstraint(ctx, constraint.ID)
+			switch {
+			case err == pgx.ErrNoRows:
+				report(InconsistencyMissingEntity, scdmodels.EntityTypeConstraint, constraint.ID, "Entity found in area but not by ID")
+			case err != nil:
+				return stacktrace.Propagate(err, "Unable to get Constraint from repo")
+			case stored.OVN != constraint.OVN:
+				report(InconsistencyStaleOVN, scdmodels.EntityTypeConstraint, constraint.ID, "OVN %s found in area but %s found by ID", constraint.OVN, stored.OVN)
+			}
+		}
+
+		return nil
+	}
+
+	err := a.Store.Transact(ctx, action)
+	if err != nil {
+		return nil, nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+
+	return key, issues, nil
+}
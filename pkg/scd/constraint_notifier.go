@@ -0,0 +1,404 @@
+package scd
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/interuss/dss/pkg/api/v1/scdpb"
+	"github.com/interuss/dss/pkg/auth"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	scdmodels "github.com/interuss/dss/pkg/scd/models"
+	"github.com/interuss/dss/pkg/scd/repos"
+	scdstore "github.com/interuss/dss/pkg/scd/store"
+	"github.com/interuss/stacktrace"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// constraintNotificationPath is the path, relative to a USS base URL, of
+	// the ASTM F3548-21 endpoint receiving Constraint change notifications.
+	constraintNotificationPath = "/uss/v1/constraints"
+)
+
+var (
+	notificationDeliveries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "dss_scd_notification_deliveries_total",
+		Help: "Number of notifications delivered by the DSS, by outcome.",
+	}, []string{"status"})
+)
+
+// SubscriptionReadScopes validates the scopes required to read information
+// about Subscriptions.
+var SubscriptionReadScopes = auth.RequireAnyScope(strategicCoordinationScope, constraintProcessingScope)
+
+// ConstraintNotifier notifies the USSs subscribed to Constraint changes on
+// behalf of the USS making the change, retrying failed deliveries with
+// exponential backoff and recording the outcome of each delivery.
+//
+// Notifications are recorded as pending deliveries in the transaction of the
+// change, and delivered by Run from the committed records: requests do not
+// wait for deliveries, and the deliveries interrupted by a crash are resumed
+// by any DSS instance once their Lease expires.
+type ConstraintNotifier struct {
+	Store  scdstore.Store
+	Client *http.Client
+	Logger *zap.Logger
+
+	// MaxAttempts is the number of attempts made to deliver each notification.
+	MaxAttempts int
+
+	// InitialBackoff is the delay before retrying a failed delivery the first
+	// time; it doubles with each subsequent retry, up to half of Lease.
+	InitialBackoff time.Duration
+
+	// AccessToken, when non-nil, provides the access token presented to the
+	// notified USSs.
+	AccessToken func() (string, error)
+
+	// Feed, when non-nil, reports committed changes to the recorded
+	// deliveries, e.g. from a changefeed, so that deliveries recorded by any
+	// DSS instance are dispatched without waiting for the next poll.
+	Feed NotificationFeed
+
+	// PollInterval is the period at which pending deliveries are looked up
+	// regardless of Feed and of the changes made by this DSS instance.
+	PollInterval time.Duration
+
+	// Lease is the duration after the latest update of a pending delivery, by
+	// the clock of Store, beyond which it is considered abandoned by the DSS
+	// instance which claimed it, and claimed again.  It must exceed twice the
+	// timeout of Client, so that the deliveries are updated by each attempt
+	// before their lease expires.
+	Lease time.Duration
+
+	wakeOnce sync.Once
+	wake     chan struct{}
+}
+
+// NotificationFeed reports the committed changes to the notification
+// deliveries recorded in a Store.
+type NotificationFeed interface {
+	// WatchNotificationDeliveries calls notify whenever a change to the
+	// recorded notification deliveries is committed, until ctx is done.
+	WatchNotificationDeliveries(ctx context.Context, notify func()) error
+}
+
+// constraintNotification is a notification to a single USS, covering the
+// deliveries to each of its Subscriptions.
+type constraintNotification struct {
+	url        string
+	entityID   dssmodels.ID
+	deliveries []*scdmodels.NotificationDelivery
+}
+
+// record records pending deliveries of a notification of the change of the
+// Constraint identified by id to the USSs managing subs, notifying constraint
+// as changed, or its deletion when nil.  It is a no-op on a nil
+// ConstraintNotifier.
+func (n *ConstraintNotifier) record(ctx context.Context, r repos.Repository, id dssmodels.ID, constraint *scdpb.Constraint, subs repos.Subscriptions) error {
+	if n == nil || len(subs) == 0 {
+		return nil
+	}
+
+	var deliveries []*scdmodels.NotificationDelivery
+	for _, sub := range subs {
+		deliveries = append(deliveries, &scdmodels.NotificationDelivery{
+			ID:                dssmodels.ID(uuid.New().String()),
+			SubscriptionID:    sub.ID,
+			Manager:           sub.Manager,
+			EntityID:          id,
+			NotificationIndex: sub.NotificationIndex,
+			URL:               sub.USSBaseURL + constraintNotificationPath,
+			Status:            scdmodels.NotificationDeliveryStatusPending,
+			Constraint:        constraint,
+		})
+	}
+
+	if err := r.InsertNotificationDeliveries(ctx, deliveries); err != nil {
+		return stacktrace.Propagate(err, "Unable to record notification deliveries")
+	}
+	return nil
+}
+
+func (n *ConstraintNotifier) wakeChannel() chan struct{} {
+	n.wakeOnce.Do(func() { n.wake = make(chan struct{}, 1) })
+	return n.wake
+}
+
+// signal has Run look up pending deliveries, once the deliveries recorded by
+// record are committed.  It is a no-op on a nil ConstraintNotifier.
+func (n *ConstraintNotifier) signal() {
+	if n == nil {
+		return
+	}
+	select {
+	case n.wakeChannel() <- struct{}{}:
+	default:
+	}
+}
+
+// Run delivers the pending notifications recorded in Store until ctx is done,
+// looking them up whenever Feed or this DSS instance reports a change, and
+// every PollInterval otherwise.
+func (n *ConstraintNotifier) Run(ctx context.Context) {
+	wake := n.wakeChannel()
+	if n.Feed != nil {
+		go n.watch(ctx)
+	}
+	ticker := time.NewTicker(n.PollInterval)
+	defer ticker.Stop()
+	for {
+		if err := n.dispatchPending(ctx); err != nil && ctx.Err() == nil {
+			n.Logger.Warn("Failed to dispatch pending Constraint notifications", zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-wake:
+		case <-ticker.C:
+		}
+	}
+}
+
+// watch signals the changes reported by Feed until ctx is done, resuming the
+// feed after PollInterval when it fails.
+func (n *ConstraintNotifier) watch(ctx context.Context) {
+	for {
+		err := n.Feed.WatchNotificationDeliveries(ctx, n.signal)
+		if ctx.Err() != nil {
+			return
+		}
+		n.Logger.Warn("Notification delivery feed interrupted", zap.Error(err))
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(n.PollInterval):
+		}
+	}
+}
+
+// dispatchPending claims the pending deliveries and delivers them in the
+// background, grouped by Constraint and notified URL.
+func (n *ConstraintNotifier) dispatchPending(ctx context.Context) error {
+	for {
+		var deliveries []*scdmodels.NotificationDelivery
+		err := n.Store.Transact(ctx, func(ctx context.Context, r repos.Repository) (err error) {
+			deliveries, err = r.ClaimNotificationDeliveries(ctx, n.Lease, dssmodels.MaxResultLimit)
+			if err != nil {
+				return stacktrace.Propagate(err, "Unable to claim notification deliveries")
+			}
+			return nil
+		})
+		if err != nil {
+			return err // No need to Propagate this error as this is not a useful stacktrace line
+		}
+
+		var (
+			notifications []*constraintNotification
+			byKey         = map[string]*constraintNotification{}
+		)
+		for _, delivery := range deliveries {
+			key := delivery.EntityID.String() + " " + delivery.URL
+			notification, ok := byKey[key]
+			if !ok {
+				notification = &constraintNotification{url: delivery.URL, entityID: delivery.EntityID}
+				byKey[key] = notification
+				notifications = append(notifications, notification)
+			}
+			notification.deliveries = append(notification.deliveries, delivery)
+		}
+		for _, notification := range notifications {
+			go n.deliver(ctx, notification, notificationParams(notification))
+		}
+
+		if len(deliveries) < dssmodels.MaxResultLimit {
+			return nil
+		}
+	}
+}
+
+// notificationParams returns the body of notification, describing the
+// Constraint as of the latest change notified, or its deletion.
+func notificationParams(notification *constraintNotification) *scdpb.PutConstraintDetailsParameters {
+	params := &scdpb.PutConstraintDetailsParameters{ConstraintId: notification.entityID.String()}
+
+	// Deliveries are claimed oldest first.
+	latest := notification.deliveries[len(notification.deliveries)-1]
+	params.Constraint = latest.Constraint
+
+	// Successive changes notified at once are reported with the latest
+	// notification index of each Subscription.
+	indices := map[dssmodels.ID]int{}
+	for _, delivery := range notification.deliveries {
+		index, ok := indices[delivery.SubscriptionID]
+		if !ok {
+			params.Subscriptions = append(params.Subscriptions, &scdpb.SubscriptionState{SubscriptionId: delivery.SubscriptionID.String()})
+		}
+		if !ok || delivery.NotificationIndex > index {
+			indices[delivery.SubscriptionID] = delivery.NotificationIndex
+		}
+	}
+	for _, state := range params.Subscriptions {
+		state.NotificationIndex = int32(indices[dssmodels.ID(state.SubscriptionId)])
+	}
+	return params
+}
+
+// deliver attempts to deliver params for notification until it succeeds or
+// MaxAttempts is reached, recording the outcome of each attempt.  Attempts
+// made before the deliveries were claimed count towards MaxAttempts.
+func (n *ConstraintNotifier) deliver(ctx context.Context, notification *constraintNotification, params *scdpb.PutConstraintDetailsParameters) {
+	logger := n.Logger.With(zap.String("url", notification.url), zap.String("constraint_id", params.ConstraintId))
+
+	body, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(params)
+	if err != nil {
+		logger.Error("Failed to marshal Constraint notification", zap.Error(err))
+		return
+	}
+
+	previousAttempts := 0
+	for _, delivery := range notification.deliveries {
+		if delivery.Attempts > previousAttempts {
+			previousAttempts = delivery.Attempts
+		}
+	}
+
+	backoff := n.InitialBackoff
+	for attempt := previousAttempts + 1; ; attempt++ {
+		err := n.post(ctx, notification.url, body)
+
+		status := scdmodels.NotificationDeliveryStatusDelivered
+		lastError := ""
+		if err != nil {
+			lastError = err.Error()
+			status = scdmodels.NotificationDeliveryStatusPending
+			if attempt >= n.MaxAttempts {
+				status = scdmodels.NotificationDeliveryStatusFailed
+			}
+		}
+
+		if err := n.Store.Transact(ctx, func(ctx context.Context, r repos.Repository) error {
+			for _, delivery := range notification.deliveries {
+				delivery.Status = status
+				delivery.Attempts = attempt
+				delivery.LastError = lastError
+				if err := r.UpdateNotificationDelivery(ctx, delivery); err != nil {
+					return stacktrace.Propagate(err, "Unable to record notification delivery outcome")
+				}
+			}
+			return nil
+		}); err != nil {
+			logger.Warn("Failed to record notification delivery outcome", zap.Error(err))
+		}
+
+		if status != scdmodels.NotificationDeliveryStatusPending {
+			notificationDeliveries.WithLabelValues(status.String()).Add(float64(len(notification.deliveries)))
+			if status == scdmodels.NotificationDeliveryStatusFailed {
+				logger.Warn("Failed to deliver Constraint notification", zap.Int("attempts", attempt), zap.String("last_error", lastError))
+			}
+			return
+		}
+
+		// Each attempt renews the lease of the deliveries, which must not
+		// expire while waiting for the next one.
+		if backoff > n.Lease/2 {
+			backoff = n.Lease / 2
+		}
+		select {
+		case <-ctx.Done():
+			// The deliveries are resumed once their lease expires.
+			return
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// post sends body to url, returning an error unless the USS acknowledged it.
+func (n *ConstraintNotifier) post(ctx context.Context, url string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return stacktrace.Propagate(err, "Failed to create notification request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if n.AccessToken != nil {
+		token, err := n.AccessToken()
+		if err != nil {
+			return stacktrace.Propagate(err, "Failed to obtain access token")
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := n.Client.Do(req)
+	if err != nil {
+		return stacktrace.Propagate(err, "Failed to send notification")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		return stacktrace.NewError("USS responded %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+// ListNotificationDeliveries returns the deliveries by the DSS of notifications
+// to the Subscription identified by subscriptionID, most recent first.
+func (a *Server) ListNotificationDeliveries(ctx context.Context, subscriptionID string) ([]*scdmodels.NotificationDelivery, error) {
+	id, err := dssmodels.IDFromString(subscriptionID)
+	if err != nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Invalid ID format: `%s`", subscriptionID)
+	}
+
+	// Retrieve ID of client making call
+	manager, ok := auth.ManagerFromContext(ctx)
+	if !ok {
+		return nil, stacktrace.NewErrorWithCode(dsserr.PermissionDenied, "Missing manager from context")
+	}
+
+	var deliveries []*scdmodels.NotificationDelivery
+	action := func(ctx context.Context, r repos.Repository) (err error) {
+		deliveries, err = r.SearchNotificationDeliveries(ctx, id, manager)
+		if err != nil {
+			return stacktrace.Propagate(err, "Unable to search notification deliveries in repo")
+		}
+		return nil
+	}
+
+	err = a.Store.Transact(ctx, action)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+
+	return deliveries, nil
+}
+
+// PurgeNotificationDeliveries deletes the records of notification deliveries
+// created before t.
+func (a *Server) PurgeNotificationDeliveries(ctx context.Context, t time.Time) (int64, error) {
+	var purged int64
+	action := func(ctx context.Context, r repos.Repository) (err error) {
+		purged, err = r.DeleteNotificationDeliveriesBefore(ctx, t)
+		if err != nil {
+			return stacktrace.Propagate(err, "Unable to delete notification deliveries from repo")
+		}
+		return nil
+	}
+
+	err := a.Store.Transact(ctx, action)
+	if err != nil {
+		return 0, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+
+	return purged, nil
+}
@@ -0,0 +1,178 @@
+package scd
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/interuss/dss/pkg/api/v1/scdpb"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	scdmodels "github.com/interuss/dss/pkg/scd/models"
+	"github.com/interuss/dss/pkg/scd/repos"
+	"github.com/interuss/dss/pkg/scd/store/memory"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var (
+	notifiedConstraintID = dssmodels.ID("4348c8e5-0b1c-43cf-9114-2e67a4532765")
+	notifiedSub          = &scdmodels.Subscription{
+		ID:                   dssmodels.ID("78ea3fe8-71c2-4f5c-9b44-9c02f5563c6f"),
+		Manager:              dssmodels.Manager("uss1"),
+		NotificationIndex:    3,
+		NotifyForConstraints: true,
+	}
+)
+
+// uss is a USS receiving Constraint notifications, which fails the first
+// failures requests.
+type uss struct {
+	mu       sync.Mutex
+	failures int
+	received []*scdpb.PutConstraintDetailsParameters
+}
+
+func (u *uss) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if u.failures > 0 {
+		u.failures--
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		return
+	}
+	body, _ := ioutil.ReadAll(r.Body)
+	params := new(scdpb.PutConstraintDetailsParameters)
+	if err := protojson.Unmarshal(body, params); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	u.received = append(u.received, params)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (u *uss) notifications() []*scdpb.PutConstraintDetailsParameters {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return append([]*scdpb.PutConstraintDetailsParameters(nil), u.received...)
+}
+
+func setUpNotifier(t *testing.T, u *uss) (*ConstraintNotifier, func()) {
+	server := httptest.NewServer(u)
+	notifier := &ConstraintNotifier{
+		Store:          memory.NewStore(zap.NewNop()),
+		Client:         server.Client(),
+		Logger:         zap.NewNop(),
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		PollInterval:   time.Hour,
+		Lease:          time.Minute,
+	}
+	sub := *notifiedSub
+	sub.USSBaseURL = server.URL
+	ctx := context.Background()
+	require.NoError(t, notifier.Store.Transact(ctx, func(ctx context.Context, r repos.Repository) error {
+		return notifier.record(ctx, r, notifiedConstraintID, &scdpb.Constraint{
+			Reference: &scdpb.ConstraintReference{Id: notifiedConstraintID.String(), Ovn: scdmodels.NoOvnPhrase},
+			Details:   &scdpb.ConstraintDetails{Volumes: []*scdpb.Volume4D{{}}},
+		}, repos.Subscriptions{&sub})
+	}))
+	return notifier, server.Close
+}
+
+// deliveries returns the recorded deliveries to notifiedSub.
+func deliveries(t *testing.T, n *ConstraintNotifier) []*scdmodels.NotificationDelivery {
+	r, err := n.Store.Interact(context.Background())
+	require.NoError(t, err)
+	result, err := r.SearchNotificationDeliveries(context.Background(), notifiedSub.ID, notifiedSub.Manager)
+	require.NoError(t, err)
+	return result
+}
+
+func requireOutcome(t *testing.T, n *ConstraintNotifier, status scdmodels.NotificationDeliveryStatus, attempts int) {
+	require.Eventually(t, func() bool {
+		d := deliveries(t, n)
+		return len(d) == 1 && d[0].Status == status
+	}, 5*time.Second, time.Millisecond)
+	require.Equal(t, attempts, deliveries(t, n)[0].Attempts)
+}
+
+func TestConstraintNotifierDelivers(t *testing.T) {
+	u := &uss{}
+	n, stop := setUpNotifier(t, u)
+	defer stop()
+
+	require.NoError(t, n.dispatchPending(context.Background()))
+	requireOutcome(t, n, scdmodels.NotificationDeliveryStatusDelivered, 1)
+
+	notifications := u.notifications()
+	require.Len(t, notifications, 1)
+	require.Equal(t, notifiedConstraintID.String(), notifications[0].ConstraintId)
+	require.Equal(t, scdmodels.NoOvnPhrase, notifications[0].Constraint.GetReference().GetOvn())
+	require.Len(t, notifications[0].Constraint.GetDetails().GetVolumes(), 1)
+	require.Len(t, notifications[0].Subscriptions, 1)
+	require.Equal(t, notifiedSub.ID.String(), notifications[0].Subscriptions[0].SubscriptionId)
+	require.Equal(t, int32(notifiedSub.NotificationIndex), notifications[0].Subscriptions[0].NotificationIndex)
+}
+
+func TestConstraintNotifierRetries(t *testing.T) {
+	u := &uss{failures: 2}
+	n, stop := setUpNotifier(t, u)
+	defer stop()
+
+	require.NoError(t, n.dispatchPending(context.Background()))
+	requireOutcome(t, n, scdmodels.NotificationDeliveryStatusDelivered, 3)
+	require.Len(t, u.notifications(), 1)
+}
+
+func TestConstraintNotifierGivesUp(t *testing.T) {
+	u := &uss{failures: 10}
+	n, stop := setUpNotifier(t, u)
+	defer stop()
+
+	require.NoError(t, n.dispatchPending(context.Background()))
+	requireOutcome(t, n, scdmodels.NotificationDeliveryStatusFailed, n.MaxAttempts)
+	require.Contains(t, deliveries(t, n)[0].LastError, "503")
+	require.Empty(t, u.notifications())
+}
+
+func TestConstraintNotifierCapsBackoff(t *testing.T) {
+	u := &uss{failures: 2}
+	n, stop := setUpNotifier(t, u)
+	defer stop()
+
+	// Retrying after InitialBackoff would outlive the lease.
+	n.InitialBackoff = time.Hour
+	n.Lease = 20 * time.Millisecond
+
+	require.NoError(t, n.dispatchPending(context.Background()))
+	requireOutcome(t, n, scdmodels.NotificationDeliveryStatusDelivered, 3)
+}
+
+func TestConstraintNotifierResumesAbandonedClaims(t *testing.T) {
+	u := &uss{}
+	n, stop := setUpNotifier(t, u)
+	defer stop()
+	n.Lease = 50 * time.Millisecond
+
+	// Another DSS instance claims the deliveries, then crashes.
+	ctx := context.Background()
+	require.NoError(t, n.Store.Transact(ctx, func(ctx context.Context, r repos.Repository) error {
+		claimed, err := r.ClaimNotificationDeliveries(ctx, n.Lease, dssmodels.MaxResultLimit)
+		require.Len(t, claimed, 1)
+		return err
+	}))
+
+	require.NoError(t, n.dispatchPending(ctx))
+	time.Sleep(10 * time.Millisecond)
+	require.Empty(t, u.notifications())
+	require.Equal(t, scdmodels.NotificationDeliveryStatusPending, deliveries(t, n)[0].Status)
+
+	time.Sleep(n.Lease)
+	require.NoError(t, n.dispatchPending(ctx))
+	requireOutcome(t, n, scdmodels.NotificationDeliveryStatusDelivered, 1)
+}
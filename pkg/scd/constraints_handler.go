@@ -41,6 +41,9 @@ func (a *Server) DeleteConstraintReference(ctx context.Context, req *scdpb.Delet
 		case old.Manager != manager:
 			return stacktrace.NewErrorWithCode(dsserr.PermissionDenied,
 				"Constraint owned by %s, but %s attempted to delete", old.Manager, manager)
+		case old.OVN != scdmodels.OVN(req.GetOvn()):
+			return stacktrace.NewErrorWithCode(dsserr.VersionMismatch,
+				"Current version is %s but client specified version %s", old.OVN, req.GetOvn())
 		}
 
 		// Find Subscriptions that may overlap the Constraint's Volume4D
@@ -78,6 +81,12 @@ func (a *Server) DeleteConstraintReference(ctx context.Context, req *scdpb.Delet
 			return stacktrace.Propagate(err, "Unable to increment notification indices")
 		}
 
+		// Record the notifications the DSS delivers, if any
+		err = a.ConstraintNotifier.record(ctx, r, id, nil, subs)
+		if err != nil {
+			return stacktrace.Propagate(err, "Unable to record notifications")
+		}
+
 		// Convert deleted Constraint to proto
 		constraintProto, err := old.ToProto()
 		if err != nil {
@@ -98,6 +107,8 @@ func (a *Server) DeleteConstraintReference(ctx context.Context, req *scdpb.Delet
 		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
 	}
 
+	a.ConstraintNotifier.signal()
+	signalNotificationIndexWraparounds(ctx, response.Subscribers)
 	return response, nil
 }
 
@@ -127,6 +138,10 @@ func (a *Server) GetConstraintReference(ctx context.Context, req *scdpb.GetConst
 			constraint.OVN = scdmodels.OVN(scdmodels.NoOvnPhrase)
 		}
 
+		if err := attachUssAvailabilities(ctx, r, nil, []*scdmodels.Constraint{constraint}); err != nil {
+			return stacktrace.Propagate(err, "Unable to attach USS availability to Constraint")
+		}
+
 		// Convert retrieved Constraint to proto
 		p, err := constraint.ToProto()
 		if err != nil {
@@ -193,18 +208,14 @@ func (a *Server) PutConstraintReference(ctx context.Context, entityid string, ov
 		}
 		extents[idx] = cExtent
 	}
+	if err := a.VolumeValidator.ValidateExtents(extents, DefaultClock.Now()); err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
 	uExtent, err := dssmodels.UnionVolumes4D(extents...)
 	if err != nil {
 		return nil, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Failed to union extents")
 	}
 
-	if uExtent.StartTime == nil {
-		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Missing time_start from extents")
-	}
-	if uExtent.EndTime == nil {
-		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Missing time_end from extents")
-	}
-
 	cells, err := uExtent.CalculateSpatialCovering()
 	if err != nil {
 		return nil, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid area")
@@ -220,7 +231,7 @@ func (a *Server) PutConstraintReference(ctx context.Context, entityid string, ov
 		case err == pgx.ErrNoRows:
 			// No existing Constraint; verify that creation was requested
 			if ovn != "" {
-				return stacktrace.NewErrorWithCode(dsserr.VersionMismatch, "Old version %s does not exist", ovn)
+				return stacktrace.NewErrorWithCode(dsserr.NotFound, "Constraint does not exist and therefore is not version %s", ovn)
 			}
 			version = 0
 		case err != nil:
@@ -231,6 +242,9 @@ func (a *Server) PutConstraintReference(ctx context.Context, entityid string, ov
 				return stacktrace.NewErrorWithCode(dsserr.PermissionDenied,
 					"Constraint owned by %s, but %s attempted to modify", old.Manager, manager)
 			}
+			if ovn == "" {
+				return stacktrace.NewErrorWithCode(dsserr.AlreadyExists, "Constraint %s already exists", id)
+			}
 			if old.OVN != scdmodels.OVN(ovn) {
 				return stacktrace.NewErrorWithCode(dsserr.VersionMismatch,
 					"Current version is %s but client specified version %s", old.OVN, ovn)
@@ -274,13 +288,13 @@ func (a *Server) PutConstraintReference(ctx context.Context, entityid string, ov
 			Cells:      cells,
 		})
 		if err != nil {
-			return err
+			return stacktrace.Propagate(err, "Failed to upsert Constraint in repo")
 		}
 
 		// Find Subscriptions that may need to be notified
 		allsubs, err := r.SearchSubscriptions(ctx, notifyVol4)
 		if err != nil {
-			return err
+			return stacktrace.Propagate(err, "Failed to search for impacted Subscriptions")
 		}
 
 		// Limit Subscription notifications to only those interested in Constraints
@@ -294,13 +308,31 @@ func (a *Server) PutConstraintReference(ctx context.Context, entityid string, ov
 		// Increment notification indices for relevant Subscriptions
 		err = subs.IncrementNotificationIndices(ctx, r)
 		if err != nil {
-			return err
+			return stacktrace.Propagate(err, "Failed to increment notification indices")
 		}
 
 		// Convert upserted Constraint to proto
 		p, err := constraint.ToProto()
 		if err != nil {
-			return err
+			return stacktrace.Propagate(err, "Could not convert Constraint to proto")
+		}
+
+		// Record the notifications the DSS delivers, if any
+		if a.ConstraintNotifier != nil {
+			reference, err := constraint.ToProto()
+			if err != nil {
+				return stacktrace.Propagate(err, "Could not convert Constraint to proto")
+			}
+			// The notified USSs do not manage the Constraint.
+			reference.Ovn = scdmodels.NoOvnPhrase
+			notified := &scdpb.Constraint{
+				Reference: reference,
+				Details:   &scdpb.ConstraintDetails{Volumes: params.GetExtents()},
+			}
+			err = a.ConstraintNotifier.record(ctx, r, id, notified, subs)
+			if err != nil {
+				return stacktrace.Propagate(err, "Failed to record notifications")
+			}
 		}
 
 		// Return response to client
@@ -317,6 +349,8 @@ func (a *Server) PutConstraintReference(ctx context.Context, entityid string, ov
 		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
 	}
 
+	a.ConstraintNotifier.signal()
+	signalNotificationIndexWraparounds(ctx, response.Subscribers)
 	return response, nil
 }
 
@@ -332,7 +366,7 @@ func (a *Server) QueryConstraintReferences(ctx context.Context, req *scdpb.Query
 	// Parse area of interest to common Volume4D
 	vol4, err := dssmodels.Volume4DFromSCDProto(aoi)
 	if err != nil {
-		return nil, err
+		return nil, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Error parsing geometry")
 	}
 
 	// Retrieve ID of client making call
@@ -346,7 +380,11 @@ func (a *Server) QueryConstraintReferences(ctx context.Context, req *scdpb.Query
 		// Perform search query on Store
 		constraints, err := r.SearchConstraints(ctx, vol4)
 		if err != nil {
-			return err
+			return stacktrace.Propagate(err, "Unable to query for Constraints in repo")
+		}
+
+		if err := attachUssAvailabilities(ctx, r, nil, constraints); err != nil {
+			return stacktrace.Propagate(err, "Unable to attach USS availabilities to Constraints")
 		}
 
 		// Create response for client
@@ -354,7 +392,7 @@ func (a *Server) QueryConstraintReferences(ctx context.Context, req *scdpb.Query
 		for _, constraint := range constraints {
 			p, err := constraint.ToProto()
 			if err != nil {
-				return err
+				return stacktrace.Propagate(err, "Could not convert Constraint model to proto")
 			}
 			if constraint.Manager != manager {
 				p.Ovn = scdmodels.NoOvnPhrase
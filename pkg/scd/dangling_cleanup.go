@@ -0,0 +1,196 @@
+package scd
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	dssmodels "github.com/interuss/dss/pkg/models"
+	scdmodels "github.com/interuss/dss/pkg/scd/models"
+	"github.com/interuss/dss/pkg/scd/repos"
+	scdstore "github.com/interuss/dss/pkg/scd/store"
+	"github.com/interuss/stacktrace"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+)
+
+// DanglingPolicy is the action taken on dangling OperationalIntent
+// references.
+type DanglingPolicy string
+
+const (
+	// DanglingPolicyFlag only reports dangling OperationalIntent references.
+	DanglingPolicyFlag DanglingPolicy = "flag"
+
+	// DanglingPolicyExpire ends dangling OperationalIntent references.
+	DanglingPolicyExpire DanglingPolicy = "expire"
+)
+
+// DanglingPolicyFromString parses s as a DanglingPolicy.
+func DanglingPolicyFromString(s string) (DanglingPolicy, error) {
+	switch p := DanglingPolicy(s); p {
+	case DanglingPolicyFlag, DanglingPolicyExpire:
+		return p, nil
+	}
+	return "", stacktrace.NewError("Invalid dangling operational intent policy `%s`; must be `%s` or `%s`", s, DanglingPolicyFlag, DanglingPolicyExpire)
+}
+
+const (
+	danglingReasonUnreachable = "unreachable"
+	danglingReasonUssDown     = "uss_down"
+)
+
+var (
+	danglingOperationalIntents = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dss_scd_dangling_operational_intents",
+		Help: "Number of active operational intent references found dangling by the latest cleanup run, by reason.",
+	}, []string{"reason"})
+	danglingOperationalIntentActions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "dss_scd_dangling_operational_intent_actions_total",
+		Help: "Number of actions taken on dangling operational intent references, by reason and action.",
+	}, []string{"reason", "action"})
+)
+
+// DanglingOperationalIntentCleaner detects active OperationalIntent
+// references whose USS base URL has been unreachable for a while, or whose
+// manager is declared down, and handles them according to Policy.
+type DanglingOperationalIntentCleaner struct {
+	Store  scdstore.Store
+	Client *http.Client
+	Logger *zap.Logger
+
+	// UnreachableFor is how long a USS base URL must be continuously
+	// unreachable before the references using it are considered dangling.
+	UnreachableFor time.Duration
+
+	Policy DanglingPolicy
+
+	// DryRun reports the actions which would be taken without taking them.
+	DryRun bool
+
+	mu               sync.Mutex
+	unreachableSince map[string]time.Time
+}
+
+// reachable returns whether any HTTP response is obtained from url.
+func (c *DanglingOperationalIntentCleaner) reachable(ctx context.Context, url string) bool {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
+	if err != nil {
+		return false
+	}
+	resp, err := c.Client.Do(req)
+	if err != nil {
+		return false
+	}
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+	return true
+}
+
+// probe checks the reachability of urls at now and returns the time since
+// which each unreachable URL has been continuously unreachable.
+func (c *DanglingOperationalIntentCleaner) probe(ctx context.Context, urls map[string]bool, now time.Time) map[string]time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	since := map[string]time.Time{}
+	for url := range urls {
+		if c.reachable(ctx, url) {
+			continue
+		}
+		if t, ok := c.unreachableSince[url]; ok {
+			since[url] = t
+		} else {
+			since[url] = now
+		}
+	}
+	c.unreachableSince = since
+	return since
+}
+
+// Run performs a single cleanup pass.
+func (c *DanglingOperationalIntentCleaner) Run(ctx context.Context) error {
+	now := time.Now()
+
+	var (
+		ops            []*scdmodels.OperationalIntent
+		availabilities []*scdmodels.UssAvailabilityStatus
+	)
+	r, err := c.Store.Interact(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "Unable to interact with store")
+	}
+	ops, err = r.ListActiveOperationalIntents(ctx, now)
+	if err != nil {
+		return stacktrace.Propagate(err, "Unable to list active Operations in repo")
+	}
+	var (
+		urls     = map[string]bool{}
+		managers []dssmodels.Manager
+		seen     = map[dssmodels.Manager]bool{}
+	)
+	for _, op := range ops {
+		urls[op.USSBaseURL] = true
+		if !seen[op.Manager] {
+			seen[op.Manager] = true
+			managers = append(managers, op.Manager)
+		}
+	}
+	availabilities, err = r.GetUssAvailabilities(ctx, managers)
+	if err != nil {
+		return stacktrace.Propagate(err, "Unable to get USS availabilities from repo")
+	}
+	down := map[dssmodels.Manager]bool{}
+	for _, availability := range availabilities {
+		if availability.Availability == scdmodels.UssAvailabilityStateDown {
+			down[availability.Uss] = true
+		}
+	}
+
+	unreachableSince := c.probe(ctx, urls, now)
+
+	counts := map[string]int{danglingReasonUnreachable: 0, danglingReasonUssDown: 0}
+	for _, op := range ops {
+		var reason string
+		if down[op.Manager] {
+			reason = danglingReasonUssDown
+		} else if since, ok := unreachableSince[op.USSBaseURL]; ok && now.Sub(since) >= c.UnreachableFor {
+			reason = danglingReasonUnreachable
+		} else {
+			continue
+		}
+		counts[reason]++
+
+		action := "flagged"
+		if c.Policy == DanglingPolicyExpire {
+			if c.DryRun {
+				action = "would_expire"
+			} else {
+				err := c.Store.Transact(ctx, func(ctx context.Context, r repos.Repository) error {
+					return r.ExpireOperationalIntent(ctx, op.ID, now)
+				})
+				if err != nil {
+					c.Logger.Warn("Failed to expire dangling operational intent reference", zap.String("id", op.ID.String()), zap.Error(err))
+					continue
+				}
+				action = "expired"
+			}
+		}
+		danglingOperationalIntentActions.WithLabelValues(reason, action).Inc()
+		c.Logger.Info("Dangling operational intent reference",
+			zap.String("id", op.ID.String()),
+			zap.String("manager", op.Manager.String()),
+			zap.String("uss_base_url", op.USSBaseURL),
+			zap.String("reason", reason),
+			zap.String("action", action))
+	}
+	for reason, count := range counts {
+		danglingOperationalIntents.WithLabelValues(reason).Set(float64(count))
+	}
+
+	return nil
+}
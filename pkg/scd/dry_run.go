@@ -0,0 +1,36 @@
+package scd
+
+import (
+	"context"
+	"errors"
+
+	dssmodels "github.com/interuss/dss/pkg/models"
+	"github.com/interuss/dss/pkg/scd/repos"
+	"github.com/interuss/stacktrace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// errDryRun aborts the transaction of a dry run once its outcome is known.
+var errDryRun = stacktrace.NewError("Dry run completed")
+
+// transact executes action in a transaction which, for a dry run, is rolled
+// back once action succeeds; the response metadata of ctx then confirms that
+// nothing was committed.
+func (a *Server) transact(ctx context.Context, dryRun bool, action func(context.Context, repos.Repository) error) error {
+	if !dryRun {
+		return a.Store.Transact(ctx, action)
+	}
+
+	err := a.Store.Transact(ctx, func(ctx context.Context, r repos.Repository) error {
+		if err := action(ctx, r); err != nil {
+			return err // No need to Propagate this error as this is not a useful stacktrace line
+		}
+		return errDryRun
+	})
+	if !errors.Is(err, errDryRun) {
+		return err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(dssmodels.DryRunHeader, "true"))
+	return nil
+}
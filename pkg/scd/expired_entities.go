@@ -0,0 +1,92 @@
+package scd
+
+import (
+	"context"
+	"time"
+
+	dssmodels "github.com/interuss/dss/pkg/models"
+	"github.com/interuss/dss/pkg/scd/repos"
+	"github.com/interuss/stacktrace"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Types of the entities purged once expired.
+const (
+	expiredOperationalIntent = "operational_intent"
+	expiredConstraint        = "constraint"
+	expiredSubscription      = "subscription"
+)
+
+var expiredEntityPurges = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "dss_scd_expired_entity_purges_total",
+	Help: "Number of strategic conflict detection entities deleted by this DSS instance after their end time and retention, by type.",
+}, []string{"type"})
+
+// PurgeExpiredEntities deletes the OperationalIntents, Constraints and
+// Subscriptions which ended before t.  Subscriptions are only deleted once no
+// OperationalIntent depends on them.  Entities are deleted in batches, each in
+// its own transaction, and PurgeExpiredEntities returns how many entities
+// were deleted.
+func (a *Server) PurgeExpiredEntities(ctx context.Context, t time.Time) (int, error) {
+	total := 0
+	for _, purge := range []struct {
+		entityType string
+		purgeBatch func(ctx context.Context, r repos.Repository) (int, error)
+	}{
+		{expiredOperationalIntent, func(ctx context.Context, r repos.Repository) (int, error) {
+			ops, err := r.ListExpiredOperationalIntents(ctx, t, dssmodels.MaxResultLimit)
+			if err != nil {
+				return 0, stacktrace.Propagate(err, "Unable to list expired OperationalIntents in repo")
+			}
+			for _, op := range ops {
+				if err := r.DeleteOperationalIntent(ctx, op.ID); err != nil {
+					return 0, stacktrace.Propagate(err, "Unable to delete expired OperationalIntent %s from repo", op.ID)
+				}
+			}
+			return len(ops), nil
+		}},
+		{expiredConstraint, func(ctx context.Context, r repos.Repository) (int, error) {
+			constraints, err := r.ListExpiredConstraints(ctx, t, dssmodels.MaxResultLimit)
+			if err != nil {
+				return 0, stacktrace.Propagate(err, "Unable to list expired Constraints in repo")
+			}
+			for _, constraint := range constraints {
+				if err := r.DeleteConstraint(ctx, constraint.ID); err != nil {
+					return 0, stacktrace.Propagate(err, "Unable to delete expired Constraint %s from repo", constraint.ID)
+				}
+			}
+			return len(constraints), nil
+		}},
+		{expiredSubscription, func(ctx context.Context, r repos.Repository) (int, error) {
+			subs, err := r.ListExpiredSubscriptions(ctx, t, dssmodels.MaxResultLimit)
+			if err != nil {
+				return 0, stacktrace.Propagate(err, "Unable to list expired Subscriptions in repo")
+			}
+			for _, sub := range subs {
+				if err := r.DeleteSubscription(ctx, sub.ID); err != nil {
+					return 0, stacktrace.Propagate(err, "Unable to delete expired Subscription %s from repo", sub.ID)
+				}
+			}
+			return len(subs), nil
+		}},
+	} {
+		for {
+			var purged int
+			err := a.Store.Transact(ctx, func(ctx context.Context, r repos.Repository) error {
+				var err error
+				purged, err = purge.purgeBatch(ctx, r)
+				return err
+			})
+			if err != nil {
+				return total, err // No need to Propagate this error as this is not a useful stacktrace line
+			}
+			expiredEntityPurges.WithLabelValues(purge.entityType).Add(float64(purged))
+			total += purged
+			if purged < dssmodels.MaxResultLimit {
+				break
+			}
+		}
+	}
+	return total, nil
+}
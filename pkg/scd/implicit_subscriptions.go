@@ -0,0 +1,96 @@
+package scd
+
+import (
+	"context"
+	"time"
+
+	dssmodels "github.com/interuss/dss/pkg/models"
+	"github.com/interuss/dss/pkg/scd/repos"
+	"github.com/interuss/stacktrace"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Reasons for removing implicit Subscriptions.
+const (
+	implicitSubscriptionDeleted  = "deleted"
+	implicitSubscriptionReplaced = "replaced"
+	implicitSubscriptionExpired  = "expired"
+)
+
+var implicitSubscriptionRemovals = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "dss_scd_implicit_subscription_removals_total",
+	Help: "Number of implicit subscriptions removed once no operational intent reference needed them, by reason.",
+}, []string{"reason"})
+
+// removeReplacedImplicitSubscription removes the Subscription identified by id
+// if it is implicit and no OperationalIntent depends on it anymore, after an
+// OperationalIntent switched to another Subscription, and returns whether it
+// was removed.
+func removeReplacedImplicitSubscription(ctx context.Context, r repos.Repository, id dssmodels.ID) (bool, error) {
+	sub, err := r.GetSubscription(ctx, id)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "Unable to get Subscription from repo")
+	}
+	if sub == nil || !sub.ImplicitSubscription {
+		return false, nil
+	}
+	dependentOps, err := r.GetDependentOperationalIntents(ctx, id)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "Could not find dependent OperationalIntents")
+	}
+	if len(dependentOps) > 0 {
+		return false, nil
+	}
+	if err := r.DeleteSubscription(ctx, id); err != nil {
+		return false, stacktrace.Propagate(err, "Unable to delete implicit Subscription from repo")
+	}
+	return true, nil
+}
+
+// CleanUpImplicitSubscriptions removes the implicit Subscriptions whose
+// dependent OperationalIntents all ended more than retention ago, per the
+// clock of the store, together with those ended OperationalIntents.
+// Subscriptions are removed in batches, each in its own transaction, and
+// CleanUpImplicitSubscriptions returns how many Subscriptions were removed.
+func (a *Server) CleanUpImplicitSubscriptions(ctx context.Context, retention time.Duration) (int, error) {
+	total := 0
+	for {
+		var removed int
+		action := func(ctx context.Context, r repos.Repository) error {
+			removed = 0
+			subs, err := r.ListIdleImplicitSubscriptions(ctx, retention, dssmodels.MaxResultLimit)
+			if err != nil {
+				return stacktrace.Propagate(err, "Unable to list idle implicit Subscriptions in repo")
+			}
+			for _, sub := range subs {
+				// Delete the ended OperationalIntents explicitly rather than through
+				// the cascade so that their deletion is recorded
+				dependentOps, err := r.GetDependentOperationalIntents(ctx, sub.ID)
+				if err != nil {
+					return stacktrace.Propagate(err, "Could not find dependent OperationalIntents")
+				}
+				for _, id := range dependentOps {
+					if err := r.DeleteOperationalIntent(ctx, id); err != nil {
+						return stacktrace.Propagate(err, "Unable to delete ended OperationalIntent %s from repo", id)
+					}
+				}
+				if err := r.DeleteSubscription(ctx, sub.ID); err != nil {
+					return stacktrace.Propagate(err, "Unable to delete implicit Subscription %s from repo", sub.ID)
+				}
+				removed++
+			}
+			return nil
+		}
+
+		err := a.Store.Transact(ctx, action)
+		if err != nil {
+			return total, err // No need to Propagate this error as this is not a useful stacktrace line
+		}
+		implicitSubscriptionRemovals.WithLabelValues(implicitSubscriptionExpired).Add(float64(removed))
+		total += removed
+		if removed < dssmodels.MaxResultLimit {
+			return total, nil
+		}
+	}
+}
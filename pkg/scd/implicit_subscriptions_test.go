@@ -0,0 +1,173 @@
+package scd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/geo/s2"
+	"github.com/interuss/dss/pkg/api/v1/scdpb"
+	"github.com/interuss/dss/pkg/auth"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	scdmodels "github.com/interuss/dss/pkg/scd/models"
+	"github.com/interuss/dss/pkg/scd/repos"
+	"github.com/interuss/dss/pkg/scd/store/memory"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var implicitTestCells = s2.CellUnion{s2.CellIDFromLatLng(s2.LatLngFromDegrees(46.1, 6.1)).Parent(13)}
+
+// seedImplicitTest stores subs and ops, which end at the given times, with the
+// Server.
+func seedImplicitTest(t *testing.T, server *Server, subs []*scdmodels.Subscription, ops map[*scdmodels.OperationalIntent]time.Time) {
+	require.NoError(t, server.Store.Transact(context.Background(), func(ctx context.Context, r repos.Repository) error {
+		for _, sub := range subs {
+			start, end := time.Now().Add(-3*time.Hour), time.Now().Add(time.Hour)
+			sub.StartTime, sub.EndTime, sub.USSBaseURL, sub.Cells = &start, &end, "https://example.com", implicitTestCells
+			sub.Manager, sub.NotifyForOperationalIntents = "uss1", true
+			if _, err := r.UpsertSubscription(ctx, sub); err != nil {
+				return err
+			}
+		}
+		for op, end := range ops {
+			start, end := end.Add(-time.Hour), end
+			op.StartTime, op.EndTime, op.USSBaseURL, op.Cells = &start, &end, "https://example.com", implicitTestCells
+			op.Manager, op.Version, op.State = "uss1", 1, scdmodels.OperationalIntentStateAccepted
+			if _, err := r.UpsertOperationalIntent(ctx, op); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+}
+
+// requireSubscriptions checks which of ids are still stored.
+func requireSubscriptions(t *testing.T, server *Server, present map[dssmodels.ID]bool) {
+	r, err := server.Store.Interact(context.Background())
+	require.NoError(t, err)
+	for id, want := range present {
+		sub, err := r.GetSubscription(context.Background(), id)
+		require.NoError(t, err)
+		require.Equal(t, want, sub != nil, "Subscription %s", id)
+	}
+}
+
+func TestRemoveReplacedImplicitSubscription(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		server   = &Server{Store: memory.NewStore(zap.NewNop())}
+		replaced = dssmodels.ID("11111111-0000-4000-8000-000000000000")
+		shared   = dssmodels.ID("22222222-0000-4000-8000-000000000000")
+		explicit = dssmodels.ID("33333333-0000-4000-8000-000000000000")
+		moved    = &scdmodels.OperationalIntent{ID: "44444444-0000-4000-8000-000000000000", SubscriptionID: replaced}
+		end      = time.Now().Add(time.Hour)
+	)
+	seedImplicitTest(t, server, []*scdmodels.Subscription{
+		{ID: replaced, ImplicitSubscription: true},
+		{ID: shared, ImplicitSubscription: true},
+		{ID: explicit},
+	}, map[*scdmodels.OperationalIntent]time.Time{
+		moved: end,
+		{ID: "55555555-0000-4000-8000-000000000000", SubscriptionID: shared}: end,
+	})
+
+	require.NoError(t, server.Store.Transact(ctx, func(ctx context.Context, r repos.Repository) error {
+		// The OperationalIntent switches to the explicit Subscription.
+		moved.SubscriptionID = explicit
+		if _, err := r.UpsertOperationalIntent(ctx, moved); err != nil {
+			return err
+		}
+
+		removed, err := removeReplacedImplicitSubscription(ctx, r, replaced)
+		require.NoError(t, err)
+		require.True(t, removed)
+
+		// Still depended on
+		removed, err = removeReplacedImplicitSubscription(ctx, r, shared)
+		require.NoError(t, err)
+		require.False(t, removed)
+
+		// Not implicit
+		removed, err = removeReplacedImplicitSubscription(ctx, r, explicit)
+		require.NoError(t, err)
+		require.False(t, removed)
+		return nil
+	}))
+
+	requireSubscriptions(t, server, map[dssmodels.ID]bool{replaced: false, shared: true, explicit: true})
+}
+
+func TestDeleteOperationalIntentRemovesImplicitSubscription(t *testing.T) {
+	var (
+		ctx    = auth.ContextWithOwner(context.Background(), "uss1")
+		server = &Server{Store: memory.NewStore(zap.NewNop())}
+		freed  = dssmodels.ID("11111111-0000-4000-8000-000000000000")
+		shared = dssmodels.ID("22222222-0000-4000-8000-000000000000")
+		opFree = dssmodels.ID("33333333-0000-4000-8000-000000000000")
+		opA    = dssmodels.ID("44444444-0000-4000-8000-000000000000")
+		end    = time.Now().Add(time.Hour)
+	)
+	seedImplicitTest(t, server, []*scdmodels.Subscription{
+		{ID: freed, ImplicitSubscription: true},
+		{ID: shared, ImplicitSubscription: true},
+	}, map[*scdmodels.OperationalIntent]time.Time{
+		{ID: opFree, SubscriptionID: freed}:                                  end,
+		{ID: opA, SubscriptionID: shared}:                                    end,
+		{ID: "55555555-0000-4000-8000-000000000000", SubscriptionID: shared}: end,
+	})
+	deleted := testutil.ToFloat64(implicitSubscriptionRemovals.WithLabelValues(implicitSubscriptionDeleted))
+
+	for _, id := range []dssmodels.ID{opFree, opA} {
+		_, err := server.DeleteOperationalIntentReference(ctx, &scdpb.DeleteOperationalIntentReferenceRequest{Entityid: id.String()})
+		require.NoError(t, err)
+	}
+
+	requireSubscriptions(t, server, map[dssmodels.ID]bool{freed: false, shared: true})
+	require.Equal(t, deleted+1, testutil.ToFloat64(implicitSubscriptionRemovals.WithLabelValues(implicitSubscriptionDeleted)))
+}
+
+func TestCleanUpImplicitSubscriptions(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		server   = &Server{Store: memory.NewStore(zap.NewNop())}
+		idle     = dssmodels.ID("11111111-0000-4000-8000-000000000000")
+		retained = dssmodels.ID("22222222-0000-4000-8000-000000000000")
+		active   = dssmodels.ID("33333333-0000-4000-8000-000000000000")
+		explicit = dssmodels.ID("44444444-0000-4000-8000-000000000000")
+		endedOp  = dssmodels.ID("55555555-0000-4000-8000-000000000000")
+		now      = time.Now()
+	)
+	seedImplicitTest(t, server, []*scdmodels.Subscription{
+		{ID: idle, ImplicitSubscription: true},
+		{ID: retained, ImplicitSubscription: true},
+		{ID: active, ImplicitSubscription: true},
+		{ID: explicit},
+	}, map[*scdmodels.OperationalIntent]time.Time{
+		{ID: endedOp, SubscriptionID: idle}:                                    now.Add(-2 * time.Hour),
+		{ID: "66666666-0000-4000-8000-000000000000", SubscriptionID: retained}: now.Add(-30 * time.Minute),
+		{ID: "77777777-0000-4000-8000-000000000000", SubscriptionID: active}:   now.Add(-2 * time.Hour),
+		{ID: "88888888-0000-4000-8000-000000000000", SubscriptionID: active}:   now.Add(time.Hour),
+		{ID: "99999999-0000-4000-8000-000000000000", SubscriptionID: explicit}: now.Add(-2 * time.Hour),
+	})
+	expired := testutil.ToFloat64(implicitSubscriptionRemovals.WithLabelValues(implicitSubscriptionExpired))
+
+	removed, err := server.CleanUpImplicitSubscriptions(ctx, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, 1, removed)
+	requireSubscriptions(t, server, map[dssmodels.ID]bool{idle: false, retained: true, active: true, explicit: true})
+	require.Equal(t, expired+1, testutil.ToFloat64(implicitSubscriptionRemovals.WithLabelValues(implicitSubscriptionExpired)))
+
+	// The ended OperationalIntents of the removed Subscription are removed
+	// along with it.
+	r, err := server.Store.Interact(ctx)
+	require.NoError(t, err)
+	op, err := r.GetOperationalIntent(ctx, endedOp)
+	require.NoError(t, err)
+	require.Nil(t, op)
+
+	removed, err = server.CleanUpImplicitSubscriptions(ctx, time.Hour)
+	require.NoError(t, err)
+	require.Zero(t, removed)
+}
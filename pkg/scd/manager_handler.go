@@ -0,0 +1,126 @@
+package scd
+
+import (
+	"context"
+
+	"github.com/interuss/dss/pkg/api/v1/scdpb"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	"github.com/interuss/dss/pkg/scd/repos"
+	"github.com/interuss/stacktrace"
+)
+
+const (
+	// DefaultPageSize is the number of references listed per page when the
+	// client does not specify a page size.
+	DefaultPageSize = 100
+
+	// MaxPageSize is the largest number of references listed per page.
+	MaxPageSize = 1000
+)
+
+// parsePage validates the page token (the ID of the last reference of the
+// previous page) and page size requested by a client.
+func parsePage(pageToken string, pageSize int32) (dssmodels.ID, int, error) {
+	after, err := dssmodels.IDFromOptionalString(pageToken)
+	if err != nil {
+		return "", 0, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Invalid page token: `%s`", pageToken)
+	}
+	switch {
+	case pageSize < 0:
+		return "", 0, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Page size may not be negative")
+	case pageSize == 0:
+		return after, DefaultPageSize, nil
+	case pageSize > MaxPageSize:
+		return after, MaxPageSize, nil
+	}
+	return after, int(pageSize), nil
+}
+
+// ListOperationalIntentsByManager returns a page of the OperationalIntent
+// references managed by manager, and the token of the next page if any.
+func (a *Server) ListOperationalIntentsByManager(ctx context.Context, manager dssmodels.Manager, pageToken string, pageSize int32) ([]*scdpb.OperationalIntentReference, string, error) {
+	if manager == "" {
+		return nil, "", stacktrace.NewErrorWithCode(dsserr.BadRequest, "Missing manager")
+	}
+	after, limit, err := parsePage(pageToken, pageSize)
+	if err != nil {
+		return nil, "", err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+
+	var (
+		refs          []*scdpb.OperationalIntentReference
+		nextPageToken string
+	)
+	action := func(ctx context.Context, r repos.Repository) (err error) {
+		// Fetch one more reference than requested to find out whether there is a
+		// next page
+		ops, err := r.ListOperationalIntentsByManager(ctx, manager, after, limit+1)
+		if err != nil {
+			return stacktrace.Propagate(err, "Unable to list Operations in repo")
+		}
+		if len(ops) > limit {
+			ops = ops[:limit]
+			nextPageToken = ops[limit-1].ID.String()
+		}
+		for _, op := range ops {
+			p, err := op.ToProto()
+			if err != nil {
+				return stacktrace.Propagate(err, "Could not convert OperationalIntent to proto")
+			}
+			refs = append(refs, p)
+		}
+		return nil
+	}
+
+	err = a.Store.Transact(ctx, action)
+	if err != nil {
+		return nil, "", err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+
+	return refs, nextPageToken, nil
+}
+
+// ListConstraintsByManager returns a page of the Constraint references
+// managed by manager, and the token of the next page if any.
+func (a *Server) ListConstraintsByManager(ctx context.Context, manager dssmodels.Manager, pageToken string, pageSize int32) ([]*scdpb.ConstraintReference, string, error) {
+	if manager == "" {
+		return nil, "", stacktrace.NewErrorWithCode(dsserr.BadRequest, "Missing manager")
+	}
+	after, limit, err := parsePage(pageToken, pageSize)
+	if err != nil {
+		return nil, "", err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+
+	var (
+		refs          []*scdpb.ConstraintReference
+		nextPageToken string
+	)
+	action := func(ctx context.Context, r repos.Repository) (err error) {
+		// Fetch one more reference than requested to find out whether there is a
+		// next page
+		constraints, err := r.ListConstraintsByManager(ctx, manager, after, limit+1)
+		if err != nil {
+			return stacktrace.Propagate(err, "Unable to list Constraints in repo")
+		}
+		if len(constraints) > limit {
+			constraints = constraints[:limit]
+			nextPageToken = constraints[limit-1].ID.String()
+		}
+		for _, constraint := range constraints {
+			p, err := constraint.ToProto()
+			if err != nil {
+				return stacktrace.Propagate(err, "Could not convert Constraint to proto")
+			}
+			refs = append(refs, p)
+		}
+		return nil
+	}
+
+	err = a.Store.Transact(ctx, action)
+	if err != nil {
+		return nil, "", err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+
+	return refs, nextPageToken, nil
+}
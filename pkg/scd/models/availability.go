@@ -27,6 +27,15 @@ func (u UssAvailabilityState) String() string {
 	return string(u)
 }
 
+// reported returns the state reported in API responses for u, where an unset
+// state means that the USS never declared its availability.
+func (u UssAvailabilityState) reported() UssAvailabilityState {
+	if u == "" {
+		return UssAvailabilityStateUnknown
+	}
+	return u
+}
+
 func UssAvailabilityStateFromString(s string) (UssAvailabilityState, error) {
 	switch strings.ToLower(s) {
 	case "", "unknown":
@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+
+	"github.com/golang/geo/s2"
+	dssmodels "github.com/interuss/dss/pkg/models"
+)
+
+// Aggregates constants for entity changes.
+const (
+	EntityChangeCreated EntityChangeType = "Created"
+	EntityChangeUpdated EntityChangeType = "Updated"
+	EntityChangeDeleted EntityChangeType = "Deleted"
+)
+
+// EntityChangeType models the kind of change made to an entity.
+type EntityChangeType string
+
+func (t EntityChangeType) String() string {
+	return string(t)
+}
+
+// EntityChange models a change made to an OperationalIntent or Constraint
+// reference, as recorded by the DSS.
+type EntityChange struct {
+	// Cursor orders the changes; changes recorded later have larger cursors.
+	Cursor     int64
+	EntityType string
+	EntityID   dssmodels.ID
+	Change     EntityChangeType
+	Manager    dssmodels.Manager
+	Version    VersionNumber
+	Cells      s2.CellUnion
+	OccurredAt time.Time
+}
@@ -34,7 +34,7 @@ func (c *Constraint) ToProto() (*scdpb.ConstraintReference, error) {
 		Manager:         c.Manager.String(),
 		Version:         int32(c.Version),
 		UssBaseUrl:      c.USSBaseURL,
-		UssAvailability: UssAvailabilityStateUnknown.String(),
+		UssAvailability: c.UssAvailability.reported().String(),
 	}
 
 	if c.StartTime != nil {
@@ -13,6 +13,12 @@ import (
 const (
 	// Value for OVN that should be returned for entities not owned by the client
 	NoOvnPhrase = "Available from USS"
+
+	// EntityTypeOperationalIntent identifies operational intent references.
+	EntityTypeOperationalIntent = "OperationalIntent"
+
+	// EntityTypeConstraint identifies constraint references.
+	EntityTypeConstraint = "Constraint"
 )
 
 type (
@@ -1,13 +1,127 @@
 package models
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/stacktrace"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
 )
 
 func TestOVNFromTimeIsValid(t *testing.T) {
 	require.True(t, NewOVNFromTime(time.Now(), uuid.New().String()).Valid())
 }
+
+func TestOperationalIntentUssAvailability(t *testing.T) {
+	op := &OperationalIntent{}
+	p, err := op.ToProto()
+	require.NoError(t, err)
+	require.Equal(t, UssAvailabilityStateUnknown.String(), p.UssAvailability)
+
+	op.UssAvailability = UssAvailabilityStateDown
+	p, err = op.ToProto()
+	require.NoError(t, err)
+	require.Equal(t, UssAvailabilityStateDown.String(), p.UssAvailability)
+}
+
+func TestOperationalIntentStateTransitions(t *testing.T) {
+	for _, c := range []struct {
+		from, to OperationalIntentState
+		valid    bool
+	}{
+		{OperationalIntentStateAccepted, OperationalIntentStateActivated, true},
+		{OperationalIntentStateAccepted, OperationalIntentStateNonconforming, true},
+		{OperationalIntentStateActivated, OperationalIntentStateAccepted, false},
+		{OperationalIntentStateActivated, OperationalIntentStateContingent, true},
+		{OperationalIntentStateNonconforming, OperationalIntentStateActivated, true},
+		{OperationalIntentStateNonconforming, OperationalIntentStateAccepted, false},
+		{OperationalIntentStateContingent, OperationalIntentStateContingent, true},
+		{OperationalIntentStateContingent, OperationalIntentStateActivated, false},
+	} {
+		err := c.to.ValidateTransitionFrom(c.from)
+		if c.valid {
+			require.NoError(t, err, "%s -> %s", c.from, c.to)
+		} else {
+			require.Error(t, err, "%s -> %s", c.from, c.to)
+		}
+	}
+}
+
+func TestOperationalIntentOffNominalSince(t *testing.T) {
+	t0 := time.Now()
+	t1 := t0.Add(time.Minute)
+
+	activated := &OperationalIntent{State: OperationalIntentStateActivated}
+	require.NoError(t, activated.TransitionFrom(nil, t0))
+	require.Nil(t, activated.OffNominalSince)
+
+	nonconforming := &OperationalIntent{State: OperationalIntentStateNonconforming}
+	require.NoError(t, nonconforming.TransitionFrom(activated, t0))
+	require.Equal(t, t0, *nonconforming.OffNominalSince)
+
+	contingent := &OperationalIntent{State: OperationalIntentStateContingent}
+	require.NoError(t, contingent.TransitionFrom(nonconforming, t1))
+	require.Equal(t, t0, *contingent.OffNominalSince)
+
+	recovered := &OperationalIntent{State: OperationalIntentStateActivated}
+	require.NoError(t, recovered.TransitionFrom(nonconforming, t1))
+	require.Nil(t, recovered.OffNominalSince)
+
+	require.Error(t, (&OperationalIntent{State: OperationalIntentStateAccepted, Priority: -1}).TransitionFrom(nil, t0))
+}
+
+func TestPriorityFromContext(t *testing.T) {
+	_, ok, err := PriorityFromContext(context.Background())
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	priority, ok, err := PriorityFromContext(metadata.NewIncomingContext(context.Background(), metadata.Pairs(PriorityHeader, "3")))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, int32(3), priority)
+
+	_, _, err = PriorityFromContext(metadata.NewIncomingContext(context.Background(), metadata.Pairs(PriorityHeader, "high")))
+	require.Error(t, err)
+}
+
+func TestStateTransitionRules(t *testing.T) {
+	require.NoError(t, DefaultStateTransitionRules.Validate())
+
+	_, err := DefaultStateTransitionRules.Find(OperationalIntentStateUnknown, OperationalIntentStateActivated)
+	requireReason(t, StateTransitionNotAllowedReason, err)
+
+	rules, err := StateTransitionRulesFromJSON([]byte(`[
+		{"name": "create_activated", "from": "", "to": "Activated", "requires_key": true, "requires_availability": "normal"}
+	]`))
+	require.NoError(t, err)
+	rule, err := rules.Find(OperationalIntentStateUnknown, OperationalIntentStateActivated)
+	require.NoError(t, err)
+	require.True(t, rule.RequiresKey)
+	require.NoError(t, rule.ValidateAvailability(UssAvailabilityStateNormal))
+	requireReason(t, "create_activated", rule.ValidateAvailability(UssAvailabilityStateUnknown))
+	_, err = rules.Find(OperationalIntentStateUnknown, OperationalIntentStateAccepted)
+	requireReason(t, StateTransitionNotAllowedReason, err)
+
+	for _, invalid := range []string{
+		`[{"from": "", "to": "Accepted"}]`,
+		`[{"name": "a", "from": "Ended", "to": "Accepted"}]`,
+		`[{"name": "a", "from": "", "to": ""}]`,
+		`[{"name": "a", "from": "", "to": "Accepted", "requires_availability": "Sleepy"}]`,
+		`[{"name": "a", "from": "", "to": "Accepted"}, {"name": "a", "from": "Accepted", "to": "Accepted"}]`,
+		`[{"name": "a", "from": "", "to": "Accepted"}, {"name": "b", "from": "", "to": "Accepted"}]`,
+	} {
+		_, err := StateTransitionRulesFromJSON([]byte(invalid))
+		require.Error(t, err, invalid)
+	}
+}
+
+func requireReason(t *testing.T, reason string, err error) {
+	require.Error(t, err)
+	reasoned, ok := stacktrace.RootCause(err).(*dsserr.ReasonedError)
+	require.True(t, ok, "Root cause of %v is not a ReasonedError", err)
+	require.Equal(t, reason, reasoned.Reason)
+}
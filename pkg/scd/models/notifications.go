@@ -0,0 +1,41 @@
+package models
+
+import (
+	"time"
+
+	"github.com/interuss/dss/pkg/api/v1/scdpb"
+	dssmodels "github.com/interuss/dss/pkg/models"
+)
+
+// Aggregates constants for notification deliveries.
+const (
+	NotificationDeliveryStatusPending   NotificationDeliveryStatus = "Pending"
+	NotificationDeliveryStatusDelivered NotificationDeliveryStatus = "Delivered"
+	NotificationDeliveryStatusFailed    NotificationDeliveryStatus = "Failed"
+)
+
+// NotificationDeliveryStatus models the outcome of a notification delivery.
+type NotificationDeliveryStatus string
+
+func (s NotificationDeliveryStatus) String() string {
+	return string(s)
+}
+
+// NotificationDelivery models the delivery by the DSS of a notification of a
+// change to an entity, to the USS managing a Subscription.
+type NotificationDelivery struct {
+	ID                dssmodels.ID
+	SubscriptionID    dssmodels.ID
+	Manager           dssmodels.Manager
+	EntityID          dssmodels.ID
+	NotificationIndex int
+	URL               string
+	Status            NotificationDeliveryStatus
+	Attempts          int
+	LastError         string
+	// Constraint is the notified Constraint as of the change, or nil when the
+	// change deleted it.
+	Constraint *scdpb.Constraint
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
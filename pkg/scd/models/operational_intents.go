@@ -1,6 +1,8 @@
 package models
 
 import (
+	"context"
+	"strconv"
 	"time"
 
 	"github.com/golang/geo/s2"
@@ -8,9 +10,28 @@ import (
 	dsserr "github.com/interuss/dss/pkg/errors"
 	dssmodels "github.com/interuss/dss/pkg/models"
 	"github.com/interuss/stacktrace"
+	"google.golang.org/grpc/metadata"
 	tspb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
+const (
+	// PriorityHeader is the metadata key carrying the priority level of an
+	// operational intent: in requests creating or updating the operational
+	// intent (forwarded by the http gateway from the
+	// Grpc-Metadata-Dss-Operational-Intent-Priority header), and in responses
+	// returning it.
+	PriorityHeader = "dss-operational-intent-priority"
+
+	// OffNominalSinceHeader is the response metadata key carrying the time at
+	// which a returned operational intent entered an off-nominal state.
+	OffNominalSinceHeader = "dss-operational-intent-off-nominal-since"
+
+	// ImplicitSubscriptionHeader is the response metadata key present, with
+	// value "true", when a returned operational intent managed by the client is
+	// supported by a Subscription created implicitly for it.
+	ImplicitSubscriptionHeader = "dss-operational-intent-implicit-subscription"
+)
+
 // Aggregates constants for operational intents.
 const (
 	OperationalIntentStateUnknown       OperationalIntentState = ""
@@ -23,18 +44,6 @@ const (
 // OperationState models the state of an operation.
 type OperationalIntentState string
 
-// RequiresKey indicates whether transitioning an OperationalIntent to this
-// OperationalIntentState requires a valid key.
-func (s OperationalIntentState) RequiresKey() bool {
-	switch s {
-	case OperationalIntentStateNonconforming:
-		fallthrough
-	case OperationalIntentStateContingent:
-		return false
-	}
-	return true
-}
-
 // IsValid indicates whether an OperationalIntent may be transitioned to the specified
 // state via a DSS PUT.
 func (s OperationalIntentState) IsValidInDSS() bool {
@@ -51,6 +60,19 @@ func (s OperationalIntentState) IsValidInDSS() bool {
 	return false
 }
 
+// IsOffNominal indicates whether s is an off-nominal state, in which the
+// OperationalIntent no longer conforms to its planned volumes.
+func (s OperationalIntentState) IsOffNominal() bool {
+	return s == OperationalIntentStateNonconforming || s == OperationalIntentStateContingent
+}
+
+// ValidateTransitionFrom returns an error if DefaultStateTransitionRules do
+// not allow an OperationalIntent in state old to be transitioned to s.
+func (s OperationalIntentState) ValidateTransitionFrom(old OperationalIntentState) error {
+	_, err := DefaultStateTransitionRules.Find(old, s)
+	return err
+}
+
 // OperationalIntent models an operational intent.
 type OperationalIntent struct {
 	// Reference
@@ -66,6 +88,23 @@ type OperationalIntent struct {
 	AltitudeLower  *float32
 	AltitudeUpper  *float32
 	Cells          s2.CellUnion
+
+	// UssAvailability is the availability declared by Manager, when known.
+	UssAvailability UssAvailabilityState
+
+	// Priority is the priority level of the OperationalIntent; higher values
+	// denote higher priorities.
+	Priority int32
+
+	// OffNominalSince is the time at which the OperationalIntent entered an
+	// off-nominal state, or nil if it is in a nominal state.
+	OffNominalSince *time.Time
+
+	// ImplicitSubscription is true when the Subscription identified by
+	// SubscriptionID was created implicitly for the OperationalIntent.  It is
+	// not stored, and only set when returning the OperationalIntent to its
+	// manager.
+	ImplicitSubscription bool
 }
 
 func (s OperationalIntentState) String() string {
@@ -82,7 +121,7 @@ func (o *OperationalIntent) ToProto() (*scdpb.OperationalIntentReference, error)
 		UssBaseUrl:      o.USSBaseURL,
 		SubscriptionId:  o.SubscriptionID.String(),
 		State:           o.State.String(),
-		UssAvailability: UssAvailabilityStateUnknown.String(),
+		UssAvailability: o.UssAvailability.reported().String(),
 	}
 
 	if o.StartTime != nil {
@@ -123,6 +162,26 @@ func (o *OperationalIntent) ValidateTimeRange() error {
 	return nil
 }
 
+// TransitionFrom validates the attributes of o replacing old (nil for a new
+// OperationalIntent) at now, and carries over or sets the time at which o
+// entered an off-nominal state.  The state transition itself is validated
+// against StateTransitionRules.
+func (o *OperationalIntent) TransitionFrom(old *OperationalIntent, now time.Time) error {
+	if o.Priority < 0 {
+		return stacktrace.NewErrorWithCode(dsserr.BadRequest, "OperationalIntent priority may not be negative")
+	}
+
+	o.OffNominalSince = nil
+	if o.State.IsOffNominal() {
+		if old != nil && old.State.IsOffNominal() && old.OffNominalSince != nil {
+			o.OffNominalSince = old.OffNominalSince
+		} else {
+			o.OffNominalSince = &now
+		}
+	}
+	return nil
+}
+
 // SetCells is a convenience function that accepts an int64 array and converts
 // to s2.CellUnion.
 // TODO: wrap s2.CellUnion in a custom type that embeds the struct such that
@@ -135,3 +194,34 @@ func (o *OperationalIntent) SetCells(cids []int64) {
 	}
 	o.Cells = cells
 }
+
+// PriorityFromContext returns the priority level requested through the
+// PriorityHeader metadata of ctx, and whether one was requested.
+func PriorityFromContext(ctx context.Context) (int32, bool, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, false, nil
+	}
+	values := md.Get(PriorityHeader)
+	if len(values) == 0 {
+		return 0, false, nil
+	}
+	priority, err := strconv.ParseInt(values[0], 10, 32)
+	if err != nil {
+		return 0, false, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid OperationalIntent priority: `%s`", values[0])
+	}
+	return int32(priority), true, nil
+}
+
+// ReferenceMetadata returns the response metadata carrying the attributes of o
+// that are not part of its reference.
+func (o *OperationalIntent) ReferenceMetadata() metadata.MD {
+	md := metadata.Pairs(PriorityHeader, strconv.FormatInt(int64(o.Priority), 10))
+	if o.OffNominalSince != nil {
+		md.Set(OffNominalSinceHeader, o.OffNominalSince.UTC().Format(time.RFC3339Nano))
+	}
+	if o.ImplicitSubscription {
+		md.Set(ImplicitSubscriptionHeader, "true")
+	}
+	return md
+}
@@ -0,0 +1,62 @@
+package models
+
+import (
+	"time"
+
+	"github.com/interuss/dss/pkg/api/v1/scdpb"
+	dssmodels "github.com/interuss/dss/pkg/models"
+)
+
+// DssReport models a report, submitted by a USS, of a discrepancy between its
+// view of the airspace and the records of the DSS.
+type DssReport struct {
+	ID       dssmodels.ID
+	Reporter dssmodels.Manager
+
+	// Exchange is the request and response between the reporting USS and the
+	// DSS which the report is about.
+	Exchange *scdpb.ExchangeRecord
+
+	// DssRecords are the references held by the DSS, when the report was
+	// submitted, in the area of the reported exchange.
+	DssRecords []*DssReportRecord
+
+	CreatedAt time.Time
+}
+
+// DssReportRecord is the state of a single reference held by the DSS when a
+// DssReport was submitted.
+type DssReportRecord struct {
+	EntityType string            `json:"entity_type"`
+	ID         dssmodels.ID      `json:"id"`
+	Manager    dssmodels.Manager `json:"manager"`
+	Version    VersionNumber     `json:"version"`
+	OVN        OVN               `json:"ovn"`
+	State      string            `json:"state,omitempty"`
+}
+
+// NewDssReportRecords builds the DssReportRecords describing ops and
+// constraints.
+func NewDssReportRecords(ops []*OperationalIntent, constraints []*Constraint) []*DssReportRecord {
+	records := make([]*DssReportRecord, 0, len(ops)+len(constraints))
+	for _, op := range ops {
+		records = append(records, &DssReportRecord{
+			EntityType: EntityTypeOperationalIntent,
+			ID:         op.ID,
+			Manager:    op.Manager,
+			Version:    op.Version,
+			OVN:        op.OVN,
+			State:      string(op.State),
+		})
+	}
+	for _, constraint := range constraints {
+		records = append(records, &DssReportRecord{
+			EntityType: EntityTypeConstraint,
+			ID:         constraint.ID,
+			Manager:    constraint.Manager,
+			Version:    constraint.Version,
+			OVN:        constraint.OVN,
+		})
+	}
+	return records
+}
@@ -0,0 +1,130 @@
+package models
+
+import (
+	"encoding/json"
+
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/stacktrace"
+)
+
+// StateTransitionNotAllowedReason is the machine-readable reason reported
+// when no StateTransitionRule allows a requested state transition.
+const StateTransitionNotAllowedReason = "state_transition_not_allowed"
+
+// StateTransitionRule allows OperationalIntents in state From to be
+// transitioned to state To, under the conditions of the rule.
+type StateTransitionRule struct {
+	// Name identifies the rule, and is the machine-readable reason reported
+	// when a condition of the rule is not met.
+	Name string `json:"name"`
+
+	// From is the state of the OperationalIntent before the transition;
+	// OperationalIntentStateUnknown denotes the creation of the
+	// OperationalIntent.
+	From OperationalIntentState `json:"from"`
+
+	// To is the state of the OperationalIntent after the transition.
+	To OperationalIntentState `json:"to"`
+
+	// RequiresKey is true when the transition requires the OVNs of all the
+	// relevant OperationalIntents and Constraints.
+	RequiresKey bool `json:"requires_key"`
+
+	// RequiresAvailability, when set, is the availability the manager of the
+	// OperationalIntent must have declared for the transition.
+	RequiresAvailability UssAvailabilityState `json:"requires_availability,omitempty"`
+}
+
+// StateTransitionRules lists the state transitions allowed for
+// OperationalIntents; transitions not listed are not allowed.
+type StateTransitionRules []*StateTransitionRule
+
+// DefaultStateTransitionRules are the state transitions of ASTM F3548-21:
+// OperationalIntents are created Accepted, may not return to Accepted once
+// they left it, and may not leave Contingent.  Off-nominal states do not
+// require a key.
+var DefaultStateTransitionRules = StateTransitionRules{
+	{Name: "create_accepted", From: OperationalIntentStateUnknown, To: OperationalIntentStateAccepted, RequiresKey: true},
+	{Name: "accepted_to_accepted", From: OperationalIntentStateAccepted, To: OperationalIntentStateAccepted, RequiresKey: true},
+	{Name: "accepted_to_activated", From: OperationalIntentStateAccepted, To: OperationalIntentStateActivated, RequiresKey: true},
+	{Name: "accepted_to_nonconforming", From: OperationalIntentStateAccepted, To: OperationalIntentStateNonconforming},
+	{Name: "accepted_to_contingent", From: OperationalIntentStateAccepted, To: OperationalIntentStateContingent},
+	{Name: "activated_to_activated", From: OperationalIntentStateActivated, To: OperationalIntentStateActivated, RequiresKey: true},
+	{Name: "activated_to_nonconforming", From: OperationalIntentStateActivated, To: OperationalIntentStateNonconforming},
+	{Name: "activated_to_contingent", From: OperationalIntentStateActivated, To: OperationalIntentStateContingent},
+	{Name: "nonconforming_to_activated", From: OperationalIntentStateNonconforming, To: OperationalIntentStateActivated, RequiresKey: true},
+	{Name: "nonconforming_to_nonconforming", From: OperationalIntentStateNonconforming, To: OperationalIntentStateNonconforming},
+	{Name: "nonconforming_to_contingent", From: OperationalIntentStateNonconforming, To: OperationalIntentStateContingent},
+	{Name: "contingent_to_contingent", From: OperationalIntentStateContingent, To: OperationalIntentStateContingent},
+}
+
+// StateTransitionRulesFromJSON parses and validates a JSON array of
+// StateTransitionRule.
+func StateTransitionRulesFromJSON(data []byte) (StateTransitionRules, error) {
+	var rules StateTransitionRules
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, stacktrace.Propagate(err, "Unable to parse state transition rules")
+	}
+	if err := rules.Validate(); err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	return rules, nil
+}
+
+// Validate returns an error if rules are not consistent.
+func (rules StateTransitionRules) Validate() error {
+	names := map[string]bool{}
+	transitions := map[[2]OperationalIntentState]string{}
+	for i, rule := range rules {
+		switch {
+		case rule == nil || rule.Name == "":
+			return stacktrace.NewError("State transition rule %d has no name", i)
+		case names[rule.Name]:
+			return stacktrace.NewError("State transition rule name %s is used more than once", rule.Name)
+		case rule.From != OperationalIntentStateUnknown && !rule.From.IsValidInDSS():
+			return stacktrace.NewError("State transition rule %s starts from invalid state `%s`", rule.Name, rule.From)
+		case !rule.To.IsValidInDSS():
+			return stacktrace.NewError("State transition rule %s leads to invalid state `%s`", rule.Name, rule.To)
+		}
+		if rule.RequiresAvailability != "" {
+			availability, err := UssAvailabilityStateFromString(rule.RequiresAvailability.String())
+			if err != nil {
+				return stacktrace.Propagate(err, "State transition rule %s requires invalid availability `%s`", rule.Name, rule.RequiresAvailability)
+			}
+			rule.RequiresAvailability = availability
+		}
+		transition := [2]OperationalIntentState{rule.From, rule.To}
+		if other, ok := transitions[transition]; ok {
+			return stacktrace.NewError("State transition rules %s and %s both apply to the same transition", other, rule.Name)
+		}
+		names[rule.Name] = true
+		transitions[transition] = rule.Name
+	}
+	return nil
+}
+
+// Find returns the rule allowing the transition of an OperationalIntent from
+// state from (OperationalIntentStateUnknown for a new OperationalIntent) to
+// state to, or an error reporting StateTransitionNotAllowedReason if no rule
+// allows it.
+func (rules StateTransitionRules) Find(from, to OperationalIntentState) (*StateTransitionRule, error) {
+	for _, rule := range rules {
+		if rule.From == from && rule.To == to {
+			return rule, nil
+		}
+	}
+	if from == OperationalIntentStateUnknown {
+		return nil, dsserr.NewErrorWithReason(dsserr.BadRequest, StateTransitionNotAllowedReason, "OperationalIntent may not be created in the %s state", to)
+	}
+	return nil, dsserr.NewErrorWithReason(dsserr.BadRequest, StateTransitionNotAllowedReason, "OperationalIntent may not be transitioned from the %s state to the %s state", from, to)
+}
+
+// ValidateAvailability returns an error reporting the name of rule if the
+// availability declared by the manager of the OperationalIntent does not meet
+// the condition of rule.
+func (rule *StateTransitionRule) ValidateAvailability(availability UssAvailabilityState) error {
+	if rule.RequiresAvailability == "" || availability.reported() == rule.RequiresAvailability {
+		return nil
+	}
+	return dsserr.NewErrorWithReason(dsserr.BadRequest, rule.Name, "State transition rule %s requires the USS availability to be %s, but it is %s", rule.Name, rule.RequiresAvailability, availability.reported())
+}
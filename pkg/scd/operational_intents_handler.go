@@ -2,7 +2,6 @@ package scd
 
 import (
 	"context"
-	"time"
 
 	"github.com/golang/geo/s2"
 	"github.com/google/uuid"
@@ -14,6 +13,8 @@ import (
 	scdmodels "github.com/interuss/dss/pkg/scd/models"
 	"github.com/interuss/dss/pkg/scd/repos"
 	"github.com/interuss/stacktrace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -32,7 +33,16 @@ func (a *Server) DeleteOperationalIntentReference(ctx context.Context, req *scdp
 		return nil, stacktrace.NewErrorWithCode(dsserr.PermissionDenied, "Missing manager from context")
 	}
 
-	var response *scdpb.ChangeOperationalIntentReferenceResponse
+	dryRun, err := dssmodels.DryRunFromContext(ctx)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+
+	var (
+		response        *scdpb.ChangeOperationalIntentReferenceResponse
+		oldState        scdmodels.OperationalIntentState
+		removedImplicit bool
+	)
 	action := func(ctx context.Context, r repos.Repository) (err error) {
 		// Get OperationalIntent to delete
 		old, err := r.GetOperationalIntent(ctx, id)
@@ -48,6 +58,7 @@ func (a *Server) DeleteOperationalIntentReference(ctx context.Context, req *scdp
 			return stacktrace.NewErrorWithCode(dsserr.PermissionDenied,
 				"OperationalIntent owned by %s, but %s attempted to delete", old.Manager, manager)
 		}
+		oldState = old.State
 
 		// Get the Subscription supporting the OperationalIntent
 		sub, err := r.GetSubscription(ctx, old.SubscriptionID)
@@ -59,6 +70,7 @@ func (a *Server) DeleteOperationalIntentReference(ctx context.Context, req *scdp
 		}
 
 		removeImplicitSubscription := false
+		removedImplicit = false
 		if sub.ImplicitSubscription {
 			// Get the Subscription's dependent OperationalIntents
 			dependentOps, err := r.GetDependentOperationalIntents(ctx, sub.ID)
@@ -111,6 +123,7 @@ func (a *Server) DeleteOperationalIntentReference(ctx context.Context, req *scdp
 			if err != nil {
 				return stacktrace.Propagate(err, "Unable to delete associated implicit Subscription")
 			}
+			removedImplicit = true
 		}
 
 		// Convert deleted OperationalIntent to proto
@@ -128,11 +141,18 @@ func (a *Server) DeleteOperationalIntentReference(ctx context.Context, req *scdp
 		return nil
 	}
 
-	err = a.Store.Transact(ctx, action)
+	err = a.transact(ctx, dryRun, action)
 	if err != nil {
 		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
 	}
 
+	if !dryRun {
+		recordStateTransition(oldState, scdmodels.OperationalIntentStateUnknown)
+		if removedImplicit {
+			implicitSubscriptionRemovals.WithLabelValues(implicitSubscriptionDeleted).Inc()
+		}
+		signalNotificationIndexWraparounds(ctx, response.Subscribers)
+	}
 	return response, nil
 }
 
@@ -148,7 +168,10 @@ func (a *Server) GetOperationalIntentReference(ctx context.Context, req *scdpb.G
 		return nil, stacktrace.NewErrorWithCode(dsserr.PermissionDenied, "Missing manager from context")
 	}
 
-	var response *scdpb.GetOperationalIntentReferenceResponse
+	var (
+		response *scdpb.GetOperationalIntentReferenceResponse
+		md       metadata.MD
+	)
 	action := func(ctx context.Context, r repos.Repository) (err error) {
 		op, err := r.GetOperationalIntent(ctx, id)
 		if err != nil {
@@ -162,6 +185,18 @@ func (a *Server) GetOperationalIntentReference(ctx context.Context, req *scdpb.G
 			op.OVN = scdmodels.OVN(scdmodels.NoOvnPhrase)
 		}
 
+		if err := attachUssAvailabilities(ctx, r, []*scdmodels.OperationalIntent{op}, nil); err != nil {
+			return stacktrace.Propagate(err, "Unable to attach USS availability to OperationalIntent")
+		}
+
+		if op.Manager == manager {
+			sub, err := r.GetSubscription(ctx, op.SubscriptionID)
+			if err != nil {
+				return stacktrace.Propagate(err, "Unable to get OperationalIntent's Subscription from repo")
+			}
+			op.ImplicitSubscription = sub != nil && sub.ImplicitSubscription
+		}
+
 		p, err := op.ToProto()
 		if err != nil {
 			return stacktrace.Propagate(err, "Could not convert OperationalIntent to proto")
@@ -170,6 +205,7 @@ func (a *Server) GetOperationalIntentReference(ctx context.Context, req *scdpb.G
 		response = &scdpb.GetOperationalIntentReferenceResponse{
 			OperationalIntentReference: p,
 		}
+		md = op.ReferenceMetadata()
 
 		return nil
 	}
@@ -179,6 +215,8 @@ func (a *Server) GetOperationalIntentReference(ctx context.Context, req *scdpb.G
 		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
 	}
 
+	_ = grpc.SetHeader(ctx, md)
+
 	return response, nil
 }
 
@@ -211,6 +249,10 @@ func (a *Server) QueryOperationalIntentReferences(ctx context.Context, req *scdp
 			return stacktrace.Propagate(err, "Unable to query for OperationalIntents in repo")
 		}
 
+		if err := attachUssAvailabilities(ctx, r, ops, nil); err != nil {
+			return stacktrace.Propagate(err, "Unable to attach USS availabilities to OperationalIntents")
+		}
+
 		// Create response for client
 		response = &scdpb.QueryOperationalIntentReferenceResponse{}
 		for _, op := range ops {
@@ -284,41 +326,40 @@ func (a *Server) PutOperationalIntentReference(ctx context.Context, entityid str
 		}
 		extents[idx] = cExtent
 	}
+	if err := a.VolumeValidator.ValidateExtents(extents, DefaultClock.Now()); err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
 	uExtent, err := dssmodels.UnionVolumes4D(extents...)
 	if err != nil {
 		return nil, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Failed to union extents")
 	}
 
-	if uExtent.StartTime == nil {
-		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Missing time_start from extents")
-	}
-	if uExtent.EndTime == nil {
-		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Missing time_end from extents")
-	}
-
-	if time.Now().After(*uExtent.EndTime) {
-		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "OperationalIntents may not end in the past")
-	}
-
 	cells, err := uExtent.CalculateSpatialCovering()
 	if err != nil {
 		return nil, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid area")
 	}
 
-	if uExtent.EndTime.Before(*uExtent.StartTime) {
-		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "End time is past the start time")
+	subscriptionID, err := dssmodels.IDFromOptionalString(params.GetSubscriptionId())
+	if err != nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Invalid ID format for Subscription ID: `%s`", params.GetSubscriptionId())
 	}
 
-	if ovn == "" && params.State != "Accepted" {
-		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Invalid state for initial version: `%s`", params.State)
+	priority, priorityRequested, err := scdmodels.PriorityFromContext(ctx)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
 	}
 
-	subscriptionID, err := dssmodels.IDFromOptionalString(params.GetSubscriptionId())
+	dryRun, err := dssmodels.DryRunFromContext(ctx)
 	if err != nil {
-		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Invalid ID format for Subscription ID: `%s`", params.GetSubscriptionId())
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
 	}
 
-	var response *scdpb.ChangeOperationalIntentReferenceResponse
+	var (
+		response         *scdpb.ChangeOperationalIntentReferenceResponse
+		op               *scdmodels.OperationalIntent
+		oldState         scdmodels.OperationalIntentState
+		replacedImplicit bool
+	)
 	action := func(ctx context.Context, r repos.Repository) (err error) {
 		var version int32 // Version of the Operational Intent (0 means creation requested).
 
@@ -327,7 +368,9 @@ func (a *Server) PutOperationalIntentReference(ctx context.Context, entityid str
 		if err != nil {
 			return stacktrace.Propagate(err, "Could not get OperationalIntent from repo")
 		}
+		oldState = scdmodels.OperationalIntentStateUnknown
 		if old != nil {
+			oldState = old.State
 			if old.Manager != manager {
 				return stacktrace.NewErrorWithCode(dsserr.PermissionDenied,
 					"OperationalIntent owned by %s, but %s attempted to modify", old.Manager, manager)
@@ -346,6 +389,24 @@ func (a *Server) PutOperationalIntentReference(ctx context.Context, entityid str
 			version = 0
 		}
 
+		rule, err := a.stateTransitionRules().Find(oldState, state)
+		if err != nil {
+			return err // No need to Propagate this error as this is not a useful stacktrace line
+		}
+		if rule.RequiresAvailability != "" {
+			availabilities, err := r.GetUssAvailabilities(ctx, []dssmodels.Manager{manager})
+			if err != nil {
+				return stacktrace.Propagate(err, "Could not get USS availability from repo")
+			}
+			var availability scdmodels.UssAvailabilityState
+			if len(availabilities) > 0 {
+				availability = availabilities[0].Availability
+			}
+			if err := rule.ValidateAvailability(availability); err != nil {
+				return err // No need to Propagate this error as this is not a useful stacktrace line
+			}
+		}
+
 		var sub *scdmodels.Subscription
 		if subscriptionID.Empty() {
 			// Create implicit Subscription
@@ -423,7 +484,7 @@ func (a *Server) PutOperationalIntentReference(ctx context.Context, entityid str
 			}
 		}
 
-		if state.RequiresKey() {
+		if rule.RequiresKey {
 			// Construct a hash set of OVNs as the key
 			key := map[scdmodels.OVN]bool{}
 			for _, ovn := range params.GetKey() {
@@ -474,7 +535,7 @@ func (a *Server) PutOperationalIntentReference(ctx context.Context, entityid str
 		}
 
 		// Construct the new OperationalIntent
-		op := &scdmodels.OperationalIntent{
+		op = &scdmodels.OperationalIntent{
 			ID:      id,
 			Manager: manager,
 			Version: scdmodels.VersionNumber(version + 1),
@@ -488,11 +549,19 @@ func (a *Server) PutOperationalIntentReference(ctx context.Context, entityid str
 			USSBaseURL:     params.UssBaseUrl,
 			SubscriptionID: sub.ID,
 			State:          state,
+			Priority:       priority,
+		}
+		if !priorityRequested && old != nil {
+			op.Priority = old.Priority
 		}
 		err = op.ValidateTimeRange()
 		if err != nil {
 			return stacktrace.Propagate(err, "Error validating time range")
 		}
+		err = op.TransitionFrom(old, DefaultClock.Now())
+		if err != nil {
+			return stacktrace.Propagate(err, "Error validating state transition")
+		}
 
 		// Compute total affected Volume4D for notification purposes
 		var notifyVol4 *dssmodels.Volume4D
@@ -520,6 +589,17 @@ func (a *Server) PutOperationalIntentReference(ctx context.Context, entityid str
 		if err != nil {
 			return stacktrace.Propagate(err, "Failed to upsert OperationalIntent in repo")
 		}
+		op.ImplicitSubscription = sub.ImplicitSubscription
+
+		// Automatically remove the implicit Subscription previously supporting
+		// the OperationalIntent if it is now unused
+		replacedImplicit = false
+		if old != nil && old.SubscriptionID != sub.ID {
+			replacedImplicit, err = removeReplacedImplicitSubscription(ctx, r, old.SubscriptionID)
+			if err != nil {
+				return stacktrace.Propagate(err, "Unable to remove replaced implicit Subscription")
+			}
+		}
 
 		// Find Subscriptions that may need to be notified
 		allsubs, err := r.SearchSubscriptions(ctx, notifyVol4)
@@ -556,10 +636,20 @@ func (a *Server) PutOperationalIntentReference(ctx context.Context, entityid str
 		return nil
 	}
 
-	err = a.Store.Transact(ctx, action)
+	err = a.transact(ctx, dryRun, action)
 	if err != nil {
 		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
 	}
 
+	_ = grpc.SetHeader(ctx, op.ReferenceMetadata())
+	if !dryRun {
+		if oldState != op.State {
+			recordStateTransition(oldState, op.State)
+		}
+		if replacedImplicit {
+			implicitSubscriptionRemovals.WithLabelValues(implicitSubscriptionReplaced).Inc()
+		}
+		signalNotificationIndexWraparounds(ctx, response.Subscribers)
+	}
 	return response, nil
 }
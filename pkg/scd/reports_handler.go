@@ -0,0 +1,147 @@
+package scd
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/interuss/dss/pkg/api/v1/scdpb"
+	"github.com/interuss/dss/pkg/auth"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	scdmodels "github.com/interuss/dss/pkg/scd/models"
+	"github.com/interuss/dss/pkg/scd/repos"
+	"github.com/interuss/stacktrace"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// reportedVolumes extracts the 4D volumes addressed by the request body of a
+// reported exchange: the area_of_interest of a query, or the extents of an
+// operational intent or constraint reference.  It returns nil if the request
+// body does not address any volume.
+func reportedVolumes(exchange *scdpb.ExchangeRecord) []*dssmodels.Volume4D {
+	body, err := base64.StdEncoding.DecodeString(exchange.GetRequestBody())
+	if err != nil || len(body) == 0 {
+		return nil
+	}
+	var params struct {
+		AreaOfInterest json.RawMessage   `json:"area_of_interest"`
+		Extents        []json.RawMessage `json:"extents"`
+	}
+	if err := json.Unmarshal(body, &params); err != nil {
+		return nil
+	}
+
+	raw := params.Extents
+	if params.AreaOfInterest != nil {
+		raw = append(raw, params.AreaOfInterest)
+	}
+	var vol4s []*dssmodels.Volume4D
+	for _, r := range raw {
+		extent := &scdpb.Volume4D{}
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(r, extent); err != nil {
+			continue
+		}
+		vol4, err := dssmodels.Volume4DFromSCDProto(extent)
+		if err != nil {
+			continue
+		}
+		vol4s = append(vol4s, vol4)
+	}
+	return vol4s
+}
+
+// MakeDssReport creates an error report about a DSS.
+func (a *Server) MakeDssReport(ctx context.Context, req *scdpb.MakeDssReportRequest) (*scdpb.ErrorReport, error) {
+	exchange := req.GetParams().GetExchange()
+	if exchange == nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Missing exchange")
+	}
+
+	// Retrieve ID of client making call
+	manager, ok := auth.ManagerFromContext(ctx)
+	if !ok {
+		return nil, stacktrace.NewErrorWithCode(dsserr.PermissionDenied, "Missing manager from context")
+	}
+
+	vol4s := reportedVolumes(exchange)
+
+	var report *scdmodels.DssReport
+	action := func(ctx context.Context, r repos.Repository) (err error) {
+		// Capture the DSS's records in the area of the reported exchange
+		var (
+			ops         []*scdmodels.OperationalIntent
+			constraints []*scdmodels.Constraint
+			seen        = map[dssmodels.ID]bool{}
+		)
+		for _, vol4 := range vol4s {
+			found, err := r.SearchOperationalIntents(ctx, vol4)
+			if err != nil {
+				return stacktrace.Propagate(err, "Unable to query for Operations in repo")
+			}
+			for _, op := range found {
+				if !seen[op.ID] {
+					seen[op.ID] = true
+					ops = append(ops, op)
+				}
+			}
+			foundConstraints, err := r.SearchConstraints(ctx, vol4)
+			if err != nil {
+				return stacktrace.Propagate(err, "Unable to query for Constraints in repo")
+			}
+			for _, constraint := range foundConstraints {
+				if !seen[constraint.ID] {
+					seen[constraint.ID] = true
+					constraints = append(constraints, constraint)
+				}
+			}
+		}
+
+		report, err = r.InsertDssReport(ctx, &scdmodels.DssReport{
+			ID:         dssmodels.ID(uuid.New().String()),
+			Reporter:   manager,
+			Exchange:   exchange,
+			DssRecords: scdmodels.NewDssReportRecords(ops, constraints),
+		})
+		if err != nil {
+			return stacktrace.Propagate(err, "Failed to store DSS report in repo")
+		}
+		return nil
+	}
+
+	err := a.Store.Transact(ctx, action)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+
+	return &scdpb.ErrorReport{
+		Exchange: report.Exchange,
+		ReportId: report.ID.String(),
+	}, nil
+}
+
+// ListDssReports returns the DSS reports submitted by reporter (or any USS if
+// empty) between earliest and latest (each unbounded if nil).
+func (a *Server) ListDssReports(ctx context.Context, reporter dssmodels.Manager, earliest *time.Time, latest *time.Time) ([]*scdmodels.DssReport, error) {
+	if earliest != nil && latest != nil && latest.Before(*earliest) {
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Latest time must not be before earliest time")
+	}
+
+	var reports []*scdmodels.DssReport
+	action := func(ctx context.Context, r repos.Repository) (err error) {
+		reports, err = r.SearchDssReports(ctx, reporter, earliest, latest)
+		if err != nil {
+			return stacktrace.Propagate(err, "Unable to search DSS reports in repo")
+		}
+		return nil
+	}
+
+	err := a.Store.Transact(ctx, action)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+
+	return reports, nil
+}
@@ -2,7 +2,9 @@ package repos
 
 import (
 	"context"
+	"time"
 
+	"github.com/golang/geo/s2"
 	dssmodels "github.com/interuss/dss/pkg/models"
 	scdmodels "github.com/interuss/dss/pkg/scd/models"
 )
@@ -27,6 +29,22 @@ type OperationalIntent interface {
 	// GetDependentOperationalIntents returns IDs of all operations dependent on
 	// subscription identified by "subscriptionID".
 	GetDependentOperationalIntents(ctx context.Context, subscriptionID dssmodels.ID) ([]dssmodels.ID, error)
+
+	// ListActiveOperationalIntents returns all operations which have not ended
+	// before "t".
+	ListActiveOperationalIntents(ctx context.Context, t time.Time) ([]*scdmodels.OperationalIntent, error)
+
+	// ExpireOperationalIntent ends the operation identified by "id" at "t".
+	ExpireOperationalIntent(ctx context.Context, id dssmodels.ID, t time.Time) error
+
+	// ListOperationalIntentsByManager returns up to "limit" operations managed
+	// by "manager" with an ID greater than "after" (or any ID if empty), in ID
+	// order.
+	ListOperationalIntentsByManager(ctx context.Context, manager dssmodels.Manager, after dssmodels.ID, limit int) ([]*scdmodels.OperationalIntent, error)
+
+	// ListExpiredOperationalIntents returns up to "limit" operations which
+	// ended before "t".
+	ListExpiredOperationalIntents(ctx context.Context, t time.Time, limit int) ([]*scdmodels.OperationalIntent, error)
 }
 
 // Subscription abstracts subscription-specific interactions with the backing repository.
@@ -51,12 +69,31 @@ type Subscription interface {
 	// specified Subscription and returns the resulting corresponding
 	// notification indices.
 	IncrementNotificationIndices(ctx context.Context, subscriptionIds []dssmodels.ID) ([]int, error)
+
+	// ListSubscriptionsByManager returns up to "limit" Subscriptions managed by
+	// "manager" with an ID greater than "after" (or any ID if empty), in ID
+	// order.
+	ListSubscriptionsByManager(ctx context.Context, manager dssmodels.Manager, after dssmodels.ID, limit int) ([]*scdmodels.Subscription, error)
+
+	// ListIdleImplicitSubscriptions returns up to "limit" implicit
+	// Subscriptions on which no OperationalIntent depends that ended less than
+	// "retention" before the current time of the store, or has not ended.
+	ListIdleImplicitSubscriptions(ctx context.Context, retention time.Duration, limit int) ([]*scdmodels.Subscription, error)
+
+	// ListExpiredSubscriptions returns up to "limit" Subscriptions which ended
+	// before "t" and on which no OperationalIntent depends.
+	ListExpiredSubscriptions(ctx context.Context, t time.Time, limit int) ([]*scdmodels.Subscription, error)
 }
 
 type UssAvailability interface {
 	GetUssAvailability(ctx context.Context, id dssmodels.Manager) (*scdmodels.UssAvailabilityStatus, error)
 
 	UpsertUssAvailability(ctx context.Context, ussa *scdmodels.UssAvailabilityStatus) (*scdmodels.UssAvailabilityStatus, error)
+
+	// GetUssAvailabilities returns the declared availabilities of the USSs
+	// identified by "ids".  USSs which never declared their availability are
+	// omitted from the result.
+	GetUssAvailabilities(ctx context.Context, ids []dssmodels.Manager) ([]*scdmodels.UssAvailabilityStatus, error)
 }
 
 // repos.Constraint abstracts constraint-specific interactions with the backing store.
@@ -75,6 +112,65 @@ type Constraint interface {
 	// deleted subscription.  Returns nil and an error if the Constraint does
 	// not exist.
 	DeleteConstraint(ctx context.Context, id dssmodels.ID) error
+
+	// ListConstraintsByManager returns up to "limit" Constraints managed by
+	// "manager" with an ID greater than "after" (or any ID if empty), in ID
+	// order.
+	ListConstraintsByManager(ctx context.Context, manager dssmodels.Manager, after dssmodels.ID, limit int) ([]*scdmodels.Constraint, error)
+
+	// ListExpiredConstraints returns up to "limit" Constraints which ended
+	// before "t".
+	ListExpiredConstraints(ctx context.Context, t time.Time, limit int) ([]*scdmodels.Constraint, error)
+}
+
+// NotificationDelivery abstracts interactions with the record of notifications
+// delivered by the DSS.
+type NotificationDelivery interface {
+	// InsertNotificationDeliveries records new notification deliveries.
+	InsertNotificationDeliveries(ctx context.Context, deliveries []*scdmodels.NotificationDelivery) error
+
+	// UpdateNotificationDelivery records the outcome of the latest attempt of
+	// "delivery".
+	UpdateNotificationDelivery(ctx context.Context, delivery *scdmodels.NotificationDelivery) error
+
+	// SearchNotificationDeliveries returns the notification deliveries for the
+	// Subscription identified by "subscriptionID" and managed by "manager",
+	// most recent first.
+	SearchNotificationDeliveries(ctx context.Context, subscriptionID dssmodels.ID, manager dssmodels.Manager) ([]*scdmodels.NotificationDelivery, error)
+
+	// ClaimNotificationDeliveries returns up to "limit" pending notification
+	// deliveries, oldest first, which were either never claimed or not updated
+	// for "lease" by the clock of the store, and claims them by updating them
+	// so that they are not returned again until their lease expires.
+	ClaimNotificationDeliveries(ctx context.Context, lease time.Duration, limit int) ([]*scdmodels.NotificationDelivery, error)
+
+	// DeleteNotificationDeliveriesBefore deletes the notification deliveries
+	// created before "t" and returns how many were deleted.
+	DeleteNotificationDeliveriesBefore(ctx context.Context, t time.Time) (int64, error)
+}
+
+// DssReport abstracts interactions with the reports submitted about the DSS.
+type DssReport interface {
+	// InsertDssReport stores a new report and returns the stored report.
+	InsertDssReport(ctx context.Context, report *scdmodels.DssReport) (*scdmodels.DssReport, error)
+
+	// SearchDssReports returns the reports submitted by "reporter" (or by any
+	// USS if empty) between "earliest" and "latest" (each unbounded if nil),
+	// most recent first.
+	SearchDssReports(ctx context.Context, reporter dssmodels.Manager, earliest *time.Time, latest *time.Time) ([]*scdmodels.DssReport, error)
+}
+
+// EntityChange abstracts interactions with the record of changes made to
+// OperationalIntent and Constraint references.
+type EntityChange interface {
+	// SearchEntityChanges returns up to "limit" changes of entities
+	// intersecting "cells" recorded after the change with cursor "after",
+	// ordered by cursor.
+	SearchEntityChanges(ctx context.Context, cells s2.CellUnion, after int64, limit int) ([]*scdmodels.EntityChange, error)
+
+	// DeleteEntityChangesBefore deletes the changes which occurred before "t"
+	// and returns how many were deleted.
+	DeleteEntityChangesBefore(ctx context.Context, t time.Time) (int64, error)
 }
 
 // Repository aggregates all SCD-specific repo interfaces.
@@ -83,6 +179,9 @@ type Repository interface {
 	Subscription
 	Constraint
 	UssAvailability
+	NotificationDelivery
+	DssReport
+	EntityChange
 }
 
 // IncrementNotificationIndices is a utility function that extracts the IDs from
@@ -0,0 +1,129 @@
+package scd
+
+import (
+	"context"
+
+	"github.com/golang/geo/s2"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	scdmodels "github.com/interuss/dss/pkg/scd/models"
+	"github.com/interuss/dss/pkg/scd/repos"
+	"github.com/interuss/stacktrace"
+)
+
+// DeletedEntities lists the IDs of the entities removed by
+// DeleteManagerEntities.
+type DeletedEntities struct {
+	OperationalIntents []dssmodels.ID
+	Constraints        []dssmodels.ID
+	Subscriptions      []dssmodels.ID
+}
+
+// inArea returns whether cells intersect area, where a nil area covers
+// everything.
+func inArea(area s2.CellUnion, cells s2.CellUnion) bool {
+	return area == nil || area.Intersects(cells)
+}
+
+// DeleteManagerEntities removes all the OperationalIntents, Constraints and
+// Subscriptions managed by manager, or only those intersecting area if it is
+// non-nil.  A Subscription in area is kept if OperationalIntents outside area
+// depend on it.  No notifications are issued for the removed entities.
+func (a *Server) DeleteManagerEntities(ctx context.Context, manager dssmodels.Manager, area s2.CellUnion) (*DeletedEntities, error) {
+	if manager == "" {
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Missing manager")
+	}
+
+	var deleted *DeletedEntities
+	action := func(ctx context.Context, r repos.Repository) error {
+		deleted = &DeletedEntities{}
+
+		var ops []*scdmodels.OperationalIntent
+		for after := dssmodels.ID(""); ; {
+			page, err := r.ListOperationalIntentsByManager(ctx, manager, after, dssmodels.MaxResultLimit)
+			if err != nil {
+				return stacktrace.Propagate(err, "Unable to list Operations in repo")
+			}
+			ops = append(ops, page...)
+			if len(page) < dssmodels.MaxResultLimit {
+				break
+			}
+			after = page[len(page)-1].ID
+		}
+		removedOps := map[dssmodels.ID]bool{}
+		for _, op := range ops {
+			if !inArea(area, op.Cells) {
+				continue
+			}
+			if err := r.DeleteOperationalIntent(ctx, op.ID); err != nil {
+				return stacktrace.Propagate(err, "Unable to delete Operation %s from repo", op.ID)
+			}
+			removedOps[op.ID] = true
+			deleted.OperationalIntents = append(deleted.OperationalIntents, op.ID)
+		}
+
+		var constraints []*scdmodels.Constraint
+		for after := dssmodels.ID(""); ; {
+			page, err := r.ListConstraintsByManager(ctx, manager, after, dssmodels.MaxResultLimit)
+			if err != nil {
+				return stacktrace.Propagate(err, "Unable to list Constraints in repo")
+			}
+			constraints = append(constraints, page...)
+			if len(page) < dssmodels.MaxResultLimit {
+				break
+			}
+			after = page[len(page)-1].ID
+		}
+		for _, constraint := range constraints {
+			if !inArea(area, constraint.Cells) {
+				continue
+			}
+			if err := r.DeleteConstraint(ctx, constraint.ID); err != nil {
+				return stacktrace.Propagate(err, "Unable to delete Constraint %s from repo", constraint.ID)
+			}
+			deleted.Constraints = append(deleted.Constraints, constraint.ID)
+		}
+
+		var subs []*scdmodels.Subscription
+		for after := dssmodels.ID(""); ; {
+			page, err := r.ListSubscriptionsByManager(ctx, manager, after, dssmodels.MaxResultLimit)
+			if err != nil {
+				return stacktrace.Propagate(err, "Unable to list Subscriptions in repo")
+			}
+			subs = append(subs, page...)
+			if len(page) < dssmodels.MaxResultLimit {
+				break
+			}
+			after = page[len(page)-1].ID
+		}
+	subscriptions:
+		for _, sub := range subs {
+			if !inArea(area, sub.Cells) {
+				continue
+			}
+			// Deleting a Subscription also deletes the Operations depending on it
+			dependentOps, err := r.GetDependentOperationalIntents(ctx, sub.ID)
+			if err != nil {
+				return stacktrace.Propagate(err, "Could not find dependent Operations of Subscription %s", sub.ID)
+			}
+			for _, opID := range dependentOps {
+				if !removedOps[opID] {
+					continue subscriptions
+				}
+			}
+			if err := r.DeleteSubscription(ctx, sub.ID); err != nil {
+				return stacktrace.Propagate(err, "Unable to delete Subscription %s from repo", sub.ID)
+			}
+			deleted.Subscriptions = append(deleted.Subscriptions, sub.ID)
+		}
+
+		return nil
+	}
+
+	err := a.Store.Transact(ctx, action)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,107 @@
+package scd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/geo/s2"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	scdmodels "github.com/interuss/dss/pkg/scd/models"
+	"github.com/interuss/dss/pkg/scd/repos"
+	"github.com/interuss/dss/pkg/scd/store/memory"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestDeleteManagerEntities(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		server  = &Server{Store: memory.NewStore(zap.NewNop())}
+		inside  = s2.CellUnion{s2.CellIDFromLatLng(s2.LatLngFromDegrees(46.1, 6.1)).Parent(13)}
+		outside = s2.CellUnion{s2.CellIDFromLatLng(s2.LatLngFromDegrees(47.1, 7.1)).Parent(13)}
+		start   = time.Now()
+		end     = start.Add(time.Hour)
+
+		// Needed by an Operation outside the area, so kept.
+		neededSub = dssmodels.ID("11111111-0000-4000-8000-000000000000")
+		// Only needed by an Operation inside the area, so removed along with it.
+		freedSub = dssmodels.ID("22222222-0000-4000-8000-000000000000")
+		otherSub = dssmodels.ID("33333333-0000-4000-8000-000000000000")
+
+		opInside     = dssmodels.ID("44444444-0000-4000-8000-000000000000")
+		opOutside    = dssmodels.ID("55555555-0000-4000-8000-000000000000")
+		otherOp      = dssmodels.ID("66666666-0000-4000-8000-000000000000")
+		cInside      = dssmodels.ID("77777777-0000-4000-8000-000000000000")
+		cOutside     = dssmodels.ID("88888888-0000-4000-8000-000000000000")
+		otherC       = dssmodels.ID("99999999-0000-4000-8000-000000000000")
+		manager      = dssmodels.Manager("uss1")
+		otherManager = dssmodels.Manager("uss2")
+	)
+
+	require.NoError(t, server.Store.Transact(ctx, func(ctx context.Context, r repos.Repository) error {
+		for _, sub := range []*scdmodels.Subscription{
+			{ID: neededSub, Manager: manager, Cells: inside},
+			{ID: freedSub, Manager: manager, Cells: inside},
+			{ID: otherSub, Manager: otherManager, Cells: inside},
+		} {
+			sub.StartTime, sub.EndTime, sub.USSBaseURL = &start, &end, "https://example.com"
+			sub.NotifyForOperationalIntents, sub.ImplicitSubscription = true, true
+			if _, err := r.UpsertSubscription(ctx, sub); err != nil {
+				return err
+			}
+		}
+		for _, op := range []*scdmodels.OperationalIntent{
+			{ID: opInside, Manager: manager, SubscriptionID: freedSub, Cells: inside},
+			{ID: opOutside, Manager: manager, SubscriptionID: neededSub, Cells: outside},
+			{ID: otherOp, Manager: otherManager, SubscriptionID: otherSub, Cells: inside},
+		} {
+			op.StartTime, op.EndTime, op.USSBaseURL = &start, &end, "https://example.com"
+			op.Version, op.State = 1, scdmodels.OperationalIntentStateAccepted
+			if _, err := r.UpsertOperationalIntent(ctx, op); err != nil {
+				return err
+			}
+		}
+		for _, constraint := range []*scdmodels.Constraint{
+			{ID: cInside, Manager: manager, Cells: inside},
+			{ID: cOutside, Manager: manager, Cells: outside},
+			{ID: otherC, Manager: otherManager, Cells: inside},
+		} {
+			constraint.StartTime, constraint.EndTime, constraint.USSBaseURL = &start, &end, "https://example.com"
+			if _, err := r.UpsertConstraint(ctx, constraint); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+
+	deleted, err := server.DeleteManagerEntities(ctx, manager, inside)
+	require.NoError(t, err)
+	require.Equal(t, []dssmodels.ID{opInside}, deleted.OperationalIntents)
+	require.Equal(t, []dssmodels.ID{cInside}, deleted.Constraints)
+	require.Equal(t, []dssmodels.ID{freedSub}, deleted.Subscriptions)
+
+	r, err := server.Store.Interact(ctx)
+	require.NoError(t, err)
+	for _, id := range []dssmodels.ID{neededSub, otherSub} {
+		sub, err := r.GetSubscription(ctx, id)
+		require.NoError(t, err)
+		require.NotNil(t, sub, "Subscription %s", id)
+	}
+	for _, id := range []dssmodels.ID{opOutside, otherOp} {
+		op, err := r.GetOperationalIntent(ctx, id)
+		require.NoError(t, err)
+		require.NotNil(t, op, "Operation %s", id)
+	}
+	for _, id := range []dssmodels.ID{cOutside, otherC} {
+		_, err := r.GetConstraint(ctx, id)
+		require.NoError(t, err, "Constraint %s", id)
+	}
+
+	// Without an area, the remaining entities of the manager are removed
+	deleted, err = server.DeleteManagerEntities(ctx, manager, nil)
+	require.NoError(t, err)
+	require.Equal(t, []dssmodels.ID{opOutside}, deleted.OperationalIntents)
+	require.Equal(t, []dssmodels.ID{cOutside}, deleted.Constraints)
+	require.Equal(t, []dssmodels.ID{neededSub}, deleted.Subscriptions)
+}
@@ -6,10 +6,9 @@ import (
 
 	"github.com/interuss/dss/pkg/api/v1/scdpb"
 	"github.com/interuss/dss/pkg/auth"
-	dsserr "github.com/interuss/dss/pkg/errors"
+	dssmodels "github.com/interuss/dss/pkg/models"
 	scdmodels "github.com/interuss/dss/pkg/scd/models"
 	scdstore "github.com/interuss/dss/pkg/scd/store"
-	"github.com/interuss/stacktrace"
 )
 
 const (
@@ -20,6 +19,20 @@ const (
 	availabilityArbitrationScope = "utm.availability_arbitration"
 )
 
+// signalNotificationIndexWraparounds reports the Subscriptions among
+// subscribers whose notification index wrapped around when incremented.
+func signalNotificationIndexWraparounds(ctx context.Context, subscribers []*scdpb.SubscriberToNotify) {
+	var wrapped []dssmodels.ID
+	for _, subscriber := range subscribers {
+		for _, state := range subscriber.Subscriptions {
+			if dssmodels.NotificationIndexWrapped(int(state.NotificationIndex)) {
+				wrapped = append(wrapped, dssmodels.ID(state.SubscriptionId))
+			}
+		}
+	}
+	dssmodels.SignalNotificationIndexWraparounds(ctx, "scd", wrapped)
+}
+
 func makeSubscribersToNotify(subscriptions []*scdmodels.Subscription) []*scdpb.SubscriberToNotify {
 	result := []*scdpb.SubscriberToNotify{}
 
@@ -43,9 +56,29 @@ func makeSubscribersToNotify(subscriptions []*scdmodels.Subscription) []*scdpb.S
 
 // Server implements scdpb.DiscoveryAndSynchronizationService.
 type Server struct {
-	Store      scdstore.Store
-	Timeout    time.Duration
-	EnableHTTP bool
+	Store                scdstore.Store
+	Timeout              time.Duration
+	EnableHTTP           bool
+	SubscriptionLifetime dssmodels.SubscriptionLifetime
+
+	// VolumeValidator checks the volumes submitted to the DSS.
+	VolumeValidator dssmodels.VolumeValidator
+
+	// StateTransitionRules lists the allowed state transitions of
+	// OperationalIntents; nil selects scdmodels.DefaultStateTransitionRules.
+	StateTransitionRules scdmodels.StateTransitionRules
+
+	// ConstraintNotifier, when non-nil, notifies subscribed USSs of Constraint
+	// changes on behalf of the USS making them.
+	ConstraintNotifier *ConstraintNotifier
+}
+
+// stateTransitionRules returns the state transition rules in effect.
+func (a *Server) stateTransitionRules() scdmodels.StateTransitionRules {
+	if a.StateTransitionRules == nil {
+		return scdmodels.DefaultStateTransitionRules
+	}
+	return a.StateTransitionRules
 }
 
 // AuthScopes returns a map of endpoint to required Oauth scope.
@@ -72,7 +105,34 @@ func (a *Server) AuthScopes() map[auth.Operation]auth.KeyClaimedScopesValidator
 	}
 }
 
-// MakeDssReport creates an error report about a DSS.
-func (a *Server) MakeDssReport(ctx context.Context, req *scdpb.MakeDssReportRequest) (*scdpb.ErrorReport, error) {
-	return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Not yet implemented")
+// SearchOperations returns the endpoints searching for entities in an area.
+func (a *Server) SearchOperations() []auth.Operation {
+	return []auth.Operation{
+		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/QueryConstraintReferences",
+		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/QueryOperationalIntentReferences",
+		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/QuerySubscriptions",
+	}
+}
+
+// ReportOperations returns the endpoints reporting errors to the DSS.
+func (a *Server) ReportOperations() []auth.Operation {
+	return []auth.Operation{
+		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/MakeDssReport",
+	}
+}
+
+// MutationOperations returns the endpoints creating, updating or deleting
+// entities.
+func (a *Server) MutationOperations() []auth.Operation {
+	return []auth.Operation{
+		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/CreateConstraintReference",
+		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/CreateOperationalIntentReference",
+		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/CreateSubscription",
+		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/DeleteConstraintReference",
+		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/DeleteOperationalIntentReference",
+		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/DeleteSubscription",
+		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/UpdateConstraintReference",
+		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/UpdateOperationalIntentReference",
+		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/UpdateSubscription",
+	}
 }
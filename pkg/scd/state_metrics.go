@@ -0,0 +1,66 @@
+package scd
+
+import (
+	"context"
+	"time"
+
+	scdmodels "github.com/interuss/dss/pkg/scd/models"
+	"github.com/interuss/stacktrace"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	// stateNone labels the absence of an OperationalIntent before its creation
+	// or after its deletion in state transitions.
+	stateNone = "None"
+)
+
+var (
+	operationalIntentsByState = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dss_scd_operational_intents",
+		Help: "Number of active operational intent references, by state and manager, as of the latest refresh.",
+	}, []string{"state", "manager"})
+	operationalIntentTransitions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "dss_scd_operational_intent_transitions_total",
+		Help: "Number of operational intent reference state transitions made through this DSS instance, by previous and new state.",
+	}, []string{"from", "to"})
+)
+
+func stateLabel(s scdmodels.OperationalIntentState) string {
+	if s == scdmodels.OperationalIntentStateUnknown {
+		return stateNone
+	}
+	return string(s)
+}
+
+// recordStateTransition counts the transition of an OperationalIntent from
+// state from to state to, where OperationalIntentStateUnknown stands for a
+// nonexistent OperationalIntent.
+func recordStateTransition(from, to scdmodels.OperationalIntentState) {
+	operationalIntentTransitions.WithLabelValues(stateLabel(from), stateLabel(to)).Inc()
+}
+
+// RefreshStateMetrics counts the active OperationalIntents by state and
+// manager.
+func (a *Server) RefreshStateMetrics(ctx context.Context) error {
+	r, err := a.Store.Interact(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "Unable to interact with store")
+	}
+	ops, err := r.ListActiveOperationalIntents(ctx, time.Now())
+	if err != nil {
+		return stacktrace.Propagate(err, "Unable to list active Operations in repo")
+	}
+
+	type key struct{ state, manager string }
+	counts := map[key]int{}
+	for _, op := range ops {
+		counts[key{stateLabel(op.State), op.Manager.String()}]++
+	}
+	operationalIntentsByState.Reset()
+	for k, count := range counts {
+		operationalIntentsByState.WithLabelValues(k.state, k.manager).Set(float64(count))
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	scdmodels "github.com/interuss/dss/pkg/scd/models"
 	dsssql "github.com/interuss/dss/pkg/sql"
 	"github.com/interuss/stacktrace"
+	"github.com/jackc/pgtype"
 	"github.com/jackc/pgx/v4"
 	"strings"
 	"time"
@@ -40,15 +41,15 @@ func init() {
 
 // Implements repos.UssAvailability.UpsertAvailability
 func (u *repo) UpsertUssAvailability(ctx context.Context, s *scdmodels.UssAvailabilityStatus) (*scdmodels.UssAvailabilityStatus, error) {
+	upsert, onConflict := u.dialect.UpsertClauses("scd_uss_availability", "id", availabilityFieldsWithoutPrefix)
 	var (
 		upsertQuery = fmt.Sprintf(`
-		UPSERT INTO
-		scd_uss_availability
-		  (%s)
+		%s
 		VALUES
 			($1, $2, transaction_timestamp())
+		%s
 		RETURNING
-			%s`, availabilityFieldsWithoutPrefix, availabilityFieldsWithPrefix)
+			%s`, upsert, onConflict, availabilityFieldsWithPrefix)
 	)
 
 	s, err := u.fetchAvailability(ctx, u.q, upsertQuery,
@@ -121,3 +122,29 @@ func (u *repo) GetUssAvailability(ctx context.Context, ussID dssmodels.Manager)
 	}
 	return ussa, nil
 }
+
+// Implements repos.UssAvailability.GetUssAvailabilities
+func (u *repo) GetUssAvailabilities(ctx context.Context, ussIDs []dssmodels.Manager) ([]*scdmodels.UssAvailabilityStatus, error) {
+	if !u.ussAvailability || len(ussIDs) == 0 {
+		return nil, nil
+	}
+
+	var ussAvailabilitiesQuery = fmt.Sprintf(`
+      SELECT %s
+      FROM
+        scd_uss_availability
+      WHERE
+        id = ANY($1)`, availabilityFieldsWithoutPrefix)
+
+	ids := make([]string, len(ussIDs))
+	for i, id := range ussIDs {
+		ids[i] = id.String()
+	}
+
+	var pgIds pgtype.TextArray
+	if err := pgIds.Set(ids); err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to convert array to jackc/pgtype")
+	}
+
+	return u.fetchAvailabilities(ctx, u.q, ussAvailabilitiesQuery, pgIds)
+}
@@ -61,6 +61,7 @@ func (c *repo) fetchConstraints(ctx context.Context, q dsssql.Queryable, query s
 	defer rows.Close()
 
 	var payload []*scdmodels.Constraint
+	encryptor := c.encryptor
 	pgCids := pgtype.Int8Array{}
 	for rows.Next() {
 		var (
@@ -89,6 +90,9 @@ func (c *repo) fetchConstraints(ctx context.Context, q dsssql.Queryable, query s
 		}
 		c.Cells = geo.CellUnionFromInt64(cids)
 		c.OVN = scdmodels.NewOVNFromTime(updatedAt, c.ID.String())
+		if c.USSBaseURL, err = encryptor.Decrypt(ctx, constraintURLColumn, c.USSBaseURL); err != nil {
+			return nil, stacktrace.Propagate(err, "Error decrypting Constraint URL")
+		}
 		payload = append(payload, c)
 	}
 	if err := rows.Err(); err != nil {
@@ -131,15 +135,15 @@ func (c *repo) GetConstraint(ctx context.Context, id dssmodels.ID) (*scdmodels.C
 
 // Implements scd.repos.Constraint.UpsertConstraint
 func (c *repo) UpsertConstraint(ctx context.Context, s *scdmodels.Constraint) (*scdmodels.Constraint, error) {
+	upsert, onConflict := c.dialect.UpsertClauses("scd_constraints", "id", constraintFieldsWithoutPrefix)
 	var (
 		upsertQuery = fmt.Sprintf(`
-		UPSERT INTO
-		  scd_constraints
-		  (%s)
+		%s
 		VALUES
 			($1, $2, $3, $4, $5, $6, $7, $8, $9, transaction_timestamp())
+		%s
 		RETURNING
-			%s`, constraintFieldsWithoutPrefix, constraintFieldsWithPrefix)
+			%s`, upsert, onConflict, constraintFieldsWithPrefix)
 	)
 
 	cids := make([]int64, len(s.Cells))
@@ -160,11 +164,15 @@ func (c *repo) UpsertConstraint(ctx context.Context, s *scdmodels.Constraint) (*
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to convert id to PgUUID")
 	}
+	url, err := c.encryptor.Encrypt(ctx, constraintURLColumn, s.USSBaseURL)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error encrypting Constraint URL")
+	}
 	s, err = c.fetchConstraint(ctx, c.q, upsertQuery,
 		id,
 		s.Manager,
 		s.Version,
-		s.USSBaseURL,
+		url,
 		s.AltitudeLower,
 		s.AltitudeUpper,
 		s.StartTime,
@@ -174,6 +182,14 @@ func (c *repo) UpsertConstraint(ctx context.Context, s *scdmodels.Constraint) (*
 		return nil, stacktrace.Propagate(err, "Error fetching Constraint")
 	}
 
+	change := scdmodels.EntityChangeUpdated
+	if s.Version == 1 {
+		change = scdmodels.EntityChangeCreated
+	}
+	if err := c.recordEntityChange(ctx, "scd_constraints", scdmodels.EntityTypeConstraint, id, change); err != nil {
+		return nil, stacktrace.Propagate(err, "Error recording change of Constraint")
+	}
+
 	return s, nil
 }
 
@@ -191,6 +207,9 @@ func (c *repo) DeleteConstraint(ctx context.Context, id dssmodels.ID) error {
 	if err != nil {
 		return stacktrace.Propagate(err, "Failed to convert id to PgUUID")
 	}
+	if err := c.recordEntityChange(ctx, "scd_constraints", scdmodels.EntityTypeConstraint, uid, scdmodels.EntityChangeDeleted); err != nil {
+		return stacktrace.Propagate(err, "Error recording deletion of Constraint")
+	}
 	res, err := c.q.Exec(ctx, query, uid)
 	if err != nil {
 		return stacktrace.Propagate(err, "Error in query: %s", query)
@@ -217,7 +236,11 @@ func (c *repo) SearchConstraints(ctx context.Context, v4d *dssmodels.Volume4D) (
 				COALESCE(starts_at <= $3, true)
 			AND
 				COALESCE(ends_at >= $2, true)
-			LIMIT $4`, constraintFieldsWithoutPrefix)
+			AND
+				COALESCE(altitude_upper >= $4, true)
+			AND
+				COALESCE(altitude_lower <= $5, true)
+			LIMIT $6`, constraintFieldsWithoutPrefix)
 	)
 
 	// TODO: Lazily calculate & cache spatial covering so that it is only ever
@@ -241,11 +264,60 @@ func (c *repo) SearchConstraints(ctx context.Context, v4d *dssmodels.Volume4D) (
 		return nil, stacktrace.Propagate(err, "Failed to convert array to jackc/pgtype")
 	}
 
+	var altitudeLo, altitudeHi *float32
+	if v4d.SpatialVolume != nil {
+		altitudeLo = v4d.SpatialVolume.AltitudeLo
+		altitudeHi = v4d.SpatialVolume.AltitudeHi
+	}
+
 	constraints, err := c.fetchConstraints(
-		ctx, c.q, query, pgCids, v4d.StartTime, v4d.EndTime, dssmodels.MaxResultLimit)
+		ctx, c.q, query, pgCids, v4d.StartTime, v4d.EndTime, altitudeLo, altitudeHi, dssmodels.MaxResultLimit)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Error fetching Constraints")
 	}
 
 	return constraints, nil
 }
+
+// Implements scd.repos.Constraint.ListConstraintsByManager
+func (c *repo) ListConstraintsByManager(ctx context.Context, manager dssmodels.Manager, after dssmodels.ID, limit int) ([]*scdmodels.Constraint, error) {
+	var query = fmt.Sprintf(`
+		SELECT
+			%s
+		FROM
+			scd_constraints
+		WHERE
+			owner = $1
+		AND
+			id > $2
+		ORDER BY id
+		LIMIT $3`, constraintFieldsWithoutPrefix)
+
+	uid, err := pageCursor(after)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+	result, err := c.fetchConstraints(ctx, c.q, query, manager, uid, limit)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error fetching Constraints")
+	}
+	return result, nil
+}
+
+// ListExpiredConstraints implements repos.Constraint.ListExpiredConstraints.
+func (c *repo) ListExpiredConstraints(ctx context.Context, t time.Time, limit int) ([]*scdmodels.Constraint, error) {
+	var query = fmt.Sprintf(`
+		SELECT
+			%s
+		FROM
+			scd_constraints
+		WHERE
+			ends_at < $1
+		LIMIT $2`, constraintFieldsWithoutPrefix)
+
+	result, err := c.fetchConstraints(ctx, c.q, query, t, limit)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error fetching Constraints")
+	}
+	return result, nil
+}
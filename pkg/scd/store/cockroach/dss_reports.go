@@ -0,0 +1,153 @@
+package cockroach
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/interuss/dss/pkg/api/v1/scdpb"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	scdmodels "github.com/interuss/dss/pkg/scd/models"
+	dsssql "github.com/interuss/dss/pkg/sql"
+	"github.com/interuss/stacktrace"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var (
+	dssReportFieldsWithIndices   [5]string
+	dssReportFieldsWithoutPrefix string
+)
+
+func init() {
+	dssReportFieldsWithIndices[0] = "id"
+	dssReportFieldsWithIndices[1] = "reporter"
+	dssReportFieldsWithIndices[2] = "exchange"
+	dssReportFieldsWithIndices[3] = "dss_records"
+	dssReportFieldsWithIndices[4] = "created_at"
+
+	dssReportFieldsWithoutPrefix = strings.Join(
+		dssReportFieldsWithIndices[:], ",",
+	)
+}
+
+func (c *repo) fetchDssReports(ctx context.Context, q dsssql.Queryable, query string, args ...interface{}) ([]*scdmodels.DssReport, error) {
+	rows, err := q.Query(ctx, query, args...)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error in query: %s", query)
+	}
+	defer rows.Close()
+
+	var payload []*scdmodels.DssReport
+	for rows.Next() {
+		var (
+			r          = &scdmodels.DssReport{Exchange: &scdpb.ExchangeRecord{}}
+			exchange   []byte
+			dssRecords []byte
+		)
+		err := rows.Scan(
+			&r.ID,
+			&r.Reporter,
+			&exchange,
+			&dssRecords,
+			&r.CreatedAt,
+		)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Error scanning DssReport row")
+		}
+		// An encrypted exchange is stored as a JSON string holding the
+		// encrypted JSON exchange record.
+		var encrypted string
+		if json.Unmarshal(exchange, &encrypted) == nil {
+			decrypted, err := c.encryptor.Decrypt(ctx, dssReportExchangeColumn, encrypted)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "Error decrypting DssReport exchange")
+			}
+			exchange = []byte(decrypted)
+		}
+		if err := protojson.Unmarshal(exchange, r.Exchange); err != nil {
+			return nil, stacktrace.Propagate(err, "Error decoding DssReport exchange")
+		}
+		if dssRecords != nil {
+			if err := json.Unmarshal(dssRecords, &r.DssRecords); err != nil {
+				return nil, stacktrace.Propagate(err, "Error decoding DssReport records")
+			}
+		}
+		payload = append(payload, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, stacktrace.Propagate(err, "Error in rows query result")
+	}
+	return payload, nil
+}
+
+// Implements scd.repos.DssReport.InsertDssReport
+func (c *repo) InsertDssReport(ctx context.Context, r *scdmodels.DssReport) (*scdmodels.DssReport, error) {
+	var insertQuery = fmt.Sprintf(`
+		INSERT INTO
+			scd_dss_reports
+			(%s)
+		VALUES
+			($1, $2, $3, $4, transaction_timestamp())
+		RETURNING
+			%s`, dssReportFieldsWithoutPrefix, dssReportFieldsWithoutPrefix)
+
+	if !c.dssReports {
+		return nil, stacktrace.NewError("DSS reports are not supported by the current database schema")
+	}
+
+	id, err := r.ID.PgUUID()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to convert id to PgUUID")
+	}
+	exchange, err := protojson.Marshal(r.Exchange)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error encoding DssReport exchange")
+	}
+	if c.encryptor != nil && c.encryptor.Columns[dssReportExchangeColumn] {
+		encrypted, err := c.encryptor.Encrypt(ctx, dssReportExchangeColumn, string(exchange))
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Error encrypting DssReport exchange")
+		}
+		if exchange, err = json.Marshal(encrypted); err != nil {
+			return nil, stacktrace.Propagate(err, "Error encoding encrypted DssReport exchange")
+		}
+	}
+	dssRecords, err := json.Marshal(r.DssRecords)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error encoding DssReport records")
+	}
+
+	reports, err := c.fetchDssReports(ctx, c.q, insertQuery, id, r.Reporter, exchange, dssRecords)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error fetching DssReport from insert query")
+	}
+	if len(reports) != 1 {
+		return nil, stacktrace.NewError("Insert query returned %d DssReports when 1 was expected", len(reports))
+	}
+	return reports[0], nil
+}
+
+// Implements scd.repos.DssReport.SearchDssReports
+func (c *repo) SearchDssReports(ctx context.Context, reporter dssmodels.Manager, earliest *time.Time, latest *time.Time) ([]*scdmodels.DssReport, error) {
+	var query = fmt.Sprintf(`
+		SELECT
+			%s
+		FROM
+			scd_dss_reports
+		WHERE
+			($1 = '' OR reporter = $1)
+		AND
+			COALESCE(created_at >= $2, true)
+		AND
+			COALESCE(created_at <= $3, true)
+		ORDER BY created_at DESC
+		LIMIT $4`, dssReportFieldsWithoutPrefix)
+
+	if !c.dssReports {
+		return nil, nil
+	}
+
+	return c.fetchDssReports(ctx, c.q, query, reporter, earliest, latest, dssmodels.MaxResultLimit)
+}
@@ -0,0 +1,25 @@
+package cockroach
+
+// Columns whose values may be encrypted, as table.column.  They hold the URLs
+// and exchanges through which USSs are reached, which operators may not want
+// to store in plaintext.
+const (
+	subscriptionURLColumn                = "scd_subscriptions.url"
+	operationURLColumn                   = "scd_operations.url"
+	constraintURLColumn                  = "scd_constraints.url"
+	notificationDeliveryURLColumn        = "scd_notification_deliveries.url"
+	notificationDeliveryConstraintColumn = "scd_notification_deliveries.constraint_json"
+	dssReportExchangeColumn              = "scd_dss_reports.exchange"
+)
+
+// EncryptableColumns are the columns, as table.column, which a Store can
+// encrypt.  Encrypted columns are not searchable, so only columns which are
+// never filtered on are encryptable.
+var EncryptableColumns = []string{
+	subscriptionURLColumn,
+	operationURLColumn,
+	constraintURLColumn,
+	notificationDeliveryURLColumn,
+	notificationDeliveryConstraintColumn,
+	dssReportExchangeColumn,
+}
@@ -0,0 +1,146 @@
+package cockroach
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang/geo/s2"
+	scdmodels "github.com/interuss/dss/pkg/scd/models"
+	"github.com/interuss/stacktrace"
+	"github.com/jackc/pgtype"
+)
+
+var (
+	entityChangeFieldsWithIndices   [8]string
+	entityChangeFieldsWithoutPrefix string
+)
+
+func init() {
+	entityChangeFieldsWithIndices[0] = "id"
+	entityChangeFieldsWithIndices[1] = "entity_type"
+	entityChangeFieldsWithIndices[2] = "entity_id"
+	entityChangeFieldsWithIndices[3] = "change"
+	entityChangeFieldsWithIndices[4] = "owner"
+	entityChangeFieldsWithIndices[5] = "version"
+	entityChangeFieldsWithIndices[6] = "cells"
+	entityChangeFieldsWithIndices[7] = "occurred_at"
+
+	entityChangeFieldsWithoutPrefix = strings.Join(
+		entityChangeFieldsWithIndices[:], ",",
+	)
+}
+
+// recordEntityChange records a change made to the entity of type entityType
+// identified by id, as currently stored in table.  Changes are only recorded
+// when supported by the schema; deletions must be recorded before deleting
+// the entity.
+func (c *repo) recordEntityChange(ctx context.Context, table string, entityType string, id *pgtype.UUID, change scdmodels.EntityChangeType) error {
+	var insertQuery = fmt.Sprintf(`
+		INSERT INTO
+			scd_entity_changes
+			(entity_type, entity_id, change, owner, version, cells, occurred_at)
+		SELECT
+			$1, id, $3, owner, version, COALESCE(cells, ARRAY[]::BIGINT[]), transaction_timestamp()
+		FROM
+			%s
+		WHERE
+			id = $2`, table)
+
+	if !c.entityChanges {
+		return nil
+	}
+
+	if _, err := c.q.Exec(ctx, insertQuery, entityType, id, change); err != nil {
+		return stacktrace.Propagate(err, "Error in query: %s", insertQuery)
+	}
+	return nil
+}
+
+// Implements scd.repos.EntityChange.SearchEntityChanges
+func (c *repo) SearchEntityChanges(ctx context.Context, cells s2.CellUnion, after int64, limit int) ([]*scdmodels.EntityChange, error) {
+	var query = fmt.Sprintf(`
+		SELECT
+			%s
+		FROM
+			scd_entity_changes
+		WHERE
+			cells && $1
+		AND
+			id > $2
+		ORDER BY id
+		LIMIT $3`, entityChangeFieldsWithoutPrefix)
+
+	if !c.entityChanges {
+		return nil, stacktrace.NewError("Entity changes are not recorded by the current database schema")
+	}
+
+	cids := make([]int64, len(cells))
+	for i, cid := range cells {
+		cids[i] = int64(cid)
+	}
+	var pgCids pgtype.Int8Array
+	if err := pgCids.Set(cids); err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to convert array to jackc/pgtype")
+	}
+
+	rows, err := c.q.Query(ctx, query, pgCids, after, limit)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error in query: %s", query)
+	}
+	defer rows.Close()
+
+	var payload []*scdmodels.EntityChange
+	for rows.Next() {
+		var (
+			ec          = new(scdmodels.EntityChange)
+			changeCells pgtype.Int8Array
+		)
+		err := rows.Scan(
+			&ec.Cursor,
+			&ec.EntityType,
+			&ec.EntityID,
+			&ec.Change,
+			&ec.Manager,
+			&ec.Version,
+			&changeCells,
+			&ec.OccurredAt,
+		)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Error scanning EntityChange row")
+		}
+		var changeCids []int64
+		if err := changeCells.AssignTo(&changeCids); err != nil {
+			return nil, stacktrace.Propagate(err, "Error Converting jackc/pgtype to array")
+		}
+		ec.Cells = make(s2.CellUnion, len(changeCids))
+		for i, cid := range changeCids {
+			ec.Cells[i] = s2.CellID(cid)
+		}
+		payload = append(payload, ec)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, stacktrace.Propagate(err, "Error in rows query result")
+	}
+	return payload, nil
+}
+
+// Implements scd.repos.EntityChange.DeleteEntityChangesBefore
+func (c *repo) DeleteEntityChangesBefore(ctx context.Context, t time.Time) (int64, error) {
+	const deleteQuery = `
+		DELETE FROM
+			scd_entity_changes
+		WHERE
+			occurred_at < $1`
+
+	if !c.entityChanges {
+		return 0, nil
+	}
+
+	res, err := c.q.Exec(ctx, deleteQuery, t)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "Error in query: %s", deleteQuery)
+	}
+	return res.RowsAffected(), nil
+}
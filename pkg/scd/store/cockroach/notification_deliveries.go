@@ -0,0 +1,280 @@
+package cockroach
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/interuss/dss/pkg/api/v1/scdpb"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	scdmodels "github.com/interuss/dss/pkg/scd/models"
+	dsssql "github.com/interuss/dss/pkg/sql"
+	"github.com/interuss/stacktrace"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var (
+	notificationDeliveryFieldsWithIndices   [12]string
+	notificationDeliveryFieldsWithoutPrefix string
+)
+
+func init() {
+	notificationDeliveryFieldsWithIndices[0] = "id"
+	notificationDeliveryFieldsWithIndices[1] = "subscription_id"
+	notificationDeliveryFieldsWithIndices[2] = "owner"
+	notificationDeliveryFieldsWithIndices[3] = "entity_id"
+	notificationDeliveryFieldsWithIndices[4] = "notification_index"
+	notificationDeliveryFieldsWithIndices[5] = "url"
+	notificationDeliveryFieldsWithIndices[6] = "status"
+	notificationDeliveryFieldsWithIndices[7] = "attempts"
+	notificationDeliveryFieldsWithIndices[8] = "last_error"
+	notificationDeliveryFieldsWithIndices[9] = "created_at"
+	notificationDeliveryFieldsWithIndices[10] = "updated_at"
+	notificationDeliveryFieldsWithIndices[11] = "constraint_json"
+
+	notificationDeliveryFieldsWithoutPrefix = strings.Join(
+		notificationDeliveryFieldsWithIndices[:], ",",
+	)
+}
+
+// notificationDeliveryFields returns the fields of the notification
+// deliveries of the schema of c, which records the notified Constraint from
+// notifiedConstraintsSchemaVersion.
+func (c *repo) notificationDeliveryFields() string {
+	if c.notifiedConstraints {
+		return notificationDeliveryFieldsWithoutPrefix
+	}
+	return strings.Join(notificationDeliveryFieldsWithIndices[:11], ",")
+}
+
+func (c *repo) fetchNotificationDeliveries(ctx context.Context, q dsssql.Queryable, query string, args ...interface{}) ([]*scdmodels.NotificationDelivery, error) {
+	rows, err := q.Query(ctx, query, args...)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error in query: %s", query)
+	}
+	defer rows.Close()
+
+	var payload []*scdmodels.NotificationDelivery
+	for rows.Next() {
+		var (
+			d              = new(scdmodels.NotificationDelivery)
+			lastError      *string
+			constraintJSON *string
+		)
+		dest := []interface{}{
+			&d.ID,
+			&d.SubscriptionID,
+			&d.Manager,
+			&d.EntityID,
+			&d.NotificationIndex,
+			&d.URL,
+			&d.Status,
+			&d.Attempts,
+			&lastError,
+			&d.CreatedAt,
+			&d.UpdatedAt,
+		}
+		if c.notifiedConstraints {
+			dest = append(dest, &constraintJSON)
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, stacktrace.Propagate(err, "Error scanning NotificationDelivery row")
+		}
+		if lastError != nil {
+			d.LastError = *lastError
+		}
+		var err error
+		if d.URL, err = c.encryptor.Decrypt(ctx, notificationDeliveryURLColumn, d.URL); err != nil {
+			return nil, stacktrace.Propagate(err, "Error decrypting NotificationDelivery URL")
+		}
+		if constraintJSON != nil {
+			data, err := c.encryptor.Decrypt(ctx, notificationDeliveryConstraintColumn, *constraintJSON)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "Error decrypting NotificationDelivery Constraint")
+			}
+			d.Constraint = new(scdpb.Constraint)
+			if err := protojson.Unmarshal([]byte(data), d.Constraint); err != nil {
+				return nil, stacktrace.Propagate(err, "Error parsing NotificationDelivery Constraint")
+			}
+		}
+		payload = append(payload, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, stacktrace.Propagate(err, "Error in rows query result")
+	}
+	return payload, nil
+}
+
+// Implements scd.repos.NotificationDelivery.InsertNotificationDeliveries
+func (c *repo) InsertNotificationDeliveries(ctx context.Context, deliveries []*scdmodels.NotificationDelivery) error {
+	var insertQuery = fmt.Sprintf(`
+		INSERT INTO
+			scd_notification_deliveries
+			(%s)
+		VALUES
+			($1, $2, $3, $4, $5, $6, $7, $8, $9, transaction_timestamp(), transaction_timestamp(), $10)`, notificationDeliveryFieldsWithoutPrefix)
+
+	if !c.notifiedConstraints {
+		return stacktrace.NewError("Notification deliveries are not supported by the current database schema")
+	}
+
+	for _, d := range deliveries {
+		id, err := d.ID.PgUUID()
+		if err != nil {
+			return stacktrace.Propagate(err, "Failed to convert id to PgUUID")
+		}
+		subid, err := d.SubscriptionID.PgUUID()
+		if err != nil {
+			return stacktrace.Propagate(err, "Failed to convert id to PgUUID")
+		}
+		entityid, err := d.EntityID.PgUUID()
+		if err != nil {
+			return stacktrace.Propagate(err, "Failed to convert id to PgUUID")
+		}
+		url, err := c.encryptor.Encrypt(ctx, notificationDeliveryURLColumn, d.URL)
+		if err != nil {
+			return stacktrace.Propagate(err, "Error encrypting NotificationDelivery URL")
+		}
+		var constraintJSON *string
+		if d.Constraint != nil {
+			data, err := protojson.Marshal(d.Constraint)
+			if err != nil {
+				return stacktrace.Propagate(err, "Error serializing NotificationDelivery Constraint")
+			}
+			encrypted, err := c.encryptor.Encrypt(ctx, notificationDeliveryConstraintColumn, string(data))
+			if err != nil {
+				return stacktrace.Propagate(err, "Error encrypting NotificationDelivery Constraint")
+			}
+			constraintJSON = &encrypted
+		}
+		if _, err := c.q.Exec(ctx, insertQuery,
+			id,
+			subid,
+			d.Manager,
+			entityid,
+			d.NotificationIndex,
+			url,
+			d.Status,
+			d.Attempts,
+			nullableString(d.LastError),
+			constraintJSON); err != nil {
+			return stacktrace.Propagate(err, "Error in query: %s", insertQuery)
+		}
+	}
+	return nil
+}
+
+// Implements scd.repos.NotificationDelivery.UpdateNotificationDelivery
+func (c *repo) UpdateNotificationDelivery(ctx context.Context, d *scdmodels.NotificationDelivery) error {
+	const updateQuery = `
+		UPDATE
+			scd_notification_deliveries
+		SET
+			status = $2, attempts = $3, last_error = $4, updated_at = transaction_timestamp()
+		WHERE
+			id = $1`
+
+	id, err := d.ID.PgUUID()
+	if err != nil {
+		return stacktrace.Propagate(err, "Failed to convert id to PgUUID")
+	}
+	res, err := c.q.Exec(ctx, updateQuery, id, d.Status, d.Attempts, nullableString(d.LastError))
+	if err != nil {
+		return stacktrace.Propagate(err, "Error in query: %s", updateQuery)
+	}
+	if res.RowsAffected() == 0 {
+		return stacktrace.NewError("Attempted to update non-existent NotificationDelivery")
+	}
+	return nil
+}
+
+// Implements scd.repos.NotificationDelivery.SearchNotificationDeliveries
+func (c *repo) SearchNotificationDeliveries(ctx context.Context, subscriptionID dssmodels.ID, manager dssmodels.Manager) ([]*scdmodels.NotificationDelivery, error) {
+	var query = fmt.Sprintf(`
+		SELECT
+			%s
+		FROM
+			scd_notification_deliveries
+		WHERE
+			subscription_id = $1
+		AND
+			owner = $2
+		ORDER BY created_at DESC
+		LIMIT $3`, c.notificationDeliveryFields())
+
+	if !c.notificationDeliveries {
+		return nil, nil
+	}
+
+	subid, err := subscriptionID.PgUUID()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to convert id to PgUUID")
+	}
+	return c.fetchNotificationDeliveries(ctx, c.q, query, subid, manager, dssmodels.MaxResultLimit)
+}
+
+// Implements scd.repos.NotificationDelivery.ClaimNotificationDeliveries
+func (c *repo) ClaimNotificationDeliveries(ctx context.Context, lease time.Duration, limit int) ([]*scdmodels.NotificationDelivery, error) {
+	// Deliveries are recorded with updated_at = created_at, which claims and
+	// attempts advance.
+	var query = fmt.Sprintf(`
+		UPDATE
+			scd_notification_deliveries
+		SET
+			updated_at = transaction_timestamp()
+		WHERE
+			id IN (
+				SELECT
+					id
+				FROM
+					scd_notification_deliveries
+				WHERE
+					status = $1
+				AND
+					(updated_at = created_at OR updated_at < transaction_timestamp() - $2::INT8 * INTERVAL '1 microsecond')
+				ORDER BY created_at
+				LIMIT $3)
+		RETURNING
+			%s`, notificationDeliveryFieldsWithoutPrefix)
+
+	// Deliveries recorded without the notified Constraint cannot be delivered.
+	if !c.notifiedConstraints {
+		return nil, nil
+	}
+
+	deliveries, err := c.fetchNotificationDeliveries(ctx, c.q, query, scdmodels.NotificationDeliveryStatusPending, lease.Microseconds(), limit)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+	sort.Slice(deliveries, func(i, j int) bool { return deliveries[i].CreatedAt.Before(deliveries[j].CreatedAt) })
+	return deliveries, nil
+}
+
+// Implements scd.repos.NotificationDelivery.DeleteNotificationDeliveriesBefore
+func (c *repo) DeleteNotificationDeliveriesBefore(ctx context.Context, t time.Time) (int64, error) {
+	const deleteQuery = `
+		DELETE FROM
+			scd_notification_deliveries
+		WHERE
+			created_at < $1`
+
+	if !c.notificationDeliveries {
+		return 0, nil
+	}
+
+	res, err := c.q.Exec(ctx, deleteQuery, t)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "Error in query: %s", deleteQuery)
+	}
+	return res.RowsAffected(), nil
+}
+
+// nullableString stores empty strings as NULL.
+func nullableString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,44 @@
+package cockroach
+
+import (
+	"context"
+
+	"github.com/interuss/stacktrace"
+	"github.com/jackc/pgx/v4"
+)
+
+// WatchNotificationDeliveries calls notify whenever a change to the recorded
+// notification deliveries is committed, until ctx is done or the changefeed
+// fails.  It relies on a CockroachDB core changefeed, which requires the
+// kv.rangefeed.enabled cluster setting.
+func (s *Store) WatchNotificationDeliveries(ctx context.Context, notify func()) error {
+	const query = `EXPERIMENTAL CHANGEFEED FOR scd_notification_deliveries WITH no_initial_scan`
+
+	if !s.db.Dialect.IsCockroachDB() {
+		return stacktrace.NewError("Changefeeds are only supported by CockroachDB")
+	}
+	if !s.notificationDeliveries {
+		return stacktrace.NewError("Notification deliveries are not supported by the current database schema")
+	}
+
+	// A changefeed holds its connection for as long as it runs, so it uses a
+	// dedicated connection rather than one of the pool.
+	conn, err := pgx.ConnectConfig(ctx, s.db.Pool.Config().ConnConfig)
+	if err != nil {
+		return stacktrace.Propagate(err, "Failed to connect for changefeed")
+	}
+	defer conn.Close(context.Background())
+
+	rows, err := conn.Query(ctx, query)
+	if err != nil {
+		return stacktrace.Propagate(err, "Error in query: %s", query)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		notify()
+	}
+	if err := rows.Err(); err != nil && ctx.Err() == nil {
+		return stacktrace.Propagate(err, "Error in changefeed: %s", query)
+	}
+	return nil
+}
@@ -87,6 +87,9 @@ func (s *repo) fetchOperationalIntents(ctx context.Context, q dsssql.Queryable,
 		}
 		o.OVN = scdmodels.NewOVNFromTime(updatedAt, o.ID.String())
 		o.SetCells(cids)
+		if o.USSBaseURL, err = s.encryptor.Decrypt(ctx, operationURLColumn, o.USSBaseURL); err != nil {
+			return nil, stacktrace.Propagate(err, "Error decrypting Operation URL")
+		}
 		payload = append(payload, o)
 	}
 	if err := rows.Err(); err != nil {
@@ -99,6 +102,10 @@ func (s *repo) fetchOperationalIntents(ctx context.Context, q dsssql.Queryable,
 		}
 	}
 
+	if err := s.populateOperationalIntentMetadata(ctx, q, payload); err != nil {
+		return nil, stacktrace.Propagate(err, "Error populating metadata for Operations")
+	}
+
 	return payload, nil
 }
 
@@ -183,6 +190,9 @@ func (s *repo) DeleteOperationalIntent(ctx context.Context, id dssmodels.ID) err
 	if err != nil {
 		return stacktrace.Propagate(err, "Failed to convert id to PgUUID")
 	}
+	if err := s.recordEntityChange(ctx, "scd_operations", scdmodels.EntityTypeOperationalIntent, uid, scdmodels.EntityChangeDeleted); err != nil {
+		return stacktrace.Propagate(err, "Error recording deletion of Operation")
+	}
 	res, err := s.q.Exec(ctx, deleteOperationQuery, uid)
 	if err != nil {
 		return stacktrace.Propagate(err, "Error in query: %s", deleteOperationQuery)
@@ -197,15 +207,15 @@ func (s *repo) DeleteOperationalIntent(ctx context.Context, id dssmodels.ID) err
 
 // UpsertOperation implements repos.Operation.UpsertOperation.
 func (s *repo) UpsertOperationalIntent(ctx context.Context, operation *scdmodels.OperationalIntent) (*scdmodels.OperationalIntent, error) {
+	upsert, onConflict := s.dialect.UpsertClauses("scd_operations", "id", operationFieldsWithoutPrefix)
 	var (
 		upsertOperationsQuery = fmt.Sprintf(`
-			UPSERT INTO
-				scd_operations
-				(%s)
+			%s
 			VALUES
 				($1, $2, $3, $4, $5, $6, $7, $8, $9, transaction_timestamp(), $10, $11)
+			%s
 			RETURNING
-				%s`, operationFieldsWithoutPrefix, operationFieldsWithPrefix)
+				%s`, upsert, onConflict, operationFieldsWithPrefix)
 	)
 
 	cids := make([]int64, len(operation.Cells))
@@ -229,11 +239,19 @@ func (s *repo) UpsertOperationalIntent(ctx context.Context, operation *scdmodels
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to convert id to PgUUID")
 	}
+	priority, offNominalSince := operation.Priority, operation.OffNominalSince
+	if !s.operationalIntentMetadata && priority != 0 {
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "OperationalIntent priorities are not supported by the current database schema")
+	}
+	url, err := s.encryptor.Encrypt(ctx, operationURLColumn, operation.USSBaseURL)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error encrypting Operation URL")
+	}
 	operation, err = s.fetchOperationalIntent(ctx, s.q, upsertOperationsQuery,
 		opid,
 		operation.Manager,
 		operation.Version,
-		operation.USSBaseURL,
+		url,
 		operation.AltitudeLower,
 		operation.AltitudeUpper,
 		operation.StartTime,
@@ -246,9 +264,84 @@ func (s *repo) UpsertOperationalIntent(ctx context.Context, operation *scdmodels
 		return nil, stacktrace.Propagate(err, "Error fetching Operation")
 	}
 
+	if s.operationalIntentMetadata {
+		upsertMetadata, onConflictMetadata := s.dialect.UpsertClauses("scd_operation_metadata", "id", "id,priority,off_nominal_since")
+		upsertMetadataQuery := fmt.Sprintf(`
+			%s
+			VALUES
+				($1, $2, $3)
+			%s`, upsertMetadata, onConflictMetadata)
+		if _, err := s.q.Exec(ctx, upsertMetadataQuery, opid, priority, offNominalSince); err != nil {
+			return nil, stacktrace.Propagate(err, "Error in query: %s", upsertMetadataQuery)
+		}
+		operation.Priority = priority
+		operation.OffNominalSince = offNominalSince
+	}
+
+	change := scdmodels.EntityChangeUpdated
+	if operation.Version == 1 {
+		change = scdmodels.EntityChangeCreated
+	}
+	if err := s.recordEntityChange(ctx, "scd_operations", scdmodels.EntityTypeOperationalIntent, opid, change); err != nil {
+		return nil, stacktrace.Propagate(err, "Error recording change of Operation")
+	}
+
 	return operation, nil
 }
 
+// populateOperationalIntentMetadata sets the Priority and OffNominalSince of
+// ops from the scd_operation_metadata table.
+func (s *repo) populateOperationalIntentMetadata(ctx context.Context, q dsssql.Queryable, ops []*scdmodels.OperationalIntent) error {
+	if !s.operationalIntentMetadata || len(ops) == 0 {
+		return nil
+	}
+
+	const query = `
+		SELECT
+			id, priority, off_nominal_since
+		FROM
+			scd_operation_metadata
+		WHERE
+			id = ANY($1)`
+
+	ids := make([]string, len(ops))
+	byID := make(map[dssmodels.ID]*scdmodels.OperationalIntent, len(ops))
+	for i, op := range ops {
+		ids[i] = op.ID.String()
+		byID[op.ID] = op
+	}
+	var pgIds pgtype.UUIDArray
+	if err := pgIds.Set(ids); err != nil {
+		return stacktrace.Propagate(err, "Failed to convert array to jackc/pgtype")
+	}
+
+	rows, err := q.Query(ctx, query, pgIds)
+	if err != nil {
+		return stacktrace.Propagate(err, "Error in query: %s", query)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id              dssmodels.ID
+			priority        int32
+			offNominalSince *time.Time
+		)
+		if err := rows.Scan(&id, &priority, &offNominalSince); err != nil {
+			return stacktrace.Propagate(err, "Error scanning Operation metadata row")
+		}
+		if op, ok := byID[id]; ok {
+			op.Priority = priority
+			op.OffNominalSince = offNominalSince
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return stacktrace.Propagate(err, "Error in rows query result")
+	}
+
+	return nil
+}
+
 func (s *repo) searchOperationalIntents(ctx context.Context, q dsssql.Queryable, v4d *dssmodels.Volume4D) ([]*scdmodels.OperationalIntent, error) {
 	var (
 		operationsIntersectingVolumeQuery = fmt.Sprintf(`
@@ -311,6 +404,95 @@ func (s *repo) SearchOperationalIntents(ctx context.Context, v4d *dssmodels.Volu
 	return s.searchOperationalIntents(ctx, s.q, v4d)
 }
 
+// ListActiveOperationalIntents implements repos.Operation.ListActiveOperationalIntents.
+func (s *repo) ListActiveOperationalIntents(ctx context.Context, t time.Time) ([]*scdmodels.OperationalIntent, error) {
+	var query = fmt.Sprintf(`
+		SELECT
+			%s
+		FROM
+			scd_operations
+		WHERE
+			COALESCE(ends_at >= $1, true)
+		LIMIT $2`, operationFieldsWithPrefix)
+
+	result, err := s.fetchOperationalIntents(ctx, s.q, query, t, dssmodels.MaxResultLimit)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error fetching Operations")
+	}
+	return result, nil
+}
+
+// ExpireOperationalIntent implements repos.Operation.ExpireOperationalIntent.
+func (s *repo) ExpireOperationalIntent(ctx context.Context, id dssmodels.ID, t time.Time) error {
+	const expireQuery = `
+		UPDATE
+			scd_operations
+		SET
+			starts_at = LEAST(starts_at, $2), ends_at = $2, updated_at = transaction_timestamp()
+		WHERE
+			id = $1`
+
+	uid, err := id.PgUUID()
+	if err != nil {
+		return stacktrace.Propagate(err, "Failed to convert id to PgUUID")
+	}
+	res, err := s.q.Exec(ctx, expireQuery, uid, t)
+	if err != nil {
+		return stacktrace.Propagate(err, "Error in query: %s", expireQuery)
+	}
+	if res.RowsAffected() == 0 {
+		return stacktrace.NewError("Could not expire Operation that does not exist")
+	}
+	if err := s.recordEntityChange(ctx, "scd_operations", scdmodels.EntityTypeOperationalIntent, uid, scdmodels.EntityChangeUpdated); err != nil {
+		return stacktrace.Propagate(err, "Error recording change of Operation")
+	}
+	return nil
+}
+
+// ListOperationalIntentsByManager implements repos.Operation.ListOperationalIntentsByManager.
+func (s *repo) ListOperationalIntentsByManager(ctx context.Context, manager dssmodels.Manager, after dssmodels.ID, limit int) ([]*scdmodels.OperationalIntent, error) {
+	var query = fmt.Sprintf(`
+		SELECT
+			%s
+		FROM
+			scd_operations
+		WHERE
+			owner = $1
+		AND
+			id > $2
+		ORDER BY id
+		LIMIT $3`, operationFieldsWithPrefix)
+
+	uid, err := pageCursor(after)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+	result, err := s.fetchOperationalIntents(ctx, s.q, query, manager, uid, limit)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error fetching Operations")
+	}
+	return result, nil
+}
+
+// ListExpiredOperationalIntents implements
+// repos.OperationalIntent.ListExpiredOperationalIntents.
+func (s *repo) ListExpiredOperationalIntents(ctx context.Context, t time.Time, limit int) ([]*scdmodels.OperationalIntent, error) {
+	var query = fmt.Sprintf(`
+		SELECT
+			%s
+		FROM
+			scd_operations
+		WHERE
+			ends_at < $1
+		LIMIT $2`, operationFieldsWithPrefix)
+
+	result, err := s.fetchOperationalIntents(ctx, s.q, query, t, limit)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error fetching Operations")
+	}
+	return result, nil
+}
+
 // GetDependentOperations implements repos.Operation.GetDependentOperations.
 func (s *repo) GetDependentOperationalIntents(ctx context.Context, subscriptionID dssmodels.ID) ([]dssmodels.ID, error) {
 	var dependentOperationsQuery = `
@@ -2,14 +2,17 @@ package cockroach
 
 import (
 	"context"
-	"github.com/cockroachdb/cockroach-go/v2/crdb"
-	"github.com/cockroachdb/cockroach-go/v2/crdb/crdbpgx"
+
 	"github.com/coreos/go-semver/semver"
+	"github.com/google/uuid"
 	"github.com/interuss/dss/pkg/cockroach"
 	"github.com/interuss/dss/pkg/cockroach/flags"
+	"github.com/interuss/dss/pkg/encryption"
+	dssmodels "github.com/interuss/dss/pkg/models"
 	"github.com/interuss/dss/pkg/scd/repos"
 	dsssql "github.com/interuss/dss/pkg/sql"
 	"github.com/interuss/stacktrace"
+	"github.com/jackc/pgtype"
 	"github.com/jackc/pgx/v4"
 	"github.com/jonboulle/clockwork"
 	"go.uber.org/zap"
@@ -20,28 +23,108 @@ const (
 	currentMajorSchemaVersion = 3
 )
 
+var (
+	// ussAvailabilitySchemaVersion is the first schema version providing the
+	// scd_uss_availability table.
+	ussAvailabilitySchemaVersion = *semver.New("3.1.0")
+
+	// operationalIntentMetadataSchemaVersion is the first schema version
+	// providing the scd_operation_metadata table.
+	operationalIntentMetadataSchemaVersion = *semver.New("3.2.0")
+
+	// notificationDeliveriesSchemaVersion is the first schema version providing
+	// the scd_notification_deliveries table.
+	notificationDeliveriesSchemaVersion = *semver.New("3.3.0")
+
+	// dssReportsSchemaVersion is the first schema version providing the
+	// scd_dss_reports table.
+	dssReportsSchemaVersion = *semver.New("3.4.0")
+
+	// entityChangesSchemaVersion is the first schema version providing the
+	// scd_entity_changes table.
+	entityChangesSchemaVersion = *semver.New("3.5.0")
+
+	// notifiedConstraintsSchemaVersion is the first schema version recording
+	// the notified Constraint with each notification delivery.
+	notifiedConstraintsSchemaVersion = *semver.New("3.6.0")
+
+	// MinimumSchemaVersion is the oldest strategic conflict detection schema
+	// version this Store understands.
+	MinimumSchemaVersion = *semver.New("3.0.0")
+
+	// LatestSchemaVersion is the latest strategic conflict detection schema
+	// version this Store understands; the Store refuses newer schemas, whose
+	// data it could corrupt.
+	LatestSchemaVersion = notifiedConstraintsSchemaVersion
+)
+
 var (
 	// DefaultClock is what is used as the Store's clock, returned from Dial.
 	DefaultClock = clockwork.NewRealClock()
 
 	// DatabaseName is the name of database storing strategic conflict detection data.
 	DatabaseName = "scd"
+
+	// EntityTables maps the strategic conflict detection entity types to the
+	// tables storing them, for cockroach.DB.RecordEntityCounts.
+	EntityTables = map[string]string{
+		"operational_intent": "scd_operations",
+		"constraint":         "scd_constraints",
+		"subscription":       "scd_subscriptions",
+	}
 )
 
 // repo is an implementation of repos.Repo using
 // a CockroachDB transaction.
 type repo struct {
-	q      dsssql.Queryable
-	logger *zap.Logger
-	clock  clockwork.Clock
+	q       dsssql.Queryable
+	logger  *zap.Logger
+	clock   clockwork.Clock
+	dialect cockroach.Dialect
+
+	// encryptor encrypts the values of the columns selected for encryption;
+	// nil when no column is encrypted.
+	encryptor *encryption.Encryptor
+
+	// ussAvailability is true when the schema stores USS availabilities.
+	ussAvailability bool
+
+	// operationalIntentMetadata is true when the schema stores the priority and
+	// off-nominal state of OperationalIntents.
+	operationalIntentMetadata bool
+
+	// notificationDeliveries is true when the schema records notification
+	// deliveries.
+	notificationDeliveries bool
+
+	// dssReports is true when the schema stores DSS reports.
+	dssReports bool
+
+	// entityChanges is true when the schema records changes made to
+	// OperationalIntents and Constraints.
+	entityChanges bool
+
+	// notifiedConstraints is true when the schema records the notified
+	// Constraint with each notification delivery.
+	notifiedConstraints bool
 }
 
 // Store is an implementation of an scd.Store using
 // a CockroachDB database.
 type Store struct {
-	db     *cockroach.DB
-	logger *zap.Logger
-	clock  clockwork.Clock
+	// Encryptor, when set, encrypts the values of the selected
+	// EncryptableColumns written by s, and decrypts them when read.
+	Encryptor *encryption.Encryptor
+
+	db                        *cockroach.DB
+	logger                    *zap.Logger
+	clock                     clockwork.Clock
+	ussAvailability           bool
+	operationalIntentMetadata bool
+	notificationDeliveries    bool
+	dssReports                bool
+	entityChanges             bool
+	notifiedConstraints       bool
 }
 
 // NewStore returns a Store instance connected to a cockroach instance via db.
@@ -56,9 +139,26 @@ func NewStore(ctx context.Context, db *cockroach.DB, logger *zap.Logger) (*Store
 		return nil, stacktrace.Propagate(err, "Strategic conflict detection schema version check failed")
 	}
 
+	vs, err := store.GetVersion(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to get database schema version for strategic conflict detection")
+	}
+	store.ussAvailability = !vs.LessThan(ussAvailabilitySchemaVersion)
+	store.operationalIntentMetadata = !vs.LessThan(operationalIntentMetadataSchemaVersion)
+	store.notificationDeliveries = !vs.LessThan(notificationDeliveriesSchemaVersion)
+	store.dssReports = !vs.LessThan(dssReportsSchemaVersion)
+	store.entityChanges = !vs.LessThan(entityChangesSchemaVersion)
+	store.notifiedConstraints = !vs.LessThan(notifiedConstraintsSchemaVersion)
+
 	return store, nil
 }
 
+// SupportsNotificationDeliveries returns whether the schema of s records the
+// delivery of notifications by the DSS, along with the notified Constraints.
+func (s *Store) SupportsNotificationDeliveries() bool {
+	return s.notifiedConstraints
+}
+
 // CheckCurrentMajorSchemaVersion returns nil if s supports the current major schema version.
 func (s *Store) CheckCurrentMajorSchemaVersion(ctx context.Context) error {
 	vs, err := s.GetVersion(ctx)
@@ -73,26 +173,48 @@ func (s *Store) CheckCurrentMajorSchemaVersion(ctx context.Context) error {
 		return stacktrace.NewError("Unsupported schema version for strategic conflict detection! Got %s, requires major version of %d. Please check https://github.com/interuss/dss/tree/master/build#updgrading-database-schemas", vs, currentMajorSchemaVersion)
 	}
 
+	if vs.LessThan(MinimumSchemaVersion) {
+		return stacktrace.NewError("Unsupported schema version for strategic conflict detection! Got %s, which is older than the minimum version %s supported by this DSS. Please check https://github.com/interuss/dss/tree/master/build#updgrading-database-schemas", vs, MinimumSchemaVersion)
+	}
+
+	if LatestSchemaVersion.LessThan(*vs) {
+		return stacktrace.NewError("Unsupported schema version for strategic conflict detection! Got %s, which is newer than the latest version %s supported by this DSS. Please upgrade the DSS before migrating its schema", vs, LatestSchemaVersion)
+	}
+
 	return nil
 }
 
 // Interact implements store.Interactor interface.
 func (s *Store) Interact(_ context.Context) (repos.Repository, error) {
 	return &repo{
-		q:      s.db.Pool,
-		logger: s.logger,
-		clock:  s.clock,
+		q:                         s.db.Instrument(s.db.Pool),
+		logger:                    s.logger,
+		clock:                     s.clock,
+		dialect:                   s.db.Dialect,
+		ussAvailability:           s.ussAvailability,
+		operationalIntentMetadata: s.operationalIntentMetadata,
+		notificationDeliveries:    s.notificationDeliveries,
+		dssReports:                s.dssReports,
+		entityChanges:             s.entityChanges,
+		notifiedConstraints:       s.notifiedConstraints,
 	}, nil
 }
 
 // Transact implements store.Transactor interface.
 func (s *Store) Transact(ctx context.Context, f func(context.Context, repos.Repository) error) error {
-	ctx = crdb.WithMaxRetries(ctx, flags.ConnectParameters().MaxRetries)
-	return crdbpgx.ExecuteTx(ctx, s.db.Pool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+	return s.db.ExecuteTx(ctx, flags.ConnectParameters().MaxRetries, func(tx pgx.Tx) error {
 		return f(ctx, &repo{
-			q:      tx,
-			logger: s.logger,
-			clock:  s.clock,
+			q:                         s.db.Instrument(tx),
+			logger:                    s.logger,
+			clock:                     s.clock,
+			dialect:                   s.db.Dialect,
+			encryptor:                 s.Encryptor,
+			ussAvailability:           s.ussAvailability,
+			operationalIntentMetadata: s.operationalIntentMetadata,
+			notificationDeliveries:    s.notificationDeliveries,
+			dssReports:                s.dssReports,
+			entityChanges:             s.entityChanges,
+			notifiedConstraints:       s.notifiedConstraints,
 		})
 	})
 }
@@ -108,3 +230,16 @@ func (s *Store) Close() error {
 func (s *Store) GetVersion(ctx context.Context) (*semver.Version, error) {
 	return s.db.GetVersion(ctx, DatabaseName)
 }
+
+// pageCursor returns the UUID after which a page of entities ordered by ID
+// starts, where an empty after starts from the first entity.
+func pageCursor(after dssmodels.ID) (*pgtype.UUID, error) {
+	if after.Empty() {
+		after = dssmodels.ID(uuid.Nil.String())
+	}
+	uid, err := after.PgUUID()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to convert page cursor to PgUUID")
+	}
+	return uid, nil
+}
@@ -130,6 +130,9 @@ func (c *repo) fetchSubscriptions(ctx context.Context, q dsssql.Queryable, query
 			return nil, stacktrace.Propagate(err, "Error Converting jackc/pgtype to array")
 		}
 		s.SetCells(cids)
+		if s.USSBaseURL, err = c.encryptor.Decrypt(ctx, subscriptionURLColumn, s.USSBaseURL); err != nil {
+			return nil, stacktrace.Propagate(err, "Error decrypting Subscription URL")
+		}
 		payload = append(payload, s)
 	}
 	if err = rows.Err(); err != nil {
@@ -182,23 +185,15 @@ func (c *repo) fetchSubscriptionByID(ctx context.Context, q dsssql.Queryable, id
 }
 
 func (c *repo) pushSubscription(ctx context.Context, q dsssql.Queryable, s *scdmodels.Subscription) (*scdmodels.Subscription, error) {
+	upsert, onConflict := c.dialect.UpsertClauses("scd_subscriptions", "id", subscriptionFieldsWithoutPrefix)
 	var (
 		upsertQuery = fmt.Sprintf(`
-		WITH v AS (
-			SELECT
-				version
-			FROM
-				scd_subscriptions
-			WHERE
-				id = $1
-		)
-		UPSERT INTO
-		  scd_subscriptions
-		  (%s)
+		%s
 		VALUES
 			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, transaction_timestamp())
+		%s
 		RETURNING
-			%s`, subscriptionFieldsWithoutPrefix, subscriptionFieldsWithPrefix)
+			%s`, upsert, onConflict, subscriptionFieldsWithPrefix)
 	)
 
 	cids := make([]int64, len(s.Cells))
@@ -218,11 +213,15 @@ func (c *repo) pushSubscription(ctx context.Context, q dsssql.Queryable, s *scdm
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to convert id to PgUUID")
 	}
+	url, err := c.encryptor.Encrypt(ctx, subscriptionURLColumn, s.USSBaseURL)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error encrypting Subscription URL")
+	}
 	s, err = c.fetchSubscription(ctx, q, upsertQuery,
 		id,
 		s.Manager,
 		0,
-		s.USSBaseURL,
+		url,
 		s.NotificationIndex,
 		s.NotifyForOperationalIntents,
 		s.NotifyForConstraints,
@@ -337,13 +336,94 @@ func (c *repo) SearchSubscriptions(ctx context.Context, v4d *dssmodels.Volume4D)
 	return subscriptions, nil
 }
 
+// Implements scd.repos.Subscription.ListSubscriptionsByManager
+func (c *repo) ListSubscriptionsByManager(ctx context.Context, manager dssmodels.Manager, after dssmodels.ID, limit int) ([]*scdmodels.Subscription, error) {
+	var query = fmt.Sprintf(`
+		SELECT
+			%s
+		FROM
+			scd_subscriptions
+		WHERE
+			owner = $1
+		AND
+			id > $2
+		ORDER BY id
+		LIMIT $3`, subscriptionFieldsWithPrefix)
+
+	uid, err := pageCursor(after)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+	subscriptions, err := c.fetchSubscriptions(ctx, c.q, query, manager, uid, limit)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Unable to fetch Subscriptions")
+	}
+	return subscriptions, nil
+}
+
+// Implements scd.repos.Subscription.ListIdleImplicitSubscriptions
+func (c *repo) ListIdleImplicitSubscriptions(ctx context.Context, retention time.Duration, limit int) ([]*scdmodels.Subscription, error) {
+	var query = fmt.Sprintf(`
+		SELECT
+			%s
+		FROM
+			scd_subscriptions
+		WHERE
+			implicit = true
+		AND
+			NOT EXISTS (
+				SELECT
+					1
+				FROM
+					scd_operations
+				WHERE
+					scd_operations.subscription_id = scd_subscriptions.id
+				AND
+					COALESCE(scd_operations.ends_at >= transaction_timestamp() - $1::INT8 * INTERVAL '1 microsecond', true)
+			)
+		LIMIT $2`, subscriptionFieldsWithPrefix)
+
+	subscriptions, err := c.fetchSubscriptions(ctx, c.q, query, retention.Microseconds(), limit)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Unable to fetch Subscriptions")
+	}
+	return subscriptions, nil
+}
+
+// Implements scd.repos.Subscription.ListExpiredSubscriptions
+func (c *repo) ListExpiredSubscriptions(ctx context.Context, t time.Time, limit int) ([]*scdmodels.Subscription, error) {
+	var query = fmt.Sprintf(`
+		SELECT
+			%s
+		FROM
+			scd_subscriptions
+		WHERE
+			ends_at < $1
+		AND
+			NOT EXISTS (
+				SELECT
+					1
+				FROM
+					scd_operations
+				WHERE
+					scd_operations.subscription_id = scd_subscriptions.id
+			)
+		LIMIT $2`, subscriptionFieldsWithPrefix)
+
+	subscriptions, err := c.fetchSubscriptions(ctx, c.q, query, t, limit)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Unable to fetch Subscriptions")
+	}
+	return subscriptions, nil
+}
+
 // Implements scd.repos.Subscription.IncrementNotificationIndices
 func (c *repo) IncrementNotificationIndices(ctx context.Context, subscriptionIds []dssmodels.ID) ([]int, error) {
-	var updateQuery = `
+	var updateQuery = fmt.Sprintf(`
 			UPDATE scd_subscriptions
-			SET notification_index = notification_index + 1
+			SET notification_index = %s
 			WHERE id = ANY($1)
-			RETURNING notification_index`
+			RETURNING notification_index`, dssmodels.NextNotificationIndexSQL("notification_index"))
 
 	ids := make([]string, len(subscriptionIds))
 	for i, id := range subscriptionIds {
@@ -0,0 +1,71 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	dssmodels "github.com/interuss/dss/pkg/models"
+	scdmodels "github.com/interuss/dss/pkg/scd/models"
+	"github.com/jackc/pgx/v4"
+)
+
+// availabilityRecord is a stored UssAvailabilityStatus.
+type availabilityRecord struct {
+	availability scdmodels.UssAvailabilityState
+	updatedAt    time.Time
+}
+
+// toModel returns the UssAvailabilityStatus of uss stored in r, as it would
+// be read from the database.
+func (r *availabilityRecord) toModel(uss dssmodels.Manager) *scdmodels.UssAvailabilityStatus {
+	return &scdmodels.UssAvailabilityStatus{
+		Uss:          uss,
+		Availability: r.availability,
+		Version:      scdmodels.NewOVNFromTime(r.updatedAt, uss.String()),
+	}
+}
+
+// UpsertUssAvailability implements repos.UssAvailability.UpsertUssAvailability.
+func (r *repo) UpsertUssAvailability(ctx context.Context, ussa *scdmodels.UssAvailabilityStatus) (*scdmodels.UssAvailabilityStatus, error) {
+	var result *scdmodels.UssAvailabilityStatus
+	err := r.write(func(s *state, now time.Time) error {
+		rec := &availabilityRecord{availability: ussa.Availability, updatedAt: now}
+		s.availabilities[ussa.Uss] = rec
+		result = rec.toModel(ussa.Uss)
+		return nil
+	})
+	return result, err
+}
+
+// GetUssAvailability implements repos.UssAvailability.GetUssAvailability.
+func (r *repo) GetUssAvailability(ctx context.Context, ussID dssmodels.Manager) (*scdmodels.UssAvailabilityStatus, error) {
+	var result *scdmodels.UssAvailabilityStatus
+	err := r.read(func(s *state) error {
+		rec, ok := s.availabilities[ussID]
+		if !ok {
+			return pgx.ErrNoRows
+		}
+		result = rec.toModel(ussID)
+		return nil
+	})
+	return result, err
+}
+
+// GetUssAvailabilities implements repos.UssAvailability.GetUssAvailabilities.
+func (r *repo) GetUssAvailabilities(ctx context.Context, ussIDs []dssmodels.Manager) ([]*scdmodels.UssAvailabilityStatus, error) {
+	if len(ussIDs) == 0 {
+		return nil, nil
+	}
+	var result []*scdmodels.UssAvailabilityStatus
+	err := r.read(func(s *state) error {
+		found := map[dssmodels.Manager]bool{}
+		for _, id := range ussIDs {
+			if rec, ok := s.availabilities[id]; ok && !found[id] {
+				found[id] = true
+				result = append(result, rec.toModel(id))
+			}
+		}
+		return nil
+	})
+	return result, err
+}
@@ -0,0 +1,158 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/golang/geo/s2"
+	"github.com/interuss/dss/pkg/geo"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	scdmodels "github.com/interuss/dss/pkg/scd/models"
+	"github.com/interuss/stacktrace"
+	"github.com/jackc/pgx/v4"
+)
+
+// constraintRecord is a stored Constraint.
+type constraintRecord struct {
+	constraint scdmodels.Constraint
+	updatedAt  time.Time
+}
+
+// toModel returns a copy of the Constraint of r, as it would be read from the
+// database.
+func (r *constraintRecord) toModel() *scdmodels.Constraint {
+	c := r.constraint
+	c.Cells = append(s2.CellUnion{}, r.constraint.Cells...)
+	c.StartTime = copyTime(r.constraint.StartTime)
+	c.EndTime = copyTime(r.constraint.EndTime)
+	c.AltitudeLower = copyFloat(r.constraint.AltitudeLower)
+	c.AltitudeUpper = copyFloat(r.constraint.AltitudeUpper)
+	c.OVN = scdmodels.NewOVNFromTime(r.updatedAt, c.ID.String())
+	return &c
+}
+
+// GetConstraint implements repos.Constraint.GetConstraint.
+func (r *repo) GetConstraint(ctx context.Context, id dssmodels.ID) (*scdmodels.Constraint, error) {
+	var result *scdmodels.Constraint
+	err := r.read(func(s *state) error {
+		rec, ok := s.constraints[id]
+		if !ok {
+			return pgx.ErrNoRows
+		}
+		result = rec.toModel()
+		return nil
+	})
+	return result, err
+}
+
+// UpsertConstraint implements repos.Constraint.UpsertConstraint.
+func (r *repo) UpsertConstraint(ctx context.Context, constraint *scdmodels.Constraint) (*scdmodels.Constraint, error) {
+	for _, cell := range constraint.Cells {
+		if err := geo.ValidateCell(cell); err != nil {
+			return nil, stacktrace.Propagate(err, "Error validating cell")
+		}
+	}
+	var result *scdmodels.Constraint
+	err := r.write(func(s *state, now time.Time) error {
+		if len(constraint.Cells) == 0 {
+			return stacktrace.NewError("Constraint cells must not be empty")
+		}
+		if err := validateExtents(constraint.StartTime, constraint.EndTime); err != nil {
+			return stacktrace.Propagate(err, "Invalid Constraint")
+		}
+		rec := &constraintRecord{
+			constraint: scdmodels.Constraint{
+				ID:            constraint.ID,
+				Manager:       constraint.Manager,
+				Version:       constraint.Version,
+				StartTime:     copyTime(constraint.StartTime),
+				EndTime:       copyTime(constraint.EndTime),
+				USSBaseURL:    constraint.USSBaseURL,
+				AltitudeLower: copyFloat(constraint.AltitudeLower),
+				AltitudeUpper: copyFloat(constraint.AltitudeUpper),
+				Cells:         append(s2.CellUnion{}, constraint.Cells...),
+			},
+			updatedAt: now,
+		}
+		s.constraints[constraint.ID] = rec
+
+		change := scdmodels.EntityChangeUpdated
+		if constraint.Version == 1 {
+			change = scdmodels.EntityChangeCreated
+		}
+		s.recordEntityChange(scdmodels.EntityTypeConstraint, constraint.ID, change, rec.constraint.Manager, rec.constraint.Version, rec.constraint.Cells, now)
+		result = rec.toModel()
+		return nil
+	})
+	return result, err
+}
+
+// DeleteConstraint implements repos.Constraint.DeleteConstraint.
+func (r *repo) DeleteConstraint(ctx context.Context, id dssmodels.ID) error {
+	return r.write(func(s *state, now time.Time) error {
+		rec, ok := s.constraints[id]
+		if !ok {
+			return pgx.ErrNoRows
+		}
+		s.recordEntityChange(scdmodels.EntityTypeConstraint, id, scdmodels.EntityChangeDeleted, rec.constraint.Manager, rec.constraint.Version, rec.constraint.Cells, now)
+		delete(s.constraints, id)
+		return nil
+	})
+}
+
+// SearchConstraints implements repos.Constraint.SearchConstraints.
+func (r *repo) SearchConstraints(ctx context.Context, v4d *dssmodels.Volume4D) ([]*scdmodels.Constraint, error) {
+	cells, err := v4d.CalculateSpatialCovering()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Could not calculate spatial covering")
+	}
+	if len(cells) == 0 {
+		return []*scdmodels.Constraint{}, nil
+	}
+
+	var altitudeLo, altitudeHi *float32
+	if v4d.SpatialVolume != nil {
+		altitudeLo = v4d.SpatialVolume.AltitudeLo
+		altitudeHi = v4d.SpatialVolume.AltitudeHi
+	}
+
+	return r.listConstraints(func(rec *constraintRecord) bool {
+		return intersects(rec.constraint.Cells, cells) &&
+			during(rec.constraint.StartTime, rec.constraint.EndTime, v4d.StartTime, v4d.EndTime) &&
+			overlaps(rec.constraint.AltitudeLower, rec.constraint.AltitudeUpper, altitudeLo, altitudeHi)
+	}, dssmodels.MaxResultLimit)
+}
+
+// ListConstraintsByManager implements repos.Constraint.ListConstraintsByManager.
+func (r *repo) ListConstraintsByManager(ctx context.Context, manager dssmodels.Manager, after dssmodels.ID, limit int) ([]*scdmodels.Constraint, error) {
+	return r.listConstraints(func(rec *constraintRecord) bool {
+		return rec.constraint.Manager == manager && rec.constraint.ID > after
+	}, limit)
+}
+
+// ListExpiredConstraints implements repos.Constraint.ListExpiredConstraints.
+func (r *repo) ListExpiredConstraints(ctx context.Context, t time.Time, limit int) ([]*scdmodels.Constraint, error) {
+	return r.listConstraints(func(rec *constraintRecord) bool {
+		return rec.constraint.EndTime != nil && rec.constraint.EndTime.Before(t)
+	}, limit)
+}
+
+// listConstraints returns the Constraints matching filter, in ID order, up to
+// limit Constraints.
+func (r *repo) listConstraints(filter func(rec *constraintRecord) bool, limit int) ([]*scdmodels.Constraint, error) {
+	var result []*scdmodels.Constraint
+	err := r.read(func(s *state) error {
+		for _, rec := range s.constraints {
+			if filter(rec) {
+				result = append(result, rec.toModel())
+			}
+		}
+		return nil
+	})
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result, err
+}
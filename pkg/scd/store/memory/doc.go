@@ -0,0 +1,4 @@
+// Package memory provides an implementation of an scd.Store holding
+// strategic conflict detection data in memory, for tests and mock deployments
+// without a database.
+package memory
@@ -0,0 +1,62 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/interuss/dss/pkg/api/v1/scdpb"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	scdmodels "github.com/interuss/dss/pkg/scd/models"
+	"github.com/interuss/stacktrace"
+	"google.golang.org/protobuf/proto"
+)
+
+// copyDssReport returns a copy of r which shares none of its mutable data.
+func copyDssReport(r *scdmodels.DssReport) *scdmodels.DssReport {
+	report := *r
+	if r.Exchange != nil {
+		report.Exchange = proto.Clone(r.Exchange).(*scdpb.ExchangeRecord)
+	}
+	report.DssRecords = make([]*scdmodels.DssReportRecord, len(r.DssRecords))
+	for i, record := range r.DssRecords {
+		rec := *record
+		report.DssRecords[i] = &rec
+	}
+	return &report
+}
+
+// InsertDssReport implements repos.DssReport.InsertDssReport.
+func (r *repo) InsertDssReport(ctx context.Context, report *scdmodels.DssReport) (*scdmodels.DssReport, error) {
+	var result *scdmodels.DssReport
+	err := r.write(func(s *state, now time.Time) error {
+		for _, existing := range s.reports {
+			if existing.ID == report.ID {
+				return stacktrace.NewError("DssReport %s already exists", report.ID)
+			}
+		}
+		stored := copyDssReport(report)
+		stored.CreatedAt = now
+		s.reports = append(s.reports, stored)
+		result = copyDssReport(stored)
+		return nil
+	})
+	return result, err
+}
+
+// SearchDssReports implements repos.DssReport.SearchDssReports.
+func (r *repo) SearchDssReports(ctx context.Context, reporter dssmodels.Manager, earliest *time.Time, latest *time.Time) ([]*scdmodels.DssReport, error) {
+	var result []*scdmodels.DssReport
+	err := r.read(func(s *state) error {
+		// Reports are stored in creation order
+		for i := len(s.reports) - 1; i >= 0 && len(result) < dssmodels.MaxResultLimit; i-- {
+			report := s.reports[i]
+			if (reporter == "" || report.Reporter == reporter) &&
+				(earliest == nil || !report.CreatedAt.Before(*earliest)) &&
+				(latest == nil || !report.CreatedAt.After(*latest)) {
+				result = append(result, copyDssReport(report))
+			}
+		}
+		return nil
+	})
+	return result, err
+}
@@ -0,0 +1,66 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/geo/s2"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	scdmodels "github.com/interuss/dss/pkg/scd/models"
+)
+
+// recordEntityChange records a change made at time now to the entity of type
+// entityType identified by id.  Deletions must be recorded before deleting the
+// entity.
+func (s *state) recordEntityChange(entityType string, id dssmodels.ID, change scdmodels.EntityChangeType, manager dssmodels.Manager, version scdmodels.VersionNumber, cells s2.CellUnion, now time.Time) {
+	s.lastCursor++
+	s.entityChanges = append(s.entityChanges, &scdmodels.EntityChange{
+		Cursor:     s.lastCursor,
+		EntityType: entityType,
+		EntityID:   id,
+		Change:     change,
+		Manager:    manager,
+		Version:    version,
+		Cells:      append(s2.CellUnion{}, cells...),
+		OccurredAt: now,
+	})
+}
+
+// SearchEntityChanges implements repos.EntityChange.SearchEntityChanges.
+func (r *repo) SearchEntityChanges(ctx context.Context, cells s2.CellUnion, after int64, limit int) ([]*scdmodels.EntityChange, error) {
+	var result []*scdmodels.EntityChange
+	err := r.read(func(s *state) error {
+		// Changes are stored in cursor order
+		for _, ec := range s.entityChanges {
+			if len(result) >= limit {
+				break
+			}
+			if ec.Cursor > after && intersects(ec.Cells, cells) {
+				change := *ec
+				change.Cells = append(s2.CellUnion{}, ec.Cells...)
+				result = append(result, &change)
+			}
+		}
+		return nil
+	})
+	return result, err
+}
+
+// DeleteEntityChangesBefore implements
+// repos.EntityChange.DeleteEntityChangesBefore.
+func (r *repo) DeleteEntityChangesBefore(ctx context.Context, t time.Time) (int64, error) {
+	var deleted int64
+	err := r.write(func(s *state, now time.Time) error {
+		var kept []*scdmodels.EntityChange
+		for _, ec := range s.entityChanges {
+			if ec.OccurredAt.Before(t) {
+				continue
+			}
+			kept = append(kept, ec)
+		}
+		deleted = int64(len(s.entityChanges) - len(kept))
+		s.entityChanges = kept
+		return nil
+	})
+	return deleted, err
+}
@@ -0,0 +1,73 @@
+package memory
+
+import (
+	"time"
+
+	"github.com/golang/geo/s2"
+	"github.com/interuss/stacktrace"
+)
+
+// validateExtents enforces the constraint of the database on the time extents
+// of entities.
+func validateExtents(startTime *time.Time, endTime *time.Time) error {
+	if startTime != nil && endTime != nil && !startTime.Before(*endTime) {
+		return stacktrace.NewError("Start time must be before end time")
+	}
+	return nil
+}
+
+// intersects returns whether a and b have a cell in common.
+func intersects(a s2.CellUnion, b s2.CellUnion) bool {
+	cells := make(map[s2.CellID]bool, len(b))
+	for _, cell := range b {
+		cells[cell] = true
+	}
+	for _, cell := range a {
+		if cells[cell] {
+			return true
+		}
+	}
+	return false
+}
+
+// during returns whether the interval from startTime to endTime overlaps the
+// interval from earliest to latest, where unset bounds are unlimited like
+// NULLs in the database comparisons.
+func during(startTime *time.Time, endTime *time.Time, earliest *time.Time, latest *time.Time) bool {
+	if endTime != nil && earliest != nil && endTime.Before(*earliest) {
+		return false
+	}
+	if startTime != nil && latest != nil && startTime.After(*latest) {
+		return false
+	}
+	return true
+}
+
+// overlaps returns whether the altitude range from lower to upper overlaps
+// the range from lo to hi, where unset bounds are unlimited like NULLs in the
+// database comparisons.
+func overlaps(lower *float32, upper *float32, lo *float32, hi *float32) bool {
+	if upper != nil && lo != nil && *upper < *lo {
+		return false
+	}
+	if lower != nil && hi != nil && *lower > *hi {
+		return false
+	}
+	return true
+}
+
+func copyTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	c := *t
+	return &c
+}
+
+func copyFloat(f *float32) *float32 {
+	if f == nil {
+		return nil
+	}
+	c := *f
+	return &c
+}
@@ -0,0 +1,118 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	dssmodels "github.com/interuss/dss/pkg/models"
+	scdmodels "github.com/interuss/dss/pkg/scd/models"
+	"github.com/interuss/stacktrace"
+)
+
+// InsertNotificationDeliveries implements
+// repos.NotificationDelivery.InsertNotificationDeliveries.
+func (r *repo) InsertNotificationDeliveries(ctx context.Context, deliveries []*scdmodels.NotificationDelivery) error {
+	return r.write(func(s *state, now time.Time) error {
+		for _, d := range deliveries {
+			if _, ok := s.deliveries[d.ID]; ok {
+				return stacktrace.NewError("NotificationDelivery %s already exists", d.ID)
+			}
+		}
+		for _, d := range deliveries {
+			delivery := *d
+			delivery.CreatedAt = now
+			delivery.UpdatedAt = now
+			s.deliveries[d.ID] = &delivery
+		}
+		return nil
+	})
+}
+
+// UpdateNotificationDelivery implements
+// repos.NotificationDelivery.UpdateNotificationDelivery.
+func (r *repo) UpdateNotificationDelivery(ctx context.Context, d *scdmodels.NotificationDelivery) error {
+	return r.write(func(s *state, now time.Time) error {
+		old, ok := s.deliveries[d.ID]
+		if !ok {
+			return stacktrace.NewError("Attempted to update non-existent NotificationDelivery")
+		}
+		delivery := *old
+		delivery.Status = d.Status
+		delivery.Attempts = d.Attempts
+		delivery.LastError = d.LastError
+		delivery.UpdatedAt = now
+		s.deliveries[d.ID] = &delivery
+		return nil
+	})
+}
+
+// SearchNotificationDeliveries implements
+// repos.NotificationDelivery.SearchNotificationDeliveries.
+func (r *repo) SearchNotificationDeliveries(ctx context.Context, subscriptionID dssmodels.ID, manager dssmodels.Manager) ([]*scdmodels.NotificationDelivery, error) {
+	var result []*scdmodels.NotificationDelivery
+	err := r.read(func(s *state) error {
+		for _, d := range s.deliveries {
+			if d.SubscriptionID == subscriptionID && d.Manager == manager {
+				delivery := *d
+				result = append(result, &delivery)
+			}
+		}
+		return nil
+	})
+	sort.Slice(result, func(i, j int) bool { return result[i].CreatedAt.After(result[j].CreatedAt) })
+	if len(result) > dssmodels.MaxResultLimit {
+		result = result[:dssmodels.MaxResultLimit]
+	}
+	return result, err
+}
+
+// ClaimNotificationDeliveries implements
+// repos.NotificationDelivery.ClaimNotificationDeliveries.
+func (r *repo) ClaimNotificationDeliveries(ctx context.Context, lease time.Duration, limit int) ([]*scdmodels.NotificationDelivery, error) {
+	var result []*scdmodels.NotificationDelivery
+	err := r.write(func(s *state, now time.Time) error {
+		result = nil
+		staleBefore := now.Add(-lease)
+		var claimable []*scdmodels.NotificationDelivery
+		for _, d := range s.deliveries {
+			if d.Status == scdmodels.NotificationDeliveryStatusPending && (d.UpdatedAt.Equal(d.CreatedAt) || d.UpdatedAt.Before(staleBefore)) {
+				claimable = append(claimable, d)
+			}
+		}
+		sort.Slice(claimable, func(i, j int) bool { return claimable[i].CreatedAt.Before(claimable[j].CreatedAt) })
+		if len(claimable) > limit {
+			claimable = claimable[:limit]
+		}
+		for _, d := range claimable {
+			delivery := *d
+			delivery.UpdatedAt = now
+			if delivery.UpdatedAt.Equal(delivery.CreatedAt) {
+				// Distinguish claimed deliveries from unclaimed ones.
+				delivery.UpdatedAt = delivery.UpdatedAt.Add(time.Nanosecond)
+			}
+			s.deliveries[d.ID] = &delivery
+			claimed := delivery
+			result = append(result, &claimed)
+		}
+		return nil
+	})
+	return result, err
+}
+
+// DeleteNotificationDeliveriesBefore implements
+// repos.NotificationDelivery.DeleteNotificationDeliveriesBefore.
+func (r *repo) DeleteNotificationDeliveriesBefore(ctx context.Context, t time.Time) (int64, error) {
+	var deleted int64
+	err := r.write(func(s *state, now time.Time) error {
+		deleted = 0
+		for id, d := range s.deliveries {
+			if d.CreatedAt.Before(t) {
+				delete(s.deliveries, id)
+				deleted++
+			}
+		}
+		return nil
+	})
+	return deleted, err
+}
@@ -0,0 +1,208 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/golang/geo/s2"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	scdmodels "github.com/interuss/dss/pkg/scd/models"
+	"github.com/interuss/stacktrace"
+)
+
+// operationRecord is a stored OperationalIntent.
+type operationRecord struct {
+	op        scdmodels.OperationalIntent
+	updatedAt time.Time
+}
+
+// toModel returns a copy of the OperationalIntent of r, as it would be read
+// from the database.
+func (r *operationRecord) toModel() *scdmodels.OperationalIntent {
+	op := r.op
+	op.Cells = append(s2.CellUnion{}, r.op.Cells...)
+	op.StartTime = copyTime(r.op.StartTime)
+	op.EndTime = copyTime(r.op.EndTime)
+	op.AltitudeLower = copyFloat(r.op.AltitudeLower)
+	op.AltitudeUpper = copyFloat(r.op.AltitudeUpper)
+	op.OffNominalSince = copyTime(r.op.OffNominalSince)
+	op.OVN = scdmodels.NewOVNFromTime(r.updatedAt, op.ID.String())
+	return &op
+}
+
+// GetOperationalIntent implements repos.OperationalIntent.GetOperationalIntent.
+func (r *repo) GetOperationalIntent(ctx context.Context, id dssmodels.ID) (*scdmodels.OperationalIntent, error) {
+	var result *scdmodels.OperationalIntent
+	err := r.read(func(s *state) error {
+		if rec, ok := s.operations[id]; ok {
+			result = rec.toModel()
+		}
+		return nil
+	})
+	return result, err
+}
+
+// DeleteOperationalIntent implements
+// repos.OperationalIntent.DeleteOperationalIntent.
+func (r *repo) DeleteOperationalIntent(ctx context.Context, id dssmodels.ID) error {
+	return r.write(func(s *state, now time.Time) error {
+		rec, ok := s.operations[id]
+		if !ok {
+			return stacktrace.NewError("Could not delete Operation that does not exist")
+		}
+		s.recordEntityChange(scdmodels.EntityTypeOperationalIntent, id, scdmodels.EntityChangeDeleted, rec.op.Manager, rec.op.Version, rec.op.Cells, now)
+		delete(s.operations, id)
+		return nil
+	})
+}
+
+// UpsertOperationalIntent implements
+// repos.OperationalIntent.UpsertOperationalIntent.
+func (r *repo) UpsertOperationalIntent(ctx context.Context, operation *scdmodels.OperationalIntent) (*scdmodels.OperationalIntent, error) {
+	var result *scdmodels.OperationalIntent
+	err := r.write(func(s *state, now time.Time) error {
+		if err := validateExtents(operation.StartTime, operation.EndTime); err != nil {
+			return stacktrace.Propagate(err, "Invalid Operation")
+		}
+		if !operation.SubscriptionID.Empty() {
+			if _, ok := s.subscriptions[operation.SubscriptionID]; !ok {
+				return stacktrace.NewError("Subscription %s of Operation does not exist", operation.SubscriptionID)
+			}
+		}
+		if operation.Priority < 0 {
+			return stacktrace.NewError("Operation priority must not be negative")
+		}
+		rec := &operationRecord{
+			op: scdmodels.OperationalIntent{
+				ID:              operation.ID,
+				Manager:         operation.Manager,
+				Version:         operation.Version,
+				State:           operation.State,
+				StartTime:       copyTime(operation.StartTime),
+				EndTime:         copyTime(operation.EndTime),
+				USSBaseURL:      operation.USSBaseURL,
+				SubscriptionID:  operation.SubscriptionID,
+				AltitudeLower:   copyFloat(operation.AltitudeLower),
+				AltitudeUpper:   copyFloat(operation.AltitudeUpper),
+				Cells:           append(s2.CellUnion{}, operation.Cells...),
+				Priority:        operation.Priority,
+				OffNominalSince: copyTime(operation.OffNominalSince),
+			},
+			updatedAt: now,
+		}
+		s.operations[operation.ID] = rec
+
+		change := scdmodels.EntityChangeUpdated
+		if operation.Version == 1 {
+			change = scdmodels.EntityChangeCreated
+		}
+		s.recordEntityChange(scdmodels.EntityTypeOperationalIntent, operation.ID, change, rec.op.Manager, rec.op.Version, rec.op.Cells, now)
+		result = rec.toModel()
+		return nil
+	})
+	return result, err
+}
+
+// SearchOperationalIntents implements
+// repos.OperationalIntent.SearchOperationalIntents.
+func (r *repo) SearchOperationalIntents(ctx context.Context, v4d *dssmodels.Volume4D) ([]*scdmodels.OperationalIntent, error) {
+	if v4d.SpatialVolume == nil || v4d.SpatialVolume.Footprint == nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Missing geospatial footprint for query")
+	}
+	cells, err := v4d.SpatialVolume.Footprint.CalculateCovering()
+	if err != nil {
+		return nil, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Failed to calculate footprint covering")
+	}
+	if len(cells) == 0 {
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Missing cell IDs for query")
+	}
+
+	return r.listOperationalIntents(func(rec *operationRecord) bool {
+		return intersects(rec.op.Cells, cells) &&
+			overlaps(rec.op.AltitudeLower, rec.op.AltitudeUpper, v4d.SpatialVolume.AltitudeLo, v4d.SpatialVolume.AltitudeHi) &&
+			during(rec.op.StartTime, rec.op.EndTime, v4d.StartTime, v4d.EndTime)
+	}, dssmodels.MaxResultLimit)
+}
+
+// GetDependentOperationalIntents implements
+// repos.OperationalIntent.GetDependentOperationalIntents.
+func (r *repo) GetDependentOperationalIntents(ctx context.Context, subscriptionID dssmodels.ID) ([]dssmodels.ID, error) {
+	ops, err := r.listOperationalIntents(func(rec *operationRecord) bool {
+		return rec.op.SubscriptionID == subscriptionID
+	}, 0)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	var ids []dssmodels.ID
+	for _, op := range ops {
+		ids = append(ids, op.ID)
+	}
+	return ids, nil
+}
+
+// ListActiveOperationalIntents implements
+// repos.OperationalIntent.ListActiveOperationalIntents.
+func (r *repo) ListActiveOperationalIntents(ctx context.Context, t time.Time) ([]*scdmodels.OperationalIntent, error) {
+	return r.listOperationalIntents(func(rec *operationRecord) bool {
+		return rec.op.EndTime == nil || !rec.op.EndTime.Before(t)
+	}, dssmodels.MaxResultLimit)
+}
+
+// ExpireOperationalIntent implements
+// repos.OperationalIntent.ExpireOperationalIntent.
+func (r *repo) ExpireOperationalIntent(ctx context.Context, id dssmodels.ID, t time.Time) error {
+	return r.write(func(s *state, now time.Time) error {
+		old, ok := s.operations[id]
+		if !ok {
+			return stacktrace.NewError("Could not expire Operation that does not exist")
+		}
+		rec := *old
+		endTime := t
+		rec.op.EndTime = &endTime
+		if rec.op.StartTime == nil || t.Before(*rec.op.StartTime) {
+			startTime := t
+			rec.op.StartTime = &startTime
+		}
+		rec.updatedAt = now
+		s.operations[id] = &rec
+		s.recordEntityChange(scdmodels.EntityTypeOperationalIntent, id, scdmodels.EntityChangeUpdated, rec.op.Manager, rec.op.Version, rec.op.Cells, now)
+		return nil
+	})
+}
+
+// ListOperationalIntentsByManager implements
+// repos.OperationalIntent.ListOperationalIntentsByManager.
+func (r *repo) ListOperationalIntentsByManager(ctx context.Context, manager dssmodels.Manager, after dssmodels.ID, limit int) ([]*scdmodels.OperationalIntent, error) {
+	return r.listOperationalIntents(func(rec *operationRecord) bool {
+		return rec.op.Manager == manager && rec.op.ID > after
+	}, limit)
+}
+
+// ListExpiredOperationalIntents implements
+// repos.OperationalIntent.ListExpiredOperationalIntents.
+func (r *repo) ListExpiredOperationalIntents(ctx context.Context, t time.Time, limit int) ([]*scdmodels.OperationalIntent, error) {
+	return r.listOperationalIntents(func(rec *operationRecord) bool {
+		return rec.op.EndTime != nil && rec.op.EndTime.Before(t)
+	}, limit)
+}
+
+// listOperationalIntents returns the OperationalIntents matching filter, in
+// ID order, up to limit OperationalIntents unless limit is 0.
+func (r *repo) listOperationalIntents(filter func(rec *operationRecord) bool, limit int) ([]*scdmodels.OperationalIntent, error) {
+	var result []*scdmodels.OperationalIntent
+	err := r.read(func(s *state) error {
+		for _, rec := range s.operations {
+			if filter(rec) {
+				result = append(result, rec.toModel())
+			}
+		}
+		return nil
+	})
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+	return result, err
+}
@@ -0,0 +1,262 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/geo/s2"
+	"github.com/interuss/dss/pkg/logging"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	scdmodels "github.com/interuss/dss/pkg/scd/models"
+	"github.com/interuss/dss/pkg/scd/repos"
+	scdstore "github.com/interuss/dss/pkg/scd/store"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	_ scdstore.Store = &Store{}
+
+	fakeClock = clockwork.NewFakeClock()
+	cells     = s2.CellUnion{s2.CellID(uint64(8768904281496485888))}
+	subID     = dssmodels.ID("78ea3fe8-71c2-4f5c-9b44-9c02f5563c6f")
+	opID      = dssmodels.ID("0b8e2a76-5b8e-4d7c-8ac2-5a7bd8e0c1a2")
+)
+
+func setUpStore() *Store {
+	fakeClock = clockwork.NewFakeClock()
+	s := NewStore(logging.Logger)
+	s.clock = fakeClock
+	return s
+}
+
+func newSubscription() *scdmodels.Subscription {
+	return &scdmodels.Subscription{
+		ID:                          subID,
+		Manager:                     dssmodels.Manager("uss1"),
+		USSBaseURL:                  "https://uss1.example.com",
+		NotifyForOperationalIntents: true,
+		ImplicitSubscription:        true,
+		Cells:                       cells,
+	}
+}
+
+func newOperationalIntent() *scdmodels.OperationalIntent {
+	startTime := fakeClock.Now()
+	endTime := startTime.Add(time.Hour)
+	return &scdmodels.OperationalIntent{
+		ID:             opID,
+		Manager:        dssmodels.Manager("uss1"),
+		Version:        1,
+		State:          scdmodels.OperationalIntentStateAccepted,
+		StartTime:      &startTime,
+		EndTime:        &endTime,
+		USSBaseURL:     "https://uss1.example.com",
+		SubscriptionID: subID,
+		Cells:          cells,
+	}
+}
+
+func TestTransactionRollsBackOnError(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		store = setUpStore()
+		fail  = errors.New("intended failure")
+	)
+
+	err := store.Transact(ctx, func(ctx context.Context, r repos.Repository) error {
+		_, err := r.UpsertSubscription(ctx, newSubscription())
+		require.NoError(t, err)
+		return fail
+	})
+	require.Equal(t, fail, err)
+
+	r, err := store.Interact(ctx)
+	require.NoError(t, err)
+	sub, err := r.GetSubscription(ctx, subID)
+	require.NoError(t, err)
+	require.Nil(t, sub)
+}
+
+func TestTransactionRetriesOnConflict(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		store    = setUpStore()
+		attempts = 0
+	)
+
+	err := store.Transact(ctx, func(ctx context.Context, r repos.Repository) error {
+		attempts++
+		sub, err := r.GetSubscription(ctx, subID)
+		require.NoError(t, err)
+		if sub == nil {
+			// A concurrent transaction creates the Subscription, so that this
+			// transaction conflicts with it.
+			require.NoError(t, store.Transact(ctx, func(ctx context.Context, r repos.Repository) error {
+				_, err := r.UpsertSubscription(ctx, newSubscription())
+				return err
+			}))
+		}
+		_, err = r.UpsertOperationalIntent(ctx, newOperationalIntent())
+		return err
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, attempts)
+}
+
+func TestOperationalIntentRequiresSubscription(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		store = setUpStore()
+	)
+	r, err := store.Interact(ctx)
+	require.NoError(t, err)
+
+	_, err = r.UpsertOperationalIntent(ctx, newOperationalIntent())
+	require.Error(t, err)
+
+	_, err = r.UpsertSubscription(ctx, newSubscription())
+	require.NoError(t, err)
+	op, err := r.UpsertOperationalIntent(ctx, newOperationalIntent())
+	require.NoError(t, err)
+	require.NotEmpty(t, op.OVN)
+
+	// Deleting the Subscription deletes the OperationalIntents depending on it
+	require.NoError(t, r.DeleteSubscription(ctx, subID))
+	op, err = r.GetOperationalIntent(ctx, opID)
+	require.NoError(t, err)
+	require.Nil(t, op)
+}
+
+func TestEntityChangesAreRecorded(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		store = setUpStore()
+	)
+	r, err := store.Interact(ctx)
+	require.NoError(t, err)
+
+	_, err = r.UpsertSubscription(ctx, newSubscription())
+	require.NoError(t, err)
+	_, err = r.UpsertOperationalIntent(ctx, newOperationalIntent())
+	require.NoError(t, err)
+	require.NoError(t, r.DeleteOperationalIntent(ctx, opID))
+
+	changes, err := r.SearchEntityChanges(ctx, cells, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	require.Equal(t, scdmodels.EntityChangeCreated, changes[0].Change)
+	require.Equal(t, scdmodels.EntityChangeDeleted, changes[1].Change)
+
+	changes, err = r.SearchEntityChanges(ctx, cells, changes[0].Cursor, 10)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+}
+
+func TestIncrementNotificationIndices(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		store = setUpStore()
+	)
+	r, err := store.Interact(ctx)
+	require.NoError(t, err)
+
+	_, err = r.UpsertSubscription(ctx, newSubscription())
+	require.NoError(t, err)
+
+	indices, err := r.IncrementNotificationIndices(ctx, []dssmodels.ID{subID})
+	require.NoError(t, err)
+	require.Equal(t, []int{1}, indices)
+
+	_, err = r.IncrementNotificationIndices(ctx, []dssmodels.ID{subID, opID})
+	require.Error(t, err)
+
+	sub, err := r.GetSubscription(ctx, subID)
+	require.NoError(t, err)
+	require.Equal(t, 1, sub.NotificationIndex)
+}
+
+func TestListExpiredEntities(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		store = setUpStore()
+	)
+
+	sub := newSubscription()
+	subEnd := fakeClock.Now().Add(30 * time.Minute)
+	sub.EndTime = &subEnd
+	r, err := store.Interact(ctx)
+	require.NoError(t, err)
+	_, err = r.UpsertSubscription(ctx, sub)
+	require.NoError(t, err)
+	_, err = r.UpsertOperationalIntent(ctx, newOperationalIntent())
+	require.NoError(t, err)
+
+	// Neither entity has ended yet
+	later := fakeClock.Now().Add(45 * time.Minute)
+	ops, err := r.ListExpiredOperationalIntents(ctx, later, 10)
+	require.NoError(t, err)
+	require.Empty(t, ops)
+
+	// The Subscription ended, but the OperationalIntent still depends on it
+	subs, err := r.ListExpiredSubscriptions(ctx, later, 10)
+	require.NoError(t, err)
+	require.Empty(t, subs)
+
+	afterEnd := fakeClock.Now().Add(2 * time.Hour)
+	ops, err = r.ListExpiredOperationalIntents(ctx, afterEnd, 10)
+	require.NoError(t, err)
+	require.Len(t, ops, 1)
+	require.NoError(t, r.DeleteOperationalIntent(ctx, ops[0].ID))
+
+	subs, err = r.ListExpiredSubscriptions(ctx, afterEnd, 10)
+	require.NoError(t, err)
+	require.Len(t, subs, 1)
+	require.Equal(t, subID, subs[0].ID)
+}
+
+func TestClaimNotificationDeliveries(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		store = setUpStore()
+	)
+	r, err := store.Interact(ctx)
+	require.NoError(t, err)
+
+	delivery := &scdmodels.NotificationDelivery{
+		ID:             dssmodels.ID("3c0e1d58-7a6b-4b1e-9f0e-1b7f2d3c4e5f"),
+		SubscriptionID: subID,
+		Manager:        dssmodels.Manager("uss1"),
+		EntityID:       opID,
+		URL:            "https://uss1.example.com/uss/v1/constraints",
+		Status:         scdmodels.NotificationDeliveryStatusPending,
+	}
+	require.NoError(t, r.InsertNotificationDeliveries(ctx, []*scdmodels.NotificationDelivery{delivery}))
+
+	// Unclaimed deliveries are claimed right away
+	claimed, err := r.ClaimNotificationDeliveries(ctx, time.Minute, 10)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	require.Equal(t, delivery.ID, claimed[0].ID)
+
+	// Claimed deliveries are only claimed again once stale
+	claimed, err = r.ClaimNotificationDeliveries(ctx, time.Minute, 10)
+	require.NoError(t, err)
+	require.Empty(t, claimed)
+
+	fakeClock.Advance(2 * time.Minute)
+	claimed, err = r.ClaimNotificationDeliveries(ctx, time.Minute, 10)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+
+	// Delivered notifications are never claimed
+	claimed[0].Status = scdmodels.NotificationDeliveryStatusDelivered
+	claimed[0].Attempts = 1
+	require.NoError(t, r.UpdateNotificationDelivery(ctx, claimed[0]))
+	fakeClock.Advance(2 * time.Minute)
+	claimed, err = r.ClaimNotificationDeliveries(ctx, time.Minute, 10)
+	require.NoError(t, err)
+	require.Empty(t, claimed)
+}
@@ -0,0 +1,213 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/golang/geo/s2"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	scdmodels "github.com/interuss/dss/pkg/scd/models"
+	"github.com/interuss/stacktrace"
+)
+
+// subscriptionRecord is a stored Subscription.
+type subscriptionRecord struct {
+	sub       scdmodels.Subscription
+	updatedAt time.Time
+}
+
+// toModel returns a copy of the Subscription of r, as it would be read from
+// the database.
+func (r *subscriptionRecord) toModel() *scdmodels.Subscription {
+	sub := r.sub
+	sub.Cells = append(s2.CellUnion{}, r.sub.Cells...)
+	sub.StartTime = copyTime(r.sub.StartTime)
+	sub.EndTime = copyTime(r.sub.EndTime)
+	sub.Version = scdmodels.NewOVNFromTime(r.updatedAt, sub.ID.String())
+	return &sub
+}
+
+// GetSubscription implements repos.Subscription.GetSubscription.
+func (r *repo) GetSubscription(ctx context.Context, id dssmodels.ID) (*scdmodels.Subscription, error) {
+	var result *scdmodels.Subscription
+	err := r.read(func(s *state) error {
+		if rec, ok := s.subscriptions[id]; ok {
+			result = rec.toModel()
+		}
+		return nil
+	})
+	return result, err
+}
+
+// UpsertSubscription implements repos.Subscription.UpsertSubscription.
+func (r *repo) UpsertSubscription(ctx context.Context, sub *scdmodels.Subscription) (*scdmodels.Subscription, error) {
+	var result *scdmodels.Subscription
+	err := r.write(func(s *state, now time.Time) error {
+		if err := validateExtents(sub.StartTime, sub.EndTime); err != nil {
+			return stacktrace.Propagate(err, "Invalid Subscription")
+		}
+		if !sub.NotifyForOperationalIntents && !sub.NotifyForConstraints {
+			return stacktrace.NewError("Subscription must notify for Operations or Constraints")
+		}
+		rec := &subscriptionRecord{
+			sub: scdmodels.Subscription{
+				ID:                          sub.ID,
+				NotificationIndex:           sub.NotificationIndex,
+				Manager:                     sub.Manager,
+				StartTime:                   copyTime(sub.StartTime),
+				EndTime:                     copyTime(sub.EndTime),
+				USSBaseURL:                  sub.USSBaseURL,
+				NotifyForOperationalIntents: sub.NotifyForOperationalIntents,
+				NotifyForConstraints:        sub.NotifyForConstraints,
+				ImplicitSubscription:        sub.ImplicitSubscription,
+				Cells:                       append(s2.CellUnion{}, sub.Cells...),
+			},
+			updatedAt: now,
+		}
+		s.subscriptions[sub.ID] = rec
+		result = rec.toModel()
+		result.Cells = sub.Cells
+		return nil
+	})
+	return result, err
+}
+
+// DeleteSubscription implements repos.Subscription.DeleteSubscription.
+// OperationalIntents depending on the Subscription are deleted with it.
+func (r *repo) DeleteSubscription(ctx context.Context, id dssmodels.ID) error {
+	return r.write(func(s *state, now time.Time) error {
+		if _, ok := s.subscriptions[id]; !ok {
+			return stacktrace.NewError("Attempted to delete non-existent Subscription")
+		}
+		delete(s.subscriptions, id)
+		for opID, op := range s.operations {
+			if op.op.SubscriptionID == id {
+				delete(s.operations, opID)
+			}
+		}
+		return nil
+	})
+}
+
+// SearchSubscriptions implements repos.Subscription.SearchSubscriptions.
+func (r *repo) SearchSubscriptions(ctx context.Context, v4d *dssmodels.Volume4D) ([]*scdmodels.Subscription, error) {
+	cells, err := v4d.CalculateSpatialCovering()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Could not calculate spatial covering")
+	}
+	if len(cells) == 0 {
+		return nil, nil
+	}
+
+	return r.listSubscriptions(func(rec *subscriptionRecord) bool {
+		return intersects(rec.sub.Cells, cells) && during(rec.sub.StartTime, rec.sub.EndTime, v4d.StartTime, v4d.EndTime)
+	}, dssmodels.MaxResultLimit)
+}
+
+// IncrementNotificationIndices implements
+// repos.Subscription.IncrementNotificationIndices.
+func (r *repo) IncrementNotificationIndices(ctx context.Context, subscriptionIds []dssmodels.ID) ([]int, error) {
+	var indices []int
+	err := r.write(func(s *state, now time.Time) error {
+		indices = nil
+		incremented := map[dssmodels.ID]int{}
+		for _, id := range subscriptionIds {
+			if _, ok := incremented[id]; ok {
+				continue
+			}
+			old, ok := s.subscriptions[id]
+			if !ok {
+				continue
+			}
+			rec := *old
+			if rec.sub.NotificationIndex >= dssmodels.MaxNotificationIndex {
+				rec.sub.NotificationIndex = 0
+			} else {
+				rec.sub.NotificationIndex++
+			}
+			incremented[id] = rec.sub.NotificationIndex
+			indices = append(indices, rec.sub.NotificationIndex)
+		}
+		if len(indices) != len(subscriptionIds) {
+			return stacktrace.NewError(
+				"Expected %d notification_index results when incrementing but got %d instead",
+				len(subscriptionIds), len(indices))
+		}
+		for id, index := range incremented {
+			rec := *s.subscriptions[id]
+			rec.sub.NotificationIndex = index
+			s.subscriptions[id] = &rec
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	return indices, nil
+}
+
+// ListSubscriptionsByManager implements
+// repos.Subscription.ListSubscriptionsByManager.
+func (r *repo) ListSubscriptionsByManager(ctx context.Context, manager dssmodels.Manager, after dssmodels.ID, limit int) ([]*scdmodels.Subscription, error) {
+	return r.listSubscriptions(func(rec *subscriptionRecord) bool {
+		return rec.sub.Manager == manager && rec.sub.ID > after
+	}, limit)
+}
+
+// ListIdleImplicitSubscriptions implements
+// repos.Subscription.ListIdleImplicitSubscriptions.
+func (r *repo) ListIdleImplicitSubscriptions(ctx context.Context, retention time.Duration, limit int) ([]*scdmodels.Subscription, error) {
+	t := r.now().Add(-retention)
+	active := map[dssmodels.ID]bool{}
+	err := r.read(func(s *state) error {
+		for _, rec := range s.operations {
+			if rec.op.EndTime == nil || !rec.op.EndTime.Before(t) {
+				active[rec.op.SubscriptionID] = true
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	return r.listSubscriptions(func(rec *subscriptionRecord) bool {
+		return rec.sub.ImplicitSubscription && !active[rec.sub.ID]
+	}, limit)
+}
+
+// ListExpiredSubscriptions implements repos.Subscription.ListExpiredSubscriptions.
+func (r *repo) ListExpiredSubscriptions(ctx context.Context, t time.Time, limit int) ([]*scdmodels.Subscription, error) {
+	dependedOn := map[dssmodels.ID]bool{}
+	err := r.read(func(s *state) error {
+		for _, rec := range s.operations {
+			dependedOn[rec.op.SubscriptionID] = true
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+	return r.listSubscriptions(func(rec *subscriptionRecord) bool {
+		return rec.sub.EndTime != nil && rec.sub.EndTime.Before(t) && !dependedOn[rec.sub.ID]
+	}, limit)
+}
+
+// listSubscriptions returns the Subscriptions matching filter, in ID order, up
+// to limit Subscriptions.
+func (r *repo) listSubscriptions(filter func(rec *subscriptionRecord) bool, limit int) ([]*scdmodels.Subscription, error) {
+	var result []*scdmodels.Subscription
+	err := r.read(func(s *state) error {
+		for _, rec := range s.subscriptions {
+			if filter(rec) {
+				result = append(result, rec.toModel())
+			}
+		}
+		return nil
+	})
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result, err
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/interuss/dss/pkg/scd/repos"
 	"github.com/interuss/stacktrace"
 	"github.com/jonboulle/clockwork"
+	"google.golang.org/grpc"
 )
 
 var (
@@ -55,6 +56,12 @@ func (a *Server) PutSubscription(ctx context.Context, subscriptionid string, ver
 	if err != nil {
 		return nil, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Unable to parse extents")
 	}
+	if extents.SpatialVolume != nil && extents.SpatialVolume.Footprint != nil {
+		// The spatial volume may otherwise be filled from a previous Subscription
+		if err := a.VolumeValidator.ValidateSpace(extents.SpatialVolume); err != nil {
+			return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+		}
+	}
 
 	// Construct requested Subscription model
 	cells, err := extents.CalculateSpatialCovering()
@@ -88,7 +95,10 @@ func (a *Server) PutSubscription(ctx context.Context, subscriptionid string, ver
 
 	// TODO: Check scopes to verify requested information (op intents or constraints) may be requested
 
-	var result *scdpb.PutSubscriptionResponse
+	var (
+		result          *scdpb.PutSubscriptionResponse
+		expiryTruncated bool
+	)
 	action := func(ctx context.Context, r repos.Repository) (err error) {
 		// Check existing Subscription (if any)
 		old, err := r.GetSubscription(ctx, subreq.ID)
@@ -97,7 +107,8 @@ func (a *Server) PutSubscription(ctx context.Context, subscriptionid string, ver
 		}
 
 		// Validate and perhaps correct StartTime and EndTime.
-		if err := subreq.AdjustTimeRange(DefaultClock.Now(), old); err != nil {
+		expiryTruncated, err = subreq.AdjustTimeRange(DefaultClock.Now(), old, a.SubscriptionLifetime)
+		if err != nil {
 			return stacktrace.Propagate(err, "Error adjusting time range of Subscription")
 		}
 
@@ -221,6 +232,12 @@ func (a *Server) PutSubscription(ctx context.Context, subscriptionid string, ver
 		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
 	}
 
+	// Report the lifetime applied to the Subscription; failing to do so does not
+	// invalidate the mutation.
+	_ = grpc.SetHeader(ctx, dssmodels.SubscriptionExpiryMetadata(*subreq.EndTime, expiryTruncated))
+	result.Expiry = result.Subscription.GetTimeEnd()
+	result.ExpiryTruncated = expiryTruncated
+
 	// Return response to client
 	return result, nil
 }
@@ -21,6 +21,39 @@ func GetDefaultAvailabilityResponse(id dssmodels.Manager) *scdpb.UssAvailability
 	}
 }
 
+// attachUssAvailabilities populates the UssAvailability of ops and constraints
+// from the availability declared by their managers.
+func attachUssAvailabilities(ctx context.Context, r repos.Repository, ops []*scdmodels.OperationalIntent, constraints []*scdmodels.Constraint) error {
+	managers := map[dssmodels.Manager]bool{}
+	for _, op := range ops {
+		managers[op.Manager] = true
+	}
+	for _, constraint := range constraints {
+		managers[constraint.Manager] = true
+	}
+	ids := make([]dssmodels.Manager, 0, len(managers))
+	for manager := range managers {
+		ids = append(ids, manager)
+	}
+
+	availabilities, err := r.GetUssAvailabilities(ctx, ids)
+	if err != nil {
+		return stacktrace.Propagate(err, "Could not get USS availabilities from repo")
+	}
+	byManager := map[dssmodels.Manager]scdmodels.UssAvailabilityState{}
+	for _, ussa := range availabilities {
+		byManager[ussa.Uss] = ussa.Availability
+	}
+
+	for _, op := range ops {
+		op.UssAvailability = byManager[op.Manager]
+	}
+	for _, constraint := range constraints {
+		constraint.UssAvailability = byManager[constraint.Manager]
+	}
+	return nil
+}
+
 func (a *Server) GetUssAvailability(ctx context.Context, request *scdpb.GetUssAvailabilityRequest) (*scdpb.UssAvailabilityStatusResponse, error) {
 	id := dssmodels.ManagerFromString(request.GetUssId())
 	if id == "" {
@@ -61,9 +94,12 @@ func (a *Server) GetUssAvailability(ctx context.Context, request *scdpb.GetUssAv
 }
 
 func (a *Server) SetUssAvailability(ctx context.Context, request *scdpb.SetUssAvailabilityRequest) (*scdpb.UssAvailabilityStatusResponse, error) {
-	return a.PutUssAvailability(ctx, request.GetUssId(), "", request.GetParams())
+	return a.PutUssAvailability(ctx, request.GetUssId(), request.GetParams().GetOldVersion(), request.GetParams())
 }
 
+// PutUssAvailability declares the availability of a USS.
+// The version argument must match the current version of the USS's
+// availability, or be empty ("") if the USS never declared its availability.
 func (a *Server) PutUssAvailability(ctx context.Context, ussID string, version string, params *scdpb.SetUssAvailabilityStatusParameters) (*scdpb.UssAvailabilityStatusResponse, error) {
 	if ussID == "" {
 		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "ussID not provided.")
@@ -80,6 +116,21 @@ func (a *Server) PutUssAvailability(ctx context.Context, ussID string, version s
 
 	var result *scdpb.UssAvailabilityStatusResponse
 	action := func(ctx context.Context, r repos.Repository) (err error) {
+		// Validate the version of the availability being replaced
+		old, err := r.GetUssAvailability(ctx, ussareq.Uss)
+		switch {
+		case err == pgx.ErrNoRows:
+			if version != "" {
+				return stacktrace.NewErrorWithCode(dsserr.VersionMismatch,
+					"Availability of USS %s was never declared and therefore is not version %s", ussID, version)
+			}
+		case err != nil:
+			return stacktrace.Propagate(err, "Could not get USS availability from repo")
+		case old.Version.String() != version:
+			return stacktrace.NewErrorWithCode(dsserr.VersionMismatch,
+				"Current version is %s but client specified version %s", old.Version, version)
+		}
+
 		ussa, err := r.UpsertUssAvailability(ctx, ussareq)
 		if err != nil {
 			return stacktrace.Propagate(err, "Could not upsert UssAvailability into repo")
@@ -0,0 +1,4 @@
+// Package tracing exports OpenTelemetry traces of the requests served by the
+// DSS over OTLP, and propagates their trace context across the gateway,
+// core-service and datastore calls.
+package tracing
@@ -0,0 +1,23 @@
+package flags
+
+import (
+	"flag"
+
+	"github.com/interuss/dss/pkg/tracing"
+)
+
+var config tracing.Config
+
+// Config returns the tracing.Config of serviceName populated from well-known
+// CLI flags.
+func Config(serviceName string) tracing.Config {
+	c := config
+	c.ServiceName = serviceName
+	return c
+}
+
+func init() {
+	flag.StringVar(&config.Endpoint, "otlp_endpoint", "", "host:port of the OpenTelemetry collector to which traces are exported over OTLP gRPC; traces are not exported when empty, though the trace context of requests is still propagated")
+	flag.BoolVar(&config.Insecure, "otlp_insecure", false, "connect to otlp_endpoint without TLS")
+	flag.Float64Var(&config.SampleRatio, "trace_sample_ratio", 0.1, "fraction of the traces started by this process which are exported; traces of requests carrying a trace context follow the sampling decision of their caller")
+}
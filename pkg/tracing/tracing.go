@@ -0,0 +1,75 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/interuss/dss/pkg/build"
+	"github.com/interuss/stacktrace"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/interuss/dss"
+
+// Config describes the export of the traces of a process.
+type Config struct {
+	// ServiceName identifies the process in the exported traces.
+	ServiceName string
+	// Endpoint is the host:port of the OTLP gRPC collector receiving the
+	// traces, which are not exported when empty.
+	Endpoint string
+	// Insecure connects to Endpoint without TLS.
+	Insecure bool
+	// SampleRatio is the fraction of the traces started by the process which
+	// are sampled; traces started upstream follow the sampling decision of
+	// their parent.
+	SampleRatio float64
+}
+
+// Init installs the global tracer provider and trace context propagator
+// described by config, and returns the function flushing and stopping the
+// export of traces.  The trace context of requests is propagated even when
+// traces are not exported.
+func Init(ctx context.Context, config Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	if config.Endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+	if config.SampleRatio < 0 || config.SampleRatio > 1 {
+		return nil, stacktrace.NewError("Trace sample ratio %g is not between 0 and 1", config.SampleRatio)
+	}
+
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(config.Endpoint)}
+	if config.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to create OTLP trace exporter for %s", config.Endpoint)
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceNameKey.String(config.ServiceName),
+		semconv.ServiceVersionKey.String(build.Describe().Commit)))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to describe trace resource")
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(config.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// Tracer returns the tracer of the spans started by the DSS itself, as
+// opposed to those of its instrumented libraries.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}